@@ -170,6 +170,7 @@ import (
 	"github.com/maany-xyz/maany-dex/v5/x/feerefunder"
 	feekeeper "github.com/maany-xyz/maany-dex/v5/x/feerefunder/keeper"
 	ibchooks "github.com/maany-xyz/maany-dex/v5/x/ibc-hooks"
+	ibchookskeeper "github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/keeper"
 	ibchookstypes "github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/types"
 	"github.com/maany-xyz/maany-dex/v5/x/interchainqueries"
 	interchainqueriesmodulekeeper "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/keeper"
@@ -199,6 +200,11 @@ import (
 
 	globalfeekeeper "github.com/maany-xyz/maany-dex/v5/x/globalfee/keeper"
 	gmpmiddleware "github.com/maany-xyz/maany-dex/v5/x/gmp"
+	gmpkeeper "github.com/maany-xyz/maany-dex/v5/x/gmp/keeper"
+	gmptypes "github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+	ibcswap "github.com/maany-xyz/maany-dex/v5/x/ibc-swap"
+	ibcswapkeeper "github.com/maany-xyz/maany-dex/v5/x/ibc-swap/keeper"
+	ibcswaptypes "github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
 
 	// Block-sdk imports
 	// blocksdkabci "github.com/skip-mev/block-sdk/v2/abci"
@@ -224,10 +230,24 @@ import (
 	gammkeeper "github.com/maany-xyz/maany-dex/v5/x/gamm/keeper"
 	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
 
+	concentratedliquidity "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity"
+	clmodule "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/clmodule"
+	cltypes "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types"
+
 	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
 	poolmanagermodule "github.com/maany-xyz/maany-dex/v5/x/poolmanager/module"
 	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
 
+	"github.com/maany-xyz/maany-dex/v5/x/twap"
+	"github.com/maany-xyz/maany-dex/v5/x/twap/twapmodule"
+	twaptypes "github.com/maany-xyz/maany-dex/v5/x/twap/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/epochs"
+	epochskeeper "github.com/maany-xyz/maany-dex/v5/x/epochs/keeper"
+	epochstypes "github.com/maany-xyz/maany-dex/v5/x/epochs/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee"
+	takerfeekeeper "github.com/maany-xyz/maany-dex/v5/x/takerfee/keeper"
 	takerfeetypes "github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
 
 	genesismintkeeper "github.com/maany-xyz/maany-dex/v5/x/genesismint/keeper"
@@ -273,7 +293,10 @@ var (
 		consensus.AppModuleBasic{},
 		// DEX essentials
 		gamm.AppModuleBasic{},
+		clmodule.AppModuleBasic{},
 		poolmanagermodule.AppModuleBasic{},
+		twapmodule.AppModuleBasic{},
+		epochs.AppModuleBasic{},
 		// -----------------------------
 		// IBC Core (+ ICS Consumer)
 		// -----------------------------
@@ -291,8 +314,13 @@ var (
 		packetforward.AppModuleBasic{},
 		feerefunder.AppModuleBasic{},
 		feeburner.AppModuleBasic{},
+		takerfee.AppModuleBasic{},
+		gmpmiddleware.AppModuleBasic{},
+		ibcswap.AppModuleBasic{},
 		contractmanager.AppModuleBasic{},
 		cron.AppModuleBasic{},
+		mintburnmodule.AppModuleBasic{},
+		genesismint.AppModuleBasic{},
 
 		globalfee.AppModule{},
 
@@ -341,6 +369,7 @@ var (
 		// feemarkettypes.FeeCollectorName:               nil,
 		mintburntypes.MintBurnModuleAccount: 		   {authtypes.Minter, authtypes.Burner},
 		gammtypes.ModuleName:                          {authtypes.Minter, authtypes.Burner},
+		cltypes.ModuleName:                            nil,
 		poolmanagertypes.ModuleName:				   {authtypes.Minter, authtypes.Burner},
 		takerfeetypes.ModuleName:					   {authtypes.Minter, authtypes.Burner},
 		genesisminttypes.ModuleName:                   {authtypes.Minter},
@@ -407,6 +436,10 @@ type App struct {
 	// FeeMarkerKeeper     *feemarketkeeper.Keeper
 	FeeKeeper           *feekeeper.Keeper
 	FeeBurnerKeeper     *feeburnerkeeper.Keeper
+	TakerFeeKeeper      *takerfeekeeper.Keeper
+	GmpKeeper           *gmpkeeper.Keeper
+	IBCSwapKeeper       *ibcswapkeeper.Keeper
+	IBCHooksKeeper      *ibchookskeeper.Keeper
 	ConsumerKeeper      ccvconsumerkeeper.Keeper
 	CronKeeper          cronkeeper.Keeper
 	PFMKeeper           *pfmkeeper.Keeper
@@ -459,7 +492,10 @@ type App struct {
 	GenesisMintKeeper genesismintkeeper.Keeper
 
 	GAMMKeeper gammkeeper.Keeper
+	ConcentratedLiquidityKeeper  *concentratedliquidity.Keeper
 	PoolManagerKeeper            *poolmanager.Keeper
+	TwapKeeper                   *twap.Keeper
+	EpochsKeeper                 *epochskeeper.Keeper
 
 
 }
@@ -540,9 +576,10 @@ func New(
 		// oracletypes.StoreKey, marketmaptypes.StoreKey, 
 		 //feemarkettypes.StoreKey, 
 		globalfeetypes.StoreKey,
-		mintburntypes.StoreKey, gammtypes.StoreKey, poolmanagertypes.StoreKey, genesisminttypes.StoreKey,
+		mintburntypes.StoreKey, gammtypes.StoreKey, cltypes.StoreKey, poolmanagertypes.StoreKey, genesisminttypes.StoreKey,
+		takerfeetypes.StoreKey, gmptypes.StoreKey, ibcswaptypes.StoreKey, twaptypes.StoreKey, epochstypes.StoreKey,
 	)
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feetypes.MemStoreKey)
 
 	app := &App{
@@ -652,17 +689,6 @@ func New(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), &app.ConsumerKeeper, app.UpgradeKeeper, scopedIBCKeeper, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
-	app.MintBurnKeeper = mintburn.NewKeeper(
-		mintburntypes.ModuleName,
-		keys[mintburntypes.StoreKey],
-		appCodec,
-		app.BankKeeper, 
-		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.ConnectionKeeper, 
-		app.IBCKeeper.ClientKeeper,
-		&app.ConsumerKeeper,
-	)
-
 	// Feekeeper needs to be initialized before middlewares injection
 	app.FeeKeeper = feekeeper.NewKeeper(
 		appCodec,
@@ -720,6 +746,7 @@ func New(
         app.ICAControllerKeeper,
         icacontrollerkeeper.NewMsgServerImpl(&app.ICAControllerKeeper),
         app.IBCKeeper.ConnectionKeeper,
+        authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
     )
 
 	app.FeeBurnerKeeper = feeburnerkeeper.NewKeeper(
@@ -831,6 +858,21 @@ func New(
 		interchainqueriesmodulekeeper.TransactionVerifier{},
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
+
+	app.MintBurnKeeper = mintburn.NewKeeper(
+		mintburntypes.ModuleName,
+		keys[mintburntypes.StoreKey],
+		appCodec,
+		app.BankKeeper, 
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ConnectionKeeper, 
+		app.IBCKeeper.ClientKeeper,
+		&app.ConsumerKeeper,
+		app.ScopedTransferKeeper,
+		app.GenesisMintKeeper,
+		&app.InterchainQueriesKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
 	app.InterchainTxsKeeper = *interchaintxskeeper.NewKeeper(
 		appCodec,
 		keys[interchaintxstypes.StoreKey],
@@ -866,8 +908,57 @@ func New(
 		keys[crontypes.StoreKey],
 		keys[crontypes.MemStoreKey],
 		app.AccountKeeper,
+		app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
+
+	// The pool keepers are created before the wasm keeper so that contracts can reach them through
+	// the custom bindings. The concentrated-liquidity keeper is created before gamm so that gamm can
+	// reference it for balancer -> CL migrations. Incentives, pool-incentives and lockup are not part of
+	// this app, so the CL paths relying on them (incentive gauges, superfluid positions) stay unused.
+	app.ConcentratedLiquidityKeeper = concentratedliquidity.NewKeeper(
+		appCodec,
+		keys[cltypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		app.GetSubspace(cltypes.ModuleName),
+	)
+
+	app.GAMMKeeper = gammkeeper.NewKeeper(
+		appCodec, keys[gammtypes.StoreKey],
+		app.GetSubspace(gammtypes.ModuleName),
+		app.AccountKeeper,
+		// TODO: Add a mintcoins restriction
+		app.BankKeeper, nil,
+		app.ConcentratedLiquidityKeeper,
+		nil,
+		nil)
+	app.PoolManagerKeeper = poolmanager.NewKeeper(keys[poolmanagertypes.StoreKey],app.GetSubspace(poolmanagertypes.ModuleName), &app.GAMMKeeper, app.ConcentratedLiquidityKeeper, nil, app.BankKeeper, app.AccountKeeper, nil, nil, nil, nil,)
+	app.GAMMKeeper.SetPoolManager(app.PoolManagerKeeper)
+	app.ConcentratedLiquidityKeeper.SetGammKeeper(app.GAMMKeeper)
+	app.ConcentratedLiquidityKeeper.SetPoolManagerKeeper(app.PoolManagerKeeper)
+	// The twap module records the pool prices on every gamm and CL pool change, and prunes its history
+	// on the day epoch. The pool manager routes to the gamm keeper by pointer so that it sees the hooks.
+	app.TwapKeeper = twap.NewKeeper(keys[twaptypes.StoreKey], tkeys[twaptypes.TransientStoreKey], app.GetSubspace(twaptypes.ModuleName), app.PoolManagerKeeper)
+	app.GAMMKeeper.SetHooks(gammtypes.NewMultiGammHooks(app.TwapKeeper.GammHooks()))
+	app.ConcentratedLiquidityKeeper.SetListeners(cltypes.NewConcentratedLiquidityListeners(app.TwapKeeper.ConcentratedLiquidityListener()))
+	app.EpochsKeeper = epochskeeper.NewKeeper(keys[epochstypes.StoreKey])
+	app.EpochsKeeper.SetHooks(epochstypes.NewMultiEpochHooks(app.TwapKeeper.EpochHooks()))
+	// account volume is priced with the twap only, as it sets the taker fee discounts
+	app.PoolManagerKeeper.SetTwapKeeper(app.TwapKeeper)
+	// the rate limiter values the transfers through channels with a channel rate limit at their twap
+	app.RateLimitingICS4Wrapper.IbcratelimitKeeper.SetTwapKeeper(app.TwapKeeper)
+	app.IBCSwapKeeper.SetPoolManagerKeeper(app.PoolManagerKeeper)
+	// relayer fees can be paid in fee denoms priced at their twap and converted through the pool manager
+	app.FeeKeeper.SetPoolManagerKeeper(app.PoolManagerKeeper)
+	app.FeeKeeper.SetTwapKeeper(app.TwapKeeper)
+
     wasmOpts = append(wasmbinding.RegisterCustomPlugins(
         &app.InterchainTxsKeeper,
         &app.InterchainQueriesKeeper,
@@ -882,6 +973,10 @@ func New(
         // app.OracleKeeper,
         // app.MarketMapKeeper,
         nil,
+        app.PoolManagerKeeper,
+        &app.GAMMKeeper,
+        app.ConcentratedLiquidityKeeper,
+        app.TwapKeeper,
     ), wasmOpts...)
 
 	queryPlugins := wasmkeeper.WithQueryPlugins(
@@ -915,21 +1010,18 @@ func New(
 	app.CronKeeper.WasmMsgServer = wasmkeeper.NewMsgServerImpl(&app.WasmKeeper)
 	cronModule := cron.NewAppModule(appCodec, app.CronKeeper)
 
-	app.GAMMKeeper = gammkeeper.NewKeeper(
-		appCodec, keys[gammtypes.StoreKey],
-		app.GetSubspace(gammtypes.ModuleName),
+
+	app.TakerFeeKeeper = takerfeekeeper.NewKeeper(
+		appCodec,
+		keys[takerfeetypes.StoreKey],
 		app.AccountKeeper,
-		// TODO: Add a mintcoins restriction
-		app.BankKeeper, nil,
-		nil,
-		nil,
-		nil)
-	app.PoolManagerKeeper = poolmanager.NewKeeper(keys[poolmanagertypes.StoreKey],app.GetSubspace(poolmanagertypes.ModuleName), app.GAMMKeeper, nil, nil, app.BankKeeper, app.AccountKeeper, nil, nil, nil, nil,)
-	app.GAMMKeeper.SetPoolManager(app.PoolManagerKeeper)
+		app.BankKeeper,
+		app.PoolManagerKeeper,
+		func(ctx sdk.Context) string { return app.FeeBurnerKeeper.GetParams(ctx).TreasuryAddress },
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+	app.TakerFeeKeeper.SetTwapKeeper(app.TwapKeeper)
 
-	gamm.NewAppModule(appCodec, app.GAMMKeeper, app.AccountKeeper, app.BankKeeper)
-	poolmanagermodule.NewAppModule(*app.PoolManagerKeeper,app.GAMMKeeper)
-	
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 
@@ -963,7 +1055,9 @@ func New(
 	app.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
 
 	app.RateLimitingICS4Wrapper.ContractKeeper = app.ContractKeeper
+	app.ConcentratedLiquidityKeeper.SetContractKeeper(app.ContractKeeper)
 	app.Ics20WasmHooks.ContractKeeper = &app.WasmKeeper
+	app.GmpKeeper.SetContractKeeper(app.ContractKeeper)
 
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
@@ -1022,8 +1116,13 @@ func New(
 		mintburnmodule.NewAppModule(appCodec, app.MintBurnKeeper),
 		genesismint.NewAppModule(appCodec, app.GenesisMintKeeper),
 		gamm.NewAppModule(appCodec, app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		clmodule.NewAppModule(appCodec, *app.ConcentratedLiquidityKeeper),
 		poolmanagermodule.NewAppModule(*app.PoolManagerKeeper, app.GAMMKeeper),
-
+		twapmodule.NewAppModule(*app.TwapKeeper),
+		epochs.NewAppModule(*app.EpochsKeeper),
+		takerfee.NewAppModule(appCodec, *app.TakerFeeKeeper),
+		gmpmiddleware.NewAppModule(appCodec, *app.GmpKeeper),
+		ibcswap.NewAppModule(appCodec, *app.IBCSwapKeeper),
 	)
 
 	app.mm.SetOrderPreBlockers(
@@ -1070,8 +1169,10 @@ func New(
 		// dextypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		gammtypes.ModuleName,
+		cltypes.ModuleName,
 		poolmanagertypes.ModuleName,
-
+		takerfeetypes.ModuleName,
+		epochstypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -1109,8 +1210,12 @@ func New(
 		// dextypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		gammtypes.ModuleName,
+		cltypes.ModuleName,
 		poolmanagertypes.ModuleName,
-
+		takerfeetypes.ModuleName,
+		mintburntypes.ModuleName,
+		// after every pool change of the block
+		twaptypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -1154,7 +1259,13 @@ func New(
 		consensusparamtypes.ModuleName,
 		mintburntypes.ModuleName,
 		gammtypes.ModuleName,
+		cltypes.ModuleName,
 		poolmanagertypes.ModuleName,
+		twaptypes.ModuleName,
+		epochstypes.ModuleName,
+		takerfeetypes.ModuleName,
+		gmptypes.ModuleName,
+		ibcswaptypes.ModuleName,
 		genesisminttypes.ModuleName,
 	)
 
@@ -1586,6 +1697,9 @@ func (app *App) BlockedAddrs() map[string]bool {
 	bankBlockedAddrs := app.ModuleAccountAddrs()
 	delete(bankBlockedAddrs, authtypes.NewModuleAddress(
 		ccvconsumertypes.ConsumerToSendToProviderName).String())
+	// The cron module account is the treasury that schedules dispatch sdk msgs from,
+	// so it has to be able to receive the funds they spend
+	delete(bankBlockedAddrs, authtypes.NewModuleAddress(crontypes.ModuleName).String())
 
 	return bankBlockedAddrs
 }
@@ -1693,6 +1807,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(interchaintxstypes.StoreKey).WithKeyTable(interchaintxstypes.ParamKeyTable())
 	paramsKeeper.Subspace(gammtypes.StoreKey).WithKeyTable(gammtypes.ParamKeyTable())
 	paramsKeeper.Subspace(poolmanagertypes.StoreKey).WithKeyTable(poolmanagertypes.ParamKeyTable())
+	paramsKeeper.Subspace(cltypes.StoreKey).WithKeyTable(cltypes.ParamKeyTable())
+	paramsKeeper.Subspace(twaptypes.ModuleName).WithKeyTable(twaptypes.ParamKeyTable())
 
 
 	return paramsKeeper
@@ -1757,14 +1873,14 @@ func overrideWasmVariables() {
 // * SendPacket. Originates from the transferKeeper and goes up the stack:
 // transferKeeper.SendPacket -> ibc_rate_limit.SendPacket -> ibc_hooks.SendPacket -> channel.SendPacket
 // * RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-// channel.RecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> gmp.OnRecvPacket -> pfm.OnRecvPacket -> transfer.OnRecvPacket
+// channel.RecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> gmp.OnRecvPacket -> ibc_swap.OnRecvPacket -> pfm.OnRecvPacket -> transfer.OnRecvPacket
 //
 // Note that the forward middleware is only integrated on the "receive" direction. It can be safely skipped when sending.
 // Note also that the forward middleware is called "router", but we are using the name "pfm" (packet forward middleware) for clarity
 // This may later be renamed upstream: https://github.com/ibc-apps/middleware/packet-forward-middleware/issues/10
 //
-// After this, the wasm keeper is required to be set on both
-// app.Ics20WasmHooks AND app.RateLimitingICS4Wrapper
+// After this, the wasm keeper is required to be set on
+// app.Ics20WasmHooks, app.RateLimitingICS4Wrapper AND app.GmpKeeper
 func (app *App) WireICS20PreWasmKeeper(
 	appCodec codec.Codec,
 ) {
@@ -1780,7 +1896,15 @@ func (app *App) WireICS20PreWasmKeeper(
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
-	wasmHooks := ibchooks.NewWasmHooks(nil, sdk.GetConfig().GetBech32AccountAddrPrefix()) // The contract keeper needs to be set later
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(app.keys[ibchookstypes.StoreKey])
+	wasmHooks := ibchooks.NewWasmHooks(
+		app.IBCHooksKeeper,
+		nil, // The contract keeper needs to be set later
+		contractmanager.NewSudoLimitWrapper(app.ContractManagerKeeper, &app.WasmKeeper),
+		sdk.GetConfig().GetBech32AccountAddrPrefix(),
+		// cron sends transfers on behalf of contracts, which can ask for their ibc_callback through it
+		[]sdk.AccAddress{authtypes.NewModuleAddress(crontypes.ModuleName)},
+	)
 	app.Ics20WasmHooks = &wasmHooks
 	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
 		app.IBCKeeper.ChannelKeeper,
@@ -1788,7 +1912,7 @@ func (app *App) WireICS20PreWasmKeeper(
 		&wasmHooks,
 	)
 
-	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(appCodec, app.keys[ibcratelimittypes.ModuleName], authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
+	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(appCodec, app.keys[ibcratelimittypes.ModuleName], app.BankKeeper, authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String())
 	// ChannelKeeper wrapper for rate limiting SendPacket(). The wasmKeeper needs to be added after it's created
 	rateLimitingICS4Wrapper := ibcratelimit.NewICS4Middleware(
 		app.HooksICS4Wrapper,
@@ -1818,6 +1942,17 @@ func (app *App) WireICS20PreWasmKeeper(
 
 	app.PFMKeeper.SetTransferKeeper(app.TransferKeeper.Keeper)
 
+	app.GmpKeeper = gmpkeeper.NewKeeper(
+		appCodec,
+		app.keys[gmptypes.StoreKey],
+		&app.WasmKeeper, // the wasm and contract keepers are set later
+		app.TransferKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
+	// the pool manager keeper is set later
+	app.IBCSwapKeeper = ibcswapkeeper.NewKeeper(appCodec, app.keys[ibcswaptypes.StoreKey], app.BankKeeper, app.TransferKeeper.Keeper)
+
 	// Packet Forward Middleware
 	// Initialize packet forward middleware router
 	var ibcStack ibcporttypes.IBCModule = packetforward.NewIBCMiddleware(
@@ -1832,7 +1967,8 @@ func (app *App) WireICS20PreWasmKeeper(
 		pfmkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
 
-	ibcStack = gmpmiddleware.NewIBCMiddleware(ibcStack)
+	ibcStack = ibcswap.NewIBCMiddleware(ibcStack, app.IBCSwapKeeper)
+	ibcStack = gmpmiddleware.NewIBCMiddleware(ibcStack, app.GmpKeeper)
 	// RateLimiting IBC Middleware
 	rateLimitingTransferModule := ibcratelimit.NewIBCModule(ibcStack, app.RateLimitingICS4Wrapper)
 
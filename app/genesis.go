@@ -8,6 +8,9 @@ import (
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	cltypes "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types"
+	clgenesis "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types/genesis"
 )
 
 var FeeDenom = "untrn"
@@ -52,5 +55,11 @@ func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
 	}
 	genesisState["feemarket"] = feemarketFeeGenesisStateBytes
 
+	// concentrated liquidity pools can be created through the poolmanager by anyone,
+	// within the authorized tick spacings, spread factors and quote denoms
+	clGenesis := clgenesis.DefaultGenesis()
+	clGenesis.Params.IsPermissionlessPoolCreationEnabled = true
+	genesisState[cltypes.ModuleName] = cdc.MustMarshalJSON(clGenesis)
+
 	return genesisState
 }
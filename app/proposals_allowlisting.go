@@ -28,6 +28,10 @@ import (
     feerefundertypes "github.com/maany-xyz/maany-dex/v5/x/feerefunder/types"
     interchainqueriestypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
     interchaintxstypes "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/types"
+    takerfeetypes "github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+    gmptypes "github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+    genesisminttypes "github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+    mintburntypes "github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
 )
 
 func IsConsumerProposalAllowlisted(content govtypes.Content) bool {
@@ -71,6 +75,16 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
         *interchaintxstypes.MsgUpdateParams,
         *feeburnertypes.MsgUpdateParams,
         *feerefundertypes.MsgUpdateParams,
+        *takerfeetypes.MsgUpdateParams,
+        *gmptypes.MsgUpdateParams,
+        *mintburntypes.MsgUpdateParams,
+        *mintburntypes.MsgPause,
+        *mintburntypes.MsgUnpause,
+        *mintburntypes.MsgRegisterEscrowQuery,
+        *mintburntypes.MsgReconcileMirrorSupply,
+        *mintburntypes.MsgRetryPendingBurn,
+        *genesisminttypes.MsgRetryClaim,
+        *genesisminttypes.MsgUpdateIcaConfig,
         *crontypes.MsgUpdateParams,
         *crontypes.MsgAddSchedule,
         *crontypes.MsgRemoveSchedule,
@@ -89,7 +103,10 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
         *feemarkettypes.MsgParams,
         *ibctransfertypes.MsgUpdateParams,
         *globalfeetypes.MsgUpdateParams,
-        *ibcratelimittypes.MsgUpdateParams:
+        *ibcratelimittypes.MsgUpdateParams,
+        *ibcratelimittypes.MsgSetRateLimit,
+        *ibcratelimittypes.MsgRemoveRateLimit,
+        *ibcratelimittypes.MsgResetRateLimitFlow:
         return true
     }
 	return false
@@ -8,20 +8,21 @@ import (
 	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
 	db2 "github.com/cosmos/cosmos-db"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/maany-xyz/maany-dex/v5/app/params"
 	"github.com/maany-xyz/maany-dex/v5/x/cron/keeper"
 	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
 )
 
-func CronKeeper(t testing.TB, wasmMsgServer types.WasmMsgServer, accountKeeper types.AccountKeeper) (*keeper.Keeper, sdk.Context) {
+func CronKeeper(t testing.TB, wasmMsgServer types.WasmMsgServer, accountKeeper types.AccountKeeper, router types.MessageRouter) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -31,7 +32,10 @@ func CronKeeper(t testing.TB, wasmMsgServer types.WasmMsgServer, accountKeeper t
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
+	registry := params.MakeEncodingConfig().InterfaceRegistry
+	// bank msgs are registered to be used as sdk msgs of schedules in tests
+	types.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	k := keeper.NewKeeper(
@@ -39,6 +43,7 @@ func CronKeeper(t testing.TB, wasmMsgServer types.WasmMsgServer, accountKeeper t
 		storeKey,
 		memStoreKey,
 		accountKeeper,
+		router,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 	k.WasmMsgServer = wasmMsgServer
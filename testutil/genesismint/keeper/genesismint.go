@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	metrics2 "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	db2 "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+func GenesisMintKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return GenesisMintKeeperWithDeps(t, nil, nil, nil)
+}
+
+func GenesisMintKeeperWithDeps(
+	t testing.TB,
+	bankKeeper keeper.BankKeeper,
+	icaCtrlKeeper keeper.ICAControllerKeeper,
+	icaMsgServer keeper.ICAControllerMsgServer,
+) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := db2.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics2.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		bankKeeper,
+		nil,
+		icaCtrlKeeper,
+		icaMsgServer,
+		nil,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	return k, ctx
+}
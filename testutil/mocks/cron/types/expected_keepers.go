@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/CosmWasm/wasmd/x/wasm/types"
+	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	types0 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -87,3 +88,40 @@ func (mr *MockWasmMsgServerMockRecorder) ExecuteContract(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteContract", reflect.TypeOf((*MockWasmMsgServer)(nil).ExecuteContract), arg0, arg1)
 }
+
+// MockMessageRouter is a mock of MessageRouter interface.
+type MockMessageRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageRouterMockRecorder
+}
+
+// MockMessageRouterMockRecorder is the mock recorder for MockMessageRouter.
+type MockMessageRouterMockRecorder struct {
+	mock *MockMessageRouter
+}
+
+// NewMockMessageRouter creates a new mock instance.
+func NewMockMessageRouter(ctrl *gomock.Controller) *MockMessageRouter {
+	mock := &MockMessageRouter{ctrl: ctrl}
+	mock.recorder = &MockMessageRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageRouter) EXPECT() *MockMessageRouterMockRecorder {
+	return m.recorder
+}
+
+// Handler mocks base method.
+func (m *MockMessageRouter) Handler(msg types0.Msg) baseapp.MsgServiceHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler", msg)
+	ret0, _ := ret[0].(baseapp.MsgServiceHandler)
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockMessageRouterMockRecorder) Handler(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockMessageRouter)(nil).Handler), msg)
+}
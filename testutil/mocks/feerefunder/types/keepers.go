@@ -7,10 +7,13 @@ package mock_types
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	gomock "github.com/golang/mock/gomock"
+	osmomath "github.com/maany-xyz/maany-dex/v5/osmomath"
 )
 
 // MockAccountKeeper is a mock of AccountKeeper interface.
@@ -166,3 +169,80 @@ func (mr *MockChannelKeeperMockRecorder) GetChannel(ctx, srcPort, srcChan interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChannelKeeper)(nil).GetChannel), ctx, srcPort, srcChan)
 }
+
+// MockPoolManagerKeeper is a mock of PoolManagerKeeper interface.
+type MockPoolManagerKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoolManagerKeeperMockRecorder
+}
+
+// MockPoolManagerKeeperMockRecorder is the mock recorder for MockPoolManagerKeeper.
+type MockPoolManagerKeeperMockRecorder struct {
+	mock *MockPoolManagerKeeper
+}
+
+// NewMockPoolManagerKeeper creates a new mock instance.
+func NewMockPoolManagerKeeper(ctrl *gomock.Controller) *MockPoolManagerKeeper {
+	mock := &MockPoolManagerKeeper{ctrl: ctrl}
+	mock.recorder = &MockPoolManagerKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPoolManagerKeeper) EXPECT() *MockPoolManagerKeeperMockRecorder {
+	return m.recorder
+}
+
+// SwapExactAmountIn mocks base method.
+func (m *MockPoolManagerKeeper) SwapExactAmountIn(ctx types.Context, sender types.AccAddress, poolId uint64, tokenIn types.Coin, tokenOutDenom string, tokenOutMinAmount math.Int) (math.Int, types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapExactAmountIn", ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(types.Coin)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SwapExactAmountIn indicates an expected call of SwapExactAmountIn.
+func (mr *MockPoolManagerKeeperMockRecorder) SwapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountIn", reflect.TypeOf((*MockPoolManagerKeeper)(nil).SwapExactAmountIn), ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount)
+}
+
+// MockTwapKeeper is a mock of TwapKeeper interface.
+type MockTwapKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwapKeeperMockRecorder
+}
+
+// MockTwapKeeperMockRecorder is the mock recorder for MockTwapKeeper.
+type MockTwapKeeperMockRecorder struct {
+	mock *MockTwapKeeper
+}
+
+// NewMockTwapKeeper creates a new mock instance.
+func NewMockTwapKeeper(ctrl *gomock.Controller) *MockTwapKeeper {
+	mock := &MockTwapKeeper{ctrl: ctrl}
+	mock.recorder = &MockTwapKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwapKeeper) EXPECT() *MockTwapKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetArithmeticTwapToNow mocks base method.
+func (m *MockTwapKeeper) GetArithmeticTwapToNow(ctx types.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArithmeticTwapToNow", ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	ret0, _ := ret[0].(osmomath.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArithmeticTwapToNow indicates an expected call of GetArithmeticTwapToNow.
+func (mr *MockTwapKeeperMockRecorder) GetArithmeticTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArithmeticTwapToNow", reflect.TypeOf((*MockTwapKeeper)(nil).GetArithmeticTwapToNow), ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+}
@@ -8,3 +8,5 @@ package mocks
 //go:generate mockgen -source=./../../x/transfer/types/expected_keepers.go -destination ./transfer/types/expected_keepers.go
 //go:generate mockgen -source=./../../x/feeburner/types/expected_keepers.go -destination ./feeburner/types/expected_keepers.go
 //go:generate mockgen -source=./../../x/cron/types/expected_keepers.go -destination ./cron/types/expected_keepers.go
+//go:generate mockgen -source=./../../x/takerfee/types/expected_keepers.go -destination ./takerfee/types/expected_keepers.go
+//go:generate mockgen -source=./../../x/genesismint/keeper/expected_keepers.go -destination ./genesismint/keeper/expected_keepers.go
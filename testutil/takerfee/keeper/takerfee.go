@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	metrics2 "cosmossdk.io/store/metrics"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	db2 "github.com/cosmos/cosmos-db"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+func TakerFeeKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return TakerFeeKeeperWithDeps(t, nil, nil, nil, func(_ sdk.Context) string { return "" })
+}
+
+func TakerFeeKeeperWithDeps(
+	t testing.TB,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	poolManagerKeeper types.PoolManagerKeeper,
+	getCommunityPoolAddr types.GetCommunityPoolAddr,
+) (*keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := db2.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics2.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		accountKeeper,
+		bankKeeper,
+		poolManagerKeeper,
+		getCommunityPoolAddr,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	err := k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, err)
+
+	return k, ctx
+}
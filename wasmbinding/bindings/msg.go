@@ -6,8 +6,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramChange "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
+	cltypes "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types"
 	feetypes "github.com/maany-xyz/maany-dex/v5/x/feerefunder/types"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
 	icqtypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
 	transferwrappertypes "github.com/maany-xyz/maany-dex/v5/x/transfer/types"
 )
 
@@ -40,7 +43,7 @@ type NeutronMsg struct {
 	ResubmitFailure *ResubmitFailure `json:"resubmit_failure,omitempty"`
 
 	// dex module bindings
-	// Dex *Dex `json:"dex,omitempty"`
+	Dex *Dex `json:"dex,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -164,14 +167,21 @@ type ResubmitFailureResponse struct {
 	FailureId uint64 `json:"failure_id"`
 }
 
-// type Dex struct {
-// 	Deposit                  *dextypes.MsgDeposit                  `json:"deposit"`
-// 	Withdrawal               *dextypes.MsgWithdrawal               `json:"withdrawal"`
-// 	PlaceLimitOrder          *MsgPlaceLimitOrder                   `json:"place_limit_order"`
-// 	WithdrawFilledLimitOrder *dextypes.MsgWithdrawFilledLimitOrder `json:"withdraw_filled_limit_order"`
-// 	CancelLimitOrder         *dextypes.MsgCancelLimitOrder         `json:"cancel_limit_order"`
-// 	MultiHopSwap             *dextypes.MsgMultiHopSwap             `json:"multi_hop_swap"`
-// }
+// Dex holds the pool messages a contract can execute. Swaps are routed through the poolmanager,
+// joins and exits go to the gamm pools and positions to the concentrated liquidity pools. The sender
+// of every message is overwritten with the contract address.
+type Dex struct {
+	SwapExactAmountIn            *poolmanagertypes.MsgSwapExactAmountIn            `json:"swap_exact_amount_in,omitempty"`
+	SwapExactAmountOut           *poolmanagertypes.MsgSwapExactAmountOut           `json:"swap_exact_amount_out,omitempty"`
+	SplitRouteSwapExactAmountIn  *poolmanagertypes.MsgSplitRouteSwapExactAmountIn  `json:"split_route_swap_exact_amount_in,omitempty"`
+	SplitRouteSwapExactAmountOut *poolmanagertypes.MsgSplitRouteSwapExactAmountOut `json:"split_route_swap_exact_amount_out,omitempty"`
+	JoinPool                     *gammtypes.MsgJoinPool                            `json:"join_pool,omitempty"`
+	ExitPool                     *gammtypes.MsgExitPool                            `json:"exit_pool,omitempty"`
+	CreatePosition               *cltypes.MsgCreatePosition                        `json:"create_position,omitempty"`
+	AddToPosition                *cltypes.MsgAddToPosition                         `json:"add_to_position,omitempty"`
+	WithdrawPosition             *cltypes.MsgWithdrawPosition                      `json:"withdraw_position,omitempty"`
+	CollectSpreadRewards         *cltypes.MsgCollectSpreadRewards                  `json:"collect_spread_rewards,omitempty"`
+}
 
 // MsgPlaceLimitOrder is a copy dextypes.MsgPlaceLimitOrder with altered ExpirationTime field,
 // it's a preferable way to pass timestamp as unixtime to contracts
@@ -111,6 +111,9 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.Dex != nil:
+			return qp.DexQuery(ctx, *contractQuery.Dex)
+
 		case contractQuery.Oracle != nil:
 			return qp.OracleQuery(ctx, *contractQuery.Oracle)
 
@@ -0,0 +1,348 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/app/config"
+	"github.com/maany-xyz/maany-dex/v5/testutil/apptesting"
+	"github.com/maany-xyz/maany-dex/v5/wasmbinding"
+	"github.com/maany-xyz/maany-dex/v5/wasmbinding/bindings"
+	clmodel "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/model"
+	cltypes "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
+	poolmanagerqueryproto "github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+	twapqueryproto "github.com/maany-xyz/maany-dex/v5/x/twap/client/queryproto"
+)
+
+const atom = "uatom"
+
+var native = config.BaseCoinUnit
+
+type DexBindingsTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	contract  sdk.AccAddress
+	messenger *wasmbinding.CustomMessenger
+	querier   func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
+}
+
+func TestDexBindingsTestSuite(t *testing.T) {
+	suite.Run(t, new(DexBindingsTestSuite))
+}
+
+func (s *DexBindingsTestSuite) SetupTest() {
+	s.Setup()
+	app := s.App
+	s.contract = sdk.AccAddress("dex_contract")
+	s.fund(s.contract, sdk.NewInt64Coin(atom, 10_000_000), sdk.NewInt64Coin(native, 10_000_000))
+
+	decorate := wasmbinding.CustomMessageDecorator(&app.InterchainTxsKeeper, &app.InterchainQueriesKeeper, &app.AdminmoduleKeeper,
+		&app.BankKeeper, &app.CronKeeper, &app.ContractManagerKeeper, app.PoolManagerKeeper, &app.GAMMKeeper, app.ConcentratedLiquidityKeeper)
+	s.messenger = decorate(nil).(*wasmbinding.CustomMessenger)
+	s.querier = wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&app.InterchainTxsKeeper, &app.InterchainQueriesKeeper, app.FeeBurnerKeeper,
+		app.FeeKeeper, &app.ContractManagerKeeper, nil, nil, app.PoolManagerKeeper, app.TwapKeeper))
+
+	poolmanagerParams := app.PoolManagerKeeper.GetParams(s.Ctx)
+	poolmanagerParams.PoolCreationFee = sdk.NewCoins()
+	app.PoolManagerKeeper.SetParams(s.Ctx, poolmanagerParams)
+	clParams := app.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	clParams.IsPermissionlessPoolCreationEnabled = true
+	app.ConcentratedLiquidityKeeper.SetParams(s.Ctx, clParams)
+}
+
+func (s *DexBindingsTestSuite) fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, gammtypes.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, gammtypes.ModuleName, addr, coins))
+}
+
+// createBalancerPool creates a 1:2 uatom/native balancer pool.
+func (s *DexBindingsTestSuite) createBalancerPool() uint64 {
+	creator := sdk.AccAddress("pool_creator")
+	assets := sdk.NewCoins(sdk.NewInt64Coin(atom, 1_000_000), sdk.NewInt64Coin(native, 2_000_000))
+	s.fund(creator, assets...)
+	poolID, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: math.LegacyNewDecWithPrec(3, 3),
+		ExitFee: math.LegacyZeroDec(),
+	}, []balancer.PoolAsset{
+		{Token: assets[0], Weight: math.NewInt(1)},
+		{Token: assets[1], Weight: math.NewInt(1)},
+	}, ""))
+	s.Require().NoError(err)
+	return poolID
+}
+
+// dispatch sends the dex message as the contract and decodes its response data into resp.
+func (s *DexBindingsTestSuite) dispatch(dex bindings.Dex, resp interface{}) error {
+	custom, err := json.Marshal(bindings.NeutronMsg{Dex: &dex})
+	s.Require().NoError(err)
+	_, data, msgResponses, err := s.messenger.DispatchMsg(s.Ctx, s.contract, "", wasmvmtypes.CosmosMsg{Custom: custom})
+	if err != nil {
+		return err
+	}
+	s.Require().Len(data, 1)
+	s.Require().Len(msgResponses, 1)
+	s.Require().NoError(json.Unmarshal(data[0], resp))
+	return nil
+}
+
+// query makes the dex query as a contract and decodes its response into resp.
+func (s *DexBindingsTestSuite) query(dex bindings.DexQuery, resp interface{}) error {
+	request, err := json.Marshal(bindings.NeutronQuery{Dex: &dex})
+	s.Require().NoError(err)
+	bz, err := s.querier(s.Ctx, request)
+	if err != nil {
+		return err
+	}
+	s.Require().NoError(json.Unmarshal(bz, resp))
+	return nil
+}
+
+func (s *DexBindingsTestSuite) balance(denom string) math.Int {
+	return s.App.BankKeeper.GetBalance(s.Ctx, s.contract, denom).Amount
+}
+
+func (s *DexBindingsTestSuite) TestSwaps() {
+	poolID := s.createBalancerPool()
+	atomBefore, nativeBefore := s.balance(atom), s.balance(native)
+
+	// the sender is always the contract
+	var swapIn poolmanagertypes.MsgSwapExactAmountInResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{SwapExactAmountIn: &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender:            sdk.AccAddress("someone_else").String(),
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+		TokenIn:           sdk.NewInt64Coin(atom, 1_000),
+		TokenOutMinAmount: math.NewInt(1_900),
+	}}, &swapIn))
+	s.Require().True(swapIn.TokenOutAmount.GTE(math.NewInt(1_900)))
+	s.Require().Equal(atomBefore.SubRaw(1_000), s.balance(atom))
+	s.Require().Equal(nativeBefore.Add(swapIn.TokenOutAmount), s.balance(native))
+
+	var swapOut poolmanagertypes.MsgSwapExactAmountOutResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{SwapExactAmountOut: &poolmanagertypes.MsgSwapExactAmountOut{
+		Routes:           []poolmanagertypes.SwapAmountOutRoute{{PoolId: poolID, TokenInDenom: native}},
+		TokenInMaxAmount: math.NewInt(2_100),
+		TokenOut:         sdk.NewInt64Coin(atom, 1_000),
+	}}, &swapOut))
+	s.Require().True(swapOut.TokenInAmount.LTE(math.NewInt(2_100)))
+	s.Require().Equal(atomBefore, s.balance(atom))
+	s.Require().Equal(nativeBefore.Add(swapIn.TokenOutAmount).Sub(swapOut.TokenInAmount), s.balance(native))
+
+	var splitIn poolmanagertypes.MsgSplitRouteSwapExactAmountInResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{SplitRouteSwapExactAmountIn: &poolmanagertypes.MsgSplitRouteSwapExactAmountIn{
+		Routes: []poolmanagertypes.SwapAmountInSplitRoute{{
+			Pools:         []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+			TokenInAmount: math.NewInt(500),
+		}},
+		TokenInDenom:      atom,
+		TokenOutMinAmount: math.NewInt(900),
+	}}, &splitIn))
+	s.Require().True(splitIn.TokenOutAmount.GTE(math.NewInt(900)))
+
+	var splitOut poolmanagertypes.MsgSplitRouteSwapExactAmountOutResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{SplitRouteSwapExactAmountOut: &poolmanagertypes.MsgSplitRouteSwapExactAmountOut{
+		Routes: []poolmanagertypes.SwapAmountOutSplitRoute{{
+			Pools:          []poolmanagertypes.SwapAmountOutRoute{{PoolId: poolID, TokenInDenom: native}},
+			TokenOutAmount: math.NewInt(500),
+		}},
+		TokenOutDenom:    atom,
+		TokenInMaxAmount: math.NewInt(1_100),
+	}}, &splitOut))
+	s.Require().True(splitOut.TokenInAmount.LTE(math.NewInt(1_100)))
+
+	// a swap below its minimum out fails
+	err := s.dispatch(bindings.Dex{SwapExactAmountIn: &poolmanagertypes.MsgSwapExactAmountIn{
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+		TokenIn:           sdk.NewInt64Coin(atom, 1_000),
+		TokenOutMinAmount: math.NewInt(2_000),
+	}}, &swapIn)
+	s.Require().ErrorContains(err, "failed to execute")
+
+	// an invalid message is rejected before execution
+	err = s.dispatch(bindings.Dex{SwapExactAmountIn: &poolmanagertypes.MsgSwapExactAmountIn{
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+		TokenIn:           sdk.Coin{Denom: atom, Amount: math.ZeroInt()},
+		TokenOutMinAmount: math.NewInt(1),
+	}}, &swapIn)
+	s.Require().ErrorContains(err, "failed to validate")
+}
+
+func (s *DexBindingsTestSuite) TestJoinAndExitPool() {
+	poolID := s.createBalancerPool()
+	atomBefore, nativeBefore := s.balance(atom), s.balance(native)
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+
+	var join gammtypes.MsgJoinPoolResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{JoinPool: &gammtypes.MsgJoinPool{
+		PoolId:         poolID,
+		ShareOutAmount: gammtypes.OneShare.MulRaw(10),
+		TokenInMaxs:    sdk.NewCoins(sdk.NewInt64Coin(atom, 100_000), sdk.NewInt64Coin(native, 200_000)),
+	}}, &join))
+	s.Require().Equal(gammtypes.OneShare.MulRaw(10), join.ShareOutAmount)
+	s.Require().Equal(join.ShareOutAmount, s.balance(shareDenom))
+	s.Require().Equal(atomBefore.Sub(sdk.Coins(join.TokenIn).AmountOf(atom)), s.balance(atom))
+	s.Require().Equal(nativeBefore.Sub(sdk.Coins(join.TokenIn).AmountOf(native)), s.balance(native))
+
+	var exit gammtypes.MsgExitPoolResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{ExitPool: &gammtypes.MsgExitPool{
+		PoolId:        poolID,
+		ShareInAmount: join.ShareOutAmount,
+		TokenOutMins:  sdk.NewCoins(),
+	}}, &exit))
+	s.Require().True(s.balance(shareDenom).IsZero())
+	s.Require().Equal(atomBefore.Sub(sdk.Coins(join.TokenIn).AmountOf(atom)).Add(sdk.Coins(exit.TokenOut).AmountOf(atom)), s.balance(atom))
+	s.Require().True(sdk.Coins(exit.TokenOut).AmountOf(atom).Sub(sdk.Coins(join.TokenIn).AmountOf(atom)).Abs().LTE(math.OneInt()))
+
+	// the contract cannot exit with shares it does not hold
+	err := s.dispatch(bindings.Dex{ExitPool: &gammtypes.MsgExitPool{
+		PoolId:        poolID,
+		ShareInAmount: gammtypes.OneShare,
+		TokenOutMins:  sdk.NewCoins(),
+	}}, &exit)
+	s.Require().ErrorContains(err, "failed to execute")
+}
+
+func (s *DexBindingsTestSuite) TestConcentratedPositions() {
+	creator := sdk.AccAddress("pool_creator")
+	poolID, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, clmodel.NewMsgCreateConcentratedPool(creator, atom, native, 100, math.LegacyMustNewDecFromStr("0.003")))
+	s.Require().NoError(err)
+
+	// the pool keeps a position of the creator, the last position in a pool cannot be added to
+	s.fund(creator, sdk.NewInt64Coin(atom, 1_000_000), sdk.NewInt64Coin(native, 1_000_000))
+	_, err = s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolID, creator, sdk.NewCoins(sdk.NewInt64Coin(atom, 1_000_000), sdk.NewInt64Coin(native, 1_000_000)), math.ZeroInt(), math.ZeroInt(), -1_000_000, 1_000_000)
+	s.Require().NoError(err)
+
+	var create cltypes.MsgCreatePositionResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{CreatePosition: &cltypes.MsgCreatePosition{
+		PoolId:          poolID,
+		LowerTick:       -1_000_000,
+		UpperTick:       1_000_000,
+		TokensProvided:  sdk.NewCoins(sdk.NewInt64Coin(atom, 1_000_000), sdk.NewInt64Coin(native, 1_000_000)),
+		TokenMinAmount0: math.ZeroInt(),
+		TokenMinAmount1: math.ZeroInt(),
+	}}, &create))
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, create.PositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.contract.String(), position.Address)
+	s.Require().Equal(create.LiquidityCreated, position.Liquidity)
+
+	// a swap through the pool accrues spread rewards to the position
+	trader := sdk.AccAddress("trader")
+	s.fund(trader, sdk.NewInt64Coin(atom, 10_000))
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, trader, []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}}, sdk.NewInt64Coin(atom, 10_000), math.OneInt(), poolmanagertypes.SwapProtection{})
+	s.Require().NoError(err)
+
+	var collect cltypes.MsgCollectSpreadRewardsResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{CollectSpreadRewards: &cltypes.MsgCollectSpreadRewards{PositionIds: []uint64{create.PositionId}}}, &collect))
+	s.Require().True(collect.CollectedSpreadRewards.AmountOf(atom).IsPositive())
+
+	var add cltypes.MsgAddToPositionResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{AddToPosition: &cltypes.MsgAddToPosition{
+		PositionId:      create.PositionId,
+		Amount0:         math.NewInt(100_000),
+		Amount1:         math.NewInt(100_000),
+		TokenMinAmount0: math.ZeroInt(),
+		TokenMinAmount1: math.ZeroInt(),
+	}}, &add))
+	added, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, add.PositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.contract.String(), added.Address)
+	s.Require().True(added.Liquidity.GT(create.LiquidityCreated))
+
+	atomBefore := s.balance(atom)
+	var withdraw cltypes.MsgWithdrawPositionResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{WithdrawPosition: &cltypes.MsgWithdrawPosition{
+		PositionId:      add.PositionId,
+		LiquidityAmount: added.Liquidity,
+	}}, &withdraw))
+	s.Require().Equal(atomBefore.Add(withdraw.Amount0), s.balance(atom))
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, add.PositionId)
+	s.Require().Error(err)
+}
+
+func (s *DexBindingsTestSuite) TestDexQueries() {
+	// a twap record at the zero time reads as a spot price error
+	createdAt := time.Unix(1_700_000_000, 0).UTC()
+	s.Ctx = s.Ctx.WithBlockTime(createdAt)
+	poolID := s.createBalancerPool()
+	s.Ctx = s.Ctx.WithBlockTime(createdAt.Add(time.Minute))
+
+	var spot poolmanagerqueryproto.SpotPriceResponse
+	s.Require().NoError(s.query(bindings.DexQuery{SpotPrice: &poolmanagerqueryproto.SpotPriceRequest{PoolId: poolID, BaseAssetDenom: atom, QuoteAssetDenom: native}}, &spot))
+	s.Require().Equal(math.LegacyNewDec(2).String(), spot.SpotPrice[:len(math.LegacyNewDec(2).String())])
+
+	var liquidity poolmanagerqueryproto.TotalPoolLiquidityResponse
+	s.Require().NoError(s.query(bindings.DexQuery{TotalPoolLiquidity: &poolmanagerqueryproto.TotalPoolLiquidityRequest{PoolId: poolID}}, &liquidity))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(atom, 1_000_000), sdk.NewInt64Coin(native, 2_000_000)), liquidity.Liquidity)
+
+	// the estimates match the amounts a swap would give
+	var estimateIn poolmanagerqueryproto.EstimateSwapExactAmountInResponse
+	s.Require().NoError(s.query(bindings.DexQuery{EstimateSwapExactAmountIn: &poolmanagerqueryproto.EstimateSwapExactAmountInRequest{
+		TokenIn: "1000" + atom,
+		Routes:  []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+	}}, &estimateIn))
+	var singleIn poolmanagerqueryproto.EstimateSwapExactAmountInResponse
+	s.Require().NoError(s.query(bindings.DexQuery{EstimateSinglePoolSwapExactAmountIn: &poolmanagerqueryproto.EstimateSinglePoolSwapExactAmountInRequest{
+		PoolId: poolID, TokenIn: "1000" + atom, TokenOutDenom: native,
+	}}, &singleIn))
+	s.Require().Equal(estimateIn.TokenOutAmount, singleIn.TokenOutAmount)
+
+	var estimateOut poolmanagerqueryproto.EstimateSwapExactAmountOutResponse
+	s.Require().NoError(s.query(bindings.DexQuery{EstimateSwapExactAmountOut: &poolmanagerqueryproto.EstimateSwapExactAmountOutRequest{
+		Routes:   []poolmanagertypes.SwapAmountOutRoute{{PoolId: poolID, TokenInDenom: atom}},
+		TokenOut: "1000" + native,
+	}}, &estimateOut))
+	var singleOut poolmanagerqueryproto.EstimateSwapExactAmountOutResponse
+	s.Require().NoError(s.query(bindings.DexQuery{EstimateSinglePoolSwapExactAmountOut: &poolmanagerqueryproto.EstimateSinglePoolSwapExactAmountOutRequest{
+		PoolId: poolID, TokenInDenom: atom, TokenOut: "1000" + native,
+	}}, &singleOut))
+	s.Require().Equal(estimateOut.TokenInAmount, singleOut.TokenInAmount)
+
+	var swapIn poolmanagertypes.MsgSwapExactAmountInResponse
+	s.Require().NoError(s.dispatch(bindings.Dex{SwapExactAmountIn: &poolmanagertypes.MsgSwapExactAmountIn{
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: native}},
+		TokenIn:           sdk.NewInt64Coin(atom, 1_000),
+		TokenOutMinAmount: math.OneInt(),
+	}}, &swapIn))
+	s.Require().Equal(estimateIn.TokenOutAmount, swapIn.TokenOutAmount)
+
+	// the pool price recorded by the twap module since the pool creation
+	endTime := createdAt.Add(30 * time.Second)
+	var arithmetic twapqueryproto.ArithmeticTwapResponse
+	s.Require().NoError(s.query(bindings.DexQuery{ArithmeticTwap: &twapqueryproto.ArithmeticTwapRequest{
+		PoolId: poolID, BaseAsset: atom, QuoteAsset: native, StartTime: createdAt, EndTime: &endTime,
+	}}, &arithmetic))
+	s.Require().Equal(math.LegacyNewDec(2), arithmetic.ArithmeticTwap)
+
+	var arithmeticToNow twapqueryproto.ArithmeticTwapToNowResponse
+	s.Require().NoError(s.query(bindings.DexQuery{ArithmeticTwapToNow: &twapqueryproto.ArithmeticTwapToNowRequest{
+		PoolId: poolID, BaseAsset: atom, QuoteAsset: native, StartTime: createdAt,
+	}}, &arithmeticToNow))
+	s.Require().Equal(math.LegacyNewDec(2), arithmeticToNow.ArithmeticTwap)
+
+	var geometric twapqueryproto.GeometricTwapResponse
+	s.Require().NoError(s.query(bindings.DexQuery{GeometricTwap: &twapqueryproto.GeometricTwapRequest{
+		PoolId: poolID, BaseAsset: atom, QuoteAsset: native, StartTime: createdAt, EndTime: &endTime,
+	}}, &geometric))
+	s.Require().True(geometric.GeometricTwap.Sub(math.LegacyNewDec(2)).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+
+	var geometricToNow twapqueryproto.GeometricTwapToNowResponse
+	s.Require().NoError(s.query(bindings.DexQuery{GeometricTwapToNow: &twapqueryproto.GeometricTwapToNowRequest{
+		PoolId: poolID, BaseAsset: atom, QuoteAsset: native, StartTime: createdAt,
+	}}, &geometricToNow))
+	s.Require().True(geometricToNow.GeometricTwap.Sub(math.LegacyNewDec(2)).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+
+	// a pool without records has no twap
+	err := s.query(bindings.DexQuery{ArithmeticTwapToNow: &twapqueryproto.ArithmeticTwapToNowRequest{
+		PoolId: poolID + 1, BaseAsset: atom, QuoteAsset: native, StartTime: createdAt,
+	}}, &arithmeticToNow)
+	s.Require().Error(err)
+}
@@ -1,9 +1,12 @@
 package wasmbinding
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	contractmanagerkeeper "github.com/maany-xyz/maany-dex/v5/x/contractmanager/keeper"
 
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -37,6 +40,13 @@ import (
 	ictxkeeper "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/keeper"
 	ictxtypes "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/types"
 
+	concentratedliquidity "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity"
+	cltypes "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/types"
+	gammkeeper "github.com/maany-xyz/maany-dex/v5/x/gamm/keeper"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+
     
 )
 
@@ -47,36 +57,45 @@ func CustomMessageDecorator(
     bankKeeper *bankkeeper.BaseKeeper,
     cronKeeper *cronkeeper.Keeper,
     contractmanagerKeeper *contractmanagerkeeper.Keeper,
+    poolmanagerKeeper *poolmanager.Keeper,
+    gammKeeper *gammkeeper.Keeper,
+    concentratedLiquidityKeeper *concentratedliquidity.Keeper,
 ) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			Keeper:                     *ictx,
-			Wrapped:                    old,
-			Ictxmsgserver:              ictxkeeper.NewMsgServerImpl(*ictx),
-			Icqmsgserver:               icqkeeper.NewMsgServerImpl(*icq),
-            Adminserver:                adminmodulekeeper.NewMsgServerImpl(*adminKeeper),
-            Bank:                       bankKeeper,
-            CronMsgServer:              cronkeeper.NewMsgServerImpl(*cronKeeper),
-            CronQueryServer:            cronKeeper,
-            AdminKeeper:                adminKeeper,
-            ContractmanagerMsgServer:   contractmanagerkeeper.NewMsgServerImpl(*contractmanagerKeeper),
-            ContractmanagerQueryServer: contractmanagerkeeper.NewQueryServerImpl(*contractmanagerKeeper),
+			Keeper:                         *ictx,
+			Wrapped:                        old,
+			Ictxmsgserver:                  ictxkeeper.NewMsgServerImpl(*ictx),
+			Icqmsgserver:                   icqkeeper.NewMsgServerImpl(*icq),
+            Adminserver:                    adminmodulekeeper.NewMsgServerImpl(*adminKeeper),
+            Bank:                           bankKeeper,
+            CronMsgServer:                  cronkeeper.NewMsgServerImpl(*cronKeeper),
+            CronQueryServer:                cronKeeper,
+            AdminKeeper:                    adminKeeper,
+            ContractmanagerMsgServer:       contractmanagerkeeper.NewMsgServerImpl(*contractmanagerKeeper),
+            ContractmanagerQueryServer:     contractmanagerkeeper.NewQueryServerImpl(*contractmanagerKeeper),
+            PoolmanagerMsgServer:           poolmanager.NewMsgServerImpl(poolmanagerKeeper),
+            GammMsgServer:                  gammkeeper.NewMsgServerImpl(gammKeeper),
+            ConcentratedLiquidityMsgServer: concentratedliquidity.NewMsgServerImpl(concentratedLiquidityKeeper),
         }
     }
 }
 
 type CustomMessenger struct {
-	Keeper                     ictxkeeper.Keeper
-	Wrapped                    wasmkeeper.Messenger
-	Ictxmsgserver              ictxtypes.MsgServer
-	Icqmsgserver               icqtypes.MsgServer
-	Adminserver                admintypes.MsgServer
-	Bank                       *bankkeeper.BaseKeeper
-    CronMsgServer              crontypes.MsgServer
-    CronQueryServer            crontypes.QueryServer
-	AdminKeeper                *adminmodulekeeper.Keeper
-	ContractmanagerMsgServer   contractmanagertypes.MsgServer
-	ContractmanagerQueryServer contractmanagertypes.QueryServer
+	Keeper                         ictxkeeper.Keeper
+	Wrapped                        wasmkeeper.Messenger
+	Ictxmsgserver                  ictxtypes.MsgServer
+	Icqmsgserver                   icqtypes.MsgServer
+	Adminserver                    admintypes.MsgServer
+	Bank                           *bankkeeper.BaseKeeper
+    CronMsgServer                  crontypes.MsgServer
+    CronQueryServer                crontypes.QueryServer
+	AdminKeeper                    *adminmodulekeeper.Keeper
+	ContractmanagerMsgServer       contractmanagertypes.MsgServer
+	ContractmanagerQueryServer     contractmanagertypes.QueryServer
+	PoolmanagerMsgServer           poolmanagertypes.MsgServer
+	GammMsgServer                  gammtypes.MsgServer
+	ConcentratedLiquidityMsgServer cltypes.MsgServer
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -131,107 +150,96 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if contractMsg.ResubmitFailure != nil {
 		return m.resubmitFailure(ctx, contractAddr, contractMsg.ResubmitFailure)
 	}
+	if contractMsg.Dex != nil {
+		return m.dispatchDexMsg(ctx, contractAddr, contractMsg.Dex)
+	}
 
 	// If none of the conditions are met, forward the message to the wrapped handler
 	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
 
-// func handleDexMsg[T sdk.LegacyMsg, R proto.Message](ctx sdk.Context, msg T, handler func(ctx context.Context, msg T) (R, error)) ([][]byte, [][]*types.Any, error) {
-// 	if len(msg.GetSigners()) != 1 {
-// 		// should never happen
-// 		panic("should be 1 signer")
-// 	}
-// 	signer := msg.GetSigners()[0].String()
+// dexMsg is a pool message executed on behalf of a contract.
+type dexMsg interface {
+	proto.Message
+	ValidateBasic() error
+}
 
-// 	resp, err := handler(ctx, msg)
-// 	if err != nil {
-// 		ctx.Logger().Debug(fmt.Sprintf("%T: failed to execute", msg),
-// 			"from_address", signer,
-// 			"msg", msg,
-// 			"error", err,
-// 		)
-// 		return nil, nil, errors.Wrapf(err, "failed to execute %T", msg)
-// 	}
+func handleDexMsg[T dexMsg, R proto.Message](ctx sdk.Context, contractAddr sdk.AccAddress, msg T, handler func(ctx context.Context, msg T) (R, error)) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "failed to validate %T", msg)
+	}
 
-// 	data, err := json.Marshal(resp)
-// 	if err != nil {
-// 		ctx.Logger().Error(fmt.Sprintf("json.Marshal: failed to marshal %T response to JSON", resp),
-// 			"from_address", signer,
-// 			"error", err,
-// 		)
-// 		return nil, nil, errors.Wrap(err, fmt.Sprintf("marshal %T failed", resp))
-// 	}
+	resp, err := handler(ctx, msg)
+	if err != nil {
+		ctx.Logger().Debug(fmt.Sprintf("%T: failed to execute", msg),
+			"from_address", contractAddr.String(),
+			"msg", msg,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrapf(err, "failed to execute %T", msg)
+	}
 
-// 	ctx.Logger().Debug(fmt.Sprintf("%T execution completed", msg),
-// 		"from_address", signer,
-// 		"msg", msg,
-// 	)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("json.Marshal: failed to marshal %T response to JSON", resp),
+			"from_address", contractAddr.String(),
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("marshal %T failed", resp))
+	}
 
-// 	anyResp, err := types.NewAnyWithValue(resp)
-// 	if err != nil {
-// 		return nil, nil, errors.Wrapf(err, "failed to convert {%T} to Any", resp)
-// 	}
-// 	msgResponses := [][]*types.Any{{anyResp}}
-// 	return [][]byte{data}, msgResponses, nil
-// }
+	ctx.Logger().Debug(fmt.Sprintf("%T execution completed", msg),
+		"from_address", contractAddr.String(),
+		"msg", msg,
+	)
 
-// func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAddress, dex bindings.Dex) ([][]byte, [][]*types.Any, error) {
-// 	switch {
-// 	case dex.Deposit != nil:
-// 		dex.Deposit.Creator = contractAddr.String()
-// 		return handleDexMsg(ctx, dex.Deposit, m.DexMsgServer.Deposit)
-// 	case dex.Withdrawal != nil:
-// 		dex.Withdrawal.Creator = contractAddr.String()
-// 		return handleDexMsg(ctx, dex.Withdrawal, m.DexMsgServer.Withdrawal)
-// 	case dex.PlaceLimitOrder != nil:
-// 		msg := dextypes.MsgPlaceLimitOrder{
-// 			Creator:  contractAddr.String(),
-// 			Receiver: dex.PlaceLimitOrder.Receiver,
-// 			TokenIn:  dex.PlaceLimitOrder.TokenIn,
-// 			TokenOut: dex.PlaceLimitOrder.TokenOut,
-// 			//nolint: staticcheck // TODO: remove in next release
-// 			TickIndexInToOut: dex.PlaceLimitOrder.TickIndexInToOut,
-// 			AmountIn:         dex.PlaceLimitOrder.AmountIn,
-// 			MaxAmountOut:     dex.PlaceLimitOrder.MaxAmountOut,
-// 		}
-// 		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceLimitOrder.OrderType]
-// 		if !ok {
-// 			return nil, nil, errors.Wrap(dextypes.ErrInvalidOrderType,
-// 				fmt.Sprintf(
-// 					"got \"%s\", expected one of %s",
-// 					dex.PlaceLimitOrder.OrderType,
-// 					strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
-// 			)
-// 		}
-// 		msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
-
-// 		if dex.PlaceLimitOrder.ExpirationTime != nil {
-// 			t := time.Unix(int64(*(dex.PlaceLimitOrder.ExpirationTime)), 0)
-// 			msg.ExpirationTime = &t
-// 		}
-
-// 		if limitPriceStr := dex.PlaceLimitOrder.LimitSellPrice; limitPriceStr != "" {
-// 			limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(limitPriceStr)
-// 			if err != nil {
-// 				return nil, nil, errors.Wrapf(err, "cannot parse string %s for limit price", limitPriceStr)
-// 			}
-// 			msg.LimitSellPrice = &limitPriceDec
-// 		}
-
-// 		return handleDexMsg(ctx, &msg, m.DexMsgServer.PlaceLimitOrder)
-// 	case dex.CancelLimitOrder != nil:
-// 		dex.CancelLimitOrder.Creator = contractAddr.String()
-// 		return handleDexMsg(ctx, dex.CancelLimitOrder, m.DexMsgServer.CancelLimitOrder)
-// 	case dex.WithdrawFilledLimitOrder != nil:
-// 		dex.WithdrawFilledLimitOrder.Creator = contractAddr.String()
-// 		return handleDexMsg(ctx, dex.WithdrawFilledLimitOrder, m.DexMsgServer.WithdrawFilledLimitOrder)
-// 	case dex.MultiHopSwap != nil:
-// 		dex.MultiHopSwap.Creator = contractAddr.String()
-// 		return handleDexMsg(ctx, dex.MultiHopSwap, m.DexMsgServer.MultiHopSwap)
-// 	}
+	anyResp, err := types.NewAnyWithValue(resp)
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "failed to convert {%T} to Any", resp)
+	}
+	msgResponses := [][]*types.Any{{anyResp}}
+	return nil, [][]byte{data}, msgResponses, nil
+}
 
-// 	return nil, nil, sdkerrors.ErrUnknownRequest
-// }
+// dispatchDexMsg executes a pool message with the contract as its sender. Swaps go through the
+// poolmanager so that they are routed across pool types and charged the taker fee.
+func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAddress, dex *bindings.Dex) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	sender := contractAddr.String()
+	switch {
+	case dex.SwapExactAmountIn != nil:
+		dex.SwapExactAmountIn.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.SwapExactAmountIn, m.PoolmanagerMsgServer.SwapExactAmountIn)
+	case dex.SwapExactAmountOut != nil:
+		dex.SwapExactAmountOut.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.SwapExactAmountOut, m.PoolmanagerMsgServer.SwapExactAmountOut)
+	case dex.SplitRouteSwapExactAmountIn != nil:
+		dex.SplitRouteSwapExactAmountIn.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.SplitRouteSwapExactAmountIn, m.PoolmanagerMsgServer.SplitRouteSwapExactAmountIn)
+	case dex.SplitRouteSwapExactAmountOut != nil:
+		dex.SplitRouteSwapExactAmountOut.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.SplitRouteSwapExactAmountOut, m.PoolmanagerMsgServer.SplitRouteSwapExactAmountOut)
+	case dex.JoinPool != nil:
+		dex.JoinPool.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.JoinPool, m.GammMsgServer.JoinPool)
+	case dex.ExitPool != nil:
+		dex.ExitPool.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.ExitPool, m.GammMsgServer.ExitPool)
+	case dex.CreatePosition != nil:
+		dex.CreatePosition.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.CreatePosition, m.ConcentratedLiquidityMsgServer.CreatePosition)
+	case dex.AddToPosition != nil:
+		dex.AddToPosition.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.AddToPosition, m.ConcentratedLiquidityMsgServer.AddToPosition)
+	case dex.WithdrawPosition != nil:
+		dex.WithdrawPosition.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.WithdrawPosition, m.ConcentratedLiquidityMsgServer.WithdrawPosition)
+	case dex.CollectSpreadRewards != nil:
+		dex.CollectSpreadRewards.Sender = sender
+		return handleDexMsg(ctx, contractAddr, dex.CollectSpreadRewards, m.ConcentratedLiquidityMsgServer.CollectSpreadRewards)
+	}
+
+	return nil, nil, nil, errors.Wrap(sdkerrors.ErrUnknownRequest, "unknown neutron.dex message type")
+}
 
 // func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, ibcTransferMsg transferwrappertypes.MsgTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 // 	ibcTransferMsg.Sender = contractAddr.String()
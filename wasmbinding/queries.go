@@ -17,6 +17,10 @@ import (
 	"github.com/maany-xyz/maany-dex/v5/wasmbinding/bindings"
 	"github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
 	icatypes "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/types"
+	poolmanagerclient "github.com/maany-xyz/maany-dex/v5/x/poolmanager/client"
+	poolmanagergrpc "github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/grpc"
+	twapclient "github.com/maany-xyz/maany-dex/v5/x/twap/client"
+	twapgrpc "github.com/maany-xyz/maany-dex/v5/x/twap/client/grpc"
 )
 
 func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, queryID uint64) (*bindings.QueryRegisteredQueryResultResponse, error) {
@@ -115,73 +119,48 @@ func (qp *QueryPlugin) GetFailures(ctx sdk.Context, address string, pagination *
 	return &bindings.FailuresResponse{Failures: res.Failures}, nil
 }
 
-// func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data []byte, err error) {
-// 	switch {
-// 	case query.EstimateMultiHopSwap != nil:
-// 		data, err = dexQuery(ctx, query.EstimateMultiHopSwap, qp.dexKeeper.EstimateMultiHopSwap)
-// 	case query.EstimatePlaceLimitOrder != nil:
-// 		q := dextypes.QueryEstimatePlaceLimitOrderRequest{
-// 			Creator:          query.EstimatePlaceLimitOrder.Creator,
-// 			Receiver:         query.EstimatePlaceLimitOrder.Receiver,
-// 			TokenIn:          query.EstimatePlaceLimitOrder.TokenIn,
-// 			TokenOut:         query.EstimatePlaceLimitOrder.TokenOut,
-// 			TickIndexInToOut: query.EstimatePlaceLimitOrder.TickIndexInToOut,
-// 			AmountIn:         query.EstimatePlaceLimitOrder.AmountIn,
-// 			MaxAmountOut:     query.EstimatePlaceLimitOrder.MaxAmountOut,
-// 		}
-// 		orderTypeInt, ok := dextypes.LimitOrderType_value[query.EstimatePlaceLimitOrder.OrderType]
-// 		if !ok {
-// 			return nil, errors.Wrap(dextypes.ErrInvalidOrderType,
-// 				fmt.Sprintf(
-// 					"got \"%s\", expected one of %s",
-// 					query.EstimatePlaceLimitOrder.OrderType,
-// 					strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
-// 			)
-// 		}
-// 		q.OrderType = dextypes.LimitOrderType(orderTypeInt)
-// 		if query.EstimatePlaceLimitOrder.ExpirationTime != nil {
-// 			t := time.Unix(int64(*query.EstimatePlaceLimitOrder.ExpirationTime), 0)
-// 			q.ExpirationTime = &t
-// 		}
-// 		data, err = dexQuery(ctx, &q, qp.dexKeeper.EstimatePlaceLimitOrder)
-// 	case query.InactiveLimitOrderTranche != nil:
-// 		data, err = dexQuery(ctx, query.InactiveLimitOrderTranche, qp.dexKeeper.InactiveLimitOrderTranche)
-// 	case query.InactiveLimitOrderTrancheAll != nil:
-// 		data, err = dexQuery(ctx, query.InactiveLimitOrderTrancheAll, qp.dexKeeper.InactiveLimitOrderTrancheAll)
-// 	case query.LimitOrderTrancheUser != nil:
-// 		data, err = dexQuery(ctx, query.LimitOrderTrancheUser, qp.dexKeeper.LimitOrderTrancheUser)
-// 	case query.LimitOrderTranche != nil:
-// 		data, err = dexQuery(ctx, query.LimitOrderTranche, qp.dexKeeper.LimitOrderTranche)
-// 	case query.LimitOrderTrancheAll != nil:
-// 		data, err = dexQuery(ctx, query.LimitOrderTrancheAll, qp.dexKeeper.LimitOrderTrancheAll)
-// 	case query.LimitOrderTrancheUserAll != nil:
-// 		data, err = dexQuery(ctx, query.LimitOrderTrancheUserAll, qp.dexKeeper.LimitOrderTrancheUserAll)
-// 	case query.Params != nil:
-// 		data, err = dexQuery(ctx, query.Params, qp.dexKeeper.Params)
-// 	case query.Pool != nil:
-// 		data, err = dexQuery(ctx, query.Pool, qp.dexKeeper.Pool)
-// 	case query.PoolByID != nil:
-// 		data, err = dexQuery(ctx, query.PoolByID, qp.dexKeeper.PoolByID)
-// 	case query.LimitOrderTrancheUserAllByAddress != nil:
-// 		data, err = dexQuery(ctx, query.LimitOrderTrancheUserAllByAddress, qp.dexKeeper.LimitOrderTrancheUserAllByAddress)
-// 	case query.PoolMetadata != nil:
-// 		data, err = dexQuery(ctx, query.PoolMetadata, qp.dexKeeper.PoolMetadata)
-// 	case query.PoolMetadataAll != nil:
-// 		data, err = dexQuery(ctx, query.PoolMetadataAll, qp.dexKeeper.PoolMetadataAll)
-// 	case query.PoolReservesAll != nil:
-// 		data, err = dexQuery(ctx, query.PoolReservesAll, qp.dexKeeper.PoolReservesAll)
-// 	case query.PoolReserves != nil:
-// 		data, err = dexQuery(ctx, query.PoolReserves, qp.dexKeeper.PoolReserves)
-// 	case query.TickLiquidityAll != nil:
-// 		data, err = dexQuery(ctx, query.TickLiquidityAll, qp.dexKeeper.TickLiquidityAll)
-// 	case query.UserDepositsAll != nil:
-// 		data, err = dexQuery(ctx, query.UserDepositsAll, qp.dexKeeper.UserDepositsAll)
-// 	default:
-// 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron.dex query type"}
-// 	}
-
-// 	return data, err
-// }
+func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) ([]byte, error) {
+	poolmanagerQueryServer := poolmanagergrpc.Querier{Q: poolmanagerclient.NewQuerier(qp.poolmanagerKeeper)}
+
+	switch {
+	case query.SpotPrice != nil:
+		return processResponse(poolmanagerQueryServer.SpotPrice(ctx, query.SpotPrice))
+	case query.EstimateSwapExactAmountIn != nil:
+		return processResponse(poolmanagerQueryServer.EstimateSwapExactAmountIn(ctx, query.EstimateSwapExactAmountIn))
+	case query.EstimateSwapExactAmountOut != nil:
+		return processResponse(poolmanagerQueryServer.EstimateSwapExactAmountOut(ctx, query.EstimateSwapExactAmountOut))
+	case query.EstimateSinglePoolSwapExactAmountIn != nil:
+		return processResponse(poolmanagerQueryServer.EstimateSinglePoolSwapExactAmountIn(ctx, query.EstimateSinglePoolSwapExactAmountIn))
+	case query.EstimateSinglePoolSwapExactAmountOut != nil:
+		return processResponse(poolmanagerQueryServer.EstimateSinglePoolSwapExactAmountOut(ctx, query.EstimateSinglePoolSwapExactAmountOut))
+	case query.TotalPoolLiquidity != nil:
+		return processResponse(poolmanagerQueryServer.TotalPoolLiquidity(ctx, query.TotalPoolLiquidity))
+	case query.ArithmeticTwap != nil, query.ArithmeticTwapToNow != nil, query.GeometricTwap != nil, query.GeometricTwapToNow != nil:
+		return qp.twapQuery(ctx, query)
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron.dex query type"}
+	}
+}
+
+// twapQuery serves the time weighted price queries. The twap module is optional, without it there
+// are no twap records to query.
+func (qp *QueryPlugin) twapQuery(ctx sdk.Context, query bindings.DexQuery) ([]byte, error) {
+	if qp.twapKeeper == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "neutron.dex twap queries are not enabled"}
+	}
+	twapQueryServer := twapgrpc.Querier{Q: twapclient.Querier{K: *qp.twapKeeper}}
+
+	switch {
+	case query.ArithmeticTwap != nil:
+		return processResponse(twapQueryServer.ArithmeticTwap(ctx, query.ArithmeticTwap))
+	case query.ArithmeticTwapToNow != nil:
+		return processResponse(twapQueryServer.ArithmeticTwapToNow(ctx, query.ArithmeticTwapToNow))
+	case query.GeometricTwap != nil:
+		return processResponse(twapQueryServer.GeometricTwap(ctx, query.GeometricTwap))
+	default:
+		return processResponse(twapQueryServer.GeometricTwapToNow(ctx, query.GeometricTwapToNow))
+	}
+}
 
 func (qp *QueryPlugin) OracleQuery(ctx sdk.Context, query bindings.OracleQuery) ([]byte, error) {
 	oracleQueryServer := oraclekeeper.NewQueryServer(*qp.oracleKeeper)
@@ -7,6 +7,8 @@ import (
     feerefunderkeeper "github.com/maany-xyz/maany-dex/v5/x/feerefunder/keeper"
     icqkeeper "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/keeper"
     icacontrollerkeeper "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/keeper"
+    "github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+    "github.com/maany-xyz/maany-dex/v5/x/twap"
     marketmapkeeper "github.com/skip-mev/slinky/x/marketmap/keeper"
     oraclekeeper "github.com/skip-mev/slinky/x/oracle/keeper"
 )
@@ -19,10 +21,12 @@ type QueryPlugin struct {
     contractmanagerQueryServer contractmanagertypes.QueryServer
     oracleKeeper               *oraclekeeper.Keeper
     marketmapKeeper            *marketmapkeeper.Keeper
+    poolmanagerKeeper          *poolmanager.Keeper
+    twapKeeper                 *twap.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(icaControllerKeeper *icacontrollerkeeper.Keeper, icqKeeper *icqkeeper.Keeper, feeBurnerKeeper *feeburnerkeeper.Keeper, feeRefunderKeeper *feerefunderkeeper.Keeper, contractmanagerKeeper *contractmanagerkeeper.Keeper, oracleKeeper *oraclekeeper.Keeper, marketmapKeeper *marketmapkeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(icaControllerKeeper *icacontrollerkeeper.Keeper, icqKeeper *icqkeeper.Keeper, feeBurnerKeeper *feeburnerkeeper.Keeper, feeRefunderKeeper *feerefunderkeeper.Keeper, contractmanagerKeeper *contractmanagerkeeper.Keeper, oracleKeeper *oraclekeeper.Keeper, marketmapKeeper *marketmapkeeper.Keeper, poolmanagerKeeper *poolmanager.Keeper, twapKeeper *twap.Keeper) *QueryPlugin {
     return &QueryPlugin{
         icaControllerKeeper:        icaControllerKeeper,
         icqKeeper:                  icqKeeper,
@@ -31,5 +35,7 @@ func NewQueryPlugin(icaControllerKeeper *icacontrollerkeeper.Keeper, icqKeeper *
         contractmanagerQueryServer: contractmanagerkeeper.NewQueryServerImpl(*contractmanagerKeeper),
         oracleKeeper:               oracleKeeper,
         marketmapKeeper:            marketmapKeeper,
+        poolmanagerKeeper:          poolmanagerKeeper,
+        twapKeeper:                 twapKeeper,
     }
 }
@@ -11,13 +11,13 @@ import (
     ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
     consumertypes "github.com/cosmos/interchain-security/v5/x/ccv/consumer/types"
     globalfeetypes "github.com/maany-xyz/maany-dex/v5/x/globalfee/types"
-    feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
-    marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
-    oracletypes "github.com/skip-mev/slinky/x/oracle/types"
     crontypes "github.com/maany-xyz/maany-dex/v5/x/cron/types"
     feeburnertypes "github.com/maany-xyz/maany-dex/v5/x/feeburner/types"
+    gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
     interchainqueriestypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
     interchaintxstypes "github.com/maany-xyz/maany-dex/v5/x/interchaintxs/types"
+    poolmanagerqueryproto "github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
+    twapqueryproto "github.com/maany-xyz/maany-dex/v5/x/twap/client/queryproto"
 )
 
 func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
@@ -41,7 +41,7 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 
 		// bank
 		"/cosmos.bank.v1beta1.Query/Balance":       &banktypes.QueryBalanceResponse{},
-		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomsMetadataResponse{},
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomMetadataResponse{},
 		"/cosmos.bank.v1beta1.Query/Params":        &banktypes.QueryParamsResponse{},
 		"/cosmos.bank.v1beta1.Query/SupplyOf":      &banktypes.QuerySupplyOfResponse{},
 
@@ -63,24 +63,34 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.feeburner.Query/Params":                    &feeburnertypes.QueryParamsResponse{},
 		"/neutron.feeburner.Query/TotalBurnedNeutronsAmount": &feeburnertypes.QueryTotalBurnedNeutronsAmountResponse{},
 
-		// dex
-
-		// oracle
-		"/slinky.oracle.v1.Query/GetAllCurrencyPairs": &oracletypes.GetAllCurrencyPairsResponse{},
-		"/slinky.oracle.v1.Query/GetPrice":            &oracletypes.GetPriceResponse{},
-		"/slinky.oracle.v1.Query/GetPrices":           &oracletypes.GetPricesResponse{},
-
-		// marketmap
-		"/slinky.marketmap.v1.Query/MarketMap":   &marketmaptypes.MarketMapResponse{},
-		"/slinky.marketmap.v1.Query/LastUpdated": &marketmaptypes.LastUpdatedResponse{},
-		"/slinky.marketmap.v1.Query/Params":      &marketmaptypes.ParamsResponse{},
-		"/slinky.marketmap.v1.Query/Market":      &marketmaptypes.MarketResponse{},
-
-		// feemarket
-		"/feemarket.feemarket.v1.Query/Params":    &feemarkettypes.ParamsResponse{},
-		"/feemarket.feemarket.v1.Query/State":     &feemarkettypes.StateResponse{},
-		"/feemarket.feemarket.v1.Query/GasPrice":  &feemarkettypes.GasPriceResponse{},
-		"/feemarket.feemarket.v1.Query/GasPrices": &feemarkettypes.GasPricesResponse{},
+		// poolmanager
+		"/osmosis.poolmanager.v1beta1.Query/Params":                               &poolmanagerqueryproto.ParamsResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn":            &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn":  &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut":           &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut": &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/NumPools":                             &poolmanagerqueryproto.NumPoolsResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/Pool":                                 &poolmanagerqueryproto.PoolResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/SpotPrice":                            &poolmanagerqueryproto.SpotPriceResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity":                   &poolmanagerqueryproto.TotalPoolLiquidityResponse{},
+		"/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee":                  &poolmanagerqueryproto.TradingPairTakerFeeResponse{},
+
+		// gamm
+		"/osmosis.gamm.v1beta1.Query/Pool":                        &gammtypes.QueryPoolResponse{},
+		"/osmosis.gamm.v1beta1.Query/PoolType":                    &gammtypes.QueryPoolTypeResponse{},
+		"/osmosis.gamm.v1beta1.Query/PoolParams":                  &gammtypes.QueryPoolParamsResponse{},
+		"/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity":          &gammtypes.QueryTotalPoolLiquidityResponse{},
+		"/osmosis.gamm.v1beta1.Query/TotalShares":                 &gammtypes.QueryTotalSharesResponse{},
+		"/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares":          &gammtypes.QueryCalcJoinPoolSharesResponse{},
+		"/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares":    &gammtypes.QueryCalcJoinPoolNoSwapSharesResponse{},
+		"/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares": &gammtypes.QueryCalcExitPoolCoinsFromSharesResponse{},
+
+		// twap
+		"/osmosis.twap.v1beta1.Query/Params":              &twapqueryproto.ParamsResponse{},
+		"/osmosis.twap.v1beta1.Query/ArithmeticTwap":      &twapqueryproto.ArithmeticTwapResponse{},
+		"/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow": &twapqueryproto.ArithmeticTwapToNowResponse{},
+		"/osmosis.twap.v1beta1.Query/GeometricTwap":       &twapqueryproto.GeometricTwapResponse{},
+		"/osmosis.twap.v1beta1.Query/GeometricTwapToNow":  &twapqueryproto.GeometricTwapToNowResponse{},
 
         // globalfee
         "/gaia.globalfee.v1beta1.Query/Params": &globalfeetypes.QueryParamsResponse{},
@@ -0,0 +1,170 @@
+package wasmbinding_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	icssimapp "github.com/cosmos/interchain-security/v5/testutil/ibc_testing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/maany-xyz/maany-dex/v5/app"
+	"github.com/maany-xyz/maany-dex/v5/app/config"
+	"github.com/maany-xyz/maany-dex/v5/testutil"
+	"github.com/maany-xyz/maany-dex/v5/wasmbinding"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
+	poolmanagerqueryproto "github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+	twapqueryproto "github.com/maany-xyz/maany-dex/v5/x/twap/client/queryproto"
+)
+
+// TestAcceptedStargateQueries checks every allowlisted query is served by the app router, that its
+// response type is the one the method returns and that it answers the same on the same state. The
+// pool and twap queries are made against a live balancer pool, they must succeed and their answer
+// must decode into the allowlisted response type through the stargate querier of the contracts.
+func TestAcceptedStargateQueries(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	chainID := ibctesting.GetChainID(1)
+
+	ibctesting.DefaultTestingAppInit = icssimapp.ProviderAppIniter
+	coordinator.Chains[chainID] = ibctesting.NewTestChain(t, coordinator, chainID)
+	providerChain := coordinator.GetChain(chainID)
+
+	_ = config.GetDefaultConfig()
+	ibctesting.DefaultTestingAppInit = testutil.SetupTestingApp(cmttypes.TM2PB.ValidatorUpdates(providerChain.Vals))
+	chain := ibctesting.NewTestChain(t, coordinator, "test")
+
+	neutronApp := chain.App.(*app.App)
+	router := neutronApp.GRPCQueryRouter()
+	ctx := chain.GetContext()
+
+	// a balancer pool gives the pool queries some state to answer from
+	poolAssets := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 2_000_000))
+	creator := chain.SenderAccount.GetAddress()
+	require.NoError(t, neutronApp.BankKeeper.MintCoins(ctx, gammtypes.ModuleName, poolAssets))
+	require.NoError(t, neutronApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, gammtypes.ModuleName, creator, poolAssets))
+	poolmanagerParams := neutronApp.PoolManagerKeeper.GetParams(ctx)
+	poolmanagerParams.PoolCreationFee = sdk.NewCoins()
+	neutronApp.PoolManagerKeeper.SetParams(ctx, poolmanagerParams)
+	poolID, err := neutronApp.PoolManagerKeeper.CreatePool(ctx, balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: math.LegacyNewDecWithPrec(3, 3),
+		ExitFee: math.LegacyZeroDec(),
+	}, []balancer.PoolAsset{
+		{Token: poolAssets[0], Weight: math.NewInt(1)},
+		{Token: poolAssets[1], Weight: math.NewInt(1)},
+	}, ""))
+	require.NoError(t, err)
+	createdAt := ctx.BlockTime()
+	endTime := createdAt.Add(30 * time.Second)
+	ctx = ctx.WithBlockTime(createdAt.Add(time.Minute))
+
+	requests := map[string]gogoproto.Message{
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomMetadataRequest{Denom: gammtypes.GetPoolShareDenom(poolID)},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn": &poolmanagerqueryproto.EstimateSwapExactAmountInRequest{
+			TokenIn: "1000uatom",
+			Routes:  []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: "untrn"}},
+		},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn": &poolmanagerqueryproto.EstimateSinglePoolSwapExactAmountInRequest{
+			PoolId: poolID, TokenIn: "1000uatom", TokenOutDenom: "untrn",
+		},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut": &poolmanagerqueryproto.EstimateSwapExactAmountOutRequest{
+			Routes:   []poolmanagertypes.SwapAmountOutRoute{{PoolId: poolID, TokenInDenom: "uatom"}},
+			TokenOut: "1000untrn",
+		},
+		"/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut": &poolmanagerqueryproto.EstimateSinglePoolSwapExactAmountOutRequest{
+			PoolId: poolID, TokenInDenom: "uatom", TokenOut: "1000untrn",
+		},
+		"/osmosis.poolmanager.v1beta1.Query/Pool":                &poolmanagerqueryproto.PoolRequest{PoolId: poolID},
+		"/osmosis.poolmanager.v1beta1.Query/SpotPrice":           &poolmanagerqueryproto.SpotPriceRequest{PoolId: poolID, BaseAssetDenom: "uatom", QuoteAssetDenom: "untrn"},
+		"/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity":  &poolmanagerqueryproto.TotalPoolLiquidityRequest{PoolId: poolID},
+		"/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee": &poolmanagerqueryproto.TradingPairTakerFeeRequest{Denom_0: "uatom", Denom_1: "untrn"},
+		"/osmosis.gamm.v1beta1.Query/Pool":                       &gammtypes.QueryPoolRequest{PoolId: poolID},
+		"/osmosis.gamm.v1beta1.Query/PoolType":                   &gammtypes.QueryPoolTypeRequest{PoolId: poolID},
+		"/osmosis.gamm.v1beta1.Query/PoolParams":                 &gammtypes.QueryPoolParamsRequest{PoolId: poolID},
+		"/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity":         &gammtypes.QueryTotalPoolLiquidityRequest{PoolId: poolID},
+		"/osmosis.gamm.v1beta1.Query/TotalShares":                &gammtypes.QueryTotalSharesRequest{PoolId: poolID},
+		"/osmosis.gamm.v1beta1.Query/CalcJoinPoolShares": &gammtypes.QueryCalcJoinPoolSharesRequest{
+			PoolId: poolID, TokensIn: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("untrn", 2000)),
+		},
+		"/osmosis.gamm.v1beta1.Query/CalcJoinPoolNoSwapShares": &gammtypes.QueryCalcJoinPoolNoSwapSharesRequest{
+			PoolId: poolID, TokensIn: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("untrn", 2000)),
+		},
+		"/osmosis.gamm.v1beta1.Query/CalcExitPoolCoinsFromShares": &gammtypes.QueryCalcExitPoolCoinsFromSharesRequest{
+			PoolId: poolID, ShareInAmount: gammtypes.OneShare,
+		},
+		"/osmosis.twap.v1beta1.Query/ArithmeticTwap": &twapqueryproto.ArithmeticTwapRequest{
+			PoolId: poolID, BaseAsset: "uatom", QuoteAsset: "untrn", StartTime: createdAt, EndTime: &endTime,
+		},
+		"/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow": &twapqueryproto.ArithmeticTwapToNowRequest{
+			PoolId: poolID, BaseAsset: "uatom", QuoteAsset: "untrn", StartTime: createdAt,
+		},
+		"/osmosis.twap.v1beta1.Query/GeometricTwap": &twapqueryproto.GeometricTwapRequest{
+			PoolId: poolID, BaseAsset: "uatom", QuoteAsset: "untrn", StartTime: createdAt, EndTime: &endTime,
+		},
+		"/osmosis.twap.v1beta1.Query/GeometricTwapToNow": &twapqueryproto.GeometricTwapToNowRequest{
+			PoolId: poolID, BaseAsset: "uatom", QuoteAsset: "untrn", StartTime: createdAt,
+		},
+	}
+
+	stargateQuerier := wasmkeeper.AcceptListStargateQuerier(wasmbinding.AcceptedStargateQueries(), router, neutronApp.AppCodec())
+
+	for path, resp := range wasmbinding.AcceptedStargateQueries() {
+		t.Run(path, func(t *testing.T) {
+			handler := router.Route(path)
+			require.NotNil(t, handler, "no handler registered for %s", path)
+
+			i := strings.LastIndex(path, "/")
+			require.True(t, strings.HasPrefix(path, "/") && i > 0, "malformed query path %s", path)
+			service, method := path[1:i], path[i+1:]
+			desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+			require.NoError(t, err)
+			serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+			require.True(t, ok, "%s is not a service", service)
+			methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+			require.NotNil(t, methodDesc, "no method %s in %s", method, service)
+			require.Equal(t, string(methodDesc.Output().FullName()), gogoproto.MessageName(resp), "response type of %s", path)
+
+			req := &abci.RequestQuery{Path: path}
+			if msg, ok := requests[path]; ok {
+				req.Data, err = neutronApp.AppCodec().Marshal(msg)
+				require.NoError(t, err)
+			}
+			queryCtx, _ := ctx.CacheContext()
+			first, firstErr := handler(queryCtx, req)
+			second, secondErr := handler(queryCtx, req)
+			if _, ok := requests[path]; ok {
+				require.NoError(t, firstErr, "%s fails on a live pool", path)
+			}
+			if firstErr != nil {
+				require.EqualError(t, secondErr, firstErr.Error(), "%s errors differently on the same state", path)
+				return
+			}
+			require.NoError(t, secondErr, "%s errors differently on the same state", path)
+			require.Equal(t, first.Value, second.Value, "%s answers differently on the same state", path)
+
+			if _, ok := requests[path]; !ok {
+				return
+			}
+			// the contract gets the answer as json of the allowlisted type, it decodes back to the same answer
+			bz, err := stargateQuerier(queryCtx, &wasmvmtypes.StargateQuery{Path: path, Data: req.Data})
+			require.NoError(t, err)
+			decoded := reflect.New(reflect.TypeOf(resp).Elem()).Interface().(gogoproto.Message)
+			require.NoError(t, neutronApp.AppCodec().UnmarshalJSON(bz, decoded), "%s does not decode into %T", path, resp)
+			reencoded, err := neutronApp.AppCodec().Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, first.Value, reencoded, "%s decodes with a loss into %T", path, resp)
+		})
+	}
+}
@@ -4,10 +4,14 @@ import (
     wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
     bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	concentratedliquidity "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity"
 	contractmanagerkeeper "github.com/maany-xyz/maany-dex/v5/x/contractmanager/keeper"
 	cronkeeper "github.com/maany-xyz/maany-dex/v5/x/cron/keeper"
 	feeburnerkeeper "github.com/maany-xyz/maany-dex/v5/x/feeburner/keeper"
 	feerefunderkeeper "github.com/maany-xyz/maany-dex/v5/x/feerefunder/keeper"
+	gammkeeper "github.com/maany-xyz/maany-dex/v5/x/gamm/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	"github.com/maany-xyz/maany-dex/v5/x/twap"
 
 	adminmodulekeeper "github.com/cosmos/admin-module/v2/x/adminmodule/keeper"
 
@@ -31,14 +35,18 @@ func RegisterCustomPlugins(
     contractmanagerKeeper *contractmanagerkeeper.Keeper,
     oracleKeeper *oraclekeeper.Keeper,
     markemapKeeper *marketmapkeeper.Keeper,
+    poolmanagerKeeper *poolmanager.Keeper,
+    gammKeeper *gammkeeper.Keeper,
+    concentratedLiquidityKeeper *concentratedliquidity.Keeper,
+    twapKeeper *twap.Keeper,
 ) []wasmkeeper.Option {
-    wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeBurnerKeeper, feeRefunderKeeper, contractmanagerKeeper, oracleKeeper, markemapKeeper)
+    wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeBurnerKeeper, feeRefunderKeeper, contractmanagerKeeper, oracleKeeper, markemapKeeper, poolmanagerKeeper, twapKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
     messagePluginOpt := wasmkeeper.WithMessageHandlerDecorator(
-        CustomMessageDecorator(ictxKeeper, icqKeeper, adminKeeper, bank, cronKeeper, contractmanagerKeeper),
+        CustomMessageDecorator(ictxKeeper, icqKeeper, adminKeeper, bank, cronKeeper, contractmanagerKeeper, poolmanagerKeeper, gammKeeper, concentratedLiquidityKeeper),
     )
 
 	return []wasmkeeper.Option{
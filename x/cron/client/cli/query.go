@@ -24,6 +24,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListSchedule())
 	cmd.AddCommand(CmdShowSchedule())
+	cmd.AddCommand(CmdListScheduleFailures())
 
 	return cmd
 }
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
+)
+
+func CmdListScheduleFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-schedule-failures [name]",
+		Short: "list the last failed executions of all schedules or of a schedule with a given name",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduleFailuresRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				params.ScheduleName = args[0]
+			}
+
+			res, err := queryClient.ScheduleFailures(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,18 +9,20 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// this line is used by starport scaffolding # genesis/module/init
+	// Params are set first since the schedules' sdk msgs are checked against them
+	err := k.SetParams(ctx, genState.Params)
+	if err != nil {
+		panic(err)
+	}
+
 	// Set all the schedules
 	for _, elem := range genState.ScheduleList {
-		err := k.AddSchedule(ctx, elem.Name, elem.Period, elem.Msgs, elem.ExecutionStage)
+		err := k.AddSchedule(ctx, elem.Name, elem.Period, elem.Msgs, elem.SdkMsgs, elem.ExecutionStage, elem.GasLimit)
 		if err != nil {
 			panic(err)
 		}
 	}
-	// this line is used by starport scaffolding # genesis/module/init
-	err := k.SetParams(ctx, genState.Params)
-	if err != nil {
-		panic(err)
-	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -12,7 +12,7 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
-	k, ctx := keeper.CronKeeper(t, nil, nil)
+	k, ctx := keeper.CronKeeper(t, nil, nil, nil)
 
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	contractmanagerkeeper "github.com/maany-xyz/maany-dex/v5/x/contractmanager/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
+)
+
+// GetScheduleFailure returns the last failed execution of the schedule with a given `name`
+func (k *Keeper) GetScheduleFailure(ctx sdk.Context, name string) (*types.ScheduleFailure, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleFailureKey)
+	bz := store.Get(types.GetScheduleKey(name))
+	if bz == nil {
+		return nil, false
+	}
+
+	var failure types.ScheduleFailure
+	k.cdc.MustUnmarshal(bz, &failure)
+	return &failure, true
+}
+
+// GetAllScheduleFailures returns the last failed executions of all schedules
+func (k *Keeper) GetAllScheduleFailures(ctx sdk.Context) []types.ScheduleFailure {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleFailureKey)
+
+	res := make([]types.ScheduleFailure, 0)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var failure types.ScheduleFailure
+		k.cdc.MustUnmarshal(iterator.Value(), &failure)
+		res = append(res, failure)
+	}
+
+	return res
+}
+
+// storeScheduleFailure records the failed execution of a schedule, replacing the previous failure of the schedule.
+// Only the redacted error is stored to keep the state deterministic, the full error is emitted as an event
+func (k *Keeper) storeScheduleFailure(ctx sdk.Context, schedule types.Schedule, err error) {
+	failure := types.ScheduleFailure{
+		ScheduleName: schedule.Name,
+		BlockHeight:  uint64(ctx.BlockHeight()),
+		Error:        contractmanagerkeeper.RedactError(err).Error(),
+		OutOfGas:     errors.IsOf(err, types.ErrScheduleOutOfGas, sdkerrors.ErrOutOfGas),
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleFailureKey)
+	store.Set(types.GetScheduleKey(schedule.Name), k.cdc.MustMarshal(&failure))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleFailure,
+			sdk.NewAttribute(types.AttributeKeyScheduleName, schedule.Name),
+			sdk.NewAttribute(types.AttributeKeyOutOfGas, strconv.FormatBool(failure.OutOfGas)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+func (k *Keeper) removeScheduleFailure(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleFailureKey)
+	store.Delete(types.GetScheduleKey(name))
+}
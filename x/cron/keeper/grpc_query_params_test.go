@@ -11,7 +11,7 @@ import (
 )
 
 func TestParamsQuery(t *testing.T) {
-	keeper, ctx := testkeeper.CronKeeper(t, nil, nil)
+	keeper, ctx := testkeeper.CronKeeper(t, nil, nil, nil)
 	params := types.DefaultParams()
 	err := keeper.SetParams(ctx, params)
 	require.NoError(t, err)
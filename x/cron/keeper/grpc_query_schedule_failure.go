@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
+)
+
+func (k Keeper) ScheduleFailures(c context.Context, req *types.QueryScheduleFailuresRequest) (*types.QueryScheduleFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.ScheduleName != "" {
+		failures := make([]types.ScheduleFailure, 0, 1)
+		if failure, found := k.GetScheduleFailure(ctx, req.ScheduleName); found {
+			failures = append(failures, *failure)
+		}
+		return &types.QueryScheduleFailuresResponse{Failures: failures}, nil
+	}
+
+	var failures []types.ScheduleFailure
+	failureStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleFailureKey)
+
+	pageRes, err := query.Paginate(failureStore, req.Pagination, func(_, value []byte) error {
+		var failure types.ScheduleFailure
+		k.cdc.MustUnmarshal(value, &failure)
+
+		failures = append(failures, failure)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduleFailuresResponse{Failures: failures, Pagination: pageRes}, nil
+}
@@ -20,7 +20,7 @@ import (
 var _ = strconv.IntSize
 
 func TestScheduleQuerySingle(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil, nil)
 	schedules := createNSchedule(t, ctx, k, 2)
 
 	for _, tc := range []struct {
@@ -71,7 +71,7 @@ func TestScheduleQuerySingle(t *testing.T) {
 }
 
 func TestScheduleQueryPaginated(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil, nil)
 	schedules := createNSchedule(t, ctx, k, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QuerySchedulesRequest {
@@ -135,7 +135,7 @@ func createNSchedule(t *testing.T, ctx sdk.Context, k *cronkeeper.Keeper, n int3
 		item.LastExecuteHeight = uint64(ctx.BlockHeight())
 		item.ExecutionStage = types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER
 
-		err := k.AddSchedule(ctx, item.Name, item.Period, item.Msgs, item.ExecutionStage)
+		err := k.AddSchedule(ctx, item.Name, item.Period, item.Msgs, item.SdkMsgs, item.ExecutionStage, item.GasLimit)
 		require.NoError(t, err)
 
 		res[idx] = item
@@ -13,8 +13,10 @@ import (
 	"cosmossdk.io/store/prefix"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	"cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
@@ -31,20 +33,22 @@ var (
 
 type (
 	Keeper struct {
-		cdc           codec.BinaryCodec
+		cdc           codec.Codec
 		storeKey      storetypes.StoreKey
 		memKey        storetypes.StoreKey
 		accountKeeper types.AccountKeeper
+		router        types.MessageRouter
 		WasmMsgServer types.WasmMsgServer
 		authority     string
 	}
 )
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	storeKey,
 	memKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
+	router types.MessageRouter,
 	authority string,
 ) *Keeper {
 	return &Keeper{
@@ -52,6 +56,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		memKey:        memKey,
 		accountKeeper: accountKeeper,
+		router:        router,
 		authority:     authority,
 	}
 }
@@ -69,32 +74,50 @@ func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k *Keeper) ExecuteReadySchedules(ctx sdk.Context, executionStage types.ExecutionStage) {
 	telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelExecuteReadySchedules)
 	schedules := k.getSchedulesReadyForExecution(ctx, executionStage)
+	if len(schedules) == 0 {
+		return
+	}
+
+	// the next execution starts right after the last schedule executed now
+	k.setScheduleCursor(ctx, executionStage, schedules[len(schedules)-1].Name)
 
 	for _, schedule := range schedules {
 		err := k.executeSchedule(ctx, schedule)
+		if err != nil {
+			k.storeScheduleFailure(ctx, schedule, err)
+		}
 		recordExecutedSchedule(err, schedule)
 	}
 }
 
 // AddSchedule adds a new schedule to be executed every certain number of blocks, specified in the `period`.
 // First schedule execution is supposed to be on `now + period` block.
+// Sdk msgs must be of a type allowlisted in the module params and must be signed only by the cron module account.
 func (k *Keeper) AddSchedule(
 	ctx sdk.Context,
 	name string,
 	period uint64,
 	msgs []types.MsgExecuteContract,
+	sdkMsgs []*codectypes.Any,
 	executionStage types.ExecutionStage,
+	gasLimit uint64,
 ) error {
 	if k.scheduleExists(ctx, name) {
 		return fmt.Errorf("schedule already exists with name=%v", name)
 	}
 
+	if _, err := k.authorizeSdkMsgs(ctx, sdkMsgs); err != nil {
+		return err
+	}
+
 	schedule := types.Schedule{
 		Name:              name,
 		Period:            period,
 		Msgs:              msgs,
 		LastExecuteHeight: uint64(ctx.BlockHeight()), // let's execute newly added schedule on `now + period` block
 		ExecutionStage:    executionStage,
+		SdkMsgs:           sdkMsgs,
+		GasLimit:          gasLimit,
 	}
 
 	k.storeSchedule(ctx, schedule)
@@ -111,6 +134,7 @@ func (k *Keeper) RemoveSchedule(ctx sdk.Context, name string) {
 
 	k.changeTotalCount(ctx, -1)
 	k.removeSchedule(ctx, name)
+	k.removeScheduleFailure(ctx, name)
 }
 
 // GetSchedule returns schedule with a given `name`
@@ -151,40 +175,85 @@ func (k *Keeper) GetScheduleCount(ctx sdk.Context) int32 {
 func (k *Keeper) getSchedulesReadyForExecution(ctx sdk.Context, executionStage types.ExecutionStage) []types.Schedule {
 	params := k.GetParams(ctx)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKey)
-	count := uint64(0)
 
 	res := make([]types.Schedule, 0)
 
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
+	// collect adds the ready schedules within [start, end) and returns true once the limit is reached
+	collect := func(start, end []byte) bool {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
-		var schedule types.Schedule
-		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		for ; iterator.Valid(); iterator.Next() {
+			var schedule types.Schedule
+			k.cdc.MustUnmarshal(iterator.Value(), &schedule)
 
-		if k.intervalPassed(ctx, schedule) && schedule.ExecutionStage == executionStage {
-			res = append(res, schedule)
-			count++
+			if k.intervalPassed(ctx, schedule) && schedule.ExecutionStage == executionStage {
+				res = append(res, schedule)
 
-			if count >= params.Limit {
-				k.Logger(ctx).Info("limit of schedule executions per block reached")
-				return res
+				if uint64(len(res)) >= params.Limit {
+					k.Logger(ctx).Info("limit of schedule executions per block reached")
+					return true
+				}
 			}
 		}
+		return false
+	}
+
+	// Iterate from the schedule following the last executed one and wrap around, so that schedules
+	// at the end of the key order are not starved when there are more ready schedules than the limit
+	cursor, found := k.getScheduleCursor(ctx, executionStage)
+	if !found {
+		collect(nil, nil)
+		return res
+	}
+
+	next := append(types.GetScheduleKey(cursor), 0)
+	if !collect(next, nil) {
+		collect(nil, next)
 	}
 
 	return res
 }
 
 // executeSchedule executes all msgs in a given schedule and changes LastExecuteHeight
-// if at least one msg execution fails, rollback all messages
+// if at least one msg execution fails or the schedule runs out of its gas limit, rollback all messages
 func (k *Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) error {
 	// Even if contract execution returned an error, we still increase the height
 	// and execute it after this interval
 	schedule.LastExecuteHeight = uint64(ctx.BlockHeight())
 	k.storeSchedule(ctx, schedule)
 
+	gasLimit := schedule.GasLimit
+	if gasLimit == 0 {
+		gasLimit = k.GetParams(ctx).DefaultGasLimit
+	}
+
 	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	err := k.executeScheduleMsgs(cacheCtx, schedule)
+	ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "consume gas from cached context")
+	if err != nil {
+		return err
+	}
+
+	// only save state if all the messages in a schedule were executed successfully
+	writeFn()
+	return nil
+}
+
+// executeScheduleMsgs executes the wasm and sdk msgs of a schedule.
+// An `out of gas` panic is converted into ErrScheduleOutOfGas, any other kind of panic is propagated
+func (k *Keeper) executeScheduleMsgs(ctx sdk.Context, schedule types.Schedule) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			_, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok || !ctx.GasMeter().IsOutOfGas() {
+				panic(r)
+			}
+			err = errors.Wrapf(types.ErrScheduleOutOfGas, "gas limit: %d", ctx.GasMeter().Limit())
+		}
+	}()
 
 	for idx, msg := range schedule.Msgs {
 		executeMsg := wasmtypes.MsgExecuteContract{
@@ -193,7 +262,7 @@ func (k *Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) error
 			Msg:      []byte(msg.Msg),
 			Funds:    sdk.NewCoins(),
 		}
-		_, err := k.WasmMsgServer.ExecuteContract(cacheCtx, &executeMsg)
+		_, err := k.WasmMsgServer.ExecuteContract(ctx, &executeMsg)
 		if err != nil {
 			ctx.Logger().Info("executeSchedule: failed to execute contract msg",
 				"schedule_name", schedule.Name,
@@ -206,8 +275,95 @@ func (k *Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) error
 		}
 	}
 
-	// only save state if all the messages in a schedule were executed successfully
-	writeFn()
+	// the allowlist might have changed since the schedule was added, so sdk msgs are authorized on every execution
+	sdkMsgs, err := k.authorizeSdkMsgs(ctx, schedule.SdkMsgs)
+	if err != nil {
+		ctx.Logger().Info("executeSchedule: sdk msgs are not authorized",
+			"schedule_name", schedule.Name,
+			"error", err,
+		)
+		return err
+	}
+
+	for idx, msg := range sdkMsgs {
+		if err := k.dispatchSdkMsg(ctx, msg); err != nil {
+			ctx.Logger().Info("executeSchedule: failed to execute sdk msg",
+				"schedule_name", schedule.Name,
+				"msg_idx", idx,
+				"msg_type", sdk.MsgTypeURL(msg),
+				"error", err,
+			)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorizeSdkMsgs unpacks the sdk msgs and checks that each one is of a type allowlisted in the module params
+// and is signed only by the cron module account, so that a schedule can't act on behalf of any other account
+func (k *Keeper) authorizeSdkMsgs(ctx sdk.Context, sdkMsgs []*codectypes.Any) ([]sdk.Msg, error) {
+	if len(sdkMsgs) == 0 {
+		return nil, nil
+	}
+
+	params := k.GetParams(ctx)
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	res := make([]sdk.Msg, 0, len(sdkMsgs))
+	for idx, anyMsg := range sdkMsgs {
+		if !params.IsMsgTypeAllowed(anyMsg.TypeUrl) {
+			return nil, errors.Wrapf(types.ErrMsgTypeNotAllowed, "sdk msg #%d: %s", idx, anyMsg.TypeUrl)
+		}
+
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, errors.Wrapf(err, "failed to unpack sdk msg #%d", idx)
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get signers of sdk msg #%d", idx)
+		}
+		if len(signers) == 0 {
+			return nil, errors.Wrapf(types.ErrUnauthorizedSigner, "sdk msg #%d has no signers", idx)
+		}
+		for _, signer := range signers {
+			if !moduleAddress.Equals(sdk.AccAddress(signer)) {
+				return nil, errors.Wrapf(types.ErrUnauthorizedSigner, "sdk msg #%d is signed by %s", idx, sdk.AccAddress(signer))
+			}
+		}
+
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
+
+// dispatchSdkMsg routes the sdk msg to its handler in the msg service router and emits the resulting events
+func (k *Keeper) dispatchSdkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errors.Wrap(types.ErrNoMsgHandler, sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	events := make(sdk.Events, len(res.GetEvents()))
+	for i, event := range res.GetEvents() {
+		events[i] = sdk.Event(event)
+	}
+	ctx.EventManager().EmitEvents(events)
+
 	return nil
 }
 
@@ -229,6 +385,18 @@ func (k *Keeper) scheduleExists(ctx sdk.Context, name string) bool {
 	return store.Has(types.GetScheduleKey(name))
 }
 
+func (k *Keeper) getScheduleCursor(ctx sdk.Context, executionStage types.ExecutionStage) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScheduleCursorKey(executionStage))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+func (k *Keeper) setScheduleCursor(ctx sdk.Context, executionStage types.ExecutionStage, name string) {
+	ctx.KVStore(k.storeKey).Set(types.GetScheduleCursorKey(executionStage), []byte(name))
+}
+
 func (k *Keeper) intervalPassed(ctx sdk.Context, schedule types.Schedule) bool {
 	return uint64(ctx.BlockHeight()) > (schedule.LastExecuteHeight + schedule.Period)
 }
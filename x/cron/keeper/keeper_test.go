@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -8,7 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -32,7 +36,7 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 	require.NoError(t, err)
 
 	wasmMsgServer := mock_types.NewMockWasmMsgServer(ctrl)
-	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper)
+	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper, nil)
 	ctx = ctx.WithBlockHeight(0)
 
 	err = k.SetParams(ctx, types.Params{
@@ -113,7 +117,7 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 
 	for _, item := range schedules {
 		ctx = ctx.WithBlockHeight(int64(item.LastExecuteHeight))
-		err := k.AddSchedule(ctx, item.Name, item.Period, item.Msgs, item.ExecutionStage)
+		err := k.AddSchedule(ctx, item.Name, item.Period, item.Msgs, item.SdkMsgs, item.ExecutionStage, item.GasLimit)
 		require.NoError(t, err)
 	}
 
@@ -212,7 +216,7 @@ func TestAddSchedule(t *testing.T) {
 	accountKeeper := mock_types.NewMockAccountKeeper(ctrl)
 
 	wasmMsgServer := mock_types.NewMockWasmMsgServer(ctrl)
-	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper)
+	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper, nil)
 	ctx = ctx.WithBlockHeight(0)
 
 	err := k.SetParams(ctx, types.Params{
@@ -227,7 +231,7 @@ func TestAddSchedule(t *testing.T) {
 			Contract: "c",
 			Msg:      "m",
 		},
-	}, types.ExecutionStage_EXECUTION_STAGE_BEGIN_BLOCKER)
+	}, nil, types.ExecutionStage_EXECUTION_STAGE_BEGIN_BLOCKER, 0)
 	require.NoError(t, err)
 
 	err = k.AddSchedule(ctx, "b", 7, []types.MsgExecuteContract{
@@ -235,11 +239,11 @@ func TestAddSchedule(t *testing.T) {
 			Contract: "c",
 			Msg:      "m",
 		},
-	}, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	}, nil, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
 	require.NoError(t, err)
 
 	// second time with same name returns error
-	err = k.AddSchedule(ctx, "a", 5, []types.MsgExecuteContract{}, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	err = k.AddSchedule(ctx, "a", 5, []types.MsgExecuteContract{}, nil, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
 	require.Error(t, err)
 
 	scheduleA, found := k.GetSchedule(ctx, "a")
@@ -266,7 +270,7 @@ func TestAddSchedule(t *testing.T) {
 }
 
 func TestGetAllSchedules(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil, nil)
 
 	err := k.SetParams(ctx, types.Params{
 		SecurityAddress: testutil.TestOwnerAddress,
@@ -284,7 +288,7 @@ func TestGetAllSchedules(t *testing.T) {
 			ExecutionStage:    types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER,
 		}
 		expectedSchedules = append(expectedSchedules, s)
-		err := k.AddSchedule(ctx, s.Name, s.Period, s.Msgs, s.ExecutionStage)
+		err := k.AddSchedule(ctx, s.Name, s.Period, s.Msgs, s.SdkMsgs, s.ExecutionStage, s.GasLimit)
 		require.NoError(t, err)
 	}
 
@@ -293,3 +297,192 @@ func TestGetAllSchedules(t *testing.T) {
 	assert.ElementsMatch(t, schedules, expectedSchedules)
 	assert.Equal(t, int32(3), k.GetScheduleCount(ctx))
 }
+
+func TestKeeperExecuteSdkMsgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cronAddr := authtypes.NewModuleAddress(types.ModuleName)
+	otherAddr := sdk.AccAddress("other_address_______")
+	accountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(cronAddr).AnyTimes()
+
+	router := mock_types.NewMockMessageRouter(ctrl)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, accountKeeper, router)
+	ctx = ctx.WithBlockHeight(0)
+
+	err := k.SetParams(ctx, types.Params{
+		SecurityAddress: testutil.TestOwnerAddress,
+		Limit:           2,
+		AllowedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		DefaultGasLimit: types.DefaultScheduleGasLimit,
+	})
+	require.NoError(t, err)
+
+	newSend := func(from sdk.AccAddress) *codectypes.Any {
+		anyMsg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(from, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("untrn", 100))))
+		require.NoError(t, err)
+		return anyMsg
+	}
+
+	// msg types that are not allowlisted are rejected
+	multiSend, err := codectypes.NewAnyWithValue(banktypes.NewMsgMultiSend(
+		banktypes.NewInput(cronAddr, sdk.NewCoins(sdk.NewInt64Coin("untrn", 100))),
+		[]banktypes.Output{banktypes.NewOutput(otherAddr, sdk.NewCoins(sdk.NewInt64Coin("untrn", 100)))},
+	))
+	require.NoError(t, err)
+	err = k.AddSchedule(ctx, "multi_send", 1, nil, []*codectypes.Any{multiSend}, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
+	require.ErrorIs(t, err, types.ErrMsgTypeNotAllowed)
+
+	// msgs signed by any account other than the cron module are rejected
+	err = k.AddSchedule(ctx, "other_signer", 1, nil, []*codectypes.Any{newSend(otherAddr)}, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
+	require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
+
+	err = k.AddSchedule(ctx, "send", 1, nil, []*codectypes.Any{newSend(cronAddr)}, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
+	require.NoError(t, err)
+
+	// the msg is dispatched through the router and its events are emitted
+	var dispatched []sdk.Msg
+	router.EXPECT().Handler(gomock.Any()).Return(func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		dispatched = append(dispatched, msg)
+		return &sdk.Result{Events: sdk.Events{sdk.NewEvent("dispatched")}.ToABCIEvents()}, nil
+	})
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	require.Len(t, dispatched, 1)
+	require.Equal(t, cronAddr.String(), dispatched[0].(*banktypes.MsgSend).FromAddress)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "dispatched", ctx.EventManager().Events()[0].Type)
+
+	// the allowlist is checked again on execution, so removing the type stops the schedule
+	err = k.SetParams(ctx, types.Params{
+		SecurityAddress: testutil.TestOwnerAddress,
+		Limit:           2,
+		DefaultGasLimit: types.DefaultScheduleGasLimit,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(4)
+	k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	require.Len(t, dispatched, 1)
+	schedule, found := k.GetSchedule(ctx, "send")
+	require.True(t, found)
+	require.Equal(t, uint64(4), schedule.LastExecuteHeight)
+}
+
+func TestKeeperExecuteReadySchedulesRoundRobin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName)).AnyTimes()
+
+	var executed []string
+	wasmMsgServer := mock_types.NewMockWasmMsgServer(ctrl)
+	wasmMsgServer.EXPECT().ExecuteContract(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+			executed = append(executed, msg.Contract)
+			return &wasmtypes.MsgExecuteContractResponse{}, nil
+		}).AnyTimes()
+
+	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper, nil)
+	ctx = ctx.WithBlockHeight(0)
+
+	params := types.DefaultParams()
+	params.Limit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// every schedule is ready on every block
+	for _, name := range []string{"a", "b", "c"} {
+		err := k.AddSchedule(ctx, name, 0, []types.MsgExecuteContract{{Contract: name, Msg: "m"}}, nil, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0)
+		require.NoError(t, err)
+	}
+
+	// the execution continues after the last executed schedule and wraps around,
+	// so that "c" is not starved by the limit
+	expected := [][]string{{"a", "b"}, {"c", "a"}, {"b", "c"}, {"a", "b"}}
+	for i, exp := range expected {
+		executed = nil
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+		require.Equal(t, exp, executed, "block %d", i+1)
+	}
+
+	// removing the schedule the cursor points to does not break the rotation
+	k.RemoveSchedule(ctx, "b")
+	executed = nil
+	ctx = ctx.WithBlockHeight(5)
+	k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	require.Equal(t, []string{"c", "a"}, executed)
+}
+
+func TestKeeperScheduleGasLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName)).AnyTimes()
+
+	// every contract call emits an event and consumes 1000 gas
+	wasmMsgServer := mock_types.NewMockWasmMsgServer(ctrl)
+	wasmMsgServer.EXPECT().ExecuteContract(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(goCtx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+			c := sdk.UnwrapSDKContext(goCtx)
+			c.EventManager().EmitEvent(sdk.NewEvent("executed", sdk.NewAttribute("contract", msg.Contract)))
+			c.GasMeter().ConsumeGas(1_000, "contract execution")
+			return &wasmtypes.MsgExecuteContractResponse{}, nil
+		}).AnyTimes()
+
+	k, ctx := testutil_keeper.CronKeeper(t, wasmMsgServer, accountKeeper, nil)
+	ctx = ctx.WithBlockHeight(0)
+
+	params := types.DefaultParams()
+	params.DefaultGasLimit = 1_500
+	require.NoError(t, k.SetParams(ctx, params))
+
+	msgs := []types.MsgExecuteContract{{Contract: "first", Msg: "m"}, {Contract: "second", Msg: "m"}}
+	require.NoError(t, k.AddSchedule(ctx, "default_limit", 1, msgs, nil, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 0))
+	require.NoError(t, k.AddSchedule(ctx, "own_limit", 1, msgs[:1], nil, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER, 500))
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+
+	// both schedules ran out of gas, so their changes are rolled back and only the failures are emitted
+	schedule, found := k.GetSchedule(ctx, "default_limit")
+	require.True(t, found)
+	require.Equal(t, uint64(2), schedule.LastExecuteHeight)
+
+	resp, err := k.ScheduleFailures(ctx, &types.QueryScheduleFailuresRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ScheduleFailure{
+		{ScheduleName: "default_limit", BlockHeight: 2, Error: "codespace: cron, code: 1104", OutOfGas: true},
+		{ScheduleName: "own_limit", BlockHeight: 2, Error: "codespace: cron, code: 1104", OutOfGas: true},
+	}, resp.Failures)
+	require.Len(t, ctx.EventManager().Events(), 2)
+	for _, event := range ctx.EventManager().Events() {
+		require.Equal(t, types.EventTypeScheduleFailure, event.Type)
+	}
+
+	// a schedule within its gas limit is executed and its changes are saved
+	params.DefaultGasLimit = 2_000
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	k.ExecuteReadySchedules(ctx, types.ExecutionStage_EXECUTION_STAGE_END_BLOCKER)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	require.Equal(t, "executed", events[0].Type)
+	require.Equal(t, "executed", events[1].Type)
+	require.Equal(t, types.EventTypeScheduleFailure, events[2].Type)
+
+	// the last failure stays queryable until the schedule is removed
+	resp, err = k.ScheduleFailures(ctx, &types.QueryScheduleFailuresRequest{ScheduleName: "default_limit"})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 1)
+	require.Equal(t, uint64(2), resp.Failures[0].BlockHeight)
+
+	k.RemoveSchedule(ctx, "default_limit")
+	resp, err = k.ScheduleFailures(ctx, &types.QueryScheduleFailuresRequest{ScheduleName: "default_limit"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+}
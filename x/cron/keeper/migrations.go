@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/maany-xyz/maany-dex/v5/x/cron/migrations/v2"
+	v3 "github.com/maany-xyz/maany-dex/v5/x/cron/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,10 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
 }
+
+// MigrateGasLimit migrates the store to the v3 layout, which adds the default gas limit of schedule executions.
+// Schedules of this chain have an execution stage since genesis, so the store is migrated from the v2 layout
+// by the consensus version 1 to 2 migration.
+func (m Migrator) MigrateGasLimit(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
@@ -34,7 +34,7 @@ func (k msgServer) AddSchedule(goCtx context.Context, req *types.MsgAddSchedule)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.keeper.AddSchedule(ctx, req.Name, req.Period, req.Msgs, req.ExecutionStage); err != nil {
+	if err := k.keeper.AddSchedule(ctx, req.Name, req.Period, req.Msgs, req.SdkMsgs, req.ExecutionStage, req.GasLimit); err != nil {
 		return nil, errors.Wrap(err, "failed to add schedule")
 	}
 
@@ -12,7 +12,7 @@ import (
 )
 
 func TestMsgAddScheduleValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
 
 	tests := []struct {
@@ -124,7 +124,7 @@ func TestMsgAddScheduleValidate(t *testing.T) {
 }
 
 func TestMsgRemoveScheduleValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
 
 	tests := []struct {
@@ -169,7 +169,7 @@ func TestMsgRemoveScheduleValidate(t *testing.T) {
 }
 
 func TestMsgUpdateParamsValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
 
 	tests := []struct {
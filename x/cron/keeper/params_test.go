@@ -17,7 +17,7 @@ import (
 func TestGetParams(t *testing.T) {
 	_ = config.GetDefaultConfig()
 
-	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil, nil)
 	params := types.Params{
 		SecurityAddress: testutil.TestOwnerAddress,
 		Limit:           5,
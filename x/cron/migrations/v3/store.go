@@ -0,0 +1,40 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration sets the default gas limit of schedule executions. Existing schedules don't set their own
+// gas limit, so they are executed with the default one.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating cron Params...")
+
+	store := ctx.KVStore(storeKey)
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	if params.DefaultGasLimit == 0 {
+		params.DefaultGasLimit = types.DefaultScheduleGasLimit
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	ctx.Logger().Info("Finished migrating cron Params...")
+
+	return nil
+}
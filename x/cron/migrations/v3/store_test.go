@@ -0,0 +1,45 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/testutil"
+	v3 "github.com/maany-xyz/maany-dex/v5/x/cron/migrations/v3"
+	"github.com/maany-xyz/maany-dex/v5/x/cron/types"
+)
+
+type V3CronMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V3CronMigrationTestSuite))
+}
+
+func (suite *V3CronMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// params stored before the default gas limit was added
+	params := types.Params{
+		SecurityAddress: app.CronKeeper.GetParams(ctx).SecurityAddress,
+		Limit:           7,
+	}
+	suite.Require().NoError(app.CronKeeper.SetParams(ctx, params))
+
+	// Run migration
+	suite.NoError(v3.MigrateStore(ctx, cdc, storeKey))
+
+	// Check Params have the default gas limit set and keep the other values
+	newParams := app.CronKeeper.GetParams(ctx)
+	suite.Equal(params.SecurityAddress, newParams.SecurityAddress)
+	suite.Equal(params.Limit, newParams.Limit)
+	suite.Equal(types.DefaultScheduleGasLimit, newParams.DefaultGasLimit)
+	suite.NoError(newParams.Validate())
+}
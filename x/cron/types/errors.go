@@ -8,5 +8,9 @@ import (
 
 // x/cron module sentinel errors
 var (
-	ErrSample = errors.Register(ModuleName, 1100, "sample error")
+	ErrSample             = errors.Register(ModuleName, 1100, "sample error")
+	ErrMsgTypeNotAllowed  = errors.Register(ModuleName, 1101, "msg type is not allowed")
+	ErrUnauthorizedSigner = errors.Register(ModuleName, 1102, "msg must be signed only by the cron module account")
+	ErrNoMsgHandler       = errors.Register(ModuleName, 1103, "no handler found for msg")
+	ErrScheduleOutOfGas   = errors.Register(ModuleName, 1104, "schedule ran out of gas")
 )
@@ -0,0 +1,10 @@
+package types
+
+// Cron module event types
+const (
+	EventTypeScheduleFailure = "schedule_failure"
+
+	AttributeKeyScheduleName = "schedule_name"
+	AttributeKeyOutOfGas     = "out_of_gas"
+	AttributeKeyError        = "error"
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,3 +18,8 @@ type WasmMsgServer interface {
 	ExecuteContract(context.Context, *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error)
 	// Methods imported from account should be defined here
 }
+
+// MessageRouter defines the expected msg service router used to dispatch the sdk msgs of schedules
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
@@ -1,6 +1,12 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
@@ -22,7 +28,21 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for schedule")
 		}
 		scheduleIndexMap[index] = struct{}{}
+
+		if err := validateSdkMsgs(elem.SdkMsgs); err != nil {
+			return fmt.Errorf("invalid schedule %s: %w", elem.Name, err)
+		}
 	}
 
 	return gs.Params.Validate()
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range gs.ScheduleList {
+		if err := gs.ScheduleList[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,14 +18,24 @@ const (
 	prefixScheduleKey = iota + 1
 	prefixScheduleCountKey
 	prefixParamsKey
+	prefixScheduleCursorKey
+	prefixScheduleFailureKey
 )
 
 var (
 	ScheduleKey      = []byte{prefixScheduleKey}
 	ScheduleCountKey = []byte{prefixScheduleCountKey}
 	ParamsKey        = []byte{prefixParamsKey}
+	// ScheduleCursorKey is the prefix of the names of the last schedules executed in each execution stage
+	ScheduleCursorKey = []byte{prefixScheduleCursorKey}
+	// ScheduleFailureKey is the prefix of the last failed executions of schedules
+	ScheduleFailureKey = []byte{prefixScheduleFailureKey}
 )
 
 func GetScheduleKey(name string) []byte {
 	return []byte(name)
 }
+
+func GetScheduleCursorKey(executionStage ExecutionStage) []byte {
+	return append(ScheduleCursorKey, byte(executionStage))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,9 +14,13 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 var (
 	KeySecurityAddress = []byte("SecurityAddress")
 	KeyLimit           = []byte("Limit")
+	KeyAllowedMsgTypes = []byte("AllowedMsgTypes")
+	KeyDefaultGasLimit = []byte("DefaultGasLimit")
 
-	DefaultSecurityAddress = ""
-	DefaultLimit           = uint64(5)
+	DefaultSecurityAddress  = ""
+	DefaultLimit            = uint64(5)
+	DefaultAllowedMsgTypes  []string
+	DefaultScheduleGasLimit = uint64(2_000_000)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -24,16 +29,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(securityAddress string, limit uint64) Params {
+func NewParams(securityAddress string, limit uint64, allowedMsgTypes []string, defaultGasLimit uint64) Params {
 	return Params{
 		SecurityAddress: securityAddress,
 		Limit:           limit,
+		AllowedMsgTypes: allowedMsgTypes,
+		DefaultGasLimit: defaultGasLimit,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultSecurityAddress, DefaultLimit)
+	return NewParams(DefaultSecurityAddress, DefaultLimit, DefaultAllowedMsgTypes, DefaultScheduleGasLimit)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -49,6 +56,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.Limit,
 			validateLimit,
 		),
+		paramtypes.NewParamSetPair(
+			KeyAllowedMsgTypes,
+			&p.AllowedMsgTypes,
+			validateAllowedMsgTypes,
+		),
+		paramtypes.NewParamSetPair(
+			KeyDefaultGasLimit,
+			&p.DefaultGasLimit,
+			validateDefaultGasLimit,
+		),
 	}
 }
 
@@ -64,9 +81,29 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid limit: %w", err)
 	}
 
+	err = validateAllowedMsgTypes(p.AllowedMsgTypes)
+	if err != nil {
+		return fmt.Errorf("invalid allowed msg types: %w", err)
+	}
+
+	err = validateDefaultGasLimit(p.DefaultGasLimit)
+	if err != nil {
+		return fmt.Errorf("invalid default gas limit: %w", err)
+	}
+
 	return nil
 }
 
+// IsMsgTypeAllowed returns true if schedules are allowed to dispatch sdk msgs with a given type url
+func (p Params) IsMsgTypeAllowed(typeURL string) bool {
+	for _, allowed := range p.AllowedMsgTypes {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -104,3 +141,36 @@ func validateLimit(i interface{}) error {
 
 	return nil
 }
+
+func validateAllowedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, typeURL := range v {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid msg type url: %q", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicated msg type url: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateDefaultGasLimit(i interface{}) error {
+	l, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if l == 0 {
+		return fmt.Errorf("default gas limit cannot be zero")
+	}
+
+	return nil
+}
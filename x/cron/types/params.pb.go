@@ -29,6 +29,10 @@ type Params struct {
 	SecurityAddress string `protobuf:"bytes,1,opt,name=security_address,json=securityAddress,proto3" json:"security_address,omitempty"`
 	// Limit of schedules executed in one block
 	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Type urls of the sdk msgs that schedules are allowed to dispatch, e.g. "/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn"
+	AllowedMsgTypes []string `protobuf:"bytes,3,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// Gas limit of a single schedule execution for schedules that don't set their own gas limit
+	DefaultGasLimit uint64 `protobuf:"varint,4,opt,name=default_gas_limit,json=defaultGasLimit,proto3" json:"default_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,6 +81,20 @@ func (m *Params) GetLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetDefaultGasLimit() uint64 {
+	if m != nil {
+		return m.DefaultGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.cron.Params")
 }
@@ -84,20 +102,24 @@ func init() {
 func init() { proto.RegisterFile("neutron/cron/params.proto", fileDescriptor_efa4f5c14a68f6e5) }
 
 var fileDescriptor_efa4f5c14a68f6e5 = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
+	// 262 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x4b, 0x2d, 0x2d,
 	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x06, 0x11, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45,
 	0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x50, 0x29, 0x3d, 0x90, 0x94, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x58, 0x42, 0x1f, 0xc4, 0x82, 0xa8, 0x51, 0xf2, 0xe7, 0x62, 0x0b, 0x00, 0xeb, 0x11, 0xd2, 0xe4,
-	0x12, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0x8c, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x87, 0x89, 0x3b, 0x42, 0x84, 0x85, 0x44,
-	0xb8, 0x58, 0x73, 0x32, 0x73, 0x33, 0x4b, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x82, 0x20, 0x1c,
+	0x58, 0x42, 0x1f, 0xc4, 0x82, 0xa8, 0x51, 0x5a, 0xce, 0xc8, 0xc5, 0x16, 0x00, 0xd6, 0x24, 0xa4,
+	0xc9, 0x25, 0x50, 0x9c, 0x9a, 0x5c, 0x5a, 0x94, 0x59, 0x52, 0x19, 0x9f, 0x98, 0x92, 0x52, 0x94,
+	0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x0f, 0x13, 0x77, 0x84, 0x08, 0x0b,
+	0x89, 0x70, 0xb1, 0xe6, 0x64, 0xe6, 0x66, 0x96, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0x41,
+	0x38, 0x42, 0x5a, 0x5c, 0x82, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xa9, 0x29, 0xf1, 0xb9, 0xc5, 0xe9,
+	0xf1, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x20, 0x13, 0xa0, 0x12, 0xbe, 0xc5,
+	0xe9, 0x21, 0x20, 0x61, 0x90, 0xda, 0x94, 0xd4, 0xb4, 0xc4, 0xd2, 0x9c, 0x92, 0xf8, 0xf4, 0xc4,
+	0xe2, 0x78, 0x88, 0x69, 0x2c, 0x60, 0xd3, 0xf8, 0xa1, 0x12, 0xee, 0x89, 0xc5, 0x3e, 0x20, 0x61,
 	0x2b, 0x96, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x3c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
 	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x2f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea,
-	0x32, 0xdd, 0xfc, 0xa2, 0x74, 0x18, 0x5b, 0xbf, 0xcc, 0x54, 0xbf, 0x02, 0xe2, 0x8b, 0x92, 0xca,
-	0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x0b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0x7c,
-	0x1b, 0xae, 0xe2, 0x00, 0x00, 0x00,
+	0x8e, 0x21, 0x4a, 0x2f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x37,
+	0x31, 0x31, 0xaf, 0x52, 0xb7, 0xa2, 0xb2, 0x0a, 0xca, 0x4a, 0x49, 0xad, 0xd0, 0x2f, 0x33, 0xd5,
+	0xaf, 0x80, 0x04, 0x0f, 0xd8, 0x49, 0x49, 0x6c, 0x60, 0xaf, 0x1b, 0x03, 0x06, 0x00, 0x67, 0x3f,
+	0x7a, 0x83, 0x3b, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -120,6 +142,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DefaultGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Limit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.Limit))
 		i--
@@ -159,6 +195,15 @@ func (m *Params) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovParams(uint64(m.Limit))
 	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DefaultGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.DefaultGasLimit))
+	}
 	return n
 }
 
@@ -248,6 +293,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultGasLimit", wireType)
+			}
+			m.DefaultGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -301,6 +301,113 @@ func (m *QuerySchedulesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// The request type for the Query/ScheduleFailures RPC method.
+type QueryScheduleFailuresRequest struct {
+	// Name of the schedule to return the failure of, failures of all schedules are returned if it is empty
+	ScheduleName string             `protobuf:"bytes,1,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleFailuresRequest) Reset()         { *m = QueryScheduleFailuresRequest{} }
+func (m *QueryScheduleFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleFailuresRequest) ProtoMessage()    {}
+func (*QueryScheduleFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e02f33367c9498fe, []int{6}
+}
+func (m *QueryScheduleFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleFailuresRequest.Merge(m, src)
+}
+func (m *QueryScheduleFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleFailuresRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleFailuresRequest) GetScheduleName() string {
+	if m != nil {
+		return m.ScheduleName
+	}
+	return ""
+}
+
+func (m *QueryScheduleFailuresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The response type for the Query/ScheduleFailures RPC method.
+type QueryScheduleFailuresResponse struct {
+	Failures   []ScheduleFailure   `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleFailuresResponse) Reset()         { *m = QueryScheduleFailuresResponse{} }
+func (m *QueryScheduleFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleFailuresResponse) ProtoMessage()    {}
+func (*QueryScheduleFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e02f33367c9498fe, []int{7}
+}
+func (m *QueryScheduleFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleFailuresResponse.Merge(m, src)
+}
+func (m *QueryScheduleFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleFailuresResponse) GetFailures() []ScheduleFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *QueryScheduleFailuresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.cron.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.cron.QueryParamsResponse")
@@ -308,43 +415,52 @@ func init() {
 	proto.RegisterType((*QueryGetScheduleResponse)(nil), "neutron.cron.QueryGetScheduleResponse")
 	proto.RegisterType((*QuerySchedulesRequest)(nil), "neutron.cron.QuerySchedulesRequest")
 	proto.RegisterType((*QuerySchedulesResponse)(nil), "neutron.cron.QuerySchedulesResponse")
+	proto.RegisterType((*QueryScheduleFailuresRequest)(nil), "neutron.cron.QueryScheduleFailuresRequest")
+	proto.RegisterType((*QueryScheduleFailuresResponse)(nil), "neutron.cron.QueryScheduleFailuresResponse")
 }
 
 func init() { proto.RegisterFile("neutron/cron/query.proto", fileDescriptor_e02f33367c9498fe) }
 
 var fileDescriptor_e02f33367c9498fe = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0xb1, 0x86, 0xe6, 0xe9, 0xe9, 0x19, 0x63, 0x5c, 0xeb, 0xb6, 0xae, 0xb6, 0x8a,
-	0xd0, 0x19, 0x1a, 0x11, 0xc4, 0x63, 0x0f, 0x56, 0x6f, 0x35, 0x7a, 0xf2, 0x22, 0x93, 0x38, 0x6c,
-	0x83, 0xcd, 0xcc, 0x76, 0x67, 0x36, 0x58, 0x44, 0x10, 0xff, 0x02, 0xc1, 0xb3, 0xff, 0x4f, 0x8f,
-	0x05, 0x2f, 0x9e, 0x44, 0x12, 0xef, 0xfe, 0x0b, 0x65, 0xe7, 0x47, 0x9a, 0x6d, 0x96, 0xe6, 0x12,
-	0x86, 0x7d, 0xdf, 0xf7, 0xfd, 0x7e, 0x66, 0xde, 0x0b, 0x74, 0x04, 0xcf, 0x75, 0x26, 0x05, 0x1d,
-	0x14, 0x3f, 0x47, 0x39, 0xcf, 0x8e, 0x49, 0x9a, 0x49, 0x2d, 0xf1, 0xba, 0xab, 0x90, 0xa2, 0x12,
-	0x3e, 0x1e, 0x48, 0x35, 0x92, 0x8a, 0xf6, 0x99, 0xe2, 0x56, 0x46, 0xc7, 0x3b, 0x7d, 0xae, 0xd9,
-	0x0e, 0x4d, 0x59, 0x32, 0x14, 0x4c, 0x0f, 0xa5, 0xb0, 0x9d, 0x61, 0x2b, 0x91, 0x89, 0x34, 0x47,
-	0x5a, 0x9c, 0xdc, 0xd7, 0xb5, 0x44, 0xca, 0xe4, 0x90, 0x53, 0x96, 0x0e, 0x29, 0x13, 0x42, 0x6a,
-	0xd3, 0xa2, 0x5c, 0xf5, 0x76, 0x89, 0x23, 0x65, 0x19, 0x1b, 0xf9, 0xd2, 0x9d, 0x52, 0x49, 0x0d,
-	0x0e, 0xf8, 0x87, 0xfc, 0x90, 0xdb, 0x62, 0xdc, 0x02, 0x7c, 0x5d, 0xd0, 0xec, 0x9b, 0x8e, 0x1e,
-	0x3f, 0xca, 0xb9, 0xd2, 0xf1, 0x2b, 0xb8, 0x51, 0xfa, 0xaa, 0x52, 0x29, 0x14, 0xc7, 0x2e, 0x34,
-	0xac, 0x73, 0x27, 0xd8, 0x08, 0x1e, 0x5d, 0xeb, 0xb6, 0xc8, 0xfc, 0x1d, 0x89, 0x55, 0xef, 0xae,
-	0x9c, 0xfc, 0x59, 0xaf, 0xf5, 0x9c, 0x32, 0xde, 0x86, 0x5b, 0xc6, 0x6a, 0x8f, 0xeb, 0x37, 0x2e,
-	0xda, 0xa5, 0x20, 0xc2, 0x8a, 0x60, 0x23, 0x6e, 0xcc, 0x9a, 0x3d, 0x73, 0x8e, 0xdf, 0x42, 0x67,
-	0x51, 0xee, 0xe2, 0x9f, 0xc1, 0xaa, 0xa7, 0x77, 0x00, 0xed, 0x32, 0x80, 0xef, 0x70, 0x08, 0x33,
-	0x75, 0xfc, 0x1e, 0x6e, 0x1a, 0x57, 0x2f, 0xf0, 0x17, 0xc5, 0x17, 0x00, 0xe7, 0xcf, 0xef, 0x4c,
-	0xb7, 0x88, 0x9d, 0x15, 0x29, 0x66, 0x45, 0xec, 0x48, 0xdd, 0xac, 0xc8, 0x3e, 0x4b, 0x3c, 0x7e,
-	0x6f, 0xae, 0x33, 0xfe, 0x19, 0x40, 0xfb, 0x62, 0x82, 0xa3, 0x7e, 0x0e, 0x4d, 0xcf, 0x51, 0xbc,
-	0xdb, 0x95, 0xa5, 0xd8, 0xe7, 0x72, 0xdc, 0x2b, 0xe1, 0xd5, 0x0d, 0xde, 0xc3, 0xa5, 0x78, 0x36,
-	0x78, 0x9e, 0xaf, 0xfb, 0xbf, 0x0e, 0x57, 0x0d, 0x1f, 0x7e, 0x84, 0x86, 0x9d, 0x13, 0x6e, 0x94,
-	0x29, 0x16, 0xd7, 0x20, 0xbc, 0x77, 0x89, 0xc2, 0x86, 0xc4, 0x6b, 0xdf, 0x7e, 0xfd, 0xfb, 0x51,
-	0x6f, 0x63, 0x8b, 0x56, 0x2c, 0x20, 0x7e, 0x0d, 0x60, 0xd5, 0xdf, 0x0e, 0x37, 0x2b, 0xdc, 0x16,
-	0xb7, 0x22, 0xdc, 0x5a, 0x26, 0x73, 0xc9, 0x9b, 0x26, 0x79, 0x1d, 0xef, 0xd2, 0xca, 0xfd, 0xa6,
-	0x9f, 0x8b, 0x7d, 0xfa, 0x82, 0x63, 0x68, 0xce, 0x66, 0x82, 0xf7, 0x2b, 0xbc, 0x2f, 0xee, 0x44,
-	0xf8, 0xe0, 0x72, 0x91, 0x8b, 0x8f, 0x4c, 0x7c, 0x07, 0xdb, 0xd5, 0xf1, 0xbb, 0x2f, 0x4f, 0x26,
-	0x51, 0x70, 0x3a, 0x89, 0x82, 0xbf, 0x93, 0x28, 0xf8, 0x3e, 0x8d, 0x6a, 0xa7, 0xd3, 0xa8, 0xf6,
-	0x7b, 0x1a, 0xd5, 0xde, 0x91, 0x64, 0xa8, 0x0f, 0xf2, 0x3e, 0x19, 0xc8, 0x91, 0xef, 0xdd, 0x96,
-	0x59, 0x32, 0xf3, 0x19, 0x3f, 0xa5, 0x9f, 0xac, 0x99, 0x3e, 0x4e, 0xb9, 0xea, 0x37, 0xcc, 0x3f,
-	0xf5, 0xc9, 0x59, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0x47, 0xef, 0x62, 0x6b, 0x04, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xb5, 0x86, 0xe4, 0x59, 0x41, 0xc6, 0x18, 0xe3, 0x9a, 0x6c, 0xda, 0xad, 0x6d,
+	0xa5, 0xd2, 0x1d, 0x1a, 0x11, 0xc4, 0x8b, 0xd0, 0x43, 0xaa, 0x17, 0xa9, 0xa9, 0x27, 0x2f, 0x61,
+	0x92, 0x8e, 0xdb, 0x60, 0x76, 0x67, 0xbb, 0x3f, 0x42, 0xa2, 0x08, 0xe2, 0xc1, 0x8b, 0x17, 0x41,
+	0xaf, 0xfe, 0x01, 0xfe, 0x27, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x49, 0xfc, 0x43, 0x64, 0xe7, 0x47,
+	0x93, 0x4d, 0xd6, 0x44, 0xc4, 0x4b, 0x18, 0xe6, 0x7d, 0xdf, 0xfb, 0x7e, 0xf6, 0xbd, 0x37, 0x81,
+	0x92, 0x4b, 0xa3, 0xd0, 0x67, 0x2e, 0x6e, 0xc7, 0x3f, 0x27, 0x11, 0xf5, 0x07, 0x96, 0xe7, 0xb3,
+	0x90, 0xa1, 0x15, 0x19, 0xb1, 0xe2, 0x88, 0xbe, 0xdd, 0x66, 0x81, 0xc3, 0x02, 0xdc, 0x22, 0x01,
+	0x15, 0x32, 0xdc, 0xdb, 0x6d, 0xd1, 0x90, 0xec, 0x62, 0x8f, 0xd8, 0x1d, 0x97, 0x84, 0x1d, 0xe6,
+	0x8a, 0x4c, 0xbd, 0x60, 0x33, 0x9b, 0xf1, 0x23, 0x8e, 0x4f, 0xf2, 0xb6, 0x6c, 0x33, 0x66, 0x77,
+	0x29, 0x26, 0x5e, 0x07, 0x13, 0xd7, 0x65, 0x21, 0x4f, 0x09, 0x64, 0xf4, 0x46, 0x82, 0xc3, 0x23,
+	0x3e, 0x71, 0x54, 0xe8, 0x66, 0x22, 0x14, 0xb4, 0x8f, 0xe9, 0x51, 0xd4, 0xa5, 0x22, 0x68, 0x16,
+	0x00, 0x3d, 0x8d, 0x69, 0x0e, 0x78, 0x46, 0x83, 0x9e, 0x44, 0x34, 0x08, 0xcd, 0xc7, 0x70, 0x35,
+	0x71, 0x1b, 0x78, 0xcc, 0x0d, 0x28, 0xaa, 0x41, 0x56, 0x54, 0x2e, 0x69, 0xab, 0xda, 0xed, 0x4b,
+	0xb5, 0x82, 0x35, 0xf9, 0x8d, 0x96, 0x50, 0xef, 0x2d, 0x9f, 0xfe, 0xa8, 0x66, 0x1a, 0x52, 0x69,
+	0xee, 0xc0, 0x75, 0x5e, 0x6a, 0x9f, 0x86, 0x87, 0xd2, 0x5a, 0xba, 0x20, 0x04, 0xcb, 0x2e, 0x71,
+	0x28, 0x2f, 0x96, 0x6f, 0xf0, 0xb3, 0xf9, 0x0c, 0x4a, 0xb3, 0x72, 0x69, 0x7f, 0x1f, 0x72, 0x8a,
+	0x5e, 0x02, 0x14, 0x93, 0x00, 0x2a, 0x43, 0x22, 0x9c, 0xab, 0xcd, 0x26, 0x5c, 0xe3, 0x55, 0x95,
+	0x40, 0x7d, 0x28, 0xaa, 0x03, 0x8c, 0xdb, 0x2f, 0x8b, 0x6e, 0x5a, 0x62, 0x56, 0x56, 0x3c, 0x2b,
+	0x4b, 0x8c, 0x54, 0xce, 0xca, 0x3a, 0x20, 0xb6, 0xc2, 0x6f, 0x4c, 0x64, 0x9a, 0x5f, 0x34, 0x28,
+	0x4e, 0x3b, 0x48, 0xea, 0x07, 0x90, 0x57, 0x1c, 0x71, 0xdf, 0x2e, 0x2c, 0xc4, 0x1e, 0xcb, 0xd1,
+	0x7e, 0x02, 0x6f, 0x89, 0xe3, 0x6d, 0x2d, 0xc4, 0x13, 0xc6, 0x09, 0xbe, 0x0f, 0x1a, 0x94, 0x13,
+	0x7c, 0x75, 0xd2, 0xe9, 0x46, 0xfe, 0xb8, 0x11, 0xeb, 0x70, 0x59, 0xd9, 0x36, 0x27, 0x86, 0xb2,
+	0xa2, 0x2e, 0x9f, 0x10, 0x87, 0xa2, 0x7a, 0x0a, 0xce, 0xbf, 0x74, 0xeb, 0xab, 0x06, 0x95, 0x3f,
+	0xd0, 0xc8, 0xa6, 0x3d, 0x84, 0xdc, 0x0b, 0x79, 0x27, 0x7b, 0x56, 0x49, 0xef, 0x99, 0xcc, 0x54,
+	0x13, 0x57, 0x49, 0xff, 0xad, 0x73, 0xb5, 0xf7, 0xcb, 0x70, 0x91, 0xb3, 0xa2, 0x97, 0x90, 0x15,
+	0x1b, 0x8e, 0x56, 0x93, 0x2c, 0xb3, 0x0f, 0x48, 0x5f, 0x9b, 0xa3, 0x10, 0x26, 0x66, 0xf9, 0xdd,
+	0xb7, 0x5f, 0x9f, 0x96, 0x8a, 0xa8, 0x80, 0x53, 0x9e, 0x2e, 0x7a, 0xab, 0x41, 0x4e, 0x7d, 0x23,
+	0xda, 0x48, 0xa9, 0x36, 0xfb, 0x9e, 0xf4, 0xcd, 0x45, 0x32, 0xe9, 0xbc, 0xc1, 0x9d, 0xab, 0xa8,
+	0x82, 0x53, 0xff, 0x19, 0xf0, 0xeb, 0x78, 0x01, 0xde, 0xa0, 0x1e, 0xe4, 0x0f, 0xcf, 0x37, 0x71,
+	0x3d, 0xa5, 0xf6, 0xf4, 0x6b, 0xd2, 0x6f, 0xcd, 0x17, 0x49, 0x7b, 0x83, 0xdb, 0x97, 0x50, 0x31,
+	0xdd, 0x1e, 0x7d, 0xd6, 0xe0, 0xca, 0xf4, 0x62, 0xa0, 0xed, 0x39, 0xa5, 0xa7, 0x76, 0x59, 0xbf,
+	0xf3, 0x57, 0x5a, 0x49, 0xb3, 0xc5, 0x69, 0xd6, 0x50, 0x35, 0x9d, 0xa6, 0xa9, 0x36, 0x6a, 0xef,
+	0xd1, 0xe9, 0xd0, 0xd0, 0xce, 0x86, 0x86, 0xf6, 0x73, 0x68, 0x68, 0x1f, 0x47, 0x46, 0xe6, 0x6c,
+	0x64, 0x64, 0xbe, 0x8f, 0x8c, 0xcc, 0x73, 0xcb, 0xee, 0x84, 0xc7, 0x51, 0xcb, 0x6a, 0x33, 0x07,
+	0x3b, 0x84, 0xb8, 0x83, 0x9d, 0xfe, 0xe0, 0x95, 0x3c, 0x1d, 0xd1, 0x3e, 0xee, 0xdd, 0xc3, 0x7d,
+	0x51, 0x35, 0x1c, 0x78, 0x34, 0x68, 0x65, 0xf9, 0x5f, 0xef, 0xdd, 0xdf, 0x03, 0x00, 0x37, 0xae,
+	0x4a, 0x7d, 0x3c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -365,6 +481,8 @@ type QueryClient interface {
 	Schedule(ctx context.Context, in *QueryGetScheduleRequest, opts ...grpc.CallOption) (*QueryGetScheduleResponse, error)
 	// Queries a list of Schedule items.
 	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// Queries the last failed execution of schedules.
+	ScheduleFailures(ctx context.Context, in *QueryScheduleFailuresRequest, opts ...grpc.CallOption) (*QueryScheduleFailuresResponse, error)
 }
 
 type queryClient struct {
@@ -402,6 +520,15 @@ func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest,
 	return out, nil
 }
 
+func (c *queryClient) ScheduleFailures(ctx context.Context, in *QueryScheduleFailuresRequest, opts ...grpc.CallOption) (*QueryScheduleFailuresResponse, error) {
+	out := new(QueryScheduleFailuresResponse)
+	err := c.cc.Invoke(ctx, "/neutron.cron.Query/ScheduleFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the parameters of the module.
@@ -410,6 +537,8 @@ type QueryServer interface {
 	Schedule(context.Context, *QueryGetScheduleRequest) (*QueryGetScheduleResponse, error)
 	// Queries a list of Schedule items.
 	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// Queries the last failed execution of schedules.
+	ScheduleFailures(context.Context, *QueryScheduleFailuresRequest) (*QueryScheduleFailuresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -425,6 +554,9 @@ func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryGetSche
 func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
 }
+func (*UnimplementedQueryServer) ScheduleFailures(ctx context.Context, req *QueryScheduleFailuresRequest) (*QueryScheduleFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFailures not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -484,6 +616,25 @@ func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduleFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.cron.Query/ScheduleFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleFailures(ctx, req.(*QueryScheduleFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.cron.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -500,6 +651,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Schedules",
 			Handler:    _Query_Schedules_Handler,
 		},
+		{
+			MethodName: "ScheduleFailures",
+			Handler:    _Query_ScheduleFailures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/cron/query.proto",
@@ -708,6 +863,97 @@ func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScheduleFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleName) > 0 {
+		i -= len(m.ScheduleName)
+		copy(dAtA[i:], m.ScheduleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScheduleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -795,6 +1041,42 @@ func (m *QuerySchedulesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduleFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1305,6 +1587,244 @@ func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduleFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, ScheduleFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &Schedule{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s *Schedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSdkMsgs(unpacker, s.SdkMsgs)
+}
+
+func unpackSdkMsgs(unpacker codectypes.AnyUnpacker, sdkMsgs []*codectypes.Any) error {
+	for _, anyMsg := range sdkMsgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(anyMsg, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSdkMsgs(sdkMsgs []*codectypes.Any) error {
+	for idx, anyMsg := range sdkMsgs {
+		if anyMsg == nil || anyMsg.TypeUrl == "" {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "sdk msg #%d is empty", idx)
+		}
+	}
+	return nil
+}
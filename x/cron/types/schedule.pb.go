@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -63,6 +65,11 @@ type Schedule struct {
 	LastExecuteHeight uint64 `protobuf:"varint,4,opt,name=last_execute_height,json=lastExecuteHeight,proto3" json:"last_execute_height,omitempty"`
 	// Stage when messages will be executed
 	ExecutionStage ExecutionStage `protobuf:"varint,5,opt,name=execution_stage,json=executionStage,proto3,enum=neutron.cron.ExecutionStage" json:"execution_stage,omitempty"`
+	// Sdk msgs that will be dispatched through the msg service router with the cron module account as the signer.
+	// Only the message types allowlisted in the module params can be used.
+	SdkMsgs []*types.Any `protobuf:"bytes,6,rep,name=sdk_msgs,json=sdkMsgs,proto3" json:"sdk_msgs,omitempty"`
+	// Gas limit of a single execution of the schedule, the module's default gas limit is used if it is zero
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *Schedule) Reset()         { *m = Schedule{} }
@@ -133,6 +140,20 @@ func (m *Schedule) GetExecutionStage() ExecutionStage {
 	return ExecutionStage_EXECUTION_STAGE_END_BLOCKER
 }
 
+func (m *Schedule) GetSdkMsgs() []*types.Any {
+	if m != nil {
+		return m.SdkMsgs
+	}
+	return nil
+}
+
+func (m *Schedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // Defines the contract and the message to pass
 type MsgExecuteContract struct {
 	// The address of the smart contract
@@ -188,6 +209,79 @@ func (m *MsgExecuteContract) GetMsg() string {
 	return ""
 }
 
+// Defines the last failed execution of a schedule
+type ScheduleFailure struct {
+	// Name of the failed schedule
+	ScheduleName string `protobuf:"bytes,1,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"`
+	// Block height of the failed execution
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Redacted error of the execution. Full error is emitted as an event
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the execution ran out of the schedule's gas limit
+	OutOfGas bool `protobuf:"varint,4,opt,name=out_of_gas,json=outOfGas,proto3" json:"out_of_gas,omitempty"`
+}
+
+func (m *ScheduleFailure) Reset()         { *m = ScheduleFailure{} }
+func (m *ScheduleFailure) String() string { return proto.CompactTextString(m) }
+func (*ScheduleFailure) ProtoMessage()    {}
+func (*ScheduleFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ace1b59de613ef, []int{2}
+}
+func (m *ScheduleFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleFailure.Merge(m, src)
+}
+func (m *ScheduleFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleFailure proto.InternalMessageInfo
+
+func (m *ScheduleFailure) GetScheduleName() string {
+	if m != nil {
+		return m.ScheduleName
+	}
+	return ""
+}
+
+func (m *ScheduleFailure) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScheduleFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ScheduleFailure) GetOutOfGas() bool {
+	if m != nil {
+		return m.OutOfGas
+	}
+	return false
+}
+
 // Defines the number of current schedules
 type ScheduleCount struct {
 	// The number of current schedules
@@ -198,7 +292,7 @@ func (m *ScheduleCount) Reset()         { *m = ScheduleCount{} }
 func (m *ScheduleCount) String() string { return proto.CompactTextString(m) }
 func (*ScheduleCount) ProtoMessage()    {}
 func (*ScheduleCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49ace1b59de613ef, []int{2}
+	return fileDescriptor_49ace1b59de613ef, []int{3}
 }
 func (m *ScheduleCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,38 +332,50 @@ func init() {
 	proto.RegisterEnum("neutron.cron.ExecutionStage", ExecutionStage_name, ExecutionStage_value)
 	proto.RegisterType((*Schedule)(nil), "neutron.cron.Schedule")
 	proto.RegisterType((*MsgExecuteContract)(nil), "neutron.cron.MsgExecuteContract")
+	proto.RegisterType((*ScheduleFailure)(nil), "neutron.cron.ScheduleFailure")
 	proto.RegisterType((*ScheduleCount)(nil), "neutron.cron.ScheduleCount")
 }
 
 func init() { proto.RegisterFile("neutron/cron/schedule.proto", fileDescriptor_49ace1b59de613ef) }
 
 var fileDescriptor_49ace1b59de613ef = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
-	0x18, 0x85, 0x33, 0x36, 0x2d, 0xed, 0xa8, 0xb5, 0x8e, 0x45, 0x42, 0xab, 0x69, 0x2c, 0x08, 0x41,
-	0x30, 0x81, 0x8a, 0x1b, 0x77, 0x26, 0x0e, 0x6d, 0x51, 0x5b, 0x48, 0x2b, 0x88, 0x9b, 0x90, 0xa6,
-	0xc3, 0x24, 0xd0, 0x64, 0x4a, 0x66, 0x22, 0xf5, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x74, 0x25, 0xd2,
-	0xae, 0x7c, 0x8b, 0x4b, 0x26, 0x69, 0xb9, 0xbd, 0x77, 0x13, 0xce, 0xe1, 0x7c, 0x87, 0x7f, 0xe6,
-	0xcf, 0xc0, 0x7e, 0x4a, 0x72, 0x91, 0xb1, 0xd4, 0x0e, 0x8b, 0x0f, 0x0f, 0x23, 0xb2, 0xce, 0x37,
-	0xc4, 0xda, 0x66, 0x4c, 0x30, 0xf4, 0xa8, 0x0a, 0xad, 0x22, 0xec, 0x75, 0x29, 0xa3, 0x4c, 0x06,
-	0x76, 0xa1, 0x4a, 0x66, 0xf8, 0x1f, 0xc0, 0xe6, 0xa2, 0xaa, 0x21, 0x04, 0xd5, 0x34, 0x48, 0x88,
-	0x06, 0x0c, 0x60, 0xb6, 0x3c, 0xa9, 0xd1, 0x73, 0xd8, 0xd8, 0x92, 0x2c, 0x66, 0x6b, 0xed, 0x81,
-	0x01, 0x4c, 0xd5, 0xab, 0x1c, 0xfa, 0x00, 0xd5, 0x84, 0x53, 0xae, 0xd5, 0x8c, 0x9a, 0xf9, 0x70,
-	0x64, 0x58, 0xb7, 0x67, 0x59, 0x5f, 0x39, 0xc5, 0x3b, 0x12, 0xe6, 0x82, 0xb8, 0x2c, 0x15, 0x59,
-	0x10, 0x0a, 0x47, 0xdd, 0xff, 0x1d, 0x28, 0x9e, 0xec, 0x20, 0x0b, 0x3e, 0xdb, 0x04, 0x5c, 0xf8,
-	0xa4, 0x64, 0xfc, 0x88, 0xc4, 0x34, 0x12, 0x9a, 0x2a, 0x07, 0x3c, 0x2d, 0xa2, 0xaa, 0x3d, 0x91,
-	0x01, 0xc2, 0xf0, 0x49, 0x89, 0xc6, 0x2c, 0xf5, 0xb9, 0x08, 0x28, 0xd1, 0xea, 0x06, 0x30, 0xdb,
-	0xa3, 0x17, 0xd7, 0x63, 0xf1, 0x19, 0x5a, 0x14, 0x8c, 0xd7, 0x26, 0x57, 0x7e, 0xe8, 0x40, 0x74,
-	0xff, 0x60, 0xa8, 0x07, 0x9b, 0x61, 0xa5, 0xab, 0x8b, 0x5f, 0x3c, 0xea, 0xc0, 0x5a, 0xc2, 0xa9,
-	0xbc, 0x79, 0xcb, 0x2b, 0xe4, 0xf0, 0x35, 0x7c, 0x7c, 0x5e, 0x97, 0xcb, 0xf2, 0x54, 0xa0, 0x2e,
-	0xac, 0x87, 0x85, 0x90, 0xdd, 0xba, 0x57, 0x9a, 0x37, 0x4b, 0xd8, 0xbe, 0x3e, 0x0c, 0x1a, 0xc0,
-	0x3e, 0xfe, 0x8e, 0xdd, 0x6f, 0xcb, 0xe9, 0x7c, 0xe6, 0x2f, 0x96, 0x1f, 0xc7, 0xd8, 0xc7, 0xb3,
-	0x4f, 0xbe, 0xf3, 0x65, 0xee, 0x7e, 0xc6, 0x5e, 0x47, 0x41, 0xaf, 0xe0, 0xcb, 0xbb, 0x80, 0x83,
-	0xc7, 0xd3, 0xd9, 0x05, 0x01, 0xce, 0x64, 0x7f, 0xd4, 0xc1, 0xe1, 0xa8, 0x83, 0x7f, 0x47, 0x1d,
-	0xfc, 0x3e, 0xe9, 0xca, 0xe1, 0xa4, 0x2b, 0x7f, 0x4e, 0xba, 0xf2, 0xc3, 0xa2, 0xb1, 0x88, 0xf2,
-	0x95, 0x15, 0xb2, 0xc4, 0xae, 0x56, 0xf2, 0x96, 0x65, 0xf4, 0xac, 0xed, 0x9f, 0xef, 0xed, 0x5d,
-	0xf9, 0x46, 0xc4, 0xaf, 0x2d, 0xe1, 0xab, 0x86, 0xfc, 0xfb, 0xef, 0x6e, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0xcd, 0x08, 0xb7, 0x40, 0x02, 0x00, 0x00,
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0xb4, 0x75, 0xa7, 0xbf, 0xdf, 0x7c, 0x15, 0xb8, 0x49, 0x71, 0xd3, 0x20, 0xa4,
+	0x08, 0xa9, 0xb6, 0x5a, 0xc4, 0x86, 0x5d, 0x1d, 0x4c, 0x5a, 0x91, 0x26, 0x92, 0x13, 0x24, 0xc4,
+	0x66, 0x34, 0x76, 0x26, 0x13, 0x2b, 0xb6, 0x27, 0xf2, 0x8c, 0xab, 0x84, 0x77, 0x40, 0xe2, 0x61,
+	0x78, 0x88, 0x8a, 0x55, 0x17, 0x2c, 0x58, 0x21, 0x94, 0xbc, 0x08, 0xf2, 0xd8, 0xae, 0x1a, 0xd8,
+	0x58, 0xf7, 0xdc, 0x7b, 0xc6, 0xf7, 0xcc, 0x39, 0x36, 0xa8, 0x45, 0x24, 0x11, 0x31, 0x8b, 0x4c,
+	0x2f, 0x7d, 0x70, 0x6f, 0x4c, 0x86, 0x49, 0x40, 0x8c, 0x69, 0xcc, 0x04, 0x83, 0x3b, 0xf9, 0xd0,
+	0x48, 0x87, 0xd5, 0x23, 0x8f, 0xf1, 0x90, 0x71, 0x24, 0x67, 0x66, 0x06, 0x32, 0x62, 0xf5, 0x90,
+	0x32, 0xca, 0xb2, 0x7e, 0x5a, 0xe5, 0xdd, 0x23, 0xca, 0x18, 0x0d, 0x88, 0x29, 0x91, 0x9b, 0x8c,
+	0x4c, 0x1c, 0xcd, 0xb3, 0x51, 0xe3, 0xc7, 0x1a, 0x50, 0xfb, 0xf9, 0x32, 0x08, 0x41, 0x25, 0xc2,
+	0x21, 0xd1, 0x94, 0xba, 0xd2, 0xdc, 0x72, 0x64, 0x0d, 0x9f, 0x80, 0x8d, 0x29, 0x89, 0x7d, 0x36,
+	0xd4, 0xd6, 0xea, 0x4a, 0xb3, 0xe2, 0xe4, 0x08, 0xbe, 0x01, 0x95, 0x90, 0x53, 0xae, 0x95, 0xeb,
+	0xe5, 0xe6, 0xf6, 0x45, 0xdd, 0x78, 0xac, 0xd0, 0xb8, 0xe1, 0xd4, 0x9e, 0x11, 0x2f, 0x11, 0xa4,
+	0xc5, 0x22, 0x11, 0x63, 0x4f, 0x58, 0x95, 0xbb, 0x5f, 0x27, 0x25, 0x47, 0x9e, 0x81, 0x06, 0xf8,
+	0x3f, 0xc0, 0x5c, 0x20, 0x92, 0x71, 0xd0, 0x98, 0xf8, 0x74, 0x2c, 0xb4, 0x8a, 0x5c, 0xf0, 0x5f,
+	0x3a, 0xca, 0x4f, 0x5f, 0xc9, 0x01, 0xb4, 0xc1, 0x7e, 0x46, 0xf5, 0x59, 0x84, 0xb8, 0xc0, 0x94,
+	0x68, 0xeb, 0x75, 0xa5, 0xb9, 0x77, 0x71, 0xbc, 0xba, 0xd6, 0x2e, 0x48, 0xfd, 0x94, 0xe3, 0xec,
+	0x91, 0x15, 0x0c, 0x3b, 0x40, 0xe5, 0xc3, 0x09, 0x92, 0xb2, 0x37, 0xa4, 0xec, 0x43, 0x23, 0x73,
+	0xc6, 0x28, 0x9c, 0x31, 0x2e, 0xa3, 0xb9, 0x55, 0xfb, 0xfe, 0xed, 0xec, 0x69, 0x6e, 0xab, 0x8b,
+	0x39, 0x31, 0x6e, 0xcf, 0x5d, 0x22, 0xf0, 0x79, 0x7a, 0x2d, 0x67, 0x93, 0x0f, 0x27, 0x37, 0xe9,
+	0x25, 0x6a, 0x60, 0x8b, 0x62, 0x8e, 0x02, 0x3f, 0xf4, 0x85, 0xb6, 0x29, 0xa5, 0xab, 0x14, 0xf3,
+	0x4e, 0x8a, 0x1b, 0x16, 0x80, 0xff, 0x7a, 0x00, 0xab, 0x40, 0xf5, 0xf2, 0x3a, 0xf7, 0xf8, 0x01,
+	0xc3, 0x03, 0x50, 0x0e, 0x39, 0x95, 0x26, 0x6f, 0x39, 0x69, 0xd9, 0xf8, 0xa2, 0x80, 0xfd, 0x22,
+	0x9a, 0x77, 0xd8, 0x0f, 0x92, 0x98, 0xc0, 0xe7, 0x60, 0xb7, 0xf8, 0x34, 0xd0, 0xa3, 0xa8, 0x76,
+	0x8a, 0x66, 0x37, 0x8d, 0xec, 0x14, 0xec, 0xb8, 0x01, 0xf3, 0x26, 0x85, 0xaf, 0x59, 0x70, 0xdb,
+	0xb2, 0x97, 0x3b, 0x7a, 0x08, 0xd6, 0x49, 0x1c, 0xb3, 0x58, 0x2b, 0xcb, 0xf3, 0x19, 0x80, 0xc7,
+	0x00, 0xb0, 0x44, 0x20, 0x36, 0x42, 0x14, 0x73, 0x19, 0x87, 0xea, 0xa8, 0x2c, 0x11, 0xbd, 0x51,
+	0x1b, 0xf3, 0xc6, 0x0b, 0xb0, 0x5b, 0xc8, 0x69, 0xb1, 0x24, 0x92, 0x2f, 0xf1, 0xd2, 0x42, 0x8a,
+	0x58, 0x77, 0x32, 0xf0, 0x72, 0x00, 0xf6, 0x56, 0x73, 0x80, 0x27, 0xa0, 0x66, 0x7f, 0xb4, 0x5b,
+	0x1f, 0x06, 0xd7, 0xbd, 0x2e, 0xea, 0x0f, 0x2e, 0xdb, 0x36, 0xb2, 0xbb, 0x6f, 0x91, 0xd5, 0xe9,
+	0xb5, 0xde, 0xdb, 0xce, 0x41, 0x09, 0x9e, 0x82, 0x67, 0x7f, 0x13, 0x2c, 0xbb, 0x7d, 0xdd, 0x7d,
+	0xa0, 0x28, 0xd6, 0xd5, 0xdd, 0x42, 0x57, 0xee, 0x17, 0xba, 0xf2, 0x7b, 0xa1, 0x2b, 0x5f, 0x97,
+	0x7a, 0xe9, 0x7e, 0xa9, 0x97, 0x7e, 0x2e, 0xf5, 0xd2, 0x27, 0x83, 0xfa, 0x62, 0x9c, 0xb8, 0x86,
+	0xc7, 0x42, 0x33, 0xc4, 0x38, 0x9a, 0x9f, 0xcd, 0xe6, 0x9f, 0xf3, 0x6a, 0x48, 0x66, 0xe6, 0xed,
+	0x6b, 0x73, 0x96, 0xfd, 0x54, 0x62, 0x3e, 0x25, 0xdc, 0xdd, 0x90, 0x59, 0xbf, 0xfa, 0x33, 0x00,
+	0x8e, 0xfe, 0x14, 0xb6, 0x71, 0x03, 0x00, 0x00,
 }
 
 func (m *Schedule) Marshal() (dAtA []byte, err error) {
@@ -292,6 +398,25 @@ func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SdkMsgs) > 0 {
+		for iNdEx := len(m.SdkMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SdkMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ExecutionStage != 0 {
 		i = encodeVarintSchedule(dAtA, i, uint64(m.ExecutionStage))
 		i--
@@ -368,6 +493,58 @@ func (m *MsgExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutOfGas {
+		i--
+		if m.OutOfGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ScheduleName) > 0 {
+		i -= len(m.ScheduleName)
+		copy(dAtA[i:], m.ScheduleName)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ScheduleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ScheduleCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -432,6 +609,15 @@ func (m *Schedule) Size() (n int) {
 	if m.ExecutionStage != 0 {
 		n += 1 + sovSchedule(uint64(m.ExecutionStage))
 	}
+	if len(m.SdkMsgs) > 0 {
+		for _, e := range m.SdkMsgs {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -452,6 +638,29 @@ func (m *MsgExecuteContract) Size() (n int) {
 	return n
 }
 
+func (m *ScheduleFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleName)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.BlockHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.OutOfGas {
+		n += 2
+	}
+	return n
+}
+
 func (m *ScheduleCount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -622,6 +831,59 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkMsgs = append(m.SdkMsgs, &types.Any{})
+			if err := m.SdkMsgs[len(m.SdkMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedule(dAtA[iNdEx:])
@@ -757,6 +1019,159 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduleFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutOfGas = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScheduleCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,11 +2,15 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ sdk.Msg = &MsgAddSchedule{}
+var (
+	_ sdk.Msg                            = &MsgAddSchedule{}
+	_ codectypes.UnpackInterfacesMessage = &MsgAddSchedule{}
+)
 
 func (msg *MsgAddSchedule) Route() string {
 	return RouterKey
@@ -41,10 +45,14 @@ func (msg *MsgAddSchedule) Validate() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "period is invalid")
 	}
 
-	if len(msg.Msgs) == 0 {
+	if len(msg.Msgs) == 0 && len(msg.SdkMsgs) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "msgs should not be empty")
 	}
 
+	if err := validateSdkMsgs(msg.SdkMsgs); err != nil {
+		return err
+	}
+
 	if _, ok := ExecutionStage_name[int32(msg.ExecutionStage)]; !ok {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "execution stage is invalid")
 	}
@@ -52,6 +60,11 @@ func (msg *MsgAddSchedule) Validate() error {
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgAddSchedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSdkMsgs(unpacker, msg.SdkMsgs)
+}
+
 //----------------------------------------------------------------
 
 var _ sdk.Msg = &MsgRemoveSchedule{}
@@ -121,5 +134,13 @@ func (msg *MsgUpdateParams) Validate() error {
 		return errors.Wrap(err, "security_address is invalid")
 	}
 
+	if err := validateAllowedMsgTypes(msg.Params.AllowedMsgTypes); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := validateDefaultGasLimit(msg.Params.DefaultGasLimit); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -43,6 +44,10 @@ type MsgAddSchedule struct {
 	Msgs []MsgExecuteContract `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs"`
 	// Stage when messages will be executed
 	ExecutionStage ExecutionStage `protobuf:"varint,5,opt,name=execution_stage,json=executionStage,proto3,enum=neutron.cron.ExecutionStage" json:"execution_stage,omitempty"`
+	// Sdk msgs that will be dispatched every certain number of blocks with the cron module account as the signer
+	SdkMsgs []*types.Any `protobuf:"bytes,6,rep,name=sdk_msgs,json=sdkMsgs,proto3" json:"sdk_msgs,omitempty"`
+	// Gas limit of a single execution of the schedule, the module's default gas limit is used if it is zero
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *MsgAddSchedule) Reset()         { *m = MsgAddSchedule{} }
@@ -113,6 +118,20 @@ func (m *MsgAddSchedule) GetExecutionStage() ExecutionStage {
 	return ExecutionStage_EXECUTION_STAGE_END_BLOCKER
 }
 
+func (m *MsgAddSchedule) GetSdkMsgs() []*types.Any {
+	if m != nil {
+		return m.SdkMsgs
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // Defines the response structure for executing a MsgAddSchedule message.
 type MsgAddScheduleResponse struct {
 }
@@ -352,42 +371,47 @@ func init() {
 func init() { proto.RegisterFile("neutron/cron/tx.proto", fileDescriptor_c9e0a673aba8d6fd) }
 
 var fileDescriptor_c9e0a673aba8d6fd = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6b, 0xdb, 0x40,
-	0x14, 0xf6, 0xd9, 0x8e, 0xc1, 0xe7, 0xe0, 0x10, 0xd5, 0x75, 0x64, 0x25, 0x55, 0x8c, 0x68, 0x1b,
-	0xd7, 0x10, 0x89, 0xba, 0xb4, 0x05, 0x6f, 0x71, 0x31, 0x74, 0x11, 0xb4, 0x72, 0xbb, 0x64, 0x09,
-	0x8a, 0x74, 0x9c, 0x05, 0x95, 0x4e, 0xe8, 0x4e, 0xc6, 0xd9, 0x4a, 0xc7, 0x4c, 0xed, 0x5f, 0xd0,
-	0xb5, 0xd0, 0xc5, 0x43, 0xff, 0x88, 0x8c, 0xa1, 0x53, 0xa7, 0x52, 0xec, 0xc1, 0xff, 0x46, 0xd1,
-	0xaf, 0x44, 0x17, 0x41, 0x0a, 0x85, 0x2c, 0xa7, 0x7b, 0xef, 0xfb, 0xde, 0xd3, 0x77, 0xdf, 0x3d,
-	0x0e, 0xde, 0xf7, 0x50, 0xc8, 0x02, 0xe2, 0x69, 0x56, 0xb4, 0xb0, 0xb9, 0xea, 0x07, 0x84, 0x11,
-	0x61, 0x33, 0x4d, 0xab, 0x51, 0x5a, 0xda, 0x36, 0x5d, 0xc7, 0x23, 0x5a, 0xbc, 0x26, 0x04, 0x69,
-	0xc7, 0x22, 0xd4, 0x25, 0x54, 0x73, 0x29, 0xd6, 0x66, 0x4f, 0xa3, 0x4f, 0x0a, 0x74, 0x12, 0xe0,
-	0x24, 0x8e, 0xb4, 0x24, 0x48, 0xa1, 0x16, 0x26, 0x98, 0x24, 0xf9, 0x68, 0x97, 0x15, 0x70, 0x0a,
-	0x7c, 0x33, 0x30, 0xdd, 0xac, 0x60, 0x97, 0x83, 0xa8, 0x35, 0x45, 0x76, 0xf8, 0x01, 0x25, 0xa0,
-	0xf2, 0xb5, 0x0c, 0x9b, 0x3a, 0xc5, 0x47, 0xb6, 0x3d, 0x49, 0x01, 0xe1, 0x05, 0xac, 0x9b, 0x21,
-	0x9b, 0x92, 0xc0, 0x61, 0x67, 0x22, 0xe8, 0x82, 0x5e, 0x7d, 0x24, 0xfe, 0xfc, 0x71, 0xd8, 0x4a,
-	0x55, 0x1c, 0xd9, 0x76, 0x80, 0x28, 0x9d, 0xb0, 0xc0, 0xf1, 0xb0, 0x71, 0x4d, 0x15, 0x04, 0x58,
-	0xf5, 0x4c, 0x17, 0x89, 0xe5, 0xa8, 0xc4, 0x88, 0xf7, 0x42, 0x1b, 0xd6, 0x7c, 0x14, 0x38, 0xc4,
-	0x16, 0x2b, 0x5d, 0xd0, 0xab, 0x1a, 0x69, 0x24, 0x0c, 0x61, 0xd5, 0xa5, 0x98, 0x8a, 0xd5, 0x6e,
-	0xa5, 0xd7, 0x18, 0x74, 0xd5, 0xbc, 0x51, 0xaa, 0x4e, 0xf1, 0x78, 0x8e, 0xac, 0x90, 0xa1, 0x57,
-	0xc4, 0x63, 0x81, 0x69, 0xb1, 0x51, 0xf5, 0xe2, 0xf7, 0x7e, 0xc9, 0x88, 0x6b, 0x84, 0x31, 0xdc,
-	0x42, 0x31, 0xec, 0x10, 0xef, 0x84, 0x32, 0x13, 0x23, 0x71, 0xa3, 0x0b, 0x7a, 0xcd, 0xc1, 0x1e,
-	0xdf, 0x66, 0x9c, 0x91, 0x26, 0x11, 0xc7, 0x68, 0x22, 0x2e, 0x1e, 0x3e, 0xfe, 0xb4, 0x5e, 0xf4,
-	0xaf, 0xe5, 0x9f, 0xaf, 0x17, 0xfd, 0x7b, 0xb1, 0x43, 0xbc, 0x1d, 0x8a, 0x08, 0xdb, 0x7c, 0xc6,
-	0x40, 0xd4, 0x27, 0x1e, 0x45, 0xca, 0x39, 0x80, 0xdb, 0x3a, 0xc5, 0x06, 0x72, 0xc9, 0x0c, 0xdd,
-	0x85, 0x7d, 0xc3, 0x27, 0x45, 0x8d, 0xed, 0x4c, 0x23, 0xff, 0x5b, 0x65, 0x17, 0x76, 0x0a, 0xc9,
-	0x2b, 0xa5, 0xdf, 0x01, 0xdc, 0xd2, 0x29, 0x7e, 0xef, 0xdb, 0x26, 0x43, 0x6f, 0xe2, 0xe1, 0xf8,
-	0x6f, 0x9d, 0x2f, 0x61, 0x2d, 0x19, 0xaf, 0x58, 0x69, 0x63, 0xd0, 0xe2, 0x5d, 0x4f, 0xba, 0x8f,
-	0xea, 0xd1, 0x85, 0x7d, 0x5b, 0x2f, 0xfa, 0xc0, 0x48, 0xe9, 0xc3, 0x83, 0xe2, 0x61, 0x5a, 0xd9,
-	0x61, 0xf2, 0xca, 0x94, 0x0e, 0xdc, 0xb9, 0x91, 0xca, 0x0e, 0x32, 0xf8, 0x52, 0x86, 0x15, 0x9d,
-	0x62, 0xe1, 0x2d, 0x6c, 0xe4, 0x47, 0x76, 0xaf, 0x30, 0x40, 0x39, 0x54, 0x7a, 0x78, 0x1b, 0x9a,
-	0xb5, 0x16, 0x8e, 0x61, 0xf3, 0xc6, 0x4d, 0xee, 0x17, 0xea, 0x78, 0x82, 0x74, 0xf0, 0x0f, 0xc2,
-	0x55, 0xef, 0x77, 0x70, 0x93, 0xf3, 0xfe, 0x41, 0xa1, 0x30, 0x0f, 0x4b, 0x8f, 0x6e, 0x85, 0xb3,
-	0xae, 0xd2, 0xc6, 0xc7, 0xc8, 0xdf, 0xd1, 0xeb, 0x8b, 0xa5, 0x0c, 0x2e, 0x97, 0x32, 0xf8, 0xb3,
-	0x94, 0xc1, 0xe7, 0x95, 0x5c, 0xba, 0x5c, 0xc9, 0xa5, 0x5f, 0x2b, 0xb9, 0x74, 0xac, 0x62, 0x87,
-	0x4d, 0xc3, 0x53, 0xd5, 0x22, 0xae, 0x96, 0x76, 0x3c, 0x24, 0x01, 0xce, 0xf6, 0xda, 0xec, 0xb9,
-	0x36, 0x4f, 0x9f, 0xac, 0x33, 0x1f, 0xd1, 0xd3, 0x5a, 0xfc, 0x26, 0x3c, 0xfb, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x37, 0xae, 0x51, 0x3e, 0xcf, 0x04, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x49, 0x08, 0xe4, 0x40, 0x41, 0xb8, 0x29, 0x18, 0x87, 0x9a, 0x28, 0x6a, 0x4b, 0x8a,
+	0x84, 0x2d, 0xa8, 0xda, 0x4a, 0xd9, 0x48, 0x85, 0xd4, 0x81, 0x48, 0xad, 0x69, 0x17, 0x96, 0xe8,
+	0x62, 0x5f, 0x0f, 0x0b, 0xec, 0xb3, 0x7c, 0xe7, 0x28, 0xee, 0x54, 0x75, 0x64, 0x6a, 0xff, 0x45,
+	0xa5, 0x2e, 0x0c, 0xfc, 0x08, 0xd4, 0x09, 0x75, 0xea, 0x54, 0x55, 0xc9, 0x90, 0xbf, 0x51, 0xdd,
+	0xd9, 0x86, 0x18, 0x4b, 0x54, 0xaa, 0xd4, 0xc5, 0xb9, 0xf7, 0xbe, 0xf7, 0xde, 0x7d, 0xef, 0x7b,
+	0xef, 0x02, 0xee, 0x7b, 0x28, 0x64, 0x01, 0xf1, 0x0c, 0x8b, 0x7f, 0xd8, 0x50, 0xf7, 0x03, 0xc2,
+	0x88, 0xbc, 0x98, 0xb8, 0x75, 0xee, 0x56, 0x97, 0xa1, 0xeb, 0x78, 0xc4, 0x10, 0xdf, 0x38, 0x40,
+	0x5d, 0xb5, 0x08, 0x75, 0x09, 0x35, 0x5c, 0x8a, 0x8d, 0xc1, 0x0e, 0xff, 0x49, 0x80, 0xb5, 0x18,
+	0xe8, 0x09, 0xcb, 0x88, 0x8d, 0x04, 0xaa, 0x61, 0x82, 0x49, 0xec, 0xe7, 0xa7, 0x34, 0x01, 0x13,
+	0x82, 0x4f, 0x91, 0x21, 0xac, 0x7e, 0xf8, 0xde, 0x80, 0x5e, 0x94, 0x42, 0x19, 0x72, 0x3e, 0x0c,
+	0xa0, 0x9b, 0xd6, 0xaa, 0x67, 0x20, 0x6a, 0x1d, 0x23, 0x3b, 0x3c, 0x45, 0x31, 0xd8, 0x3c, 0x2b,
+	0x82, 0x6a, 0x97, 0xe2, 0x3d, 0xdb, 0x3e, 0x4c, 0x00, 0xf9, 0x39, 0xa8, 0xc0, 0x90, 0x1d, 0x93,
+	0xc0, 0x61, 0x91, 0x22, 0x35, 0xa4, 0x56, 0xa5, 0xa3, 0xfc, 0xb8, 0xd8, 0xae, 0x25, 0x04, 0xf7,
+	0x6c, 0x3b, 0x40, 0x94, 0x1e, 0xb2, 0xc0, 0xf1, 0xb0, 0x79, 0x13, 0x2a, 0xcb, 0xa0, 0xe4, 0x41,
+	0x17, 0x29, 0x33, 0x3c, 0xc5, 0x14, 0x67, 0x79, 0x05, 0x94, 0x7d, 0x14, 0x38, 0xc4, 0x56, 0x8a,
+	0x0d, 0xa9, 0x55, 0x32, 0x13, 0x4b, 0x6e, 0x83, 0x92, 0x4b, 0x31, 0x55, 0x4a, 0x8d, 0x62, 0x6b,
+	0x61, 0xb7, 0xa1, 0x4f, 0x6b, 0xa8, 0x77, 0x29, 0xde, 0x1f, 0x22, 0x2b, 0x64, 0xe8, 0x25, 0xf1,
+	0x58, 0x00, 0x2d, 0xd6, 0x29, 0x5d, 0xfe, 0xda, 0x28, 0x98, 0x22, 0x47, 0xde, 0x07, 0x4b, 0x48,
+	0xc0, 0x0e, 0xf1, 0x7a, 0x94, 0x41, 0x8c, 0x94, 0xd9, 0x86, 0xd4, 0xaa, 0xee, 0xae, 0x67, 0xcb,
+	0xec, 0xa7, 0x41, 0x87, 0x3c, 0xc6, 0xac, 0xa2, 0x8c, 0x2d, 0x1f, 0x80, 0x79, 0x6a, 0x9f, 0xf4,
+	0x04, 0x8d, 0xb2, 0xa0, 0x51, 0xd3, 0x63, 0x7d, 0xf5, 0x54, 0x5f, 0x7d, 0xcf, 0x8b, 0x3a, 0xf5,
+	0xef, 0x17, 0xdb, 0xc9, 0x08, 0xf5, 0x3e, 0xa4, 0x48, 0x1f, 0xec, 0xf4, 0x11, 0x83, 0x3b, 0x9c,
+	0xa6, 0x39, 0x47, 0xed, 0x93, 0x2e, 0x27, 0x55, 0x07, 0x15, 0x0c, 0x69, 0xef, 0xd4, 0x71, 0x1d,
+	0xa6, 0xcc, 0x89, 0x5e, 0xe7, 0x31, 0xa4, 0x07, 0xdc, 0x6e, 0x3f, 0xfe, 0x34, 0x39, 0xdf, 0xba,
+	0x51, 0xea, 0x6c, 0x72, 0xbe, 0x75, 0x4f, 0x0c, 0x23, 0xab, 0x7c, 0x53, 0x01, 0x2b, 0x59, 0x8f,
+	0x89, 0xa8, 0x4f, 0x3c, 0x8a, 0x9a, 0x67, 0x12, 0x58, 0xe6, 0xf7, 0x21, 0x97, 0x0c, 0xd0, 0xff,
+	0x98, 0x54, 0xfb, 0x49, 0x9e, 0xe3, 0x4a, 0xca, 0x31, 0x7b, 0x6d, 0xb3, 0x0e, 0xd6, 0x72, 0xce,
+	0x6b, 0xa6, 0xdf, 0x24, 0xb0, 0xd4, 0xa5, 0xf8, 0x9d, 0x6f, 0x43, 0x86, 0x5e, 0x8b, 0x3d, 0xfc,
+	0x67, 0x9e, 0x2f, 0x40, 0x39, 0xde, 0x64, 0xc1, 0x94, 0x0f, 0x28, 0x33, 0xe0, 0xb8, 0x7a, 0xa7,
+	0xc2, 0x77, 0xe3, 0xeb, 0xe4, 0x7c, 0x4b, 0x32, 0x93, 0xf0, 0xf6, 0x66, 0xbe, 0x99, 0x5a, 0xda,
+	0xcc, 0x34, 0xb3, 0xe6, 0x1a, 0x58, 0xbd, 0xe5, 0x4a, 0x1b, 0xd9, 0xfd, 0x32, 0x03, 0x8a, 0x5d,
+	0x8a, 0xe5, 0x37, 0x60, 0x61, 0xfa, 0x75, 0xac, 0xe7, 0x76, 0x75, 0x0a, 0x55, 0x1f, 0xde, 0x85,
+	0xa6, 0xa5, 0xe5, 0x23, 0x50, 0xbd, 0x35, 0xc9, 0x8d, 0x5c, 0x5e, 0x36, 0x40, 0xdd, 0xfc, 0x4b,
+	0xc0, 0x75, 0xed, 0xb7, 0x60, 0x31, 0xa3, 0xfd, 0x83, 0x5c, 0xe2, 0x34, 0xac, 0x3e, 0xba, 0x13,
+	0x4e, 0xab, 0xaa, 0xb3, 0x1f, 0xb9, 0xbe, 0x9d, 0x57, 0x97, 0x23, 0x4d, 0xba, 0x1a, 0x69, 0xd2,
+	0xef, 0x91, 0x26, 0x7d, 0x1e, 0x6b, 0x85, 0xab, 0xb1, 0x56, 0xf8, 0x39, 0xd6, 0x0a, 0x47, 0x3a,
+	0x76, 0xd8, 0x71, 0xd8, 0xd7, 0x2d, 0xe2, 0x1a, 0x2e, 0x84, 0x5e, 0xb4, 0x3d, 0x8c, 0x3e, 0x24,
+	0x27, 0x1b, 0x0d, 0x8d, 0xc1, 0x33, 0x63, 0x98, 0xfc, 0x71, 0x46, 0x3e, 0xa2, 0xfd, 0xb2, 0x78,
+	0x63, 0x4f, 0xff, 0x0c, 0x00, 0xf2, 0x03, 0xe2, 0xb2, 0x55, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -527,6 +551,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.cron.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -568,6 +593,25 @@ func (m *MsgAddSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SdkMsgs) > 0 {
+		for iNdEx := len(m.SdkMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SdkMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ExecutionStage != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionStage))
 		i--
@@ -792,6 +836,15 @@ func (m *MsgAddSchedule) Size() (n int) {
 	if m.ExecutionStage != 0 {
 		n += 1 + sovTx(uint64(m.ExecutionStage))
 	}
+	if len(m.SdkMsgs) > 0 {
+		for _, e := range m.SdkMsgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -1025,6 +1078,59 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkMsgs = append(m.SdkMsgs, &types.Any{})
+			if err := m.SdkMsgs[len(m.SdkMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
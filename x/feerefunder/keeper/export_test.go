@@ -7,5 +7,10 @@ import (
 )
 
 func (k Keeper) CheckFees(ctx sdk.Context, fees types.Fee) error {
+	_, err := k.checkFees(ctx, fees)
+	return err
+}
+
+func (k Keeper) CheckFeesPrices(ctx sdk.Context, fees types.Fee) (sdk.DecCoins, error) {
 	return k.checkFees(ctx, fees)
 }
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/feerefunder/types"
+)
+
+// feePrices returns the prices in the native denom of the fee denoms valued in the native denom, which
+// are the ones missing from a min fee. The denoms that cannot be priced have no price.
+func (k Keeper) feePrices(ctx sdk.Context, params types.Params, fees types.Fee) sdk.DecCoins {
+	prices := sdk.NewDecCoins()
+	for _, coin := range fees.Total() {
+		if coin.Denom == params.NativeDenom {
+			continue
+		}
+		if !params.MinFee.AckFee.AmountOf(coin.Denom).IsZero() && !params.MinFee.TimeoutFee.AmountOf(coin.Denom).IsZero() {
+			continue
+		}
+
+		price, ok := k.priceInNativeDenom(ctx, params, coin.Denom)
+		if !ok {
+			continue
+		}
+		prices = prices.Add(sdk.NewDecCoinFromDec(coin.Denom, price))
+	}
+	return prices
+}
+
+// nativeEquivalent returns the fee with the coins in other denoms than the min fee's replaced by their value
+// in the native denom at the given prices, along with the coins that have no price.
+func (k Keeper) nativeEquivalent(params types.Params, fee, minFee sdk.Coins, prices sdk.DecCoins) (equivalent, unpriced sdk.Coins) {
+	equivalent, unpriced = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range fee {
+		if !minFee.AmountOf(coin.Denom).IsZero() {
+			equivalent = equivalent.Add(coin)
+			continue
+		}
+		if params.NativeDenom != "" && coin.Denom == params.NativeDenom {
+			equivalent = equivalent.Add(coin)
+			continue
+		}
+
+		price := prices.AmountOf(coin.Denom)
+		if !price.IsPositive() {
+			unpriced = unpriced.Add(coin)
+			continue
+		}
+		value := math.LegacyNewDecFromInt(coin.Amount).Mul(price).TruncateInt()
+		equivalent = equivalent.Add(sdk.NewCoin(params.NativeDenom, value))
+	}
+	return equivalent, unpriced
+}
+
+// priceInNativeDenom returns the price of the denom in the native denom, the TWAP of its pool over the
+// twap duration. Denoms cannot be priced without a twap keeper and a twap duration, the spot price of a
+// pool being too easy to move within the transaction locking the fees.
+func (k Keeper) priceInNativeDenom(ctx sdk.Context, params types.Params, denom string) (math.LegacyDec, bool) {
+	if params.NativeDenom == "" || k.twapKeeper == nil || params.TwapDuration <= 0 {
+		return math.LegacyZeroDec(), false
+	}
+
+	poolId, ok := params.FeeDenomPool(denom)
+	if !ok {
+		return math.LegacyZeroDec(), false
+	}
+
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, denom, params.NativeDenom, ctx.BlockTime().Add(-params.TwapDuration))
+	if err != nil || !twap.IsPositive() {
+		return math.LegacyZeroDec(), false
+	}
+	return twap, true
+}
+
+// convertToNativeDenom swaps the fee coins in other denoms to the native denom through their pool when
+// the params enable it. A swap must return the value of the coin at its lock price minus the max slippage,
+// the coins without a lock price or that fail to be swapped are paid as they are.
+func (k Keeper) convertToNativeDenom(ctx sdk.Context, fee sdk.Coins, lockPrices sdk.DecCoins) sdk.Coins {
+	params := k.GetParams(ctx)
+	if !params.ConvertToNativeDenom || k.poolManagerKeeper == nil {
+		return fee
+	}
+
+	maxSlippage := params.MaxSlippage
+	if maxSlippage.IsNil() {
+		maxSlippage = math.LegacyZeroDec()
+	}
+
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		poolId, ok := params.FeeDenomPool(coin.Denom)
+		if coin.Denom == params.NativeDenom || !ok {
+			converted = converted.Add(coin)
+			continue
+		}
+
+		lockValue := math.LegacyNewDecFromInt(coin.Amount).Mul(lockPrices.AmountOf(coin.Denom))
+		tokenOutMin := lockValue.Mul(math.LegacyOneDec().Sub(maxSlippage)).TruncateInt()
+		if !tokenOutMin.IsPositive() {
+			converted = converted.Add(coin)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		tokenOut, _, err := k.poolManagerKeeper.SwapExactAmountIn(cacheCtx, moduleAddress, poolId, coin, params.NativeDenom, tokenOutMin)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to convert fee to the native denom", "fee", coin, "pool", poolId, "error", err)
+			converted = converted.Add(coin)
+			continue
+		}
+		writeCache()
+		converted = converted.Add(sdk.NewCoin(params.NativeDenom, tokenOut))
+	}
+	return converted
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	testutil_keeper "github.com/maany-xyz/maany-dex/v5/testutil/feerefunder/keeper"
+	mock_types "github.com/maany-xyz/maany-dex/v5/testutil/mocks/feerefunder/types"
+	"github.com/maany-xyz/maany-dex/v5/x/feerefunder/types"
+)
+
+const (
+	nativeDenom = "native"
+	stableDenom = "stable"
+	tokenDenom  = "token"
+	stablePool  = uint64(1)
+	tokenPool   = uint64(2)
+)
+
+func feeTokensParams() types.Params {
+	minFee := sdk.NewCoins(sdk.NewCoin(nativeDenom, math.NewInt(100)))
+	return types.Params{
+		MinFee:        types.Fee{AckFee: minFee, TimeoutFee: minFee},
+		NativeDenom:   nativeDenom,
+		FeeDenomPools: []types.FeeDenomPool{{Denom: stableDenom, PoolId: stablePool}, {Denom: tokenDenom, PoolId: tokenPool}},
+		TwapDuration:  time.Hour,
+		MaxSlippage:   math.LegacyNewDecWithPrec(5, 2),
+	}
+}
+
+func feeIn(coins ...sdk.Coin) types.Fee {
+	return types.Fee{AckFee: sdk.NewCoins(coins...), TimeoutFee: sdk.NewCoins(coins...)}
+}
+
+func TestKeeperCheckFeesInFeeDenoms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	twapKeeper := mock_types.NewMockTwapKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, nil)
+	k.SetTwapKeeper(twapKeeper)
+	require.NoError(t, k.SetParams(ctx, feeTokensParams()))
+
+	// the fee denoms are priced with their fee denom pool
+	startTime := ctx.BlockTime().Add(-time.Hour)
+	twapKeeper.EXPECT().GetArithmeticTwapToNow(gomock.Any(), stablePool, stableDenom, nativeDenom, startTime).Return(osmomath.NewDec(2), nil).AnyTimes()
+	twapKeeper.EXPECT().GetArithmeticTwapToNow(gomock.Any(), tokenPool, tokenDenom, nativeDenom, startTime).Return(osmomath.NewDec(10), nil).AnyTimes()
+
+	for _, tc := range []struct {
+		desc string
+		fees types.Fee
+		err  error
+	}{
+		{
+			desc: "FeeDenomPoolSufficient",
+			fees: feeIn(sdk.NewCoin(stableDenom, math.NewInt(50))),
+		},
+		{
+			desc: "FeeDenomPoolInsufficient",
+			fees: feeIn(sdk.NewCoin(stableDenom, math.NewInt(49))),
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			desc: "OtherFeeDenomPoolSufficient",
+			fees: feeIn(sdk.NewCoin(tokenDenom, math.NewInt(10))),
+		},
+		{
+			desc: "NativeAndFeeDenomsAddUp",
+			fees: feeIn(sdk.NewCoin(nativeDenom, math.NewInt(50)), sdk.NewCoin(stableDenom, math.NewInt(15)), sdk.NewCoin(tokenDenom, math.NewInt(2))),
+		},
+		{
+			desc: "UnpricedDenomInsufficient",
+			fees: feeIn(sdk.NewCoin("unknown", math.NewInt(1000))),
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			desc: "UnpricedDenomNotAllowed",
+			fees: feeIn(sdk.NewCoin(nativeDenom, math.NewInt(100)), sdk.NewCoin("unknown", math.NewInt(1))),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := k.CheckFees(ctx, tc.fees)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestKeeperCheckFeesNeedTwap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	twapKeeper := mock_types.NewMockTwapKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, nil)
+	require.NoError(t, k.SetParams(ctx, feeTokensParams()))
+	fees := feeIn(sdk.NewCoin(stableDenom, math.NewInt(50)))
+
+	// fees in other denoms are refused without a twap keeper
+	require.ErrorIs(t, k.CheckFees(ctx, fees), sdkerrors.ErrInsufficientFee)
+
+	// or when the twap cannot be computed
+	k.SetTwapKeeper(twapKeeper)
+	twapKeeper.EXPECT().GetArithmeticTwapToNow(ctx, stablePool, stableDenom, nativeDenom, ctx.BlockTime().Add(-time.Hour)).Return(osmomath.Dec{}, errors.New("no twap"))
+	require.ErrorIs(t, k.CheckFees(ctx, fees), sdkerrors.ErrInsufficientFee)
+
+	// the lock prices are the twaps
+	twapKeeper.EXPECT().GetArithmeticTwapToNow(ctx, stablePool, stableDenom, nativeDenom, ctx.BlockTime().Add(-time.Hour)).Return(osmomath.NewDec(2), nil)
+	prices, err := k.CheckFeesPrices(ctx, fees)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(stableDenom, math.NewInt(2))), prices)
+}
+
+func TestDistributeAcknowledgementFeeConvertsToNativeDenom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	poolManagerKeeper := mock_types.NewMockPoolManagerKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, bankKeeper)
+	k.SetPoolManagerKeeper(poolManagerKeeper)
+	params := feeTokensParams()
+	params.ConvertToNativeDenom = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	payer := sdk.AccAddress("payer")
+	relayer := sdk.AccAddress("relayer")
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	fee := types.Fee{
+		AckFee:     sdk.NewCoins(sdk.NewCoin(nativeDenom, math.NewInt(10)), sdk.NewCoin(stableDenom, math.NewInt(50))),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin(stableDenom, math.NewInt(50))),
+	}
+	packet := types.NewPacketID("transfer", "channel-0", 1)
+	lockPrices := sdk.NewDecCoins(sdk.NewDecCoin(stableDenom, math.NewInt(2)))
+
+	// the relayer is paid in the native denom, the swap must return the lock value minus the max slippage
+	// and the unused timeout fee is returned as it is
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), Fee: fee, PacketId: packet, LockPrices: lockPrices})
+	poolManagerKeeper.EXPECT().SwapExactAmountIn(gomock.Any(), moduleAddress, stablePool, sdk.NewCoin(stableDenom, math.NewInt(50)), nativeDenom, math.NewInt(95)).
+		Return(math.NewInt(99), sdk.Coin{}, nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, sdk.NewCoins(sdk.NewCoin(nativeDenom, math.NewInt(109)))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, fee.TimeoutFee).Return(nil)
+	require.NotPanics(t, func() { k.DistributeAcknowledgementFee(ctx, relayer, packet) })
+
+	// the fee is paid as it is if the conversion fails
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), Fee: fee, PacketId: packet, LockPrices: lockPrices})
+	poolManagerKeeper.EXPECT().SwapExactAmountIn(gomock.Any(), moduleAddress, stablePool, sdk.NewCoin(stableDenom, math.NewInt(50)), nativeDenom, math.NewInt(95)).
+		Return(math.Int{}, sdk.Coin{}, errors.New("slippage too high"))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, fee.AckFee).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, fee.TimeoutFee).Return(nil)
+	require.NotPanics(t, func() { k.DistributeAcknowledgementFee(ctx, relayer, packet) })
+
+	// or if it has no lock price
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), Fee: fee, PacketId: packet})
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, fee.AckFee).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, fee.TimeoutFee).Return(nil)
+	require.NotPanics(t, func() { k.DistributeAcknowledgementFee(ctx, relayer, packet) })
+}
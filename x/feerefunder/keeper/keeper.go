@@ -26,6 +26,9 @@ type (
 		memKey        storetypes.StoreKey
 		channelKeeper types.ChannelKeeper
 		authority     string
+
+		poolManagerKeeper types.PoolManagerKeeper
+		twapKeeper        types.TwapKeeper
 	}
 )
 
@@ -47,6 +50,18 @@ func NewKeeper(
 	}
 }
 
+// SetPoolManagerKeeper sets the pool manager keeper used to convert the fees to the native denom. The fee keeper is
+// built first, as the interchain txs keeper locking fees takes it, so the pool manager is set once it exists. Until
+// then, relayers are paid in the denoms the fees were locked in.
+func (k *Keeper) SetPoolManagerKeeper(poolManagerKeeper types.PoolManagerKeeper) {
+	k.poolManagerKeeper = poolManagerKeeper
+}
+
+// SetTwapKeeper sets the twap keeper used to price fee denoms in the native denom.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
@@ -64,14 +79,16 @@ func (k Keeper) LockFees(ctx context.Context, payer sdk.AccAddress, packetID typ
 		return errors.Wrapf(channeltypes.ErrChannelNotFound, "channel with id %s and port %s not found", packetID.ChannelId, packetID.PortId)
 	}
 
-	if err := k.checkFees(c, fee); err != nil {
+	lockPrices, err := k.checkFees(c, fee)
+	if err != nil {
 		return errors.Wrapf(err, "failed to lock fees")
 	}
 
 	feeInfo := types.FeeInfo{
-		Payer:    payer.String(),
-		Fee:      fee,
-		PacketId: packetID,
+		Payer:      payer.String(),
+		Fee:        fee,
+		PacketId:   packetID,
+		LockPrices: lockPrices,
 	}
 	k.StoreFeeInfo(c, feeInfo)
 
@@ -107,7 +124,7 @@ func (k Keeper) DistributeAcknowledgementFee(ctx context.Context, receiver sdk.A
 	}
 
 	// try to distribute ack fee
-	if err := k.distributeFee(c, receiver, feeInfo.Fee.AckFee); err != nil {
+	if err := k.distributeFee(c, receiver, k.convertToNativeDenom(c, feeInfo.Fee.AckFee, feeInfo.LockPrices)); err != nil {
 		k.Logger(c).Error("error distributing ack fee", "receiver", receiver, "payer", feeInfo.Payer, "packet", packetID)
 		panic(errors.Wrapf(err, "error distributing ack fee: receiver = %s, packetID=%v", receiver, packetID))
 	}
@@ -146,7 +163,7 @@ func (k Keeper) DistributeTimeoutFee(ctx context.Context, receiver sdk.AccAddres
 	}
 
 	// try to distribute timeout fee
-	if err := k.distributeFee(c, receiver, feeInfo.Fee.TimeoutFee); err != nil {
+	if err := k.distributeFee(c, receiver, k.convertToNativeDenom(c, feeInfo.Fee.TimeoutFee, feeInfo.LockPrices)); err != nil {
 		k.Logger(c).Error("error distributing timeout fee", "receiver", receiver, "payer", feeInfo.Payer, "packet", packetID)
 		panic(errors.Wrapf(err, "error distributing timeout fee: receiver = %s, packetID=%v", receiver, packetID))
 	}
@@ -222,31 +239,38 @@ func (k Keeper) removeFeeInfo(ctx sdk.Context, packetID types.PacketID) {
 	store.Delete(types.GetFeePacketKey(packetID))
 }
 
-func (k Keeper) checkFees(ctx sdk.Context, fees types.Fee) error {
+// checkFees checks that the ack and timeout fees are worth at least the min fees and returns the prices the
+// fees were valued at. Coins in other denoms than the min fees' are valued in the native denom at their TWAP,
+// so they must have a fee denom pool.
+func (k Keeper) checkFees(ctx sdk.Context, fees types.Fee) (sdk.DecCoins, error) {
 	params := k.GetParams(ctx)
+	prices := k.feePrices(ctx, params, fees)
 
-	if !fees.TimeoutFee.IsAnyGTE(params.MinFee.TimeoutFee) {
-		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided timeout fee is less than min governance set timeout fee: %v < %v", fees.TimeoutFee, params.MinFee.TimeoutFee)
+	timeoutFee, unpricedTimeoutFee := k.nativeEquivalent(params, fees.TimeoutFee, params.MinFee.TimeoutFee, prices)
+	ackFee, unpricedAckFee := k.nativeEquivalent(params, fees.AckFee, params.MinFee.AckFee, prices)
+
+	if !timeoutFee.IsAnyGTE(params.MinFee.TimeoutFee) {
+		return nil, errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided timeout fee is less than min governance set timeout fee: %v < %v", fees.TimeoutFee, params.MinFee.TimeoutFee)
 	}
 
-	if !fees.AckFee.IsAnyGTE(params.MinFee.AckFee) {
-		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided ack fee is less than min governance set ack fee: %v < %v", fees.AckFee, params.MinFee.AckFee)
+	if !ackFee.IsAnyGTE(params.MinFee.AckFee) {
+		return nil, errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided ack fee is less than min governance set ack fee: %v < %v", fees.AckFee, params.MinFee.AckFee)
 	}
 
-	if allowedCoins(fees.TimeoutFee, params.MinFee.TimeoutFee) {
-		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "timeout fee cannot have coins other than in params")
+	if !unpricedTimeoutFee.IsZero() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "timeout fee cannot have coins other than in params or priced in the native denom: %v", unpricedTimeoutFee)
 	}
 
-	if allowedCoins(fees.AckFee, params.MinFee.AckFee) {
-		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "ack fee cannot have coins other than in params")
+	if !unpricedAckFee.IsZero() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "ack fee cannot have coins other than in params or priced in the native denom: %v", unpricedAckFee)
 	}
 
 	// we don't allow users to set recv fees, because we can't refund relayers for such messages
 	if !fees.RecvFee.IsZero() {
-		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "recv fee must be zero")
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "recv fee must be zero")
 	}
 
-	return nil
+	return prices, nil
 }
 
 func (k Keeper) distributeFee(ctx sdk.Context, receiver sdk.AccAddress, fee sdk.Coins) error {
@@ -257,14 +281,3 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver sdk.AccAddress, fee sdk.
 	}
 	return nil
 }
-
-// allowedCoins returns true if one or more coins from `fees` are not present in coins from `params`
-// assumes that `params` is sorted
-func allowedCoins(fees, params sdk.Coins) bool {
-	for _, fee := range fees {
-		if params.AmountOf(fee.Denom).IsZero() {
-			return true
-		}
-	}
-	return false
-}
@@ -2,9 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -26,3 +30,13 @@ type BankKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 }
+
+// PoolManagerKeeper defines the expected pool manager keeper used to convert fee denoms to the native denom
+type PoolManagerKeeper interface {
+	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount math.Int) (math.Int, sdk.Coin, error)
+}
+
+// TwapKeeper defines the expected twap keeper used to price fee denoms in the native denom
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
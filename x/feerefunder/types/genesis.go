@@ -43,6 +43,10 @@ func (gs GenesisState) Validate() error {
 		if err := info.Fee.Validate(); err != nil {
 			return fmt.Errorf("invalid fees %s: %w", info.Fee, err)
 		}
+
+		if err := info.LockPrices.Validate(); err != nil {
+			return fmt.Errorf("invalid lock prices %s: %w", info.LockPrices, err)
+		}
 	}
 	return gs.Params.Validate()
 }
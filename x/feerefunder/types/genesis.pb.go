@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -80,6 +82,8 @@ type FeeInfo struct {
 	Payer    string   `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
 	PacketId PacketID `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
 	Fee      Fee      `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// TWAP prices in the native denom of the fee denoms valued when the fees were locked
+	LockPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=lock_prices,json=lockPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"lock_prices"`
 }
 
 func (m *FeeInfo) Reset()         { *m = FeeInfo{} }
@@ -136,6 +140,13 @@ func (m *FeeInfo) GetFee() Fee {
 	return Fee{}
 }
 
+func (m *FeeInfo) GetLockPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.LockPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.feerefunder.GenesisState")
 	proto.RegisterType((*FeeInfo)(nil), "neutron.feerefunder.FeeInfo")
@@ -144,27 +155,32 @@ func init() {
 func init() { proto.RegisterFile("neutron/feerefunder/genesis.proto", fileDescriptor_43aedfe31f06653d) }
 
 var fileDescriptor_43aedfe31f06653d = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4f, 0x02, 0x31,
-	0x14, 0xc6, 0xaf, 0xa0, 0x28, 0xc5, 0xa9, 0x32, 0x5c, 0x50, 0x2a, 0x32, 0xb1, 0x78, 0x35, 0x18,
-	0x06, 0x27, 0x0d, 0x31, 0x1a, 0x26, 0x0d, 0x6e, 0x2e, 0xe4, 0x80, 0xd7, 0xf3, 0x62, 0x68, 0x2f,
-	0xbd, 0x62, 0xe4, 0x5f, 0x70, 0x32, 0xf1, 0x9f, 0x62, 0x64, 0x74, 0x32, 0x86, 0xfb, 0x47, 0xcc,
-	0xb5, 0x25, 0xd1, 0xe4, 0xd8, 0xde, 0xcb, 0xf7, 0xfb, 0xde, 0xf7, 0xe5, 0xe1, 0x53, 0x01, 0x73,
-	0xad, 0xa4, 0x60, 0x1c, 0x40, 0x01, 0x9f, 0x8b, 0x29, 0x28, 0x16, 0x81, 0x80, 0x34, 0x4e, 0x83,
-	0x44, 0x49, 0x2d, 0xc9, 0xa1, 0x43, 0x82, 0x3f, 0x48, 0xa3, 0x1e, 0xc9, 0x48, 0x1a, 0x9d, 0xe5,
-	0x93, 0x45, 0x1b, 0xcd, 0xa2, 0x6b, 0x1c, 0xc0, 0xc9, 0xad, 0x22, 0x39, 0x09, 0x55, 0x38, 0x73,
-	0x59, 0xed, 0x77, 0x84, 0x0f, 0xee, 0x6c, 0xfa, 0xa3, 0x0e, 0x35, 0x90, 0x4b, 0x5c, 0xb1, 0x80,
-	0x8f, 0x5a, 0xa8, 0x53, 0xeb, 0x1e, 0x05, 0x05, 0x6d, 0x82, 0x07, 0x83, 0xf4, 0x77, 0x96, 0xdf,
-	0x27, 0xde, 0xd0, 0x19, 0xc8, 0x15, 0xae, 0x72, 0x80, 0x51, 0x2c, 0xb8, 0x4c, 0xfd, 0x52, 0xab,
-	0xdc, 0xa9, 0x75, 0x8f, 0x0b, 0xdd, 0xb7, 0x00, 0x03, 0xc1, 0xa5, 0xb3, 0xef, 0x73, 0xbb, 0xa6,
-	0xed, 0x4f, 0x84, 0xf7, 0x9c, 0x46, 0xea, 0x78, 0x37, 0x09, 0x17, 0xa0, 0x4c, 0x8d, 0xea, 0xd0,
-	0x2e, 0xe4, 0x1a, 0x57, 0x93, 0x70, 0xf2, 0x02, 0x7a, 0x14, 0x4f, 0xfd, 0x92, 0x29, 0xd8, 0xdc,
-	0x52, 0x30, 0xa7, 0x06, 0x37, 0x9b, 0x0c, 0xeb, 0x1a, 0x4c, 0xc9, 0x39, 0x2e, 0x73, 0x00, 0xbf,
-	0x6c, 0xbc, 0xfe, 0xb6, 0x7a, 0xce, 0x96, 0xa3, 0xfd, 0xfb, 0xe5, 0x9a, 0xa2, 0xd5, 0x9a, 0xa2,
-	0x9f, 0x35, 0x45, 0x1f, 0x19, 0xf5, 0x56, 0x19, 0xf5, 0xbe, 0x32, 0xea, 0x3d, 0xf5, 0xa2, 0x58,
-	0x3f, 0xcf, 0xc7, 0xc1, 0x44, 0xce, 0x98, 0x3b, 0x74, 0x26, 0x55, 0xb4, 0x99, 0xd9, 0x6b, 0x8f,
-	0xbd, 0xfd, 0x7b, 0xbd, 0x5e, 0x24, 0x90, 0x8e, 0x2b, 0xe6, 0xf5, 0x17, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0x39, 0x82, 0x22, 0x0b, 0x02, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x86, 0x93, 0xf6, 0x38, 0xa8, 0xcb, 0x14, 0x6e, 0x88, 0x0e, 0x2e, 0x57, 0x6e, 0x3a, 0x09,
+	0x9d, 0xcd, 0xb5, 0xea, 0xc0, 0x04, 0x2a, 0x15, 0xa8, 0x13, 0x55, 0xd9, 0x58, 0x2a, 0x27, 0xf9,
+	0x1c, 0xa2, 0x12, 0x3b, 0xb2, 0xdd, 0xaa, 0xe1, 0x0f, 0x20, 0x31, 0xf1, 0x3b, 0xf8, 0x25, 0x1d,
+	0x3b, 0x32, 0x01, 0x6a, 0xff, 0x08, 0x8a, 0xed, 0x4a, 0x45, 0x4a, 0xa7, 0x7c, 0x5f, 0xbe, 0xf7,
+	0xc9, 0xfb, 0x46, 0x2f, 0x7a, 0xce, 0x61, 0xa9, 0xa5, 0xe0, 0x84, 0x01, 0x48, 0x60, 0x4b, 0x9e,
+	0x82, 0x24, 0x19, 0x70, 0x50, 0xb9, 0xc2, 0xa5, 0x14, 0x5a, 0x04, 0x4f, 0x9c, 0x04, 0x1f, 0x49,
+	0x2e, 0xa3, 0x44, 0xa8, 0x42, 0x28, 0x12, 0x53, 0x05, 0x64, 0x75, 0x1f, 0x83, 0xa6, 0xf7, 0x24,
+	0x11, 0x39, 0xb7, 0xd0, 0xe5, 0x45, 0x26, 0x32, 0x61, 0x46, 0x52, 0x4f, 0xee, 0xed, 0x55, 0x93,
+	0x1b, 0x03, 0x70, 0xe7, 0x5e, 0xd3, 0xb9, 0xa4, 0x92, 0x16, 0x2e, 0xcb, 0xcd, 0x77, 0x1f, 0x3d,
+	0x7e, 0x6f, 0xd3, 0x7d, 0xd4, 0x54, 0x43, 0xf0, 0x0a, 0x9d, 0x5b, 0x41, 0xe8, 0xf7, 0xfc, 0xdb,
+	0x6e, 0xff, 0x29, 0x6e, 0x48, 0x8b, 0xa7, 0x46, 0x32, 0x3a, 0xdb, 0xfc, 0xbe, 0xf6, 0x66, 0x0e,
+	0x08, 0x5e, 0xa3, 0x0e, 0x03, 0x98, 0xe7, 0x9c, 0x09, 0x15, 0xb6, 0x7a, 0xed, 0xdb, 0x6e, 0xff,
+	0x59, 0x23, 0xfd, 0x0e, 0x60, 0xc2, 0x99, 0x70, 0xf8, 0x23, 0x66, 0x57, 0x75, 0xf3, 0xad, 0x85,
+	0x1e, 0xba, 0x5b, 0x70, 0x81, 0x1e, 0x94, 0xb4, 0x02, 0x69, 0x62, 0x74, 0x66, 0x76, 0x09, 0xde,
+	0xa0, 0x4e, 0x49, 0x93, 0x05, 0xe8, 0x79, 0x9e, 0x86, 0x2d, 0x13, 0xf0, 0xea, 0x44, 0xc0, 0x5a,
+	0x35, 0x19, 0x1f, 0x3c, 0x2c, 0x35, 0x49, 0x83, 0x97, 0xa8, 0xcd, 0x00, 0xc2, 0xb6, 0x61, 0xc3,
+	0x53, 0xf1, 0x1c, 0x56, 0x4b, 0x03, 0x89, 0xba, 0x5f, 0x44, 0xb2, 0x98, 0x97, 0x32, 0x4f, 0x40,
+	0x85, 0x67, 0xee, 0xc7, 0x6c, 0x5f, 0xb8, 0xee, 0x0b, 0xbb, 0xbe, 0xf0, 0x18, 0x92, 0xb7, 0x22,
+	0xe7, 0xa3, 0x41, 0x4d, 0xff, 0xfc, 0x73, 0xfd, 0x22, 0xcb, 0xf5, 0xe7, 0x65, 0x8c, 0x13, 0x51,
+	0x10, 0xd7, 0xaf, 0x7d, 0xdc, 0xa9, 0x74, 0x41, 0x74, 0x55, 0x82, 0x3a, 0x30, 0x6a, 0x86, 0x6a,
+	0x97, 0xa9, 0x31, 0x19, 0x7d, 0xd8, 0xec, 0x22, 0x7f, 0xbb, 0x8b, 0xfc, 0xbf, 0xbb, 0xc8, 0xff,
+	0xb1, 0x8f, 0xbc, 0xed, 0x3e, 0xf2, 0x7e, 0xed, 0x23, 0xef, 0xd3, 0xf0, 0xe8, 0x93, 0x05, 0xa5,
+	0xbc, 0xba, 0x5b, 0x57, 0x5f, 0xdd, 0x94, 0xc2, 0x9a, 0xac, 0x86, 0x64, 0xfd, 0x5f, 0xdd, 0xc6,
+	0x25, 0x3e, 0x37, 0x75, 0x0f, 0xfe, 0x0d, 0x00, 0xa2, 0xc2, 0x38, 0x5e, 0x9f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -234,6 +250,20 @@ func (m *FeeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockPrices) > 0 {
+		for iNdEx := len(m.LockPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -306,6 +336,12 @@ func (m *FeeInfo) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Fee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LockPrices) > 0 {
+		for _, e := range m.LockPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -559,6 +595,40 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockPrices = append(m.LockPrices, types.DecCoin{})
+			if err := m.LockPrices[len(m.LockPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +22,7 @@ var (
 		AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
 		TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
 	}
+	DefaultMaxSlippage = math.LegacyNewDecWithPrec(5, 2)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,7 +32,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(minfee Fee) Params {
-	return Params{MinFee: minfee}
+	return Params{MinFee: minfee, NativeDenom: params.DefaultDenom, MaxSlippage: DefaultMaxSlippage}
 }
 
 // DefaultParams returns a default set of parameters
@@ -37,6 +40,16 @@ func DefaultParams() Params {
 	return NewParams(DefaultFees)
 }
 
+// FeeDenomPool returns the pool pricing the fee denom in the native denom, if any.
+func (p Params) FeeDenomPool(denom string) (uint64, bool) {
+	for _, feeDenomPool := range p.FeeDenomPools {
+		if feeDenomPool.Denom == denom {
+			return feeDenomPool.PoolId, true
+		}
+	}
+	return 0, false
+}
+
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{paramtypes.NewParamSetPair(KeyFees, &p.MinFee, validateFee)}
@@ -44,7 +57,28 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return p.MinFee.Validate()
+	if err := p.MinFee.Validate(); err != nil {
+		return err
+	}
+	if err := validateNativeDenom(p.NativeDenom); err != nil {
+		return err
+	}
+	if err := validateFeeDenomPools(p.FeeDenomPools); err != nil {
+		return err
+	}
+	if (len(p.FeeDenomPools) > 0 || p.ConvertToNativeDenom) && p.NativeDenom == "" {
+		return errors.New("fee denom pools and fee conversion need a native denom")
+	}
+	if _, ok := p.FeeDenomPool(p.NativeDenom); ok {
+		return fmt.Errorf("the native denom %s needs no fee denom pool", p.NativeDenom)
+	}
+	if err := validateTwapDuration(p.TwapDuration); err != nil {
+		return err
+	}
+	if len(p.FeeDenomPools) > 0 && p.TwapDuration == 0 {
+		return errors.New("fee denom pools need a twap duration")
+	}
+	return validateMaxSlippage(p.MaxSlippage)
 }
 
 // String implements the Stringer interface.
@@ -61,3 +95,69 @@ func validateFee(i interface{}) error {
 
 	return v.Validate()
 }
+
+// validateNativeDenom returns an error if the native denom is invalid. It can be empty when fees
+// can only be paid in the min fee denoms.
+func validateNativeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(v)
+}
+
+// validateFeeDenomPools returns an error if the fee denom pools have an invalid or duplicate denom.
+func validateFeeDenomPools(i interface{}) error {
+	feeDenomPools, ok := i.([]FeeDenomPool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(feeDenomPools))
+	for _, feeDenomPool := range feeDenomPools {
+		if err := sdk.ValidateDenom(feeDenomPool.Denom); err != nil {
+			return err
+		}
+		if seen[feeDenomPool.Denom] {
+			return fmt.Errorf("duplicate fee denom pool for %s", feeDenomPool.Denom)
+		}
+		seen[feeDenomPool.Denom] = true
+		if feeDenomPool.PoolId == 0 {
+			return errors.New("fee denom pool id cannot be 0")
+		}
+	}
+	return nil
+}
+
+func validateTwapDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return errors.New("twap duration cannot be negative")
+	}
+	return nil
+}
+
+// validateMaxSlippage returns an error if the max slippage is not in [0, 1). It can be unset, which
+// is the same as zero.
+func validateMaxSlippage(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max slippage must be in [0, 1): %s", v)
+	}
+	return nil
+}
@@ -4,18 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	MinFee Fee `protobuf:"bytes,1,opt,name=min_fee,json=minFee,proto3" json:"min_fee"`
+	// Denom the fees paid in other denoms than the min fee's are valued in and converted to
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// Pools used to price fee denoms in the native denom, the only other denoms than the min fee's fees can be paid in
+	FeeDenomPools []FeeDenomPool `protobuf:"bytes,3,rep,name=fee_denom_pools,json=feeDenomPools,proto3" json:"fee_denom_pools" yaml:"fee_denom_pools"`
+	// Duration of the TWAP used to price fee denoms, the fees in other denoms than the min fee's are refused if it is zero
+	TwapDuration time.Duration `protobuf:"bytes,4,opt,name=twap_duration,json=twapDuration,proto3,stdduration" json:"twap_duration" yaml:"twap_duration"`
+	// Whether the fees paid in other denoms are converted to the native denom before paying the relayer
+	ConvertToNativeDenom bool `protobuf:"varint,5,opt,name=convert_to_native_denom,json=convertToNativeDenom,proto3" json:"convert_to_native_denom,omitempty" yaml:"convert_to_native_denom"`
+	// Share of the fee value at lock time the conversion to the native denom can lose, the fee is paid
+	// as it is if the swap would lose more
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage" yaml:"max_slippage"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -67,27 +84,129 @@ func (m *Params) GetMinFee() Fee {
 	return Fee{}
 }
 
+func (m *Params) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDenomPools() []FeeDenomPool {
+	if m != nil {
+		return m.FeeDenomPools
+	}
+	return nil
+}
+
+func (m *Params) GetTwapDuration() time.Duration {
+	if m != nil {
+		return m.TwapDuration
+	}
+	return 0
+}
+
+func (m *Params) GetConvertToNativeDenom() bool {
+	if m != nil {
+		return m.ConvertToNativeDenom
+	}
+	return false
+}
+
+// FeeDenomPool defines the pool used to price a fee denom in the native denom.
+type FeeDenomPool struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *FeeDenomPool) Reset()         { *m = FeeDenomPool{} }
+func (m *FeeDenomPool) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPool) ProtoMessage()    {}
+func (*FeeDenomPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dae67276ca81c89, []int{1}
+}
+func (m *FeeDenomPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPool.Merge(m, src)
+}
+func (m *FeeDenomPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPool proto.InternalMessageInfo
+
+func (m *FeeDenomPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.feerefunder.Params")
+	proto.RegisterType((*FeeDenomPool)(nil), "neutron.feerefunder.FeeDenomPool")
 }
 
 func init() { proto.RegisterFile("neutron/feerefunder/params.proto", fileDescriptor_2dae67276ca81c89) }
 
 var fileDescriptor_2dae67276ca81c89 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x4b, 0x4d, 0x2d, 0x4a, 0x4d, 0x2b, 0xcd, 0x4b, 0x49, 0x2d, 0xd2,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x43, 0x52, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd7, 0x07, 0xb1, 0x20, 0x4a,
-	0xa5, 0x64, 0xb1, 0x19, 0x96, 0x96, 0x9a, 0x0a, 0x91, 0x56, 0x72, 0xe7, 0x62, 0x0b, 0x00, 0x9b,
-	0x2c, 0x64, 0xce, 0xc5, 0x9e, 0x9b, 0x99, 0x17, 0x9f, 0x96, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa1, 0x87, 0xc5, 0x16, 0x3d, 0xb7, 0xd4, 0x54, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xd8, 0x72, 0x33, 0xf3, 0xdc, 0x52, 0x53, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70,
-	0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x89, 0xba, 0xf9, 0x45, 0xe9, 0x30, 0xb6,
-	0x7e, 0x99, 0xa9, 0x7e, 0x05, 0x8a, 0xeb, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0e,
-	0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x06, 0xda, 0x04, 0xf4, 0x0e, 0x01, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x9a, 0xd2, 0x4d, 0x2a, 0x24, 0x37, 0x22, 0x6e, 0x11, 0xb6, 0xf1, 0x29, 0x97,
+	0xda, 0x52, 0x51, 0x85, 0x14, 0x89, 0x8b, 0x15, 0x55, 0x20, 0x21, 0xa8, 0x0c, 0x17, 0xb8, 0x98,
+	0x8d, 0x3d, 0x76, 0xad, 0x66, 0x3d, 0x96, 0xed, 0x84, 0x84, 0xaf, 0xe0, 0x98, 0x23, 0x1f, 0xc1,
+	0x47, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x0c, 0x4a, 0xfe, 0x20, 0x5f, 0x80, 0xec, 0xdd, 0x08, 0xa7,
+	0x6a, 0x6f, 0xfb, 0xf6, 0xbd, 0x9d, 0x79, 0xfb, 0x66, 0x88, 0x1e, 0xc3, 0x24, 0x4f, 0x31, 0xb6,
+	0x02, 0x80, 0x14, 0x82, 0x49, 0xec, 0x43, 0x6a, 0x25, 0x34, 0xa5, 0x2c, 0x33, 0x93, 0x14, 0x73,
+	0x94, 0x0f, 0x85, 0xc2, 0xac, 0x29, 0x8e, 0x8f, 0x3c, 0xcc, 0x18, 0x66, 0x6e, 0x25, 0xb1, 0x38,
+	0xe0, 0xfa, 0xe3, 0x6e, 0x88, 0x21, 0xf2, 0xfb, 0xf2, 0x24, 0x6e, 0xd5, 0x10, 0x31, 0x1c, 0x83,
+	0x55, 0xa1, 0xd1, 0x24, 0xb0, 0xfc, 0x49, 0x4a, 0xf3, 0x08, 0x63, 0xc1, 0x3f, 0xbd, 0xcb, 0x47,
+	0x00, 0xc0, 0x69, 0x63, 0xd1, 0x24, 0xad, 0x8b, 0xca, 0x95, 0xfc, 0x82, 0xec, 0xb1, 0x28, 0x76,
+	0x03, 0x00, 0x45, 0xd2, 0xa5, 0x7e, 0xfb, 0x54, 0x31, 0xef, 0x70, 0x68, 0x9e, 0x03, 0xd8, 0xcd,
+	0xeb, 0x42, 0x6b, 0x38, 0x2d, 0x16, 0xc5, 0xe7, 0x00, 0xf2, 0x80, 0x74, 0x62, 0x9a, 0x47, 0x53,
+	0x70, 0x7d, 0x88, 0x91, 0x29, 0x0f, 0x74, 0xa9, 0xbf, 0x6f, 0xf7, 0xd6, 0x85, 0x76, 0x38, 0xa7,
+	0x6c, 0x3c, 0x30, 0xea, 0xac, 0xe1, 0xb4, 0x39, 0x1c, 0x96, 0x48, 0x8e, 0xc8, 0xa3, 0x00, 0x04,
+	0xe5, 0x26, 0x88, 0xe3, 0x4c, 0xd9, 0xd1, 0x77, 0xfa, 0xed, 0xd3, 0x67, 0xf7, 0x35, 0xaf, 0xde,
+	0x5d, 0x20, 0x8e, 0x6d, 0xb5, 0x74, 0xb1, 0x2e, 0xb4, 0xc7, 0xbc, 0xcb, 0xad, 0x3a, 0x86, 0x73,
+	0x10, 0xd4, 0xd4, 0x99, 0xfc, 0x99, 0x1c, 0xe4, 0x5f, 0x68, 0xe2, 0x6e, 0x02, 0x52, 0x9a, 0xd5,
+	0x2f, 0x8f, 0x4c, 0x9e, 0xa0, 0xb9, 0x49, 0xd0, 0x1c, 0x0a, 0x81, 0xad, 0x8b, 0x06, 0x5d, 0xde,
+	0x60, 0xeb, 0xb5, 0xb1, 0xf8, 0xa3, 0x49, 0x4e, 0xa7, 0xbc, 0xdb, 0xe8, 0xe5, 0x8f, 0xa4, 0xe7,
+	0x61, 0x3c, 0x85, 0x34, 0x77, 0x73, 0x74, 0xb7, 0x32, 0xd9, 0xd5, 0xa5, 0xfe, 0x43, 0xdb, 0x58,
+	0x17, 0x9a, 0xca, 0x8b, 0xdd, 0x23, 0x34, 0x9c, 0xae, 0x60, 0x3e, 0xe0, 0xdb, 0x5a, 0x4e, 0x57,
+	0xa4, 0xc3, 0xe8, 0xcc, 0xcd, 0xc6, 0x51, 0x92, 0xd0, 0x10, 0x94, 0x56, 0x95, 0xf1, 0xab, 0xd2,
+	0xe0, 0xef, 0x42, 0x7b, 0xc2, 0x17, 0x25, 0xf3, 0xaf, 0xcc, 0x08, 0x2d, 0x46, 0xf3, 0x4b, 0xf3,
+	0x0d, 0x84, 0xd4, 0x9b, 0x0f, 0xc1, 0xfb, 0x3f, 0x86, 0x7a, 0x01, 0xe3, 0xe7, 0x8f, 0x13, 0x22,
+	0xd6, 0x6b, 0x08, 0x9e, 0xd3, 0x66, 0x74, 0xf6, 0x5e, 0x70, 0x83, 0xe6, 0xe2, 0xbb, 0xd6, 0x30,
+	0x5e, 0x92, 0x4e, 0x3d, 0x6e, 0xb9, 0x4b, 0x76, 0xf9, 0x5f, 0xca, 0xed, 0xd8, 0x77, 0x38, 0x90,
+	0x7b, 0x64, 0xaf, 0x8c, 0xdb, 0x8d, 0xfc, 0x6a, 0xee, 0x4d, 0xa7, 0x55, 0xc2, 0xd7, 0xbe, 0xfd,
+	0xee, 0x7a, 0xa9, 0x4a, 0x37, 0x4b, 0x55, 0xfa, 0xbb, 0x54, 0xa5, 0x6f, 0x2b, 0xb5, 0x71, 0xb3,
+	0x52, 0x1b, 0xbf, 0x56, 0x6a, 0xe3, 0xd3, 0x59, 0x18, 0xe5, 0x97, 0x93, 0x91, 0xe9, 0x21, 0xb3,
+	0x18, 0xa5, 0xf1, 0xfc, 0x64, 0x36, 0xff, 0x2a, 0x4e, 0x3e, 0xcc, 0xac, 0xe9, 0x99, 0x35, 0xdb,
+	0x5a, 0xd7, 0x7c, 0x9e, 0x40, 0x36, 0x6a, 0x55, 0x03, 0x7a, 0xfe, 0x6f, 0x00, 0x1d, 0x99, 0x00,
+	0xc7, 0x5a, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -110,6 +229,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ConvertToNativeDenom {
+		i--
+		if m.ConvertToNativeDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.FeeDenomPools) > 0 {
+		for iNdEx := len(m.FeeDenomPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.MinFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -123,6 +291,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenomPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -142,6 +345,39 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.FeeDenomPools) > 0 {
+		for _, e := range m.FeeDenomPools {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ConvertToNativeDenom {
+		n += 2
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeDenomPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovParams(uint64(m.PoolId))
+	}
 	return n
 }
 
@@ -213,6 +449,260 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomPools = append(m.FeeDenomPools, FeeDenomPool{})
+			if err := m.FeeDenomPools[len(m.FeeDenomPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertToNativeDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvertToNativeDenom = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -130,9 +130,9 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 	// twap records at pool creation time.
 	// Additionally, these hooks are used in x/pool-incentives to
 	// create gauges.
-
-	// TODO: create custom logic to deal with gauges
-	// k.hooks.AfterCFMMPoolCreated(ctx, sender, pool.GetId())
+	if k.hooks != nil {
+		k.hooks.AfterCFMMPoolCreated(ctx, sender, pool.GetId())
+	}
 	// ctx.Logger().Info("After created hook ")
 
 	k.RecordTotalLiquidityIncrease(ctx, cfmmPool.GetTotalPoolLiquidity(ctx))
@@ -26,8 +26,10 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool poolmanagertypes.
 	}
 
 	events.EmitAddLiquidityEvent(ctx, joiner, pool.GetId(), joinCoins)
+	if k.hooks != nil {
+		k.hooks.AfterJoinPool(ctx, joiner, pool.GetId(), joinCoins, numShares)
+	}
 	//TODO: handle custom logic here
-	//k.hooks.AfterJoinPool(ctx, joiner, pool.GetId(), joinCoins, numShares)
 	// k.RecordTotalLiquidityIncrease(ctx, joinCoins)
 	return nil
 }
@@ -49,8 +51,10 @@ func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool poolmanagertypes.
 	}
 
 	events.EmitRemoveLiquidityEvent(ctx, exiter, pool.GetId(), exitCoins)
+	if k.hooks != nil {
+		k.hooks.AfterExitPool(ctx, exiter, pool.GetId(), numShares, exitCoins)
+	}
 	//TODO: custom logic
-	// k.hooks.AfterExitPool(ctx, exiter, pool.GetId(), numShares, exitCoins)
 	// k.RecordTotalLiquidityDecrease(ctx, exitCoins)
 	return nil
 }
@@ -224,8 +224,10 @@ func (k Keeper) updatePoolForSwap(
 	// Search for references to this function to see where else it is used.
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	if k.hooks != nil {
+		k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	}
 	//TODO: custom logic
-	// k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	// k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	// k.RecordTotalLiquidityDecrease(ctx, tokensOut)
 
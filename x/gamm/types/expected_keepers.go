@@ -71,13 +71,15 @@ type PoolManager interface {
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
-		tokenOutMinAmount osmomath.Int) (tokenOutAmount osmomath.Int, err error)
+		tokenOutMinAmount osmomath.Int,
+		protection poolmanagertypes.SwapProtection) (tokenOutAmount osmomath.Int, err error)
 
 	RouteExactAmountOut(ctx sdk.Context,
 		sender sdk.AccAddress,
 		routes []poolmanagertypes.SwapAmountOutRoute,
 		tokenInMaxAmount osmomath.Int,
 		tokenOut sdk.Coin,
+		protection poolmanagertypes.SwapProtection,
 	) (tokenInAmount osmomath.Int, err error)
 
 	MultihopEstimateOutGivenExactAmountIn(
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+func (k Keeper) SetClaimed(ctx sdk.Context, providerChainID, escrowID string) error {
+	return k.setClaimed(ctx, providerChainID, escrowID)
+}
+
+func (k Keeper) EnqueuePendingClaim(ctx sdk.Context, providerChainID, escrowID string) error {
+	return k.enqueuePendingClaim(ctx, providerChainID, escrowID)
+}
+
+func (k Keeper) IsPendingClaim(ctx sdk.Context, providerChainID, escrowID string) bool {
+	return k.isPendingClaim(ctx, providerChainID, escrowID)
+}
+
+func (k Keeper) SetInflight(ctx sdk.Context, providerChainID, escrowID string) error {
+	return k.setInflight(ctx, providerChainID, escrowID)
+}
+
+func (k Keeper) IsInflight(ctx sdk.Context, providerChainID, escrowID string) bool {
+	return k.isInflight(ctx, providerChainID, escrowID)
+}
+
+func (k Keeper) MapPacketToBatch(ctx sdk.Context, channelID string, sequence uint64, batch types.ClaimBatch) error {
+	return k.mapPacketToBatch(ctx, channelID, sequence, batch)
+}
+
+func (k Keeper) ConsumePacketMapping(ctx sdk.Context, channelID string, sequence uint64) ([]types.ClaimRef, bool) {
+	return k.consumePacketMapping(ctx, channelID, sequence)
+}
+
+func (k Keeper) SetDone(ctx sdk.Context) {
+	k.setDone(ctx)
+}
+
+func (k Keeper) IsDone(ctx sdk.Context) bool {
+	return k.isDone(ctx)
+}
+
+func (k Keeper) IsIsolated(ctx sdk.Context, providerChainID, escrowID string) bool {
+	return k.isIsolated(ctx, providerChainID, escrowID)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) Claim(goCtx context.Context, req *types.QueryClaimRequest) (*types.QueryClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProviderChainId == "" || req.EscrowId == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider chain id and escrow id are required")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &types.QueryClaimResponse{
+		Claimed:  k.isClaimed(ctx, req.ProviderChainId, req.EscrowId),
+		Pending:  k.isPendingClaim(ctx, req.ProviderChainId, req.EscrowId),
+		Inflight: k.isInflight(ctx, req.ProviderChainId, req.EscrowId),
+	}
+	resp.Isolated = k.isIsolated(ctx, req.ProviderChainId, req.EscrowId)
+	if resp.Inflight {
+		resp.InflightSince = k.inflightSince(ctx, req.ProviderChainId, req.EscrowId)
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) PendingClaims(goCtx context.Context, req *types.QueryPendingClaimsRequest) (*types.QueryPendingClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claims, pageRes, err := query.CollectionPaginate(ctx, k.pendingClaims, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.ClaimRef, error) {
+			return types.ClaimRef{ProviderChainId: key.K1(), EscrowId: key.K2()}, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingClaimsResponse{Claims: claims, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InflightClaims(goCtx context.Context, req *types.QueryInflightClaimsRequest) (*types.QueryInflightClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &types.QueryInflightClaimsResponse{
+		Claims:  make([]types.InflightClaim, 0),
+		Packets: make([]types.PacketMapping, 0),
+	}
+
+	err := k.inflightClaims.Walk(ctx, nil, func(key collections.Pair[string, string], since int64) (bool, error) {
+		resp.Claims = append(resp.Claims, types.InflightClaim{
+			ProviderChainId: key.K1(),
+			EscrowId:        key.K2(),
+			InflightSince:   since,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = k.icaPackets.Walk(ctx, nil, func(key collections.Pair[string, uint64], batch types.ClaimBatch) (bool, error) {
+		resp.Packets = append(resp.Packets, types.PacketMapping{
+			ChannelId: key.K1(),
+			Sequence:  key.K2(),
+			Claims:    batch.Claims,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) IcaStatus(goCtx context.Context, req *types.QueryIcaStatusRequest) (*types.QueryIcaStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	config := k.GetIcaConfig(ctx)
+	portID, err := icatypes.NewControllerPortID(config.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "bad ICA owner %q: %s", config.Owner, err)
+	}
+
+	resp := &types.QueryIcaStatusResponse{
+		Config:              config,
+		PortId:              portID,
+		RegistrationPending: k.isICAPending(ctx, config.ConnectionId, config.Owner),
+		Done:                k.isDone(ctx),
+	}
+	if channelID, found := k.icaCtrlKeeper.GetActiveChannelID(ctx, config.ConnectionId, portID); found {
+		resp.ChannelId = channelID
+	}
+	if icaAddr, found := k.icaCtrlKeeper.GetInterchainAccountAddress(ctx, config.ConnectionId, portID); found {
+		resp.IcaAddress = icaAddr
+	}
+
+	return resp, nil
+}
@@ -7,11 +7,13 @@ import (
     "strconv"
     "time"
 
+    "cosmossdk.io/collections"
     sdkmath "cosmossdk.io/math"
 
     storetypes "cosmossdk.io/store/types"
     "github.com/cosmos/cosmos-sdk/codec"
     codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+    "github.com/cosmos/cosmos-sdk/runtime"
     sdk "github.com/cosmos/cosmos-sdk/types"
     icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
     icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -20,7 +22,7 @@ import (
     commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 
     "github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
-    // provider message packed as Any (no direct provider module import)
+    mintburntypes "github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
 )
 type hasRoot interface{ GetRoot() *commitmenttypes.MerkleRoot }
 
@@ -34,18 +36,30 @@ type Keeper struct {
     icaCtrlKeeper ICAControllerKeeper
     icaMsgServer  ICAControllerMsgServer
     connKeeper   ConnectionKeeper
+    authority    string
+
+    // claim queues, keyed by (provider_chain_id, escrow_id)
+    claimed        collections.KeySet[collections.Pair[string, string]]
+    pendingClaims  collections.KeySet[collections.Pair[string, string]]
+    inflightClaims collections.Map[collections.Pair[string, string], int64] // unix seconds the claim was sent at
+    // claims sent in an ICA tx of their own, since a batch they were part of failed
+    isolatedClaims collections.KeySet[collections.Pair[string, string]]
+    // ICA packets awaiting an ack, keyed by (channel_id, sequence)
+    icaPackets collections.Map[collections.Pair[string, uint64], types.ClaimBatch]
 }
 
 func NewKeeper(
     cdc codec.BinaryCodec,
-    key storetypes.StoreKey,
+    key *storetypes.KVStoreKey,
     bk BankKeeper,
     ck ClientKeeper,
     icaCtrl ICAControllerKeeper,
     icaMsg ICAControllerMsgServer,
     connKeeper ConnectionKeeper,
+    authority string,
 ) Keeper {
-    return Keeper{
+    sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+    k := Keeper{
         cdc:          cdc,
         storeKey:     key,
         bank:         bk,
@@ -53,7 +67,37 @@ func NewKeeper(
         icaCtrlKeeper: icaCtrl,
         icaMsgServer:  icaMsg,
         connKeeper:   connKeeper,
+        authority:    authority,
+
+        claimed:        collections.NewKeySet(sb, types.ClaimedPrefix, "claimed", types.ClaimKeyCodec),
+        pendingClaims:  collections.NewKeySet(sb, types.PendingClaimPrefix, "pending_claims", types.ClaimKeyCodec),
+        inflightClaims: collections.NewMap(sb, types.InflightPrefix, "inflight_claims", types.ClaimKeyCodec, collections.Int64Value),
+        isolatedClaims: collections.NewKeySet(sb, types.IsolatedClaimPrefix, "isolated_claims", types.ClaimKeyCodec),
+        icaPackets:     collections.NewMap(sb, types.ICAPacketPrefix, "ica_packets", types.ICAPacketKeyCodec, codec.CollValue[types.ClaimBatch](cdc)),
+    }
+    if _, err := sb.Build(); err != nil {
+        panic(err)
+    }
+    return k
+}
+
+func (k Keeper) GetAuthority() string {
+    return k.authority
+}
+
+// --- Params ---
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+    ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+    bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+    if bz == nil {
+        return params
     }
+    k.cdc.MustUnmarshal(bz, &params)
+    return params
 }
 
 // Exported setters for InitGenesis config wiring
@@ -61,18 +105,17 @@ func (k Keeper) SetICAConnectionID(ctx sdk.Context, v string) { k.setICAConnecti
 func (k Keeper) SetICAOwner(ctx sdk.Context, v string)        { k.setICAOwner(ctx, v) }
 func (k Keeper) SetICATimeoutSeconds(ctx sdk.Context, v uint64) { k.setICATimeoutSeconds(ctx, v) }
 func (k Keeper) SetMaxClaimsPerBlock(ctx sdk.Context, v int)  { k.setMaxClaimsPerBlock(ctx, v) }
+func (k Keeper) SetMaxClaimsPerTx(ctx sdk.Context, v int)     { k.setMaxClaimsPerTx(ctx, v) }
 
 // --- Claimed index ---
 
-// claimed key: claimed|<providerChainID>|<escrowID>
-func (k Keeper) setClaimed(ctx sdk.Context, providerChainID, escrowID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ClaimedKey(providerChainID, escrowID), []byte{1})
+func (k Keeper) setClaimed(ctx sdk.Context, providerChainID, escrowID string) error {
+	return k.claimed.Set(ctx, collections.Join(providerChainID, escrowID))
 }
 
 func (k Keeper) isClaimed(ctx sdk.Context, providerChainID, escrowID string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.ClaimedKey(providerChainID, escrowID))
+	has, err := k.claimed.Has(ctx, collections.Join(providerChainID, escrowID))
+	return err == nil && has
 }
 
 // --- Core flow: verify (optional) + mint + mark claimed ---
@@ -202,10 +245,16 @@ func (k Keeper) ProcessGenesisMint(
     }
     ctx.Logger().Info("genesismint: sent coins to recipient", "recipient", intent.Recipient, "amount", coins.String())
 
+    k.addTotalMinted(ctx, coins)
+
     // Mark claimed
-    k.setClaimed(ctx, intent.ProviderChainId, intent.EscrowId)
+    if err := k.setClaimed(ctx, intent.ProviderChainId, intent.EscrowId); err != nil {
+        return fmt.Errorf("mark claimed: %w", err)
+    }
     // Enqueue a pending claim to notify provider via ICA later.
-    k.enqueuePendingClaim(ctx, intent.ProviderChainId, intent.EscrowId)
+    if err := k.enqueuePendingClaim(ctx, intent.ProviderChainId, intent.EscrowId); err != nil {
+        return fmt.Errorf("enqueue pending claim: %w", err)
+    }
     ctx.Logger().Info("genesismint: marked claimed and enqueued pending ICA claim",
         "provider_chain_id", intent.ProviderChainId,
         "escrow_id", intent.EscrowId,
@@ -223,108 +272,183 @@ func (k Keeper) ProcessGenesisMint(
 	return nil
 }
 
+// --- Minted totals ---
+
+// GetTotalMinted returns the amount of denom minted by the module so far. The other
+// supply bookkeepers (e.g. the mintburn mirror supply invariant) use it to tell the
+// genesis allocation apart from the supply they are accounting for.
+func (k Keeper) GetTotalMinted(ctx sdk.Context, denom string) sdkmath.Int {
+    bz := ctx.KVStore(k.storeKey).Get(types.MintedTotalKey(denom))
+    if bz == nil {
+        return sdkmath.ZeroInt()
+    }
+    amt, ok := sdkmath.NewIntFromString(string(bz))
+    if !ok {
+        panic(fmt.Sprintf("genesismint: corrupted minted total for %s: %s", denom, bz))
+    }
+    return amt
+}
+
+func (k Keeper) addTotalMinted(ctx sdk.Context, coins sdk.Coins) {
+    for _, c := range coins {
+        total := k.GetTotalMinted(ctx, c.Denom).Add(c.Amount)
+        ctx.KVStore(k.storeKey).Set(types.MintedTotalKey(c.Denom), []byte(total.String()))
+    }
+}
+
 // ---- Pending claim queue helpers ----
 
-func (k Keeper) enqueuePendingClaim(ctx sdk.Context, providerChainID, escrowID string) {
-    store := ctx.KVStore(k.storeKey)
-    store.Set(types.PendingClaimKey(providerChainID, escrowID), []byte{1})
+func (k Keeper) enqueuePendingClaim(ctx sdk.Context, providerChainID, escrowID string) error {
+    return k.pendingClaims.Set(ctx, collections.Join(providerChainID, escrowID))
 }
 
-func (k Keeper) deletePendingClaim(ctx sdk.Context, providerChainID, escrowID string) {
-    store := ctx.KVStore(k.storeKey)
-    store.Delete(types.PendingClaimKey(providerChainID, escrowID))
+func (k Keeper) deletePendingClaim(ctx sdk.Context, providerChainID, escrowID string) error {
+    return k.pendingClaims.Remove(ctx, collections.Join(providerChainID, escrowID))
 }
 
-func (k Keeper) mapPacketToEscrow(ctx sdk.Context, channelID string, sequence uint64, providerChainID, escrowID string) {
-    store := ctx.KVStore(k.storeKey)
-    val := providerChainID + "|" + escrowID
-    store.Set(types.ICAPacketKey(channelID, sequence), []byte(val))
+func (k Keeper) isPendingClaim(ctx sdk.Context, providerChainID, escrowID string) bool {
+    has, err := k.pendingClaims.Has(ctx, collections.Join(providerChainID, escrowID))
+    return err == nil && has
 }
 
-func (k Keeper) consumePacketMapping(ctx sdk.Context, channelID string, sequence uint64) (string, string, bool) {
-    store := ctx.KVStore(k.storeKey)
-    key := types.ICAPacketKey(channelID, sequence)
-    if !store.Has(key) { return "", "", false }
-    bz := store.Get(key)
-    store.Delete(key)
-    // value format: provider|escrow
-    s := string(bz)
-    for i := 0; i < len(s); i++ {
-        if s[i] == '|' {
-            return s[:i], s[i+1:], true
+func (k Keeper) mapPacketToBatch(ctx sdk.Context, channelID string, sequence uint64, batch types.ClaimBatch) error {
+    return k.icaPackets.Set(ctx, collections.Join(channelID, sequence), batch)
+}
+
+func (k Keeper) consumePacketMapping(ctx sdk.Context, channelID string, sequence uint64) ([]types.ClaimRef, bool) {
+    key := collections.Join(channelID, sequence)
+    batch, err := k.icaPackets.Get(ctx, key)
+    if err != nil {
+        return nil, false
+    }
+    if err := k.icaPackets.Remove(ctx, key); err != nil {
+        return nil, false
+    }
+    return batch.Claims, true
+}
+
+// dropClaimFromPackets removes the claim from the batch of the ICA packet it was sent in, and the
+// packet mapping itself once its batch is empty, so that a late ack of that packet leaves the claim alone.
+func (k Keeper) dropClaimFromPackets(ctx sdk.Context, providerChainID, escrowID string) error {
+    it, err := k.icaPackets.Iterate(ctx, nil)
+    if err != nil {
+        return err
+    }
+    kvs, err := it.KeyValues()
+    if err != nil {
+        return err
+    }
+    for _, kv := range kvs {
+        claims := make([]types.ClaimRef, 0, len(kv.Value.Claims))
+        for _, claim := range kv.Value.Claims {
+            if claim.ProviderChainId != providerChainID || claim.EscrowId != escrowID {
+                claims = append(claims, claim)
+            }
+        }
+        switch {
+        case len(claims) == len(kv.Value.Claims):
+            continue
+        case len(claims) == 0:
+            err = k.icaPackets.Remove(ctx, kv.Key)
+        default:
+            err = k.icaPackets.Set(ctx, kv.Key, types.ClaimBatch{Claims: claims})
+        }
+        if err != nil {
+            return err
         }
     }
-    return "", s, true
+    return nil
 }
 
-// Iterate a limited number of pending claims, returning pairs (providerChainID, escrowID).
-func (k Keeper) listPendingClaims(ctx sdk.Context, limit int) [][2]string {
-    store := ctx.KVStore(k.storeKey)
-    it := storetypes.KVStorePrefixIterator(store, types.PendingClaimPrefix)
+// ---- Isolated claim helpers ----
+func (k Keeper) setIsolated(ctx sdk.Context, providerChainID, escrowID string) error {
+    return k.isolatedClaims.Set(ctx, collections.Join(providerChainID, escrowID))
+}
+func (k Keeper) clearIsolated(ctx sdk.Context, providerChainID, escrowID string) error {
+    return k.isolatedClaims.Remove(ctx, collections.Join(providerChainID, escrowID))
+}
+func (k Keeper) isIsolated(ctx sdk.Context, providerChainID, escrowID string) bool {
+    has, err := k.isolatedClaims.Has(ctx, collections.Join(providerChainID, escrowID))
+    return err == nil && has
+}
+
+// listPendingClaims returns up to limit pending claims that can be sent, as (providerChainID, escrowID) pairs.
+// The claims in flight awaiting their ack are skipped, so that they do not use up the limit; an in-flight
+// claim without ack or timeout past the ICA tx timeout is cleared, dropped from its ICA packet and listed for a retry.
+func (k Keeper) listPendingClaims(ctx sdk.Context, limit int) ([]collections.Pair[string, string], error) {
+    it, err := k.pendingClaims.Iterate(ctx, nil)
+    if err != nil {
+        return nil, err
+    }
     defer it.Close()
-    out := make([][2]string, 0, limit)
-    for it.Valid() && len(out) < limit {
-        // key format: PendingClaimPrefix + "pclaim|" + provider + "|" + escrow
-        key := it.Key()
-        // strip prefix byte + "pclaim|"
-        s := string(key[len(types.PendingClaimPrefix):])
-        // s == "pclaim|<provider>|<escrow>"
-        // find provider and escrow by splitting on '|'
-        // avoid allocations: simple parse
-        const head = "pclaim|"
-        if len(s) > len(head) && s[:len(head)] == head {
-            rest := s[len(head):]
-            // find next '|'
-            for i := 0; i < len(rest); i++ {
-                if rest[i] == '|' {
-                    prov := rest[:i]
-                    esc := rest[i+1:]
-                    out = append(out, [2]string{prov, esc})
-                    break
-                }
+    timeoutSeconds := k.getICATimeoutSeconds(ctx)
+    out := make([]collections.Pair[string, string], 0, limit)
+    for ; it.Valid() && len(out) < limit; it.Next() {
+        key, err := it.Key()
+        if err != nil {
+            return nil, err
+        }
+        providerChainID, escrowID := key.K1(), key.K2()
+        if k.isInflight(ctx, providerChainID, escrowID) {
+            if !k.inflightExpired(ctx, providerChainID, escrowID, timeoutSeconds) {
+                continue
+            }
+            ctx.Logger().Info("genesismint: inflight expired; retrying claim", "escrow_id", escrowID, "provider_chain_id", providerChainID)
+            if err := k.clearInflight(ctx, providerChainID, escrowID); err != nil {
+                ctx.Logger().Error("genesismint: clear inflight failed", "err", err, "escrow_id", escrowID)
+                continue
+            }
+            // like RetryClaim, so that a late ack of the expired packet leaves the retried claim alone
+            if err := k.dropClaimFromPackets(ctx, providerChainID, escrowID); err != nil {
+                ctx.Logger().Error("genesismint: drop claim from packets failed", "err", err, "escrow_id", escrowID)
+                continue
             }
         }
-        it.Next()
+        out = append(out, key)
     }
-    return out
+    return out, nil
 }
 
 // hasAnyPendingClaims returns true if there is at least one pending claim queued.
 func (k Keeper) hasAnyPendingClaims(ctx sdk.Context) bool {
-    store := ctx.KVStore(k.storeKey)
-    it := storetypes.KVStorePrefixIterator(store, types.PendingClaimPrefix)
+    it, err := k.pendingClaims.Iterate(ctx, nil)
+    if err != nil {
+        return false
+    }
     defer it.Close()
     return it.Valid()
 }
 
 // ---- In-flight claim helpers ----
-func (k Keeper) setInflight(ctx sdk.Context, providerChainID, escrowID string) {
-    store := ctx.KVStore(k.storeKey)
-    store.Set(types.InflightKey(providerChainID, escrowID), []byte{1})
-    // record when it was enqueued (unix seconds)
-    ts := strconv.FormatInt(ctx.BlockTime().Unix(), 10)
-    store.Set(types.InflightAtKey(providerChainID, escrowID), []byte(ts))
+func (k Keeper) setInflight(ctx sdk.Context, providerChainID, escrowID string) error {
+    // record when it was sent (unix seconds)
+    return k.inflightClaims.Set(ctx, collections.Join(providerChainID, escrowID), ctx.BlockTime().Unix())
 }
-func (k Keeper) clearInflight(ctx sdk.Context, providerChainID, escrowID string) {
-    store := ctx.KVStore(k.storeKey)
-    store.Delete(types.InflightKey(providerChainID, escrowID))
-    store.Delete(types.InflightAtKey(providerChainID, escrowID))
+func (k Keeper) clearInflight(ctx sdk.Context, providerChainID, escrowID string) error {
+    return k.inflightClaims.Remove(ctx, collections.Join(providerChainID, escrowID))
 }
 func (k Keeper) isInflight(ctx sdk.Context, providerChainID, escrowID string) bool {
-    return ctx.KVStore(k.storeKey).Has(types.InflightKey(providerChainID, escrowID))
+    has, err := k.inflightClaims.Has(ctx, collections.Join(providerChainID, escrowID))
+    return err == nil && has
 }
 // inflightExpired returns true if inflight started more than limit seconds ago.
 func (k Keeper) inflightExpired(ctx sdk.Context, providerChainID, escrowID string, limitSeconds uint64) bool {
-    store := ctx.KVStore(k.storeKey)
-    bz := store.Get(types.InflightAtKey(providerChainID, escrowID))
-    if len(bz) == 0 { return false }
-    started, err := strconv.ParseInt(string(bz), 10, 64)
+    started, err := k.inflightClaims.Get(ctx, collections.Join(providerChainID, escrowID))
     if err != nil { return false }
     elapsed := ctx.BlockTime().Unix() - started
     return elapsed >= int64(limitSeconds)
 }
+// inflightSince returns the unix seconds the claim was sent at, 0 if unknown.
+func (k Keeper) inflightSince(ctx sdk.Context, providerChainID, escrowID string) int64 {
+    started, err := k.inflightClaims.Get(ctx, collections.Join(providerChainID, escrowID))
+    if err != nil { return 0 }
+    return started
+}
 func (k Keeper) hasAnyInflight(ctx sdk.Context) bool {
-    it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.InflightPrefix)
+    it, err := k.inflightClaims.Iterate(ctx, nil)
+    if err != nil {
+        return false
+    }
     defer it.Close()
     return it.Valid()
 }
@@ -336,6 +460,7 @@ const (
     defaultICAOwnerID        = "mintburn-claims"
     defaultICATimeoutSeconds = 300
     defaultMaxClaimsPerBlock = 10
+    defaultMaxClaimsPerTx    = 10
 )
 
 // ---- Config getters/setters ----
@@ -371,6 +496,35 @@ func (k Keeper) getMaxClaimsPerBlock(ctx sdk.Context) int {
 }
 func (k Keeper) setMaxClaimsPerBlock(ctx sdk.Context, v int) { ctx.KVStore(k.storeKey).Set(types.ConfigMaxClaimsPerBlockKey(), []byte(strconv.FormatUint(uint64(v),10))) }
 
+func (k Keeper) getMaxClaimsPerTx(ctx sdk.Context) int {
+    bz := ctx.KVStore(k.storeKey).Get(types.ConfigMaxClaimsPerTxKey())
+    if len(bz) == 0 { return defaultMaxClaimsPerTx }
+    u, err := strconv.ParseUint(string(bz), 10, 32)
+    if err != nil || u == 0 { return defaultMaxClaimsPerTx }
+    return int(u)
+}
+func (k Keeper) setMaxClaimsPerTx(ctx sdk.Context, v int) { ctx.KVStore(k.storeKey).Set(types.ConfigMaxClaimsPerTxKey(), []byte(strconv.FormatUint(uint64(v),10))) }
+
+// GetIcaConfig returns the ICA config in use, defaults included.
+func (k Keeper) GetIcaConfig(ctx sdk.Context) types.IcaConfig {
+    return types.IcaConfig{
+        ConnectionId:      k.getICAConnectionID(ctx),
+        Owner:             k.getICAOwner(ctx),
+        TxTimeoutSeconds:  k.getICATimeoutSeconds(ctx),
+        MaxClaimsPerBlock: uint64(k.getMaxClaimsPerBlock(ctx)),
+        MaxClaimsPerTx:    uint64(k.getMaxClaimsPerTx(ctx)),
+    }
+}
+
+// SetIcaConfig overrides every ICA config value.
+func (k Keeper) SetIcaConfig(ctx sdk.Context, cfg types.IcaConfig) {
+    k.setICAConnectionID(ctx, cfg.ConnectionId)
+    k.setICAOwner(ctx, cfg.Owner)
+    k.setICATimeoutSeconds(ctx, cfg.TxTimeoutSeconds)
+    k.setMaxClaimsPerBlock(ctx, int(cfg.MaxClaimsPerBlock))
+    k.setMaxClaimsPerTx(ctx, int(cfg.MaxClaimsPerTx))
+}
+
 // BeginBlocker attempts to ensure ICA registration and flush a few pending claims to provider.
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
     // If module finished its one-shot workflow, skip all further work.
@@ -408,8 +562,12 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
         return
     }
 
-    // Flush a bounded number of pending claims
-    pending := k.listPendingClaims(ctx, k.getMaxClaimsPerBlock(ctx))
+    // Flush a bounded number of pending claims, the ones in flight are not listed
+    pending, err := k.listPendingClaims(ctx, k.getMaxClaimsPerBlock(ctx))
+    if err != nil {
+        ctx.Logger().Error("genesismint: list pending claims failed", "err", err)
+        return
+    }
     if len(pending) == 0 {
         // Only stop if nothing pending and nothing in-flight.
         if !k.hasAnyInflight(ctx) {
@@ -418,103 +576,99 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
         }
         return
     }
-    ctx.Logger().Info("genesismint: flushing pending claims via ICA",
-        "count", len(pending), "connection", conn, "port_id", portID,
-    )
+    // Pack the claims into batches of up to max claims per tx. A claim whose batch failed
+    // goes alone, so that a failing escrow cannot hold back the confirmation of the others.
+    maxPerTx := k.getMaxClaimsPerTx(ctx)
+    batches := make([][]types.ClaimRef, 0)
+    batch := make([]types.ClaimRef, 0, maxPerTx)
     for _, pair := range pending {
-        providerChainID, escrowID := pair[0], pair[1]
-
-        // Skip claims already in-flight awaiting ack, unless inflight has expired (no ack/timeout observed).
-        if k.isInflight(ctx, providerChainID, escrowID) {
-            if k.inflightExpired(ctx, providerChainID, escrowID, k.getICATimeoutSeconds(ctx)) {
-                ctx.Logger().Info("genesismint: inflight expired; retrying claim", "escrow_id", escrowID, "provider_chain_id", providerChainID)
-                k.clearInflight(ctx, providerChainID, escrowID)
-            } else {
-                continue
-            }
-        }
-
-        // Build provider message Any without importing provider module
-        anyMsg, err := buildProviderMarkClaimAny(icaAddr, escrowID, ctx.ChainID())
-        if err != nil {
-            ctx.Logger().Error("genesismint: build provider msg failed", "err", err, "escrow_id", escrowID)
+        providerChainID, escrowID := pair.K1(), pair.K2()
+        ref := types.ClaimRef{ProviderChainId: providerChainID, EscrowId: escrowID}
+        if k.isIsolated(ctx, providerChainID, escrowID) {
+            batches = append(batches, []types.ClaimRef{ref})
             continue
         }
-
-        // Serialize CosmosTx with single message
-        cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{anyMsg}}
-        bz, err := k.cdc.Marshal(cosmosTx)
-        if err != nil {
-            ctx.Logger().Error("genesismint: marshal CosmosTx failed", "err", err)
-            continue
+        batch = append(batch, ref)
+        if len(batch) >= maxPerTx {
+            batches = append(batches, batch)
+            batch = make([]types.ClaimRef, 0, maxPerTx)
         }
+    }
+    if len(batch) > 0 {
+        batches = append(batches, batch)
+    }
 
-        packet := icatypes.InterchainAccountPacketData{
-            Type: icatypes.EXECUTE_TX,
-            Data: bz,
-            Memo: "",
-        }
+    ctx.Logger().Info("genesismint: flushing pending claims via ICA",
+        "count", len(pending), "txs", len(batches), "connection", conn, "port_id", portID,
+    )
+    for _, claims := range batches {
+        k.sendClaimBatch(ctx, owner, conn, channelID, icaAddr, claims)
+    }
+}
 
-        // SendTx via ICA controller
-        resp, err := k.icaMsgServer.SendTx(ctx, &icacontrollertypes.MsgSendTx{
-            Owner:           owner,
-            ConnectionId:    conn,
-            PacketData:      packet,
-            RelativeTimeout: uint64(time.Duration(k.getICATimeoutSeconds(ctx)) * time.Second),
+// sendClaimBatch confirms the claims on the provider with a single ICA tx, one
+// MsgMarkEscrowClaimed per claim, and marks them in-flight until the packet is acked.
+func (k Keeper) sendClaimBatch(ctx sdk.Context, owner, conn, channelID, icaAddr string, claims []types.ClaimRef) {
+    msgs := make([]*codectypes.Any, 0, len(claims))
+    for _, claim := range claims {
+        anyMsg, err := codectypes.NewAnyWithValue(&mintburntypes.MsgMarkEscrowClaimed{
+            Sender:          icaAddr,
+            EscrowId:        claim.EscrowId,
+            ConsumerChainId: ctx.ChainID(),
         })
         if err != nil {
-            ctx.Logger().Error("genesismint: ICA SendTx failed", "err", err, "escrow_id", escrowID)
-            // keep for retry
-            continue
-        }
-
-        // Map packet (channel, sequence) back to the escrowID for ack handling
-        if resp != nil {
-            seq := resp.Sequence
-            ch := channelID
-            k.mapPacketToEscrow(ctx, ch, seq, providerChainID, escrowID)
-            k.setInflight(ctx, providerChainID, escrowID)
-            ctx.Logger().Info("genesismint: mapped ICA packet to escrow",
-                "channel", ch, "sequence", seq, "escrow_id", escrowID,
-            )
+            ctx.Logger().Error("genesismint: build provider msg failed", "err", err, "escrow_id", claim.EscrowId)
+            return
         }
-
-        ctx.Logger().Info("genesismint: sent provider claim via ICA (awaiting ack)",
-            "escrow_id", escrowID,
-            "provider_chain_id", providerChainID,
-            "sender_ica", icaAddr,
-        )
+        msgs = append(msgs, anyMsg)
     }
-}
 
-// ---- Minimal protobuf encoding for /maany.mintburn.v1.MsgMarkEscrowClaimed ----
-// message MsgMarkEscrowClaimed { string sender = 1; string escrow_id = 2; string consumer_chain_id = 3; }
-// We avoid importing provider stubs by building the Any payload manually.
+    // Serialize CosmosTx with a message per claim
+    cosmosTx := &icatypes.CosmosTx{Messages: msgs}
+    bz, err := k.cdc.Marshal(cosmosTx)
+    if err != nil {
+        ctx.Logger().Error("genesismint: marshal CosmosTx failed", "err", err)
+        return
+    }
 
-const providerMsgMarkEscrowClaimedTypeURL = "/maany.mintburn.v1.MsgMarkEscrowClaimed"
+    packet := icatypes.InterchainAccountPacketData{
+        Type: icatypes.EXECUTE_TX,
+        Data: bz,
+        Memo: "",
+    }
 
-func buildProviderMarkClaimAny(sender, escrowID, consumerChainID string) (*codectypes.Any, error) {
-    // Helper to encode a single string field as protobuf: key (varint), len (varint), bytes
-    // key = (field_number << 3) | 2 (wire type 2 = length-delimited)
-    encField := func(fieldNum int, s string, dst *[]byte) {
-        key := uint64((fieldNum << 3) | 2)
-        *dst = appendVarint(*dst, key)
-        *dst = appendVarint(*dst, uint64(len(s)))
-        *dst = append(*dst, []byte(s)...)
+    // SendTx via ICA controller
+    resp, err := k.icaMsgServer.SendTx(ctx, &icacontrollertypes.MsgSendTx{
+        Owner:           owner,
+        ConnectionId:    conn,
+        PacketData:      packet,
+        RelativeTimeout: uint64(time.Duration(k.getICATimeoutSeconds(ctx)) * time.Second),
+    })
+    if err != nil {
+        // keep for retry
+        ctx.Logger().Error("genesismint: ICA SendTx failed", "err", err, "claims", len(claims))
+        return
+    }
+    if resp == nil {
+        return
     }
-    var b []byte
-    encField(1, sender, &b)
-    encField(2, escrowID, &b)
-    encField(3, consumerChainID, &b)
-    return &codectypes.Any{TypeUrl: providerMsgMarkEscrowClaimedTypeURL, Value: b}, nil
-}
 
-func appendVarint(dst []byte, x uint64) []byte {
-    for x >= 0x80 {
-        dst = append(dst, byte(x)|0x80)
-        x >>= 7
+    // Map packet (channel, sequence) back to the batch for ack handling
+    if err := k.mapPacketToBatch(ctx, channelID, resp.Sequence, types.ClaimBatch{Claims: claims}); err != nil {
+        ctx.Logger().Error("genesismint: map ICA packet failed", "err", err, "sequence", resp.Sequence)
+    }
+    for _, claim := range claims {
+        if err := k.setInflight(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: set inflight failed", "err", err, "escrow_id", claim.EscrowId)
+        }
     }
-    return append(dst, byte(x))
+
+    ctx.Logger().Info("genesismint: sent provider claims via ICA (awaiting ack)",
+        "channel", channelID,
+        "sequence", resp.Sequence,
+        "claims", len(claims),
+        "sender_ica", icaAddr,
+    )
 }
 
 // ---- ICA registration pending flag helpers ----
@@ -610,40 +764,65 @@ func (k Keeper) ensureICARegistered(ctx sdk.Context, connectionID, owner string)
 func (k Keeper) HandleICAAckSuccess(ctx sdk.Context, packet channeltypes.Packet) {
     // source on controller side is our icacontroller-<owner> port
     channelID := packet.SourceChannel
-    providerChainID, escrowID, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
+    claims, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
     if !ok {
         ctx.Logger().Info("genesismint: ICA ack success for unknown packet; ignoring", "channel", channelID, "sequence", packet.Sequence)
         return
     }
-    // Remove pending claim now that provider acknowledged success
-    ctx.Logger().Info("genesismint: provider ack success; removing pending claim", "escrow_id", escrowID, "provider_chain_id", providerChainID, "channel", channelID, "sequence", packet.Sequence)
-    k.clearInflight(ctx, providerChainID, escrowID)
-    k.deletePendingClaim(ctx, providerChainID, escrowID)
+    // Remove pending claims now that provider acknowledged success
+    ctx.Logger().Info("genesismint: provider ack success; removing pending claims", "claims", len(claims), "channel", channelID, "sequence", packet.Sequence)
+    for _, claim := range claims {
+        if err := k.clearInflight(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: clear inflight failed", "err", err, "escrow_id", claim.EscrowId)
+        }
+        if err := k.clearIsolated(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: clear isolated failed", "err", err, "escrow_id", claim.EscrowId)
+        }
+        if err := k.deletePendingClaim(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: delete pending claim failed", "err", err, "escrow_id", claim.EscrowId)
+        }
+    }
     // Mark module done if queue empty
     if !k.hasAnyPendingClaims(ctx) && !k.hasAnyInflight(ctx) {
         k.setDone(ctx)
     }
 }
 
+// HandleICAAckError keeps the claims of the failed packet pending. The ICA host executes the tx
+// atomically, so a single failing message fails the whole batch: the claims of a batch are then
+// isolated, each retried in a tx of its own, and only the failing escrows keep failing.
 func (k Keeper) HandleICAAckError(ctx sdk.Context, packet channeltypes.Packet) {
     channelID := packet.SourceChannel
-    providerChainID, escrowID, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
-    if ok {
-        ctx.Logger().Error("genesismint: provider ack error; will retry pending claim", "escrow_id", escrowID, "provider_chain_id", providerChainID, "channel", channelID, "sequence", packet.Sequence)
-        k.clearInflight(ctx, providerChainID, escrowID)
-    } else {
+    claims, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
+    if !ok {
         ctx.Logger().Error("genesismint: provider ack error for unknown packet", "channel", channelID, "sequence", packet.Sequence)
+        return
+    }
+    ctx.Logger().Error("genesismint: provider ack error; will retry pending claims", "claims", len(claims), "channel", channelID, "sequence", packet.Sequence)
+    for _, claim := range claims {
+        if err := k.clearInflight(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: clear inflight failed", "err", err, "escrow_id", claim.EscrowId)
+        }
+        if len(claims) > 1 {
+            if err := k.setIsolated(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+                ctx.Logger().Error("genesismint: set isolated failed", "err", err, "escrow_id", claim.EscrowId)
+            }
+        }
     }
 }
 
 func (k Keeper) HandleICATimeout(ctx sdk.Context, packet channeltypes.Packet) {
     channelID := packet.SourceChannel
-    providerChainID, escrowID, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
-    if ok {
-        ctx.Logger().Error("genesismint: ICA timeout; will retry pending claim", "escrow_id", escrowID, "provider_chain_id", providerChainID, "channel", channelID, "sequence", packet.Sequence)
-        k.clearInflight(ctx, providerChainID, escrowID)
-    } else {
+    claims, ok := k.consumePacketMapping(ctx, channelID, packet.Sequence)
+    if !ok {
         ctx.Logger().Error("genesismint: ICA timeout for unknown packet", "channel", channelID, "sequence", packet.Sequence)
+        return
+    }
+    ctx.Logger().Error("genesismint: ICA timeout; will retry pending claims", "claims", len(claims), "channel", channelID, "sequence", packet.Sequence)
+    for _, claim := range claims {
+        if err := k.clearInflight(ctx, claim.ProviderChainId, claim.EscrowId); err != nil {
+            ctx.Logger().Error("genesismint: clear inflight failed", "err", err, "escrow_id", claim.EscrowId)
+        }
     }
 }
 
@@ -653,6 +832,11 @@ func (k Keeper) setDone(ctx sdk.Context) {
     store.Set(types.DoneKey(), []byte{1})
 }
 
+func (k Keeper) clearDone(ctx sdk.Context) {
+    store := ctx.KVStore(k.storeKey)
+    store.Delete(types.DoneKey())
+}
+
 func (k Keeper) isDone(ctx sdk.Context) bool {
     store := ctx.KVStore(k.storeKey)
     return store.Has(types.DoneKey())
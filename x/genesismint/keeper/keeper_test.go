@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/maany-xyz/maany-dex/v5/testutil/genesismint/keeper"
+	mock_keeper "github.com/maany-xyz/maany-dex/v5/testutil/mocks/genesismint/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+const icaChannel = "channel-0"
+
+// claimsKeeper returns a keeper with an active ICA channel, sending its claims with the config.
+func claimsKeeper(t *testing.T, maxClaimsPerBlock, maxClaimsPerTx uint64) (keeper.Keeper, sdk.Context) {
+	ctrl := gomock.NewController(t)
+	icaCtrlKeeper := mock_keeper.NewMockICAControllerKeeper(ctrl)
+	icaMsgServer := mock_keeper.NewMockICAControllerMsgServer(ctrl)
+	k, ctx := testkeeper.GenesisMintKeeperWithDeps(t, nil, icaCtrlKeeper, icaMsgServer)
+
+	icaCtrlKeeper.EXPECT().GetParams(gomock.Any()).Return(icacontrollertypes.Params{ControllerEnabled: true}).AnyTimes()
+	icaCtrlKeeper.EXPECT().GetActiveChannelID(gomock.Any(), gomock.Any(), gomock.Any()).Return(icaChannel, true).AnyTimes()
+	icaCtrlKeeper.EXPECT().GetInterchainAccountAddress(gomock.Any(), gomock.Any(), gomock.Any()).Return("provider_ica", true).AnyTimes()
+	var sequence uint64
+	icaMsgServer.EXPECT().SendTx(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, *icacontrollertypes.MsgSendTx) (*icacontrollertypes.MsgSendTxResponse, error) {
+		sequence++
+		return &icacontrollertypes.MsgSendTxResponse{Sequence: sequence}, nil
+	}).AnyTimes()
+
+	config := k.GetIcaConfig(ctx)
+	config.MaxClaimsPerBlock = maxClaimsPerBlock
+	config.MaxClaimsPerTx = maxClaimsPerTx
+	k.SetIcaConfig(ctx, config)
+	return k, ctx
+}
+
+func enqueueClaims(t *testing.T, k keeper.Keeper, ctx sdk.Context, count int) {
+	for i := 1; i <= count; i++ {
+		require.NoError(t, k.EnqueuePendingClaim(ctx, providerChainID, fmt.Sprintf("escrow-%d", i)))
+	}
+}
+
+func claimRefs(escrowIDs ...string) []types.ClaimRef {
+	refs := make([]types.ClaimRef, 0, len(escrowIDs))
+	for _, escrowID := range escrowIDs {
+		refs = append(refs, types.ClaimRef{ProviderChainId: providerChainID, EscrowId: escrowID})
+	}
+	return refs
+}
+
+func requirePacket(t *testing.T, k keeper.Keeper, ctx sdk.Context, sequence uint64, escrowIDs ...string) {
+	claims, ok := k.ConsumePacketMapping(ctx, icaChannel, sequence)
+	require.True(t, ok, "packet %d", sequence)
+	require.Equal(t, claimRefs(escrowIDs...), claims)
+	require.NoError(t, k.MapPacketToBatch(ctx, icaChannel, sequence, types.ClaimBatch{Claims: claims}))
+}
+
+func icaPacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{SourceChannel: icaChannel, Sequence: sequence}
+}
+
+func TestBeginBlockerBatchesClaims(t *testing.T) {
+	k, ctx := claimsKeeper(t, 5, 2)
+	enqueueClaims(t, k, ctx, 6)
+
+	// up to max claims per block are sent, in txs of up to max claims per tx
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 1, "escrow-1", "escrow-2")
+	requirePacket(t, k, ctx, 2, "escrow-3", "escrow-4")
+	requirePacket(t, k, ctx, 3, "escrow-5")
+	_, ok := k.ConsumePacketMapping(ctx, icaChannel, 4)
+	require.False(t, ok)
+	for i := 1; i <= 5; i++ {
+		require.True(t, k.IsInflight(ctx, providerChainID, fmt.Sprintf("escrow-%d", i)))
+	}
+	require.False(t, k.IsInflight(ctx, providerChainID, "escrow-6"))
+
+	// the success ack confirms the claims of its packet only
+	k.HandleICAAckSuccess(ctx, icaPacket(1))
+	require.False(t, k.IsPendingClaim(ctx, providerChainID, "escrow-1"))
+	require.False(t, k.IsPendingClaim(ctx, providerChainID, "escrow-2"))
+	require.True(t, k.IsPendingClaim(ctx, providerChainID, "escrow-3"))
+	require.True(t, k.IsInflight(ctx, providerChainID, "escrow-3"))
+}
+
+func TestBeginBlockerSkipsInflightClaims(t *testing.T) {
+	k, ctx := claimsKeeper(t, 2, 10)
+	ctx = ctx.WithBlockTime(time.Unix(1_000, 0))
+	enqueueClaims(t, k, ctx, 4)
+	require.NoError(t, k.SetInflight(ctx, providerChainID, "escrow-1"))
+	require.NoError(t, k.SetInflight(ctx, providerChainID, "escrow-2"))
+
+	// the claims awaiting their ack do not use up the block limit
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 1, "escrow-3", "escrow-4")
+
+	// nothing is left to send until the in-flight claims expire
+	k.BeginBlocker(ctx)
+	_, ok := k.ConsumePacketMapping(ctx, icaChannel, 2)
+	require.False(t, ok)
+	require.False(t, k.IsDone(ctx))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(k.GetIcaConfig(ctx).TxTimeoutSeconds) * time.Second))
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 2, "escrow-1", "escrow-2")
+}
+
+func TestBeginBlockerDropsExpiredClaimsFromTheirPacket(t *testing.T) {
+	k, ctx := claimsKeeper(t, 10, 10)
+	ctx = ctx.WithBlockTime(time.Unix(1_000, 0))
+	enqueueClaims(t, k, ctx, 2)
+
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 1, "escrow-1", "escrow-2")
+
+	// the expired claims are resent and no longer belong to their first packet
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(k.GetIcaConfig(ctx).TxTimeoutSeconds) * time.Second))
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 2, "escrow-1", "escrow-2")
+	_, ok := k.ConsumePacketMapping(ctx, icaChannel, 1)
+	require.False(t, ok)
+
+	// so a late error ack of the first packet leaves the resent claims alone
+	k.HandleICAAckError(ctx, icaPacket(1))
+	for i := 1; i <= 2; i++ {
+		escrowID := fmt.Sprintf("escrow-%d", i)
+		require.True(t, k.IsInflight(ctx, providerChainID, escrowID))
+		require.False(t, k.IsIsolated(ctx, providerChainID, escrowID))
+	}
+}
+
+func TestBeginBlockerIsolatesClaimsOfFailedBatch(t *testing.T) {
+	k, ctx := claimsKeeper(t, 10, 10)
+	enqueueClaims(t, k, ctx, 3)
+
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 1, "escrow-1", "escrow-2", "escrow-3")
+
+	// the error ack fails the whole batch, its claims are retried one per tx
+	k.HandleICAAckError(ctx, icaPacket(1))
+	for i := 1; i <= 3; i++ {
+		escrowID := fmt.Sprintf("escrow-%d", i)
+		require.False(t, k.IsInflight(ctx, providerChainID, escrowID))
+		require.True(t, k.IsIsolated(ctx, providerChainID, escrowID))
+		require.True(t, k.IsPendingClaim(ctx, providerChainID, escrowID))
+	}
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 2, "escrow-1")
+	requirePacket(t, k, ctx, 3, "escrow-2")
+	requirePacket(t, k, ctx, 4, "escrow-3")
+
+	// the others are confirmed while a failing escrow keeps failing on its own
+	k.HandleICAAckSuccess(ctx, icaPacket(2))
+	k.HandleICAAckError(ctx, icaPacket(3))
+	k.HandleICAAckSuccess(ctx, icaPacket(4))
+	require.False(t, k.IsPendingClaim(ctx, providerChainID, "escrow-1"))
+	require.False(t, k.IsIsolated(ctx, providerChainID, "escrow-1"))
+	require.False(t, k.IsPendingClaim(ctx, providerChainID, "escrow-3"))
+	require.True(t, k.IsPendingClaim(ctx, providerChainID, "escrow-2"))
+	require.True(t, k.IsIsolated(ctx, providerChainID, "escrow-2"))
+
+	k.BeginBlocker(ctx)
+	requirePacket(t, k, ctx, 5, "escrow-2")
+	k.HandleICAAckSuccess(ctx, icaPacket(5))
+	require.True(t, k.IsDone(ctx))
+}
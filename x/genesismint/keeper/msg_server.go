@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RetryClaim drops the in-flight state of a claimed escrow and puts it back into the pending queue,
+// so that the next BeginBlock sends a fresh confirmation. The claim is also removed from the packet
+// it was last sent in: a late ack or timeout of that packet no longer affects it.
+func (k msgServer) RetryClaim(goCtx context.Context, req *types.MsgRetryClaim) (*types.MsgRetryClaimResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRetryClaim")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.isClaimed(ctx, req.ProviderChainId, req.EscrowId) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "escrow %s of %s is not claimed", req.EscrowId, req.ProviderChainId)
+	}
+
+	if err := k.clearInflight(ctx, req.ProviderChainId, req.EscrowId); err != nil {
+		return nil, errors.Wrap(err, "failed to clear in-flight claim")
+	}
+	if err := k.dropClaimFromPackets(ctx, req.ProviderChainId, req.EscrowId); err != nil {
+		return nil, errors.Wrap(err, "failed to drop the claim from its ICA packet")
+	}
+	if err := k.enqueuePendingClaim(ctx, req.ProviderChainId, req.EscrowId); err != nil {
+		return nil, errors.Wrap(err, "failed to enqueue pending claim")
+	}
+	k.clearDone(ctx)
+	ctx.Logger().Info("genesismint: claim re-queued by authority",
+		"provider_chain_id", req.ProviderChainId,
+		"escrow_id", req.EscrowId,
+	)
+
+	return &types.MsgRetryClaimResponse{}, nil
+}
+
+// UpdateIcaConfig replaces the ICA config. If the (connection, owner) pair changes, the next BeginBlock
+// registers a new interchain account for it.
+func (k msgServer) UpdateIcaConfig(goCtx context.Context, req *types.MsgUpdateIcaConfig) (*types.MsgUpdateIcaConfigResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateIcaConfig")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetIcaConfig(ctx, req.Config)
+
+	return &types.MsgUpdateIcaConfigResponse{}, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/maany-xyz/maany-dex/v5/testutil/genesismint/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+const providerChainID = "provider-1"
+
+func TestMsgServer_RetryClaim(t *testing.T) {
+	k, ctx := testkeeper.GenesisMintKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// escrow-1 and escrow-2 were sent in the same packet, escrow-1 is still awaiting its ack
+	for _, escrowID := range []string{"escrow-1", "escrow-2"} {
+		require.NoError(t, k.SetClaimed(ctx, providerChainID, escrowID))
+		require.NoError(t, k.SetInflight(ctx, providerChainID, escrowID))
+	}
+	require.NoError(t, k.MapPacketToBatch(ctx, "channel-0", 1, types.ClaimBatch{Claims: []types.ClaimRef{
+		{ProviderChainId: providerChainID, EscrowId: "escrow-1"},
+		{ProviderChainId: providerChainID, EscrowId: "escrow-2"},
+	}}))
+	require.NoError(t, k.MapPacketToBatch(ctx, "channel-0", 2, types.ClaimBatch{Claims: []types.ClaimRef{
+		{ProviderChainId: providerChainID, EscrowId: "escrow-1"},
+	}}))
+	k.SetDone(ctx)
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgRetryClaim
+		err  error
+	}{
+		{
+			desc: "InvalidAuthority",
+			msg:  types.MsgRetryClaim{Authority: sdk.AccAddress("not_authority").String(), ProviderChainId: providerChainID, EscrowId: "escrow-1"},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "NotClaimed",
+			msg:  types.MsgRetryClaim{Authority: k.GetAuthority(), ProviderChainId: providerChainID, EscrowId: "escrow-3"},
+			err:  sdkerrors.ErrNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := msgServer.RetryClaim(ctx, &tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err := msgServer.RetryClaim(ctx, &types.MsgRetryClaim{Authority: k.GetAuthority(), ProviderChainId: providerChainID, EscrowId: "escrow-1"})
+	require.NoError(t, err)
+
+	// the claim is queued again and the module resumes its work
+	require.False(t, k.IsInflight(ctx, providerChainID, "escrow-1"))
+	require.True(t, k.IsPendingClaim(ctx, providerChainID, "escrow-1"))
+	require.False(t, k.IsDone(ctx))
+
+	// the old packets no longer resolve the claim, the other claims of its batch are kept
+	claims, ok := k.ConsumePacketMapping(ctx, "channel-0", 1)
+	require.True(t, ok)
+	require.Equal(t, []types.ClaimRef{{ProviderChainId: providerChainID, EscrowId: "escrow-2"}}, claims)
+	_, ok = k.ConsumePacketMapping(ctx, "channel-0", 2)
+	require.False(t, ok)
+	require.True(t, k.IsInflight(ctx, providerChainID, "escrow-2"))
+}
+
+func TestMsgServer_UpdateIcaConfig(t *testing.T) {
+	k, ctx := testkeeper.GenesisMintKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	config := types.IcaConfig{
+		ConnectionId:      "connection-1",
+		Owner:             "genesismint-claims",
+		TxTimeoutSeconds:  600,
+		MaxClaimsPerBlock: 20,
+		MaxClaimsPerTx:    5,
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		msg    types.MsgUpdateIcaConfig
+		errMsg string
+	}{
+		{
+			desc:   "InvalidAuthority",
+			msg:    types.MsgUpdateIcaConfig{Authority: sdk.AccAddress("not_authority").String(), Config: config},
+			errMsg: "invalid authority",
+		},
+		{
+			desc:   "InvalidConfig",
+			msg:    types.MsgUpdateIcaConfig{Authority: k.GetAuthority(), Config: types.IcaConfig{ConnectionId: "connection-1"}},
+			errMsg: "failed to validate MsgUpdateIcaConfig",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			before := k.GetIcaConfig(ctx)
+			_, err := msgServer.UpdateIcaConfig(ctx, &tc.msg)
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Equal(t, before, k.GetIcaConfig(ctx))
+		})
+	}
+
+	_, err := msgServer.UpdateIcaConfig(ctx, &types.MsgUpdateIcaConfig{Authority: k.GetAuthority(), Config: config})
+	require.NoError(t, err)
+	require.Equal(t, config, k.GetIcaConfig(ctx))
+}
@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+// heads of the '|' separated keys of the consensus version 1
+const (
+	claimedHead    = "claimed|"
+	pendingHead    = "pclaim|"
+	inflightHead   = "inflight|"
+	inflightAtHead = "inflight_at|"
+	packetHead     = "pkt|"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration moves the claim queues from the '|' separated keys of the consensus version 1
+// into the collections of the keeper, which use the same store prefixes.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	claimed, pendingClaims collections.KeySet[collections.Pair[string, string]],
+	inflightClaims collections.Map[collections.Pair[string, string], int64],
+	icaPackets collections.Map[collections.Pair[string, uint64], types.ClaimBatch],
+) error {
+	ctx.Logger().Info("Migrating genesismint claim queues...")
+
+	store := ctx.KVStore(storeKey)
+
+	for _, q := range []struct {
+		prefix []byte
+		head   string
+		set    collections.KeySet[collections.Pair[string, string]]
+	}{
+		{types.ClaimedPrefix, claimedHead, claimed},
+		{types.PendingClaimPrefix, pendingHead, pendingClaims},
+	} {
+		refs := make([][2]string, 0)
+		for _, kv := range takeLegacy(store, q.prefix) {
+			if providerChainID, escrowID, ok := cutLegacyKey(kv[0], q.head); ok {
+				refs = append(refs, [2]string{providerChainID, escrowID})
+			}
+		}
+		for _, ref := range refs {
+			if err := q.set.Set(ctx, collections.Join(ref[0], ref[1])); err != nil {
+				return errors.Wrapf(err, "failed to migrate claim %s/%s", ref[0], ref[1])
+			}
+		}
+	}
+
+	// in-flight flags and their send times share a prefix
+	inflight := make([][2]string, 0)
+	since := make(map[[2]string]int64)
+	for _, kv := range takeLegacy(store, types.InflightPrefix) {
+		if providerChainID, escrowID, ok := cutLegacyKey(kv[0], inflightAtHead); ok {
+			if started, err := strconv.ParseInt(string(kv[1]), 10, 64); err == nil {
+				since[[2]string{providerChainID, escrowID}] = started
+			}
+		} else if providerChainID, escrowID, ok := cutLegacyKey(kv[0], inflightHead); ok {
+			inflight = append(inflight, [2]string{providerChainID, escrowID})
+		}
+	}
+	for _, ref := range inflight {
+		started, found := since[ref]
+		if !found {
+			// the watchdog retries the claim after a full timeout
+			started = ctx.BlockTime().Unix()
+		}
+		if err := inflightClaims.Set(ctx, collections.Join(ref[0], ref[1]), started); err != nil {
+			return errors.Wrapf(err, "failed to migrate in-flight claim %s/%s", ref[0], ref[1])
+		}
+	}
+
+	// channel ids never contain '|', escrow ids may
+	for _, kv := range takeLegacy(store, types.ICAPacketPrefix) {
+		key := kv[0]
+		if !bytes.HasPrefix(key, []byte(packetHead)) {
+			continue
+		}
+		i := bytes.LastIndexByte(key, '|')
+		sequence, err := strconv.ParseUint(string(key[i+1:]), 10, 64)
+		if i < len(packetHead) || err != nil {
+			return fmt.Errorf("malformed ICA packet key %q", key)
+		}
+		providerChainID, escrowID, _ := strings.Cut(string(kv[1]), "|")
+		batch := types.ClaimBatch{Claims: []types.ClaimRef{{ProviderChainId: providerChainID, EscrowId: escrowID}}}
+		if err := icaPackets.Set(ctx, collections.Join(string(key[len(packetHead):i]), sequence), batch); err != nil {
+			return errors.Wrapf(err, "failed to migrate ICA packet %q", key)
+		}
+	}
+
+	ctx.Logger().Info("Finished migrating genesismint claim queues...")
+
+	return nil
+}
+
+// takeLegacy removes and returns the (key without prefix, value) pairs stored under prefix.
+func takeLegacy(store storetypes.KVStore, prefix []byte) [][2][]byte {
+	kvs := make([][2][]byte, 0)
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, [2][]byte{it.Key()[len(prefix):], it.Value()})
+	}
+	it.Close()
+	for _, kv := range kvs {
+		store.Delete(append(append([]byte{}, prefix...), kv[0]...))
+	}
+	return kvs
+}
+
+// cutLegacyKey splits a "<head><provider_chain_id>|<escrow_id>" key on its first '|' after head.
+func cutLegacyKey(key []byte, head string) (string, string, bool) {
+	rest, found := bytes.CutPrefix(key, []byte(head))
+	if !found {
+		return "", "", false
+	}
+	providerChainID, escrowID, found := strings.Cut(string(rest), "|")
+	return providerChainID, escrowID, found
+}
@@ -0,0 +1,70 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/maany-xyz/maany-dex/v5/x/genesismint/migrations/v2"
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
+)
+
+func TestClaimQueuesUpgrade(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	claimed := collections.NewKeySet(sb, types.ClaimedPrefix, "claimed", types.ClaimKeyCodec)
+	pending := collections.NewKeySet(sb, types.PendingClaimPrefix, "pending_claims", types.ClaimKeyCodec)
+	inflight := collections.NewMap(sb, types.InflightPrefix, "inflight_claims", types.ClaimKeyCodec, collections.Int64Value)
+	packets := collections.NewMap(sb, types.ICAPacketPrefix, "ica_packets", types.ICAPacketKeyCodec, codec.CollValue[types.ClaimBatch](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	store := ctx.KVStore(storeKey)
+	legacy := func(prefix []byte, key string) []byte { return append(append([]byte{}, prefix...), key...) }
+	store.Set(legacy(types.ClaimedPrefix, "claimed|maany-1|escrow-1"), []byte{1})
+	store.Set(legacy(types.ClaimedPrefix, "claimed|maany-1|escrow|2"), []byte{1})
+	store.Set(legacy(types.PendingClaimPrefix, "pclaim|maany-1|escrow|2"), []byte{1})
+	store.Set(legacy(types.InflightPrefix, "inflight|maany-1|escrow|2"), []byte{1})
+	store.Set(legacy(types.InflightPrefix, "inflight_at|maany-1|escrow|2"), []byte("1700000000"))
+	store.Set(legacy(types.ICAPacketPrefix, "pkt|channel-3|7"), []byte("maany-1|escrow|2"))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, claimed, pending, inflight, packets))
+
+	for _, key := range []collections.Pair[string, string]{
+		collections.Join("maany-1", "escrow-1"),
+		collections.Join("maany-1", "escrow|2"),
+	} {
+		has, err := claimed.Has(ctx, key)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+
+	it, err := pending.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := it.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, string]{collections.Join("maany-1", "escrow|2")}, keys)
+
+	since, err := inflight.Get(ctx, collections.Join("maany-1", "escrow|2"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000), since)
+
+	batch, err := packets.Get(ctx, collections.Join("channel-3", uint64(7)))
+	require.NoError(t, err)
+	require.Equal(t, []types.ClaimRef{{ProviderChainId: "maany-1", EscrowId: "escrow|2"}}, batch.Claims)
+
+	// no legacy key is left
+	require.False(t, store.Has(legacy(types.ClaimedPrefix, "claimed|maany-1|escrow-1")))
+	require.False(t, store.Has(legacy(types.PendingClaimPrefix, "pclaim|maany-1|escrow|2")))
+	require.False(t, store.Has(legacy(types.InflightPrefix, "inflight_at|maany-1|escrow|2")))
+	require.False(t, store.Has(legacy(types.ICAPacketPrefix, "pkt|channel-3|7")))
+}
@@ -18,7 +18,9 @@ import (
 	// If your project uses gateway v1, replace the next line with:
 	//   runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
+	"github.com/maany-xyz/maany-dex/v5/x/genesismint/client/cli"
 	"github.com/maany-xyz/maany-dex/v5/x/genesismint/keeper"
 	"github.com/maany-xyz/maany-dex/v5/x/genesismint/types"
 )
@@ -26,6 +28,7 @@ import (
 /* ===== Interface assertions (SDK v0.50) ===== */
 
 var (
+    _ module.AppModuleBasic      = AppModuleBasic{}
     _ appmodule.AppModule         = AppModule{} // marker + Name()
     _ module.HasABCIGenesis      = (*AppModule)(nil) // InitGenesis + ExportGenesis
     _ module.HasServices         = (*AppModule)(nil)
@@ -40,20 +43,38 @@ type AppModuleBasic struct {}
 
 func (AppModuleBasic) Name() string { return types.ModuleName }
 
-func (am AppModule) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+// NOTE: AppModuleBasic deliberately has no DefaultGenesis: the module genesis requires the
+// provider client, so it must not end up in the default genesis of a new chain.
 
-func (am AppModule) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterCodec(cdc)
+}
+
+func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
+
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
 
-func (am AppModule) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
 /* ===== AppModule ===== */
 
 type AppModule struct {
+	AppModuleBasic
+
 	cdc    codec.Codec
 	keeper keeper.Keeper
 }
 
 func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
-	return AppModule{cdc: cdc, keeper: k}
+	return AppModule{AppModuleBasic: AppModuleBasic{}, cdc: cdc, keeper: k}
 }
 
 // Some SDKs still require Name() on AppModule too.
@@ -63,10 +84,17 @@ func (am AppModule) Name() string { return types.ModuleName }
 func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) IsAppModule()        {}
 
-func (am AppModule) ConsensusVersion() uint64 { return 1 }
+func (am AppModule) ConsensusVersion() uint64 { return 2 }
 
-// No Msg/Query services yet; keep as no-op
-func (am AppModule) RegisterServices(_ module.Configurator) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+}
 
 // No invariants
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
@@ -96,8 +124,8 @@ func (am AppModule) InitGenesis(
     sanitized := data
     var extractedConn, extractedOwner string
     var extractedTO uint64
-    var extractedMC int
-    var haveConn, haveOwner, haveTO, haveMC bool
+    var extractedMC, extractedMT int
+    var haveConn, haveOwner, haveTO, haveMC, haveMT bool
 
     var raw map[string]stdjson.RawMessage
     if err := stdjson.Unmarshal(data, &raw); err == nil {
@@ -164,6 +192,24 @@ func (am AppModule) InitGenesis(
                     }
                     delete(pMap, "icaMaxClaimPerBlock")
                 }
+                // ica_max_claims_per_tx / icaMaxClaimsPerTx
+                if v, ok := pMap["ica_max_claims_per_tx"]; ok {
+                    switch t := v.(type) {
+                    case float64:
+                        extractedMT, haveMT = int(t), true
+                    case string:
+                        var u int; if _, err := fmt.Sscan(t, &u); err == nil { extractedMT, haveMT = u, true }
+                    }
+                    delete(pMap, "ica_max_claims_per_tx")
+                } else if v, ok := pMap["icaMaxClaimsPerTx"]; ok {
+                    switch t := v.(type) {
+                    case float64:
+                        extractedMT, haveMT = int(t), true
+                    case string:
+                        var u int; if _, err := fmt.Sscan(t, &u); err == nil { extractedMT, haveMT = u, true }
+                    }
+                    delete(pMap, "icaMaxClaimsPerTx")
+                }
 
                 // re-pack sanitized params back into raw and overall genesis
                 if sanitizedParams, err := stdjson.Marshal(pMap); err == nil {
@@ -185,11 +231,14 @@ func (am AppModule) InitGenesis(
         panic(fmt.Errorf("genesismint genesis validate: %w", err))
     }
 
+    am.keeper.SetParams(ctx, *gs.Params)
+
     // 3) Apply any extracted ICA config values.
     if haveConn { am.keeper.SetICAConnectionID(ctx, extractedConn) }
     if haveOwner { am.keeper.SetICAOwner(ctx, extractedOwner) }
     if haveTO { am.keeper.SetICATimeoutSeconds(ctx, extractedTO) }
     if haveMC { am.keeper.SetMaxClaimsPerBlock(ctx, extractedMC) }
+    if haveMT { am.keeper.SetMaxClaimsPerTx(ctx, extractedMT) }
 
     strict := true // set false if the provider client+consensus@height isn't in genesis yet
     ctx.Logger().Info("genesismint: InitGenesis start",
@@ -206,8 +255,9 @@ func (am AppModule) InitGenesis(
     return nil
 }
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	params := am.keeper.GetParams(ctx)
 	state := types.GenesisState{
-		Params:           &types.Params{},
+		Params:           &params,
 		Mints:            []*types.MintIntent{},
 		ClaimedEscrowIds: []string{},
 	}
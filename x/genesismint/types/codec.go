@@ -3,10 +3,23 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-// NOTE: No interfaces to register yet (no Msg/Query). Keep for future.
-func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {}
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRetryClaim{}, "genesismint/MsgRetryClaim", nil)
+	cdc.RegisterConcrete(&MsgUpdateIcaConfig{}, "genesismint/MsgUpdateIcaConfig", nil)
+}
+
+func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	reg.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRetryClaim{},
+		&MsgUpdateIcaConfig{},
+	)
+	msgservice.RegisterMsgServiceDesc(reg, &_Msg_serviceDesc)
+}
 
 var (
 	// Amino is required by some SDK internals; keep minimal.
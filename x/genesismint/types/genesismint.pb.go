@@ -364,7 +364,7 @@ func init() {
 }
 
 var fileDescriptor_a08cfa38e3b6bdc3 = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
+	// 715 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x4e, 0xdb, 0x40,
 	0x14, 0xc6, 0x31, 0x09, 0x21, 0x9e, 0x44, 0x05, 0x46, 0xa1, 0x72, 0x68, 0x48, 0x82, 0x2b, 0x95,
 	0xa8, 0x6a, 0x6d, 0x41, 0xa1, 0x95, 0xba, 0xa9, 0x44, 0xff, 0x11, 0x55, 0xb4, 0xd1, 0x50, 0xb5,
@@ -395,7 +395,7 @@ var fileDescriptor_a08cfa38e3b6bdc3 = []byte{
 	0xda, 0x7d, 0x68, 0x90, 0xae, 0x63, 0xa4, 0xe9, 0x34, 0xa6, 0xf2, 0x38, 0xd8, 0x31, 0x8e, 0x38,
 	0xdb, 0x49, 0x51, 0x54, 0x0a, 0xb2, 0x02, 0xbe, 0x02, 0x35, 0x6e, 0x20, 0xf3, 0x64, 0xdd, 0x0c,
 	0xa2, 0xc8, 0x57, 0x95, 0x33, 0x22, 0x5c, 0x68, 0x36, 0x93, 0x77, 0x1a, 0xc8, 0x80, 0xe6, 0xee,
-	0x34, 0x90, 0x59, 0xad, 0x82, 0xe2, 0x29, 0x1e, 0x5a, 0x7d, 0x9b, 0x79, 0x5a, 0xbe, 0x99, 0x6b,
+	0x34, 0x10, 0x15, 0xac, 0x82, 0xe2, 0x29, 0x1e, 0x5a, 0x7d, 0x9b, 0x79, 0x5a, 0xbe, 0x99, 0x6b,
 	0xa9, 0x68, 0xf9, 0x14, 0x0f, 0x3b, 0x36, 0xf3, 0x60, 0x05, 0x2c, 0x0d, 0x6c, 0x3f, 0xc1, 0xfc,
 	0xc8, 0x55, 0x24, 0x0a, 0xf8, 0x78, 0x5e, 0xe0, 0x0a, 0x9c, 0xb8, 0x15, 0xa9, 0x2d, 0x50, 0xb6,
 	0x03, 0x9a, 0x4c, 0x76, 0xbf, 0xcc, 0xb1, 0x92, 0xd0, 0xc4, 0x9a, 0x33, 0x44, 0xcc, 0x2a, 0x4e,
@@ -409,7 +409,7 @@ var fileDescriptor_a08cfa38e3b6bdc3 = []byte{
 	0xf2, 0xe3, 0xaa, 0xbe, 0x70, 0x71, 0x55, 0x5f, 0xf8, 0x7b, 0x55, 0x5f, 0xf8, 0xb6, 0xdf, 0x23,
 	0xcc, 0x4b, 0xba, 0x69, 0xaa, 0x4c, 0x3e, 0xfa, 0xe9, 0xf9, 0xf0, 0xbb, 0x7c, 0x72, 0xf1, 0xb9,
 	0x39, 0xd8, 0x37, 0xcf, 0x67, 0xfe, 0xb3, 0x6c, 0xd8, 0xc7, 0x71, 0xb7, 0xc0, 0xff, 0x77, 0xcf,
-	0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x37, 0x83, 0x38, 0x5c, 0x89, 0x05, 0x00, 0x00,
+	0xfe, 0x0f, 0x00, 0x37, 0x83, 0x38, 0x5c, 0x89, 0x05, 0x00, 0x00,
 }
 
 func (m *TrustedRoot) Marshal() (dAtA []byte, err error) {
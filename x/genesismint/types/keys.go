@@ -1,6 +1,6 @@
 package types
 
-import "fmt"
+import "cosmossdk.io/collections"
 
 const (
 	ModuleName   = "genesismint"
@@ -10,31 +10,26 @@ const (
 )
 
 var (
-    ClaimedPrefix = []byte{0x11} // claimed escrow ids
-    PendingClaimPrefix = []byte{0x12} // pending claims to be sent via ICA
+    ClaimedPrefix = collections.NewPrefix(0x11) // claimed escrow ids
+    PendingClaimPrefix = collections.NewPrefix(0x12) // pending claims to be sent via ICA
     ICAPendingPrefix = []byte{0x13} // ICA registration pending flags
     DonePrefix = []byte{0x14} // module completion flag
     ConfigPrefix = []byte{0x15} // module configuration
-    ICAPacketPrefix = []byte{0x16} // mapping from (channel,seq) -> escrow id
-    InflightPrefix = []byte{0x17} // in-flight claims awaiting ack
+    ICAPacketPrefix = collections.NewPrefix(0x16) // mapping from (channel,seq) -> escrow id
+    InflightPrefix = collections.NewPrefix(0x17) // in-flight claims awaiting ack
+    ParamsKey = []byte{0x18} // module params set at genesis
+    MintedTotalPrefix = []byte{0x19} // total minted amount per denom
+    IsolatedClaimPrefix = collections.NewPrefix(0x1a) // claims sent alone after their batch failed
 )
 
-// key: claimed/<provider_chain_id>/<escrow_id> -> []byte{1}
-func ClaimedKey(providerChainID, escrowID string) []byte {
-	// simple: claimed|provider|escrow
-	k := append([]byte("claimed|"), []byte(providerChainID)...)
-	k = append(k, []byte("|")...)
-	k = append(k, []byte(escrowID)...)
-    return append(ClaimedPrefix, k...)
-}
-
-// key: pclaim/<provider_chain_id>/<escrow_id> -> []byte{1}
-func PendingClaimKey(providerChainID, escrowID string) []byte {
-    k := append([]byte("pclaim|"), []byte(providerChainID)...)
-    k = append(k, []byte("|")...)
-    k = append(k, []byte(escrowID)...)
-    return append(PendingClaimPrefix, k...)
-}
+var (
+    // ClaimKeyCodec encodes the (provider_chain_id, escrow_id) keys of the claimed, pending and
+    // in-flight claim collections. The chain id is written NUL terminated, so it may not hold a
+    // NUL byte; the escrow id is the last part and may hold any byte.
+    ClaimKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+    // ICAPacketKeyCodec encodes the (channel_id, sequence) keys of the ICA packet mapping.
+    ICAPacketKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+)
 
 // key: ica|<connection_id>|<owner> -> []byte{1}
 func ICAPendingKey(connectionID, owner string) []byte {
@@ -58,25 +53,10 @@ func ConfigICAOwnerKey() []byte { return append(ConfigPrefix, []byte("cfg|ica_ow
 func ConfigICATimeoutSecondsKey() []byte { return append(ConfigPrefix, []byte("cfg|ica_timeout_seconds")...) }
 // key: cfg|max_claims_per_block -> uint64 (ASCII)
 func ConfigMaxClaimsPerBlockKey() []byte { return append(ConfigPrefix, []byte("cfg|max_claims_per_block")...) }
+// key: cfg|max_claims_per_tx -> uint64 (ASCII)
+func ConfigMaxClaimsPerTxKey() []byte { return append(ConfigPrefix, []byte("cfg|max_claims_per_tx")...) }
 
-// key: pkt|<channel_id>|<sequence> -> escrow_id
-func ICAPacketKey(channelID string, sequence uint64) []byte {
-    s := fmt.Sprintf("pkt|%s|%d", channelID, sequence)
-    return append(ICAPacketPrefix, []byte(s)...)
-}
-
-// key: inflight|<provider_chain_id>|<escrow_id> -> 1
-func InflightKey(providerChainID, escrowID string) []byte {
-    k := append([]byte("inflight|"), []byte(providerChainID)...)
-    k = append(k, '|')
-    k = append(k, []byte(escrowID)...)
-    return append(InflightPrefix, k...)
-}
-
-// key: inflight_at|<provider>|<escrow> -> unix seconds as ASCII
-func InflightAtKey(providerChainID, escrowID string) []byte {
-    k := append([]byte("inflight_at|"), []byte(providerChainID)...)
-    k = append(k, '|')
-    k = append(k, []byte(escrowID)...)
-    return append(InflightPrefix, k...)
+// key: minted|<denom> -> sdkmath.Int (decimal string)
+func MintedTotalKey(denom string) []byte {
+    return append(MintedTotalPrefix, []byte("minted|"+denom)...)
 }
@@ -1,12 +1,19 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Attach validation to the generated Params type.
 func (p Params) ValidateBasic() error {
 	if p.ProviderClientId == "" || p.ProviderChainId == "" {
 		return fmt.Errorf("provider client/chain id required")
 	}
+	// the chain id is the NUL terminated first part of the claim keys
+	if strings.IndexByte(p.ProviderChainId, 0) >= 0 {
+		return fmt.Errorf("provider chain id may not contain a NUL byte")
+	}
 	if p.AllowedProviderDenom == "" || p.MintDenom == "" {
 		return fmt.Errorf("denoms required")
 	}
@@ -0,0 +1,3846 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/genesismint/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IcaConfig is the ICA setup used to confirm claims on the provider
+type IcaConfig struct {
+	ConnectionId      string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Owner             string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	TxTimeoutSeconds  uint64 `protobuf:"varint,3,opt,name=tx_timeout_seconds,json=txTimeoutSeconds,proto3" json:"tx_timeout_seconds,omitempty"`
+	MaxClaimsPerBlock uint64 `protobuf:"varint,4,opt,name=max_claims_per_block,json=maxClaimsPerBlock,proto3" json:"max_claims_per_block,omitempty"`
+	// pending claims packed into a single ICA tx
+	MaxClaimsPerTx uint64 `protobuf:"varint,5,opt,name=max_claims_per_tx,json=maxClaimsPerTx,proto3" json:"max_claims_per_tx,omitempty"`
+}
+
+func (m *IcaConfig) Reset()         { *m = IcaConfig{} }
+func (m *IcaConfig) String() string { return proto.CompactTextString(m) }
+func (*IcaConfig) ProtoMessage()    {}
+func (*IcaConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{0}
+}
+func (m *IcaConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaConfig.Merge(m, src)
+}
+func (m *IcaConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaConfig proto.InternalMessageInfo
+
+func (m *IcaConfig) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IcaConfig) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *IcaConfig) GetTxTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TxTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *IcaConfig) GetMaxClaimsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxClaimsPerBlock
+	}
+	return 0
+}
+
+func (m *IcaConfig) GetMaxClaimsPerTx() uint64 {
+	if m != nil {
+		return m.MaxClaimsPerTx
+	}
+	return 0
+}
+
+// ClaimRef identifies an escrow claimed on the consumer
+type ClaimRef struct {
+	ProviderChainId string `protobuf:"bytes,1,opt,name=provider_chain_id,json=providerChainId,proto3" json:"provider_chain_id,omitempty"`
+	EscrowId        string `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+}
+
+func (m *ClaimRef) Reset()         { *m = ClaimRef{} }
+func (m *ClaimRef) String() string { return proto.CompactTextString(m) }
+func (*ClaimRef) ProtoMessage()    {}
+func (*ClaimRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{1}
+}
+func (m *ClaimRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRef.Merge(m, src)
+}
+func (m *ClaimRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRef proto.InternalMessageInfo
+
+func (m *ClaimRef) GetProviderChainId() string {
+	if m != nil {
+		return m.ProviderChainId
+	}
+	return ""
+}
+
+func (m *ClaimRef) GetEscrowId() string {
+	if m != nil {
+		return m.EscrowId
+	}
+	return ""
+}
+
+// ClaimBatch is the list of claims confirmed by a single ICA tx, one message per claim
+type ClaimBatch struct {
+	Claims []ClaimRef `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+}
+
+func (m *ClaimBatch) Reset()         { *m = ClaimBatch{} }
+func (m *ClaimBatch) String() string { return proto.CompactTextString(m) }
+func (*ClaimBatch) ProtoMessage()    {}
+func (*ClaimBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{2}
+}
+func (m *ClaimBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimBatch.Merge(m, src)
+}
+func (m *ClaimBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimBatch proto.InternalMessageInfo
+
+func (m *ClaimBatch) GetClaims() []ClaimRef {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+// InflightClaim is a claim sent via ICA and awaiting an ack or timeout
+type InflightClaim struct {
+	ProviderChainId string `protobuf:"bytes,1,opt,name=provider_chain_id,json=providerChainId,proto3" json:"provider_chain_id,omitempty"`
+	EscrowId        string `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	// unix seconds of the block the claim was sent in
+	InflightSince int64 `protobuf:"varint,3,opt,name=inflight_since,json=inflightSince,proto3" json:"inflight_since,omitempty"`
+}
+
+func (m *InflightClaim) Reset()         { *m = InflightClaim{} }
+func (m *InflightClaim) String() string { return proto.CompactTextString(m) }
+func (*InflightClaim) ProtoMessage()    {}
+func (*InflightClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{3}
+}
+func (m *InflightClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflightClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflightClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflightClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflightClaim.Merge(m, src)
+}
+func (m *InflightClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflightClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflightClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflightClaim proto.InternalMessageInfo
+
+func (m *InflightClaim) GetProviderChainId() string {
+	if m != nil {
+		return m.ProviderChainId
+	}
+	return ""
+}
+
+func (m *InflightClaim) GetEscrowId() string {
+	if m != nil {
+		return m.EscrowId
+	}
+	return ""
+}
+
+func (m *InflightClaim) GetInflightSince() int64 {
+	if m != nil {
+		return m.InflightSince
+	}
+	return 0
+}
+
+// PacketMapping maps an ICA packet (channel, sequence) to the claims it carries
+type PacketMapping struct {
+	ChannelId string     `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Claims    []ClaimRef `protobuf:"bytes,5,rep,name=claims,proto3" json:"claims"`
+}
+
+func (m *PacketMapping) Reset()         { *m = PacketMapping{} }
+func (m *PacketMapping) String() string { return proto.CompactTextString(m) }
+func (*PacketMapping) ProtoMessage()    {}
+func (*PacketMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{4}
+}
+func (m *PacketMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketMapping.Merge(m, src)
+}
+func (m *PacketMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketMapping proto.InternalMessageInfo
+
+func (m *PacketMapping) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketMapping) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketMapping) GetClaims() []ClaimRef {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{5}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{6}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryClaimRequest struct {
+	ProviderChainId string `protobuf:"bytes,1,opt,name=provider_chain_id,json=providerChainId,proto3" json:"provider_chain_id,omitempty"`
+	EscrowId        string `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+}
+
+func (m *QueryClaimRequest) Reset()         { *m = QueryClaimRequest{} }
+func (m *QueryClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRequest) ProtoMessage()    {}
+func (*QueryClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{7}
+}
+func (m *QueryClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRequest.Merge(m, src)
+}
+func (m *QueryClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRequest) GetProviderChainId() string {
+	if m != nil {
+		return m.ProviderChainId
+	}
+	return ""
+}
+
+func (m *QueryClaimRequest) GetEscrowId() string {
+	if m != nil {
+		return m.EscrowId
+	}
+	return ""
+}
+
+type QueryClaimResponse struct {
+	// minted on the consumer
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// waiting to be confirmed on the provider
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// sent via ICA and awaiting an ack or timeout
+	Inflight      bool  `protobuf:"varint,3,opt,name=inflight,proto3" json:"inflight,omitempty"`
+	InflightSince int64 `protobuf:"varint,4,opt,name=inflight_since,json=inflightSince,proto3" json:"inflight_since,omitempty"`
+	// sent in an ICA tx of its own, since a batch it was part of failed
+	Isolated bool `protobuf:"varint,5,opt,name=isolated,proto3" json:"isolated,omitempty"`
+}
+
+func (m *QueryClaimResponse) Reset()         { *m = QueryClaimResponse{} }
+func (m *QueryClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimResponse) ProtoMessage()    {}
+func (*QueryClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{8}
+}
+func (m *QueryClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimResponse.Merge(m, src)
+}
+func (m *QueryClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimResponse proto.InternalMessageInfo
+
+func (m *QueryClaimResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+func (m *QueryClaimResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *QueryClaimResponse) GetInflight() bool {
+	if m != nil {
+		return m.Inflight
+	}
+	return false
+}
+
+func (m *QueryClaimResponse) GetInflightSince() int64 {
+	if m != nil {
+		return m.InflightSince
+	}
+	return 0
+}
+
+func (m *QueryClaimResponse) GetIsolated() bool {
+	if m != nil {
+		return m.Isolated
+	}
+	return false
+}
+
+type QueryPendingClaimsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingClaimsRequest) Reset()         { *m = QueryPendingClaimsRequest{} }
+func (m *QueryPendingClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingClaimsRequest) ProtoMessage()    {}
+func (*QueryPendingClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{9}
+}
+func (m *QueryPendingClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingClaimsRequest.Merge(m, src)
+}
+func (m *QueryPendingClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingClaimsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingClaimsResponse struct {
+	Claims     []ClaimRef          `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingClaimsResponse) Reset()         { *m = QueryPendingClaimsResponse{} }
+func (m *QueryPendingClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingClaimsResponse) ProtoMessage()    {}
+func (*QueryPendingClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{10}
+}
+func (m *QueryPendingClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingClaimsResponse.Merge(m, src)
+}
+func (m *QueryPendingClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingClaimsResponse) GetClaims() []ClaimRef {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *QueryPendingClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryInflightClaimsRequest struct {
+}
+
+func (m *QueryInflightClaimsRequest) Reset()         { *m = QueryInflightClaimsRequest{} }
+func (m *QueryInflightClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflightClaimsRequest) ProtoMessage()    {}
+func (*QueryInflightClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{11}
+}
+func (m *QueryInflightClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflightClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflightClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflightClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflightClaimsRequest.Merge(m, src)
+}
+func (m *QueryInflightClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflightClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflightClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflightClaimsRequest proto.InternalMessageInfo
+
+type QueryInflightClaimsResponse struct {
+	Claims  []InflightClaim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	Packets []PacketMapping `protobuf:"bytes,2,rep,name=packets,proto3" json:"packets"`
+}
+
+func (m *QueryInflightClaimsResponse) Reset()         { *m = QueryInflightClaimsResponse{} }
+func (m *QueryInflightClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflightClaimsResponse) ProtoMessage()    {}
+func (*QueryInflightClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{12}
+}
+func (m *QueryInflightClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflightClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflightClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflightClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflightClaimsResponse.Merge(m, src)
+}
+func (m *QueryInflightClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflightClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflightClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflightClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryInflightClaimsResponse) GetClaims() []InflightClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *QueryInflightClaimsResponse) GetPackets() []PacketMapping {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+type QueryIcaStatusRequest struct {
+}
+
+func (m *QueryIcaStatusRequest) Reset()         { *m = QueryIcaStatusRequest{} }
+func (m *QueryIcaStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaStatusRequest) ProtoMessage()    {}
+func (*QueryIcaStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{13}
+}
+func (m *QueryIcaStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIcaStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaStatusRequest.Merge(m, src)
+}
+func (m *QueryIcaStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIcaStatusRequest proto.InternalMessageInfo
+
+type QueryIcaStatusResponse struct {
+	Config IcaConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	PortId string    `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// set when the ICA registration was initiated and the channel is not open yet
+	RegistrationPending bool `protobuf:"varint,3,opt,name=registration_pending,json=registrationPending,proto3" json:"registration_pending,omitempty"`
+	// empty if there is no active ICA channel
+	ChannelId  string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	IcaAddress string `protobuf:"bytes,5,opt,name=ica_address,json=icaAddress,proto3" json:"ica_address,omitempty"`
+	// the module stops its BeginBlock work once every claim is confirmed
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *QueryIcaStatusResponse) Reset()         { *m = QueryIcaStatusResponse{} }
+func (m *QueryIcaStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaStatusResponse) ProtoMessage()    {}
+func (*QueryIcaStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a7eb8741cca36f, []int{14}
+}
+func (m *QueryIcaStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIcaStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIcaStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIcaStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaStatusResponse.Merge(m, src)
+}
+func (m *QueryIcaStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIcaStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIcaStatusResponse proto.InternalMessageInfo
+
+func (m *QueryIcaStatusResponse) GetConfig() IcaConfig {
+	if m != nil {
+		return m.Config
+	}
+	return IcaConfig{}
+}
+
+func (m *QueryIcaStatusResponse) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryIcaStatusResponse) GetRegistrationPending() bool {
+	if m != nil {
+		return m.RegistrationPending
+	}
+	return false
+}
+
+func (m *QueryIcaStatusResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryIcaStatusResponse) GetIcaAddress() string {
+	if m != nil {
+		return m.IcaAddress
+	}
+	return ""
+}
+
+func (m *QueryIcaStatusResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*IcaConfig)(nil), "maany.genesismint.v1.IcaConfig")
+	proto.RegisterType((*ClaimRef)(nil), "maany.genesismint.v1.ClaimRef")
+	proto.RegisterType((*ClaimBatch)(nil), "maany.genesismint.v1.ClaimBatch")
+	proto.RegisterType((*InflightClaim)(nil), "maany.genesismint.v1.InflightClaim")
+	proto.RegisterType((*PacketMapping)(nil), "maany.genesismint.v1.PacketMapping")
+	proto.RegisterType((*QueryParamsRequest)(nil), "maany.genesismint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "maany.genesismint.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClaimRequest)(nil), "maany.genesismint.v1.QueryClaimRequest")
+	proto.RegisterType((*QueryClaimResponse)(nil), "maany.genesismint.v1.QueryClaimResponse")
+	proto.RegisterType((*QueryPendingClaimsRequest)(nil), "maany.genesismint.v1.QueryPendingClaimsRequest")
+	proto.RegisterType((*QueryPendingClaimsResponse)(nil), "maany.genesismint.v1.QueryPendingClaimsResponse")
+	proto.RegisterType((*QueryInflightClaimsRequest)(nil), "maany.genesismint.v1.QueryInflightClaimsRequest")
+	proto.RegisterType((*QueryInflightClaimsResponse)(nil), "maany.genesismint.v1.QueryInflightClaimsResponse")
+	proto.RegisterType((*QueryIcaStatusRequest)(nil), "maany.genesismint.v1.QueryIcaStatusRequest")
+	proto.RegisterType((*QueryIcaStatusResponse)(nil), "maany.genesismint.v1.QueryIcaStatusResponse")
+}
+
+func init() { proto.RegisterFile("maany/genesismint/v1/query.proto", fileDescriptor_c5a7eb8741cca36f) }
+
+var fileDescriptor_c5a7eb8741cca36f = []byte{
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0xb6, 0xe3, 0xbc, 0x7c, 0x93, 0x6f, 0x32, 0x35, 0xd4, 0xb8, 0xc1, 0x89, 0xb6,
+	0x6d, 0xe2, 0x96, 0xd4, 0x8b, 0x83, 0x7a, 0x41, 0x05, 0xa9, 0x09, 0x02, 0x39, 0x12, 0x22, 0xdd,
+	0xf4, 0x84, 0x90, 0x56, 0x93, 0xd9, 0xc9, 0x66, 0x54, 0x7b, 0x66, 0xbb, 0x33, 0x49, 0x1d, 0xaa,
+	0x0a, 0x09, 0xce, 0x48, 0x95, 0xe0, 0x0f, 0x40, 0x02, 0xc4, 0xbf, 0xd2, 0x63, 0x24, 0x2e, 0x9c,
+	0x10, 0x4a, 0xf8, 0x1f, 0xb8, 0xa2, 0x9d, 0x99, 0x75, 0x6c, 0x77, 0xe3, 0xa6, 0xa2, 0xb7, 0x79,
+	0xbf, 0x3f, 0xef, 0xcd, 0xbc, 0xcf, 0x2e, 0xac, 0x74, 0x31, 0xe6, 0xc7, 0x5e, 0x44, 0x39, 0x95,
+	0x4c, 0x76, 0x19, 0x57, 0xde, 0x51, 0xcb, 0x7b, 0x7c, 0x48, 0x93, 0xe3, 0x66, 0x9c, 0x08, 0x25,
+	0x50, 0x45, 0x7b, 0x34, 0x07, 0x3c, 0x9a, 0x47, 0xad, 0xda, 0x6d, 0x22, 0x64, 0x57, 0x48, 0x6f,
+	0x0f, 0x4b, 0x6a, 0xdc, 0xbd, 0xa3, 0xd6, 0x1e, 0x55, 0xb8, 0xe5, 0xc5, 0x38, 0x62, 0x1c, 0x2b,
+	0x26, 0xb8, 0xc9, 0x50, 0xab, 0x44, 0x22, 0x12, 0xfa, 0xe8, 0xa5, 0x27, 0xab, 0x5d, 0x8a, 0x84,
+	0x88, 0x3a, 0xd4, 0xc3, 0x31, 0xf3, 0x30, 0xe7, 0x42, 0xe9, 0x10, 0x69, 0xad, 0xab, 0xb9, 0xb8,
+	0x06, 0x41, 0x68, 0x3f, 0xf7, 0xc4, 0x81, 0x99, 0x36, 0xc1, 0x5b, 0x82, 0xef, 0xb3, 0x08, 0x5d,
+	0x87, 0x39, 0x22, 0x38, 0xa7, 0x24, 0x4d, 0x15, 0xb0, 0xb0, 0xea, 0xac, 0x38, 0x8d, 0x19, 0xff,
+	0x7f, 0xe7, 0xca, 0x76, 0x88, 0x2a, 0x50, 0x14, 0x4f, 0x38, 0x4d, 0xaa, 0x93, 0xda, 0x68, 0x04,
+	0xb4, 0x0e, 0x48, 0xf5, 0x02, 0xc5, 0xba, 0x54, 0x1c, 0xaa, 0x40, 0x52, 0x22, 0x78, 0x28, 0xab,
+	0x53, 0x2b, 0x4e, 0xa3, 0xe0, 0x2f, 0xa8, 0xde, 0x43, 0x63, 0xd8, 0x35, 0x7a, 0xe4, 0x41, 0xa5,
+	0x8b, 0x7b, 0x01, 0xe9, 0x60, 0xd6, 0x95, 0x41, 0x4c, 0x93, 0x60, 0xaf, 0x23, 0xc8, 0xa3, 0x6a,
+	0x41, 0xfb, 0x2f, 0x76, 0x71, 0x6f, 0x4b, 0x9b, 0x76, 0x68, 0xb2, 0x99, 0x1a, 0xd0, 0x2d, 0x58,
+	0x1c, 0x09, 0x50, 0xbd, 0x6a, 0x51, 0x7b, 0xcf, 0x0f, 0x7a, 0x3f, 0xec, 0xb9, 0xbb, 0x50, 0xd6,
+	0xa2, 0x4f, 0xf7, 0xd1, 0x6d, 0x58, 0x8c, 0x13, 0x71, 0xc4, 0x42, 0x9a, 0x04, 0xe4, 0x00, 0xb3,
+	0x81, 0xa6, 0xfe, 0x9f, 0x19, 0xb6, 0x52, 0x7d, 0x3b, 0x44, 0xd7, 0x60, 0x86, 0x4a, 0x92, 0x88,
+	0x27, 0xa9, 0x8f, 0xe9, 0xad, 0x6c, 0x14, 0xed, 0xd0, 0xdd, 0x06, 0xd0, 0x49, 0x37, 0xb1, 0x22,
+	0x07, 0xe8, 0x1e, 0x94, 0x0c, 0x92, 0xaa, 0xb3, 0x32, 0xd5, 0x98, 0xdd, 0xa8, 0x37, 0xf3, 0x2e,
+	0xb9, 0x99, 0xc1, 0xd8, 0x2c, 0xbc, 0xf8, 0x73, 0x79, 0xc2, 0xb7, 0x31, 0xee, 0x37, 0x30, 0xd7,
+	0xe6, 0xfb, 0x1d, 0x16, 0x1d, 0x28, 0xed, 0xf1, 0xc6, 0x50, 0xa2, 0x9b, 0x30, 0xcf, 0x6c, 0xe6,
+	0x40, 0x32, 0x4e, 0xa8, 0xbe, 0x80, 0x29, 0x7f, 0x2e, 0xd3, 0xee, 0xa6, 0x4a, 0xf7, 0x47, 0x07,
+	0xe6, 0x76, 0x30, 0x79, 0x44, 0xd5, 0xe7, 0x38, 0x8e, 0x19, 0x8f, 0xd0, 0xbb, 0x00, 0xe4, 0x00,
+	0x73, 0x4e, 0x3b, 0xe7, 0xa5, 0x67, 0xac, 0xa6, 0x1d, 0xa2, 0x1a, 0x94, 0x25, 0x7d, 0x7c, 0x48,
+	0xd3, 0x8c, 0x93, 0x7a, 0xe8, 0x7d, 0x79, 0x60, 0x16, 0xc5, 0xd7, 0x9f, 0xc5, 0x76, 0xa1, 0x3c,
+	0xb5, 0x50, 0xd8, 0x2e, 0x94, 0x0b, 0x0b, 0x45, 0xb7, 0x02, 0xe8, 0x41, 0xba, 0x09, 0x3b, 0x38,
+	0xc1, 0x5d, 0xe9, 0xa7, 0x05, 0xa4, 0x72, 0x1f, 0xc0, 0x95, 0x21, 0xad, 0x8c, 0x05, 0x97, 0x14,
+	0x7d, 0x08, 0xa5, 0x58, 0x6b, 0x34, 0xda, 0xd9, 0x8d, 0xa5, 0xfc, 0xb2, 0x26, 0x2a, 0x2b, 0x6a,
+	0x22, 0xdc, 0xaf, 0x60, 0x51, 0xa7, 0xb4, 0x98, 0x74, 0x9d, 0x37, 0xf7, 0x54, 0x7e, 0x73, 0x00,
+	0x0d, 0xa6, 0xb7, 0x80, 0xab, 0x30, 0xad, 0x7b, 0xa6, 0x26, 0x6b, 0xd9, 0xcf, 0xc4, 0xd4, 0x12,
+	0x53, 0x1e, 0x32, 0x1e, 0xe9, 0x5c, 0x65, 0x3f, 0x13, 0xd3, 0xb9, 0x67, 0x37, 0xa7, 0x6f, 0xb2,
+	0xec, 0xf7, 0xe5, 0x9c, 0xbb, 0x2e, 0xe4, 0xdc, 0xb5, 0x4e, 0x21, 0x45, 0x07, 0x2b, 0x1a, 0x56,
+	0x8b, 0x36, 0x85, 0x95, 0x5d, 0x02, 0xef, 0x98, 0xd1, 0x9a, 0x72, 0x66, 0x89, 0xb2, 0x79, 0x7c,
+	0x0a, 0x70, 0xce, 0x44, 0x76, 0xc8, 0xab, 0x4d, 0x43, 0x5b, 0xcd, 0x94, 0xb6, 0x9a, 0x86, 0xe5,
+	0x2c, 0x6d, 0x35, 0x77, 0x70, 0x44, 0x6d, 0xac, 0x3f, 0x10, 0xe9, 0xfe, 0xec, 0x40, 0x2d, 0xaf,
+	0x8a, 0x1d, 0xcb, 0x7f, 0x5a, 0x25, 0xf4, 0xd9, 0x10, 0xc8, 0x49, 0x0d, 0x72, 0xed, 0x95, 0x20,
+	0x4d, 0xe9, 0x21, 0x94, 0x4b, 0x16, 0xe4, 0xd0, 0x62, 0xf6, 0xdf, 0xe0, 0xaf, 0x0e, 0x5c, 0xcb,
+	0x35, 0xdb, 0x26, 0xee, 0x8f, 0x34, 0x71, 0x3d, 0xbf, 0x89, 0xa1, 0xe8, 0x91, 0x4e, 0xb6, 0x60,
+	0x3a, 0xd6, 0x2b, 0x29, 0xab, 0x93, 0xe3, 0x72, 0x0c, 0xed, 0xad, 0xcd, 0x91, 0x45, 0xba, 0x57,
+	0xe1, 0x2d, 0x03, 0x93, 0xe0, 0x5d, 0x85, 0xd5, 0x61, 0xbf, 0x81, 0x7f, 0x1c, 0x78, 0x7b, 0xd4,
+	0x62, 0xb1, 0x7f, 0x04, 0x25, 0xa2, 0xd9, 0xdf, 0xde, 0xf1, 0xf2, 0x05, 0xd8, 0xb3, 0x8f, 0x44,
+	0x1f, 0xb7, 0x96, 0xd0, 0x55, 0x98, 0x8e, 0x45, 0xa2, 0xce, 0x17, 0xa1, 0x94, 0x8a, 0xed, 0x10,
+	0xb5, 0xa0, 0x92, 0xd0, 0x88, 0x49, 0x95, 0xe8, 0x09, 0x07, 0xd9, 0x13, 0x37, 0xef, 0xf8, 0xca,
+	0xa0, 0xcd, 0xbe, 0x8c, 0x11, 0x16, 0x2a, 0x8c, 0xb2, 0xd0, 0x32, 0xcc, 0x32, 0x82, 0x03, 0x1c,
+	0x86, 0x09, 0x95, 0x52, 0xbf, 0xe6, 0x19, 0x1f, 0x18, 0xc1, 0xf7, 0x8d, 0x06, 0x21, 0x28, 0x84,
+	0x82, 0xd3, 0x6a, 0x49, 0x97, 0xd0, 0xe7, 0x8d, 0xef, 0x4b, 0x50, 0xd4, 0x9d, 0xa3, 0xef, 0x1c,
+	0x28, 0x19, 0x3a, 0x40, 0x8d, 0xfc, 0x1e, 0x5f, 0x66, 0x9f, 0xda, 0xad, 0x4b, 0x78, 0x9a, 0x41,
+	0xba, 0x37, 0xbe, 0xfd, 0xfd, 0xef, 0x1f, 0x26, 0xeb, 0x68, 0xc9, 0xcb, 0xfd, 0xf6, 0x1a, 0xee,
+	0x41, 0x3f, 0x39, 0x50, 0x34, 0xac, 0xbf, 0x36, 0x26, 0xf5, 0x20, 0x33, 0xd5, 0x1a, 0xaf, 0x76,
+	0xb4, 0x10, 0x3e, 0xd1, 0x10, 0x3e, 0x46, 0xf7, 0xf2, 0x21, 0x98, 0xa7, 0xe6, 0x3d, 0x7d, 0x89,
+	0xe7, 0x9e, 0x79, 0x4f, 0xfb, 0x7c, 0xf6, 0x2c, 0x85, 0x38, 0x37, 0xb4, 0xac, 0xc8, 0x1b, 0x37,
+	0x85, 0x1c, 0xf2, 0xa8, 0xbd, 0x7f, 0xf9, 0x00, 0x0b, 0x7d, 0x5d, 0x43, 0x5f, 0x45, 0x37, 0x2e,
+	0x98, 0x9e, 0x09, 0xb2, 0x3f, 0x00, 0xe8, 0x17, 0x07, 0xe6, 0x87, 0x77, 0x11, 0x8d, 0x2b, 0x99,
+	0xbb, 0xd5, 0xb5, 0xd6, 0x6b, 0x44, 0x58, 0x94, 0x77, 0x34, 0xca, 0x35, 0x74, 0x33, 0x1f, 0x65,
+	0x9f, 0x90, 0x2d, 0xcc, 0xe7, 0xe6, 0xef, 0xca, 0x6c, 0x1c, 0x7a, 0x6f, 0x5c, 0xbd, 0x91, 0x8d,
+	0xad, 0xad, 0x5f, 0xce, 0xd9, 0xe2, 0x6a, 0x68, 0x5c, 0x2e, 0x5a, 0xb9, 0x00, 0x17, 0xc1, 0x81,
+	0xd4, 0x11, 0x9b, 0x5f, 0xbc, 0x38, 0xad, 0x3b, 0x27, 0xa7, 0x75, 0xe7, 0xaf, 0xd3, 0xba, 0xf3,
+	0xfc, 0xac, 0x3e, 0x71, 0x72, 0x56, 0x9f, 0xf8, 0xe3, 0xac, 0x3e, 0xf1, 0xe5, 0xdd, 0x88, 0xa9,
+	0x83, 0xc3, 0xbd, 0x26, 0x11, 0x5d, 0x93, 0xe5, 0x4e, 0xef, 0xf8, 0x6b, 0x7b, 0x0a, 0x69, 0xcf,
+	0x3b, 0xba, 0xeb, 0xf5, 0x86, 0x12, 0xab, 0xe3, 0x98, 0xca, 0xbd, 0x92, 0xfe, 0x91, 0xfc, 0xe0,
+	0xdf, 0x01, 0x00, 0x29, 0x7a, 0x63, 0x03, 0x0a, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the genesismint params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Claim queries the state of a single escrow claim
+	Claim(ctx context.Context, in *QueryClaimRequest, opts ...grpc.CallOption) (*QueryClaimResponse, error)
+	// PendingClaims queries the claims not yet confirmed on the provider
+	PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error)
+	// InflightClaims queries the claims sent via ICA and awaiting an ack, along with the ICA packets mapped to escrows
+	InflightClaims(ctx context.Context, in *QueryInflightClaimsRequest, opts ...grpc.CallOption) (*QueryInflightClaimsResponse, error)
+	// IcaStatus queries the ICA config and registration state, and whether the module is done
+	IcaStatus(ctx context.Context, in *QueryIcaStatusRequest, opts ...grpc.CallOption) (*QueryIcaStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Claim(ctx context.Context, in *QueryClaimRequest, opts ...grpc.CallOption) (*QueryClaimResponse, error) {
+	out := new(QueryClaimResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Query/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingClaims(ctx context.Context, in *QueryPendingClaimsRequest, opts ...grpc.CallOption) (*QueryPendingClaimsResponse, error) {
+	out := new(QueryPendingClaimsResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Query/PendingClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflightClaims(ctx context.Context, in *QueryInflightClaimsRequest, opts ...grpc.CallOption) (*QueryInflightClaimsResponse, error) {
+	out := new(QueryInflightClaimsResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Query/InflightClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaStatus(ctx context.Context, in *QueryIcaStatusRequest, opts ...grpc.CallOption) (*QueryIcaStatusResponse, error) {
+	out := new(QueryIcaStatusResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Query/IcaStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the genesismint params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Claim queries the state of a single escrow claim
+	Claim(context.Context, *QueryClaimRequest) (*QueryClaimResponse, error)
+	// PendingClaims queries the claims not yet confirmed on the provider
+	PendingClaims(context.Context, *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error)
+	// InflightClaims queries the claims sent via ICA and awaiting an ack, along with the ICA packets mapped to escrows
+	InflightClaims(context.Context, *QueryInflightClaimsRequest) (*QueryInflightClaimsResponse, error)
+	// IcaStatus queries the ICA config and registration state, and whether the module is done
+	IcaStatus(context.Context, *QueryIcaStatusRequest) (*QueryIcaStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Claim(ctx context.Context, req *QueryClaimRequest) (*QueryClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedQueryServer) PendingClaims(ctx context.Context, req *QueryPendingClaimsRequest) (*QueryPendingClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingClaims not implemented")
+}
+func (*UnimplementedQueryServer) InflightClaims(ctx context.Context, req *QueryInflightClaimsRequest) (*QueryInflightClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflightClaims not implemented")
+}
+func (*UnimplementedQueryServer) IcaStatus(ctx context.Context, req *QueryIcaStatusRequest) (*QueryIcaStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Query/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claim(ctx, req.(*QueryClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Query/PendingClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingClaims(ctx, req.(*QueryPendingClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflightClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflightClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflightClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Query/InflightClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflightClaims(ctx, req.(*QueryInflightClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Query/IcaStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaStatus(ctx, req.(*QueryIcaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.genesismint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Query_Claim_Handler,
+		},
+		{
+			MethodName: "PendingClaims",
+			Handler:    _Query_PendingClaims_Handler,
+		},
+		{
+			MethodName: "InflightClaims",
+			Handler:    _Query_InflightClaims_Handler,
+		},
+		{
+			MethodName: "IcaStatus",
+			Handler:    _Query_IcaStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/genesismint/v1/query.proto",
+}
+
+func (m *IcaConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxClaimsPerTx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxClaimsPerTx))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxClaimsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxClaimsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxTimeoutSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowId) > 0 {
+		i -= len(m.EscrowId)
+		copy(dAtA[i:], m.EscrowId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderChainId) > 0 {
+		i -= len(m.ProviderChainId)
+		copy(dAtA[i:], m.ProviderChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflightClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflightClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflightClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InflightSince != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InflightSince))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EscrowId) > 0 {
+		i -= len(m.EscrowId)
+		copy(dAtA[i:], m.EscrowId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderChainId) > 0 {
+		i -= len(m.ProviderChainId)
+		copy(dAtA[i:], m.ProviderChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowId) > 0 {
+		i -= len(m.EscrowId)
+		copy(dAtA[i:], m.EscrowId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderChainId) > 0 {
+		i -= len(m.ProviderChainId)
+		copy(dAtA[i:], m.ProviderChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Isolated {
+		i--
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InflightSince != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InflightSince))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Inflight {
+		i--
+		if m.Inflight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflightClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflightClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflightClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflightClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflightClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflightClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.IcaAddress) > 0 {
+		i -= len(m.IcaAddress)
+		copy(dAtA[i:], m.IcaAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IcaAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RegistrationPending {
+		i--
+		if m.RegistrationPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxTimeoutSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.TxTimeoutSeconds))
+	}
+	if m.MaxClaimsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxClaimsPerBlock))
+	}
+	if m.MaxClaimsPerTx != 0 {
+		n += 1 + sovQuery(uint64(m.MaxClaimsPerTx))
+	}
+	return n
+}
+
+func (m *ClaimRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflightClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InflightSince != 0 {
+		n += 1 + sovQuery(uint64(m.InflightSince))
+	}
+	return n
+}
+
+func (m *PacketMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	if m.Pending {
+		n += 2
+	}
+	if m.Inflight {
+		n += 2
+	}
+	if m.InflightSince != 0 {
+		n += 1 + sovQuery(uint64(m.InflightSince))
+	}
+	if m.Isolated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPendingClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflightClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflightClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIcaStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIcaStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationPending {
+		n += 2
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IcaAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Done {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxTimeoutSeconds", wireType)
+			}
+			m.TxTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimsPerBlock", wireType)
+			}
+			m.MaxClaimsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimsPerTx", wireType)
+			}
+			m.MaxClaimsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimRef{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflightClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflightClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflightClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflightSince", wireType)
+			}
+			m.InflightSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflightSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimRef{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inflight = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflightSince", wireType)
+			}
+			m.InflightSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflightSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingClaimsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingClaimsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimRef{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflightClaimsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflightClaimsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflightClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflightClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflightClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflightClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, InflightClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PacketMapping{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistrationPending = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
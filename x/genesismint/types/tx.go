@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRetryClaim{}
+	_ sdk.Msg = &MsgUpdateIcaConfig{}
+)
+
+func (msg *MsgRetryClaim) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryClaim) Type() string {
+	return "retry-claim"
+}
+
+func (msg *MsgRetryClaim) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRetryClaim) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRetryClaim) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if msg.ProviderChainId == "" {
+		return fmt.Errorf("provider chain id is required")
+	}
+	if strings.IndexByte(msg.ProviderChainId, 0) >= 0 {
+		return fmt.Errorf("provider chain id may not contain a NUL byte")
+	}
+	if msg.EscrowId == "" {
+		return fmt.Errorf("escrow id is required")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateIcaConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateIcaConfig) Type() string {
+	return "update-ica-config"
+}
+
+func (msg *MsgUpdateIcaConfig) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateIcaConfig) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateIcaConfig) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.Config.Validate()
+}
+
+// Validate checks that every config value is set and usable by the ICA controller.
+func (c IcaConfig) Validate() error {
+	if err := host.ConnectionIdentifierValidator(c.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection id")
+	}
+	if _, err := icatypes.NewControllerPortID(c.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner")
+	}
+	if c.TxTimeoutSeconds == 0 {
+		return fmt.Errorf("tx timeout seconds must be positive")
+	}
+	if c.MaxClaimsPerBlock == 0 {
+		return fmt.Errorf("max claims per block must be positive")
+	}
+	if c.MaxClaimsPerTx == 0 {
+		return fmt.Errorf("max claims per tx must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,1030 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/genesismint/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgRetryClaim struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ProviderChainId string `protobuf:"bytes,2,opt,name=provider_chain_id,json=providerChainId,proto3" json:"provider_chain_id,omitempty"`
+	EscrowId        string `protobuf:"bytes,3,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+}
+
+func (m *MsgRetryClaim) Reset()         { *m = MsgRetryClaim{} }
+func (m *MsgRetryClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryClaim) ProtoMessage()    {}
+func (*MsgRetryClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4ab6bb01a82cd5, []int{0}
+}
+func (m *MsgRetryClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryClaim.Merge(m, src)
+}
+func (m *MsgRetryClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryClaim proto.InternalMessageInfo
+
+func (m *MsgRetryClaim) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRetryClaim) GetProviderChainId() string {
+	if m != nil {
+		return m.ProviderChainId
+	}
+	return ""
+}
+
+func (m *MsgRetryClaim) GetEscrowId() string {
+	if m != nil {
+		return m.EscrowId
+	}
+	return ""
+}
+
+type MsgRetryClaimResponse struct {
+}
+
+func (m *MsgRetryClaimResponse) Reset()         { *m = MsgRetryClaimResponse{} }
+func (m *MsgRetryClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryClaimResponse) ProtoMessage()    {}
+func (*MsgRetryClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4ab6bb01a82cd5, []int{1}
+}
+func (m *MsgRetryClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryClaimResponse.Merge(m, src)
+}
+func (m *MsgRetryClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryClaimResponse proto.InternalMessageInfo
+
+type MsgUpdateIcaConfig struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All fields must be supplied.
+	Config IcaConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateIcaConfig) Reset()         { *m = MsgUpdateIcaConfig{} }
+func (m *MsgUpdateIcaConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIcaConfig) ProtoMessage()    {}
+func (*MsgUpdateIcaConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4ab6bb01a82cd5, []int{2}
+}
+func (m *MsgUpdateIcaConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIcaConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIcaConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateIcaConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIcaConfig.Merge(m, src)
+}
+func (m *MsgUpdateIcaConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIcaConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIcaConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateIcaConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateIcaConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateIcaConfig) GetConfig() IcaConfig {
+	if m != nil {
+		return m.Config
+	}
+	return IcaConfig{}
+}
+
+type MsgUpdateIcaConfigResponse struct {
+}
+
+func (m *MsgUpdateIcaConfigResponse) Reset()         { *m = MsgUpdateIcaConfigResponse{} }
+func (m *MsgUpdateIcaConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIcaConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateIcaConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4ab6bb01a82cd5, []int{3}
+}
+func (m *MsgUpdateIcaConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIcaConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIcaConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateIcaConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIcaConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateIcaConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIcaConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIcaConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateIcaConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRetryClaim)(nil), "maany.genesismint.v1.MsgRetryClaim")
+	proto.RegisterType((*MsgRetryClaimResponse)(nil), "maany.genesismint.v1.MsgRetryClaimResponse")
+	proto.RegisterType((*MsgUpdateIcaConfig)(nil), "maany.genesismint.v1.MsgUpdateIcaConfig")
+	proto.RegisterType((*MsgUpdateIcaConfigResponse)(nil), "maany.genesismint.v1.MsgUpdateIcaConfigResponse")
+}
+
+func init() { proto.RegisterFile("maany/genesismint/v1/tx.proto", fileDescriptor_8f4ab6bb01a82cd5) }
+
+var fileDescriptor_8f4ab6bb01a82cd5 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbf, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0xa9, 0xa8, 0x88, 0x11, 0xaa, 0x7a, 0x0a, 0x6a, 0x7a, 0xc0, 0xb5, 0x0a, 0x4b, 0x15,
+	0xe8, 0x99, 0x14, 0x95, 0xa1, 0x1b, 0xc9, 0x94, 0x21, 0x42, 0x3a, 0xc4, 0xc2, 0x40, 0xe4, 0x9e,
+	0x8d, 0x63, 0x09, 0xdb, 0x87, 0xed, 0x84, 0x1c, 0x13, 0x62, 0x64, 0xe2, 0xcf, 0x60, 0xcc, 0xc0,
+	0xcc, 0xc2, 0xd2, 0xb1, 0x62, 0x42, 0x42, 0x42, 0x28, 0x19, 0xf2, 0x6f, 0xa0, 0xf3, 0x5d, 0x68,
+	0x92, 0x06, 0x29, 0xea, 0x62, 0xd9, 0xef, 0xbd, 0xef, 0xc7, 0xfb, 0xfc, 0xc1, 0x7b, 0x02, 0x63,
+	0x99, 0x22, 0x46, 0x25, 0x35, 0xdc, 0x08, 0x2e, 0x2d, 0x1a, 0x34, 0x90, 0x1d, 0x86, 0x89, 0x56,
+	0x56, 0x79, 0x15, 0x47, 0x87, 0x73, 0x74, 0x38, 0x68, 0xf8, 0xdb, 0x58, 0x70, 0xa9, 0x90, 0x3b,
+	0x73, 0xa1, 0xbf, 0x13, 0x2b, 0x23, 0x94, 0x41, 0xc2, 0xb0, 0x2c, 0x81, 0x30, 0xac, 0x20, 0x76,
+	0x73, 0xa2, 0xeb, 0x5e, 0x28, 0x7f, 0x14, 0x54, 0x85, 0x29, 0xa6, 0x72, 0x3c, 0xbb, 0x15, 0xe8,
+	0xfe, 0xca, 0x8e, 0xde, 0xf6, 0xa9, 0x4e, 0x73, 0x45, 0xed, 0x1b, 0x80, 0xb7, 0x3a, 0x86, 0x45,
+	0xd4, 0xea, 0xb4, 0xf5, 0x06, 0x73, 0xe1, 0x3d, 0x81, 0x65, 0xdc, 0xb7, 0x3d, 0xa5, 0xb9, 0x4d,
+	0xab, 0x60, 0x1f, 0x1c, 0x94, 0x9b, 0xd5, 0x1f, 0x5f, 0x0f, 0x2b, 0x45, 0xb9, 0xa7, 0x84, 0x68,
+	0x6a, 0xcc, 0x73, 0xab, 0xb9, 0x64, 0xd1, 0x85, 0xd4, 0xab, 0xc3, 0xed, 0x44, 0xab, 0x01, 0x27,
+	0x54, 0x77, 0xe3, 0x1e, 0xe6, 0xb2, 0xcb, 0x49, 0xf5, 0x5a, 0x16, 0x1f, 0x6d, 0xcd, 0x88, 0x56,
+	0x86, 0xb7, 0x89, 0x77, 0x07, 0x96, 0xa9, 0x89, 0xb5, 0x7a, 0x97, 0x69, 0x36, 0x9c, 0xe6, 0x46,
+	0x0e, 0xb4, 0xc9, 0xc9, 0xc3, 0x8f, 0xd3, 0x51, 0xfd, 0x22, 0xf1, 0xa7, 0xe9, 0xa8, 0xbe, 0x3b,
+	0xef, 0x60, 0xa1, 0xdd, 0xda, 0x0e, 0xbc, 0xbd, 0x00, 0x44, 0xd4, 0x24, 0x4a, 0x1a, 0x5a, 0xfb,
+	0x0e, 0xa0, 0xd7, 0x31, 0xec, 0x45, 0x42, 0xb0, 0xa5, 0xed, 0x18, 0xb7, 0x94, 0x7c, 0xcd, 0xd9,
+	0x95, 0xed, 0x35, 0xe1, 0x66, 0xec, 0x32, 0x38, 0x4f, 0x37, 0x8f, 0xf6, 0xc2, 0x55, 0xdf, 0x19,
+	0xfe, 0x2b, 0xd4, 0x2c, 0x9f, 0xfd, 0xde, 0x2b, 0x7d, 0x99, 0x8e, 0xea, 0x20, 0x2a, 0x22, 0x4f,
+	0x1a, 0x97, 0x9d, 0x05, 0x4b, 0xce, 0x96, 0xda, 0xad, 0xdd, 0x85, 0xfe, 0x65, 0x74, 0xe6, 0xf1,
+	0xe8, 0x17, 0x80, 0x1b, 0x1d, 0xc3, 0xbc, 0x57, 0x10, 0xce, 0xfd, 0xe0, 0xfd, 0xd5, 0xad, 0x2d,
+	0x8c, 0xc9, 0x7f, 0xb0, 0x86, 0x68, 0x56, 0xc7, 0x13, 0x70, 0x6b, 0x79, 0x8e, 0x07, 0xff, 0x8d,
+	0x5f, 0x52, 0xfa, 0x8f, 0xd6, 0x55, 0xce, 0xca, 0xf9, 0xd7, 0x3f, 0x64, 0x63, 0x6b, 0x3e, 0x3b,
+	0x1b, 0x07, 0xe0, 0x7c, 0x1c, 0x80, 0x3f, 0xe3, 0x00, 0x7c, 0x9e, 0x04, 0xa5, 0xf3, 0x49, 0x50,
+	0xfa, 0x39, 0x09, 0x4a, 0x2f, 0x8f, 0x19, 0xb7, 0xbd, 0xfe, 0x69, 0x18, 0x2b, 0x81, 0x5c, 0xf2,
+	0xc3, 0x61, 0xfa, 0xbe, 0xb8, 0x11, 0x3a, 0x44, 0x83, 0x63, 0x34, 0x5c, 0xd8, 0x7a, 0x9b, 0x26,
+	0xd4, 0x9c, 0x6e, 0xba, 0x9d, 0x7f, 0xfc, 0x77, 0x00, 0x59, 0x59, 0x33, 0x79, 0xa9, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RetryClaim re-queues a claimed escrow for confirmation on the provider,
+	// dropping its in-flight state and resuming the module if it was done
+	RetryClaim(ctx context.Context, in *MsgRetryClaim, opts ...grpc.CallOption) (*MsgRetryClaimResponse, error)
+	// UpdateIcaConfig replaces the ICA config
+	UpdateIcaConfig(ctx context.Context, in *MsgUpdateIcaConfig, opts ...grpc.CallOption) (*MsgUpdateIcaConfigResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RetryClaim(ctx context.Context, in *MsgRetryClaim, opts ...grpc.CallOption) (*MsgRetryClaimResponse, error) {
+	out := new(MsgRetryClaimResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Msg/RetryClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateIcaConfig(ctx context.Context, in *MsgUpdateIcaConfig, opts ...grpc.CallOption) (*MsgUpdateIcaConfigResponse, error) {
+	out := new(MsgUpdateIcaConfigResponse)
+	err := c.cc.Invoke(ctx, "/maany.genesismint.v1.Msg/UpdateIcaConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RetryClaim re-queues a claimed escrow for confirmation on the provider,
+	// dropping its in-flight state and resuming the module if it was done
+	RetryClaim(context.Context, *MsgRetryClaim) (*MsgRetryClaimResponse, error)
+	// UpdateIcaConfig replaces the ICA config
+	UpdateIcaConfig(context.Context, *MsgUpdateIcaConfig) (*MsgUpdateIcaConfigResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RetryClaim(ctx context.Context, req *MsgRetryClaim) (*MsgRetryClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryClaim not implemented")
+}
+func (*UnimplementedMsgServer) UpdateIcaConfig(ctx context.Context, req *MsgUpdateIcaConfig) (*MsgUpdateIcaConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIcaConfig not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RetryClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Msg/RetryClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryClaim(ctx, req.(*MsgRetryClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateIcaConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIcaConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIcaConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.genesismint.v1.Msg/UpdateIcaConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIcaConfig(ctx, req.(*MsgUpdateIcaConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.genesismint.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetryClaim",
+			Handler:    _Msg_RetryClaim_Handler,
+		},
+		{
+			MethodName: "UpdateIcaConfig",
+			Handler:    _Msg_UpdateIcaConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/genesismint/v1/tx.proto",
+}
+
+func (m *MsgRetryClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowId) > 0 {
+		i -= len(m.EscrowId)
+		copy(dAtA[i:], m.EscrowId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EscrowId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderChainId) > 0 {
+		i -= len(m.ProviderChainId)
+		copy(dAtA[i:], m.ProviderChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIcaConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIcaConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIcaConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIcaConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIcaConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIcaConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRetryClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateIcaConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateIcaConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRetryClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIcaConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIcaConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIcaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIcaConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIcaConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIcaConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+const (
+	flagType                = "type"
+	flagGatewayFeeAmount    = "gateway-fee-amount"
+	flagGatewayFeeRecipient = "gateway-fee-recipient"
+	flagTimeoutTimestamp    = "timeout-timestamp"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdSendGeneralMessage())
+
+	return cmd
+}
+
+func CmdSendGeneralMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-general-message [channel-id] [destination-chain] [destination-address] [payload-hex] [token]",
+		Short: "Send a general message to a destination chain through the trusted gateway of a channel",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := hex.DecodeString(strings.TrimPrefix(args[3], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to parse payload: %w", err)
+			}
+			token, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return fmt.Errorf("failed to parse token: %w", err)
+			}
+			msgType, err := cmd.Flags().GetInt64(flagType)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			feeAmount, err := cmd.Flags().GetString(flagGatewayFeeAmount)
+			if err != nil {
+				return err
+			}
+			feeRecipient, err := cmd.Flags().GetString(flagGatewayFeeRecipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSendGeneralMessage{
+				Sender:             clientCtx.GetFromAddress().String(),
+				SourceChannel:      args[0],
+				DestinationChain:   args[1],
+				DestinationAddress: args[2],
+				Payload:            payload,
+				Type:               msgType,
+				Token:              token,
+				TimeoutTimestamp:   timeout,
+			}
+			if feeAmount != "" || feeRecipient != "" {
+				msg.GatewayFee = &types.GatewayFee{Amount: feeAmount, Recipient: feeRecipient}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagType, types.TypeGeneralMessage, "message type, a general message (1) or a general message with token (2)")
+	cmd.Flags().String(flagGatewayFeeAmount, "", "amount of the token paid to the gateway relayers")
+	cmd.Flags().String(flagGatewayFeeRecipient, "", "address on the gateway chain receiving the gateway fee")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "absolute timeout in unix nanoseconds, the default timeout of the params from the block time if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
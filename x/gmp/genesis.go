@@ -0,0 +1,29 @@
+package gmp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, msg := range genState.OutboundMessages {
+		k.SetOutboundMessage(ctx, msg)
+	}
+
+	err := k.SetParams(ctx, genState.Params)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.OutboundMessages = k.GetAllOutboundMessages(ctx)
+
+	return genesis
+}
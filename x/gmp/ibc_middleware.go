@@ -4,21 +4,28 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/keeper"
+	gmptypes "github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
 )
 
 type IBCMiddleware struct {
-	app porttypes.IBCModule
+	app    porttypes.IBCModule
+	keeper *keeper.Keeper
 }
 
-func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
-		app: app,
+		app:    app,
+		keeper: k,
 	}
 }
 
@@ -89,7 +96,9 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket implements the IBCMiddleware interface
+// OnRecvPacket implements the IBCMiddleware interface.
+// General messages to a contract are only executed when they are sent by the trusted gateway of the channel,
+// the memo of other general messages is replaced by their payload before passing them to the next layer.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -108,23 +117,68 @@ func (im IBCMiddleware) OnRecvPacket(
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	switch msg.Type {
-	case TypeGeneralMessage:
-		// let the next layer deal with this
-		// the rest of the data fields should be normal
-		fallthrough
-	case TypeGeneralMessageWithToken:
-		// we throw out the rest of the msg.Payload fields here, for better or worse
-		data.Memo = string(msg.Payload)
-		var dataBytes []byte
-		if dataBytes, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
-			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
-		}
-		packet.Data = dataBytes
-		return im.app.OnRecvPacket(ctx, packet, relayer)
-	default:
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unrecognized mesasge type: %d", msg.Type))
+	if err := gmptypes.ValidateMessageType(msg.Type); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	gateway, found := im.keeper.GetParams(ctx).Gateway(packet.GetDestChannel())
+	if contract, ok := im.keeper.IsContract(ctx, data.GetReceiver()); ok && found && gateway.Address == data.GetSender() {
+		return im.executeGeneralMessage(ctx, packet, data, msg, contract, relayer)
+	}
+
+	// let the next layer deal with this
+	// the rest of the data fields should be normal
+	// we throw out the rest of the msg.Payload fields here, for better or worse
+	data.Memo = string(msg.Payload)
+	var dataBytes []byte
+	if dataBytes, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
+	}
+	packet.Data = dataBytes
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// executeGeneralMessage receives the funds of a general message on the intermediate sender of its source
+// and executes the message on the receiving contract with them.
+func (im IBCMiddleware) executeGeneralMessage(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketData,
+	msg Message,
+	contract sdk.AccAddress,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	// The funds are hijacked to the intermediate sender, which sends them along the execution
+	data.Receiver = gmptypes.IntermediateSender(packet.GetDestChannel(), msg.SourceChain, msg.SourceAddress).String()
+	dataBytes, err := types.ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
 	}
+	packet.Data = dataBytes
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := math.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket
+		return utils.NewEmitErrorAcknowledgement(ctx, gmptypes.ErrInvalidGeneralMessage, "amount is not an int")
+	}
+	funds := sdk.NewCoins(sdk.NewCoin(utils.MustExtractDenomFromPacketOnRecv(packet), amount))
+
+	res, err := im.keeper.ExecuteGeneralMessage(ctx, packet.GetDestChannel(), contract, msg, funds)
+	if err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	bz, err := json.Marshal(gmptypes.ContractAck{ContractResult: res, IbcAck: ack.Acknowledgement()})
+	if err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
 }
 
 // OnAcknowledgementPacket implements the IBCMiddleware interface
@@ -134,7 +188,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), acknowledgement)
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCMiddleware interface
@@ -143,5 +202,10 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	return nil
 }
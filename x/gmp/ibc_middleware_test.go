@@ -0,0 +1,318 @@
+package gmp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/app/params"
+	"github.com/maany-xyz/maany-dex/v5/testutil"
+	contractmanagertypes "github.com/maany-xyz/maany-dex/v5/x/contractmanager/types"
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+)
+
+const (
+	sourceChain   = "ethereum"
+	sourceAddress = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+)
+
+type GMPTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestGMPTestSuite(t *testing.T) {
+	suite.Run(t, new(GMPTestSuite))
+}
+
+// contractKeeperMock records the general messages executed on contracts
+type contractKeeperMock struct {
+	contract sdk.AccAddress
+	caller   sdk.AccAddress
+	msg      []byte
+	funds    sdk.Coins
+}
+
+func (m *contractKeeperMock) Execute(_ sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	m.contract, m.caller, m.msg, m.funds = contractAddress, caller, msg, coins
+	return []byte("executed"), nil
+}
+
+func (suite *GMPTestSuite) keeper() *keeper.Keeper {
+	return suite.GetNeutronZoneApp(suite.ChainA).GmpKeeper
+}
+
+// gateway returns the gateway trusted on the chain A end of the transfer channel
+func (suite *GMPTestSuite) gateway() types.Gateway {
+	return types.Gateway{
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+		Address:   suite.ChainB.SenderAccount.GetAddress().String(),
+	}
+}
+
+func (suite *GMPTestSuite) trustGateway() {
+	ctx := suite.ChainA.GetContext()
+	gmpParams := suite.keeper().GetParams(ctx)
+	gmpParams.Gateways = []types.Gateway{suite.gateway()}
+	suite.Require().NoError(suite.keeper().SetParams(ctx, gmpParams))
+}
+
+func generalMessageMemo(msgType int64) string {
+	bz, _ := json.Marshal(types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte("payload"),
+		Type:          msgType,
+	})
+	return string(bz)
+}
+
+// receivePacket sends a transfer of 1 stake from the chain B sender to the chain A receiver and returns its ack
+func (suite *GMPTestSuite) receivePacket(receiver, memo string) []byte {
+	packetData := transfertypes.FungibleTokenPacketData{
+		Denom:    sdk.DefaultBondDenom,
+		Amount:   "1",
+		Sender:   suite.ChainB.SenderAccount.GetAddress().String(),
+		Receiver: receiver,
+		Memo:     memo,
+	}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		1,
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		clienttypes.NewHeight(0, 150),
+		0,
+	)
+
+	channelCap := suite.ChainB.GetChannelCapability(suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID)
+	_, err := suite.GetNeutronZoneApp(suite.ChainB).HooksICS4Wrapper.SendPacket(
+		suite.ChainB.GetContext(), channelCap, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+
+	res, err := suite.TransferPath.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return ack
+}
+
+func (suite *GMPTestSuite) receivedDenom() string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+}
+
+func (suite *GMPTestSuite) TestUntrustedGeneralMessageIsNotExecuted() {
+	suite.ConfigureTransferChannel()
+	receiver := suite.ChainA.SenderAccount.GetAddress()
+	contractKeeper := &contractKeeperMock{}
+	suite.keeper().SetContractKeeper(contractKeeper)
+
+	// no gateway is trusted on the channel, so the message is a transfer with its payload as memo
+	ack := suite.receivePacket(receiver.String(), generalMessageMemo(types.TypeGeneralMessageWithToken))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+	suite.Require().Nil(contractKeeper.msg)
+
+	balance := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), receiver, suite.receivedDenom())
+	suite.Require().Equal(int64(1), balance.Amount.Int64())
+}
+
+func (suite *GMPTestSuite) TestUntrustedGeneralMessageIsNotExecutedOnContract() {
+	suite.ConfigureTransferChannel()
+	contractKeeper := &contractKeeperMock{}
+	suite.keeper().SetContractKeeper(contractKeeper)
+
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../ibc-hooks/bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	ack := suite.receivePacket(contract.String(), generalMessageMemo(types.TypeGeneralMessageWithToken))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+	suite.Require().Nil(contractKeeper.msg)
+}
+
+func (suite *GMPTestSuite) TestTrustedGeneralMessageWithInvalidType() {
+	suite.ConfigureTransferChannel()
+	suite.trustGateway()
+
+	ack := suite.receivePacket(suite.ChainA.SenderAccount.GetAddress().String(), generalMessageMemo(3))
+	suite.Require().True(utils.IsAckError(ack), string(ack))
+}
+
+func (suite *GMPTestSuite) TestTrustedGeneralMessageIsExecutedOnContract() {
+	suite.ConfigureTransferChannel()
+	suite.trustGateway()
+	contractKeeper := &contractKeeperMock{}
+	suite.keeper().SetContractKeeper(contractKeeper)
+
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../ibc-hooks/bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	ack := suite.receivePacket(contract.String(), generalMessageMemo(types.TypeGeneralMessageWithToken))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+
+	var res channeltypes.Acknowledgement_Result
+	suite.Require().NoError(json.Unmarshal(ack, &res))
+	var contractAck types.ContractAck
+	suite.Require().NoError(json.Unmarshal(res.Result, &contractAck))
+	suite.Require().Equal([]byte("executed"), contractAck.ContractResult)
+
+	// the contract is called by the intermediate sender of the source with the verified source and the funds
+	caller := types.IntermediateSender(suite.TransferPath.EndpointA.ChannelID, sourceChain, sourceAddress)
+	suite.Require().Equal(contract, contractKeeper.contract)
+	suite.Require().Equal(caller, contractKeeper.caller)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.receivedDenom(), 1)), contractKeeper.funds)
+	suite.Require().JSONEq(
+		fmt.Sprintf(`{"execute_general_message":{"source_chain":%q,"source_address":%q,"payload":"cGF5bG9hZA=="}}`, sourceChain, sourceAddress),
+		string(contractKeeper.msg),
+	)
+	balance := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), caller, suite.receivedDenom())
+	suite.Require().Equal(int64(1), balance.Amount.Int64())
+}
+
+func (suite *GMPTestSuite) TestFundsAreReturnedOnFailedContractExecution() {
+	suite.ConfigureTransferChannel()
+	suite.trustGateway()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	suite.keeper().SetContractKeeper(app.ContractKeeper)
+
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../ibc-hooks/bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	// the echo contract does not handle general messages
+	ack := suite.receivePacket(contract.String(), generalMessageMemo(types.TypeGeneralMessage))
+	suite.Require().True(utils.IsAckError(ack), string(ack))
+
+	caller := types.IntermediateSender(suite.TransferPath.EndpointA.ChannelID, sourceChain, sourceAddress)
+	suite.Require().True(app.BankKeeper.GetBalance(suite.ChainA.GetContext(), caller, suite.receivedDenom()).IsZero())
+	suite.Require().True(app.BankKeeper.GetBalance(suite.ChainA.GetContext(), contract, suite.receivedDenom()).IsZero())
+}
+
+func (suite *GMPTestSuite) TestSendGeneralMessage() {
+	suite.ConfigureTransferChannel()
+	sender := suite.ChainA.SenderAccount.GetAddress()
+	token := sdk.NewInt64Coin(params.DefaultDenom, 100)
+	suite.FundAcc(sender, sdk.NewCoins(token))
+	msg := &types.MsgSendGeneralMessage{
+		Sender:             sender.String(),
+		SourceChannel:      suite.TransferPath.EndpointA.ChannelID,
+		DestinationChain:   sourceChain,
+		DestinationAddress: sourceAddress,
+		Payload:            []byte("payload"),
+		Type:               types.TypeGeneralMessageWithToken,
+		Token:              token,
+		GatewayFee:         &types.GatewayFee{Amount: "10", Recipient: "axelar1fee"},
+	}
+	msgServer := keeper.NewMsgServerImpl(*suite.keeper())
+
+	// the channel has no trusted gateway yet
+	_, err := msgServer.SendGeneralMessage(suite.ChainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, types.ErrUntrustedGateway)
+
+	suite.trustGateway()
+	ctx := suite.ChainA.GetContext()
+	res, err := msgServer.SendGeneralMessage(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Sequence)
+
+	outbound, found := suite.keeper().GetOutboundMessage(ctx, res.Channel, res.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(sourceAddress, outbound.DestinationAddress)
+
+	// the transfer is sent to the gateway with the general message memo
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+	suite.Require().NoError(err)
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+	suite.Require().Equal(suite.gateway().Address, data.Receiver)
+	var memo types.OutboundMemo
+	suite.Require().NoError(json.Unmarshal([]byte(data.Memo), &memo))
+	suite.Require().Equal(types.OutboundMemo{
+		DestinationChain:   sourceChain,
+		DestinationAddress: sourceAddress,
+		Payload:            []byte("payload"),
+		Type:               types.TypeGeneralMessageWithToken,
+		Fee:                &types.OutboundMemoFee{Amount: "10", Recipient: "axelar1fee"},
+	}, memo)
+
+	// the outbound message is removed once the packet is acknowledged
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	recvRes, err := suite.TransferPath.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.TransferPath.EndpointA.AcknowledgePacket(packet, ack))
+
+	_, found = suite.keeper().GetOutboundMessage(suite.ChainA.GetContext(), res.Channel, res.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *GMPTestSuite) TestContractSenderReceivesGeneralMessageAck() {
+	suite.ConfigureTransferChannel()
+	suite.trustGateway()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../ibc-hooks/bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	fee := app.FeeKeeper.GetMinFee(ctx)
+	token := sdk.NewInt64Coin(params.DefaultDenom, 100)
+	suite.FundAcc(contract, fee.Total().Add(token))
+
+	res, err := keeper.NewMsgServerImpl(*suite.keeper()).SendGeneralMessage(ctx, &types.MsgSendGeneralMessage{
+		Sender:             contract.String(),
+		SourceChannel:      suite.TransferPath.EndpointA.ChannelID,
+		DestinationChain:   sourceChain,
+		DestinationAddress: sourceAddress,
+		Payload:            []byte("payload"),
+		Type:               types.TypeGeneralMessageWithToken,
+		Token:              token,
+		Fee:                fee,
+	})
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	recvRes, err := suite.TransferPath.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.TransferPath.EndpointA.AcknowledgePacket(packet, ack))
+
+	// the contract is called back with the ack result of the packet. The echo contract has no sudo
+	// entry point, so the callback is kept as a failure the contract can resubmit
+	failure, err := app.ContractManagerKeeper.GetFailure(suite.ChainA.GetContext(), contract, 0)
+	suite.Require().NoError(err)
+	var callback contractmanagertypes.MessageSudoCallback
+	suite.Require().NoError(json.Unmarshal(failure.SudoPayload, &callback))
+	suite.Require().NotNil(callback.Response)
+	suite.Require().Equal(res.Sequence, callback.Response.Request.Sequence)
+	var ibcAck channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &ibcAck))
+	suite.Require().Equal(ibcAck.GetResult(), callback.Response.Data)
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+	transfertypes "github.com/maany-xyz/maany-dex/v5/x/transfer/types"
+)
+
+// SendGeneralMessage sends a general message to the trusted gateway of a channel with an ICS20 transfer
+// of the message token. The message is kept until the packet is acknowledged or timed out.
+func (k Keeper) SendGeneralMessage(ctx sdk.Context, msg *types.MsgSendGeneralMessage) (*transfertypes.MsgTransferResponse, error) {
+	params := k.GetParams(ctx)
+	gateway, found := params.Gateway(msg.SourceChannel)
+	if !found {
+		return nil, errors.Wrapf(types.ErrUntrustedGateway, "channel %s", msg.SourceChannel)
+	}
+
+	memo := types.OutboundMemo{
+		DestinationChain:   msg.DestinationChain,
+		DestinationAddress: msg.DestinationAddress,
+		Payload:            msg.Payload,
+		Type:               msg.Type,
+	}
+	if msg.GatewayFee != nil {
+		memo.Fee = &types.OutboundMemoFee{
+			Amount:    msg.GatewayFee.Amount,
+			Recipient: msg.GatewayFee.Recipient,
+		}
+	}
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal general message memo")
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(params.DefaultTimeout).UnixNano())
+	}
+
+	resp, err := k.transferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    msg.SourceChannel,
+		Token:            msg.Token,
+		Sender:           msg.Sender,
+		Receiver:         gateway.Address,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             string(bz),
+		Fee:              msg.Fee,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transfer general message")
+	}
+
+	k.SetOutboundMessage(ctx, types.OutboundMessage{
+		Sender:             msg.Sender,
+		ChannelId:          resp.Channel,
+		Sequence:           resp.SequenceId,
+		DestinationChain:   msg.DestinationChain,
+		DestinationAddress: msg.DestinationAddress,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendGeneralMessage,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, resp.Channel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(resp.SequenceId, 10)),
+		sdk.NewAttribute(types.AttributeKeyDestinationChain, msg.DestinationChain),
+		sdk.NewAttribute(types.AttributeKeyDestinationAddress, msg.DestinationAddress),
+	))
+
+	return resp, nil
+}
+
+// IsContract checks whether the receiver of a general message is a contract
+func (k Keeper) IsContract(ctx sdk.Context, receiver string) (sdk.AccAddress, bool) {
+	addr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, false
+	}
+	return addr, k.wasmKeeper.HasContractInfo(ctx, addr)
+}
+
+// ExecuteGeneralMessage executes a general message received from the trusted gateway of a channel on a
+// contract, passing the verified source chain and address of the message. The contract is called by the
+// intermediate sender of the message source, which sends the funds received with the message along.
+func (k Keeper) ExecuteGeneralMessage(
+	ctx sdk.Context,
+	channelID string,
+	contract sdk.AccAddress,
+	msg types.Message,
+	funds sdk.Coins,
+) ([]byte, error) {
+	if k.contractKeeper == nil {
+		return nil, errors.Wrap(types.ErrContractExecution, "contract keeper is not set")
+	}
+
+	bz, err := json.Marshal(types.ExecuteGeneralMessage{
+		ExecuteGeneralMessage: types.GeneralMessage{
+			SourceChain:   msg.SourceChain,
+			SourceAddress: msg.SourceAddress,
+			Payload:       msg.Payload,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal general message")
+	}
+
+	caller := types.IntermediateSender(channelID, msg.SourceChain, msg.SourceAddress)
+	res, err := k.contractKeeper.Execute(ctx, contract, caller, bz, funds)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrContractExecution, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteGeneralMessage,
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySourceChain, msg.SourceChain),
+		sdk.NewAttribute(types.AttributeKeySourceAddress, msg.SourceAddress),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+	))
+
+	return res, nil
+}
+
+// OnAcknowledgementPacket removes the outbound message sent in an acknowledged packet
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, channelID string, sequence uint64, acknowledgement []byte) {
+	msg, found := k.GetOutboundMessage(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemoveOutboundMessage(ctx, channelID, sequence)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGeneralMessageAck,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(!utils.IsAckError(acknowledgement))),
+	))
+}
+
+// OnTimeoutPacket removes the outbound message sent in a timed out packet
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	msg, found := k.GetOutboundMessage(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemoveOutboundMessage(ctx, channelID, sequence)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGeneralMessageTimeout,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+	))
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) OutboundMessages(goCtx context.Context, req *types.QueryOutboundMessagesRequest) (*types.QueryOutboundMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutboundMessageKey)
+	msgs := make([]types.OutboundMessage, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var msg types.OutboundMessage
+		if err := k.cdc.Unmarshal(value, &msg); err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutboundMessagesResponse{OutboundMessages: msgs, Pagination: pageRes}, nil
+}
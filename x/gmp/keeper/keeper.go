@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+
+		wasmKeeper     types.WasmKeeper
+		contractKeeper types.ContractKeeper
+		transferKeeper types.TransferKeeper
+		authority      string
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	wasmKeeper types.WasmKeeper,
+	transferKeeper types.TransferKeeper,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		wasmKeeper:     wasmKeeper,
+		transferKeeper: transferKeeper,
+		authority:      authority,
+	}
+}
+
+// SetContractKeeper sets the keeper executing general messages on contracts, which is created
+// after the IBC transfer stack
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams updates the module parameters
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SendGeneralMessage sends a general message to a destination chain through a trusted gateway
+func (k msgServer) SendGeneralMessage(goCtx context.Context, req *types.MsgSendGeneralMessage) (*types.MsgSendGeneralMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSendGeneralMessage")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp, err := k.Keeper.SendGeneralMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendGeneralMessageResponse{Sequence: resp.SequenceId, Channel: resp.Channel}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+// GetOutboundMessage gets the outbound message sent in a packet
+func (k Keeper) GetOutboundMessage(ctx sdk.Context, channelID string, sequence uint64) (types.OutboundMessage, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOutboundMessageKey(channelID, sequence))
+	if bz == nil {
+		return types.OutboundMessage{}, false
+	}
+
+	var msg types.OutboundMessage
+	k.cdc.MustUnmarshal(bz, &msg)
+	return msg, true
+}
+
+// SetOutboundMessage sets an outbound message until its packet is acknowledged or timed out
+func (k Keeper) SetOutboundMessage(ctx sdk.Context, msg types.OutboundMessage) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOutboundMessageKey(msg.ChannelId, msg.Sequence), k.cdc.MustMarshal(&msg))
+}
+
+// RemoveOutboundMessage removes the outbound message sent in a packet
+func (k Keeper) RemoveOutboundMessage(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOutboundMessageKey(channelID, sequence))
+}
+
+// GetAllOutboundMessages returns all the outbound messages waiting for their packet to be acknowledged
+func (k Keeper) GetAllOutboundMessages(ctx sdk.Context) []types.OutboundMessage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutboundMessageKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	msgs := make([]types.OutboundMessage, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.OutboundMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &msg)
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
@@ -1,19 +1,14 @@
 package gmp
 
-// Message is attached in ICS20 packet memo field
-type Message struct {
-	SourceChain   string `json:"source_chain"`
-	SourceAddress string `json:"source_address"`
-	Payload       []byte `json:"payload"`
-	Type          int64  `json:"type"`
-}
+import "github.com/maany-xyz/maany-dex/v5/x/gmp/types"
 
-type MessageType int
+// Message is attached in ICS20 packet memo field
+type Message = types.Message
 
 const (
 	// TypeUnrecognized means coin type is unrecognized
-	TypeUnrecognized = iota
-	TypeGeneralMessage
+	TypeUnrecognized   = types.TypeUnrecognized
+	TypeGeneralMessage = types.TypeGeneralMessage
 	// TypeGeneralMessageWithToken is a general message with token
-	TypeGeneralMessageWithToken
+	TypeGeneralMessageWithToken = types.TypeGeneralMessageWithToken
 )
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/gmp module sentinel errors
+var (
+	ErrUntrustedGateway      = errors.Register(ModuleName, 1100, "channel has no trusted gateway")
+	ErrInvalidMessageType    = errors.Register(ModuleName, 1101, "invalid general message type")
+	ErrInvalidGeneralMessage = errors.Register(ModuleName, 1102, "invalid general message")
+	ErrContractExecution     = errors.Register(ModuleName, 1103, "failed to execute general message on contract")
+)
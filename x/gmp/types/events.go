@@ -0,0 +1,19 @@
+package types
+
+// gmp module event types
+const (
+	EventTypeSendGeneralMessage    = "send_general_message"
+	EventTypeExecuteGeneralMessage = "execute_general_message"
+	EventTypeGeneralMessageAck     = "general_message_ack"
+	EventTypeGeneralMessageTimeout = "general_message_timeout"
+
+	AttributeKeySender             = "sender"
+	AttributeKeyChannelID          = "channel_id"
+	AttributeKeySequence           = "sequence"
+	AttributeKeySourceChain        = "source_chain"
+	AttributeKeySourceAddress      = "source_address"
+	AttributeKeyDestinationChain   = "destination_chain"
+	AttributeKeyDestinationAddress = "destination_address"
+	AttributeKeyContract           = "contract"
+	AttributeKeySuccess            = "success"
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/maany-xyz/maany-dex/v5/x/transfer/types"
+)
+
+// TransferKeeper defines the expected interface needed to send general messages with transfers.
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// WasmKeeper defines the expected interface needed to find the contracts receiving general messages.
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
+
+// ContractKeeper defines the expected interface needed to execute general messages on contracts.
+type ContractKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
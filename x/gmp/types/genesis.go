@@ -0,0 +1,32 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.OutboundMessages))
+	for _, msg := range gs.OutboundMessages {
+		if err := msg.Validate(); err != nil {
+			return err
+		}
+		key := string(GetOutboundMessageKey(msg.ChannelId, msg.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate outbound message for channel %s and sequence %d", msg.ChannelId, msg.Sequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "gmp"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// SenderPrefix is the prefix of the intermediate accounts executing contracts on behalf of
+	// the sources of general messages
+	SenderPrefix = "gmp-intermediary"
+)
+
+const (
+	prefixParamsKey = iota + 1
+	prefixOutboundMessageKey
+)
+
+var (
+	ParamsKey          = []byte{prefixParamsKey}
+	OutboundMessageKey = []byte{prefixOutboundMessageKey}
+)
+
+// GetOutboundMessagePrefix returns the store prefix of the outbound messages sent through a channel
+func GetOutboundMessagePrefix(channelID string) []byte {
+	key := append([]byte{}, OutboundMessageKey...)
+	key = append(key, byte(len(channelID)))
+	return append(key, channelID...)
+}
+
+// GetOutboundMessageKey returns the store key of the outbound message sent in a packet
+func GetOutboundMessageKey(channelID string, sequence uint64) []byte {
+	return append(GetOutboundMessagePrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
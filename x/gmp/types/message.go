@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Message is attached in ICS20 packet memo field of the general messages received from a gateway
+type Message struct {
+	SourceChain   string `json:"source_chain"`
+	SourceAddress string `json:"source_address"`
+	Payload       []byte `json:"payload"`
+	Type          int64  `json:"type"`
+}
+
+// OutboundMemo is attached in ICS20 packet memo field of the general messages sent to a gateway
+type OutboundMemo struct {
+	DestinationChain   string           `json:"destination_chain"`
+	DestinationAddress string           `json:"destination_address"`
+	Payload            []byte           `json:"payload"`
+	Type               int64            `json:"type"`
+	Fee                *OutboundMemoFee `json:"fee,omitempty"`
+}
+
+// OutboundMemoFee is the gateway fee of an outbound memo
+type OutboundMemoFee struct {
+	Amount    string `json:"amount"`
+	Recipient string `json:"recipient"`
+}
+
+// ExecuteGeneralMessage is the message executed on a contract receiving a general message
+type ExecuteGeneralMessage struct {
+	ExecuteGeneralMessage GeneralMessage `json:"execute_general_message"`
+}
+
+// GeneralMessage passes the verified source of a general message to a contract
+type GeneralMessage struct {
+	SourceChain   string `json:"source_chain"`
+	SourceAddress string `json:"source_address"`
+	Payload       []byte `json:"payload"`
+}
+
+// ContractAck is the acknowledgement of a general message executed on a contract
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IbcAck         []byte `json:"ibc_ack"`
+}
+
+const (
+	// TypeUnrecognized means coin type is unrecognized
+	TypeUnrecognized = iota
+	TypeGeneralMessage
+	// TypeGeneralMessageWithToken is a general message with token
+	TypeGeneralMessageWithToken
+)
+
+// ValidateMessageType checks that a message type is a general message, with or without token
+func ValidateMessageType(msgType int64) error {
+	if msgType != TypeGeneralMessage && msgType != TypeGeneralMessageWithToken {
+		return fmt.Errorf("%w: %d", ErrInvalidMessageType, msgType)
+	}
+	return nil
+}
+
+// Validate validates an outbound message
+func (m OutboundMessage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid outbound message sender: %w", err)
+	}
+	if m.ChannelId == "" {
+		return fmt.Errorf("outbound message channel_id must not be empty")
+	}
+	if m.Sequence == 0 {
+		return fmt.Errorf("outbound message sequence must be positive")
+	}
+	return nil
+}
+
+// IntermediateSender returns the account executing contracts on behalf of the source of general
+// messages received through a channel. The funds transferred with the messages are received by it
+// and sent along the execution.
+func IntermediateSender(channelID, sourceChain, sourceAddress string) sdk.AccAddress {
+	return address.Hash(SenderPrefix, []byte(fmt.Sprintf("%s/%s/%s", channelID, sourceChain, sourceAddress)))
+}
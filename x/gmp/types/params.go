@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+)
+
+var DefaultTimeout = time.Hour
+
+// NewParams creates a new Params instance
+func NewParams(gateways []Gateway, defaultTimeout time.Duration) Params {
+	return Params{
+		Gateways:       gateways,
+		DefaultTimeout: defaultTimeout,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams([]Gateway{}, DefaultTimeout)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	channels := make(map[string]bool, len(p.Gateways))
+	for _, gateway := range p.Gateways {
+		if err := host.ChannelIdentifierValidator(gateway.ChannelId); err != nil {
+			return fmt.Errorf("invalid gateway channel_id: %w", err)
+		}
+		if gateway.Address == "" {
+			return fmt.Errorf("gateway address of channel %s must not be empty", gateway.ChannelId)
+		}
+		if channels[gateway.ChannelId] {
+			return fmt.Errorf("duplicate gateway for channel %s", gateway.ChannelId)
+		}
+		channels[gateway.ChannelId] = true
+	}
+
+	if p.DefaultTimeout <= 0 {
+		return fmt.Errorf("default_timeout must be positive")
+	}
+
+	return nil
+}
+
+// Gateway returns the trusted gateway of a channel
+func (p Params) Gateway(channelID string) (Gateway, bool) {
+	for _, gateway := range p.Gateways {
+		if gateway.ChannelId == channelID {
+			return gateway, true
+		}
+	}
+	return Gateway{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
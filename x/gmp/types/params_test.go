@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/gmp/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	gateway := types.Gateway{ChannelId: "channel-0", Address: "axelar1gateway"}
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(p *types.Params)
+		errMsg string
+	}{
+		{
+			desc:   "default params",
+			modify: func(_ *types.Params) {},
+		},
+		{
+			desc:   "trusted gateway",
+			modify: func(p *types.Params) { p.Gateways = []types.Gateway{gateway} },
+		},
+		{
+			desc:   "invalid gateway channel",
+			modify: func(p *types.Params) { p.Gateways = []types.Gateway{{ChannelId: "0", Address: gateway.Address}} },
+			errMsg: "invalid gateway channel_id",
+		},
+		{
+			desc:   "empty gateway address",
+			modify: func(p *types.Params) { p.Gateways = []types.Gateway{{ChannelId: gateway.ChannelId}} },
+			errMsg: "gateway address of channel channel-0 must not be empty",
+		},
+		{
+			desc:   "duplicate gateway channel",
+			modify: func(p *types.Params) { p.Gateways = []types.Gateway{gateway, gateway} },
+			errMsg: "duplicate gateway for channel channel-0",
+		},
+		{
+			desc:   "zero default timeout",
+			modify: func(p *types.Params) { p.DefaultTimeout = 0 },
+			errMsg: "default_timeout must be positive",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSendGeneralMessage{}
+)
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg *MsgSendGeneralMessage) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSendGeneralMessage) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSendGeneralMessage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "source_channel is invalid")
+	}
+	if msg.DestinationChain == "" {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "destination_chain must not be empty")
+	}
+	if msg.DestinationAddress == "" {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "destination_address must not be empty")
+	}
+	if len(msg.Payload) == 0 {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "payload must not be empty")
+	}
+	if err := ValidateMessageType(msg.Type); err != nil {
+		return err
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token %s must be positive", msg.Token)
+	}
+
+	if msg.GatewayFee != nil {
+		amount, ok := math.NewIntFromString(msg.GatewayFee.Amount)
+		if !ok || !amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidGeneralMessage, "gateway_fee amount %s must be a positive integer", msg.GatewayFee.Amount)
+		}
+		if amount.GT(msg.Token.Amount) {
+			return errorsmod.Wrap(ErrInvalidGeneralMessage, "gateway_fee amount must not exceed the token amount")
+		}
+		if msg.GatewayFee.Recipient == "" {
+			return errorsmod.Wrap(ErrInvalidGeneralMessage, "gateway_fee recipient must not be empty")
+		}
+	}
+
+	return nil
+}
@@ -12,13 +12,18 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/maany-xyz/maany-dex/v5/app/params"
 	"github.com/maany-xyz/maany-dex/v5/testutil"
+	crontypes "github.com/maany-xyz/maany-dex/v5/x/cron/types"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
 	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/testutils"
+	hookstypes "github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/types"
 	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+	transferwrappertypes "github.com/maany-xyz/maany-dex/v5/x/transfer/types"
 
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
@@ -287,6 +292,193 @@ func (suite *HooksTestSuite) TestPacketsThatShouldBeSkipped() {
 	}
 }
 
+// sendTransferWithMemo sends an ICS-20 transfer of 1 token from the sender on chain A to chain B and returns the sent packet.
+// The sender is funded with the token and the relayer fees contracts pay.
+func (suite *HooksTestSuite) sendTransferWithMemo(sender sdk.AccAddress, memo string, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	token := sdk.NewInt64Coin(params.DefaultDenom, 1)
+	fee := app.FeeKeeper.GetMinFee(ctx)
+	suite.FundAcc(sender, fee.Total().Add(token))
+
+	ctx = suite.ChainA.GetContext()
+	_, err := app.TransferKeeper.Transfer(ctx, &transferwrappertypes.MsgTransfer{
+		SourcePort:    suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		SourceChannel: suite.TransferPath.EndpointA.ChannelID,
+		Token:         token,
+		Sender:        sender.String(),
+		Receiver:      suite.ChainB.SenderAccount.GetAddress().String(),
+		TimeoutHeight: timeoutHeight,
+		Memo:          memo,
+		Fee:           fee,
+	})
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	return ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+}
+
+// lastContractFailure returns the sudo payload of the last failure recorded for the contract
+func (suite *HooksTestSuite) lastContractFailure(contract sdk.AccAddress) string {
+	var payload string
+	for _, failure := range suite.GetNeutronZoneApp(suite.ChainA).ContractManagerKeeper.GetAllFailures(suite.ChainA.GetContext()) {
+		if failure.Address == contract.String() {
+			payload = string(failure.SudoPayload)
+		}
+	}
+	return payload
+}
+
+func (suite *HooksTestSuite) instantiateEchoContract() sdk.AccAddress {
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "./bytecode/echo.wasm")
+	return suite.InstantiateTestContract(ctx, owner, codeID)
+}
+
+func (suite *HooksTestSuite) TestCallbackIsCalledOnAck() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	contract := suite.instantiateEchoContract()
+
+	packet, err := suite.sendTransferWithMemo(contract, fmt.Sprintf(`{"ibc_callback":%q,"other":"value"}`, contract.String()), clienttypes.NewHeight(1, 110))
+	suite.Require().NoError(err)
+
+	// the callback is registered and removed from the memo sent to the counterparty
+	channel := suite.TransferPath.EndpointA.ChannelID
+	suite.Require().Equal(contract.String(), app.IBCHooksKeeper.GetPacketCallback(suite.ChainA.GetContext(), channel, packet.GetSequence()))
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+	suite.Require().JSONEq(`{"other":"value"}`, data.Memo)
+
+	suite.Coordinator.CommitBlock(suite.ChainA)
+	_, ack := suite.RelayPacket(packet, AtoB)
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+
+	// the echo contract has no sudo handler, so the callback failure is recorded without blocking the ack
+	suite.Require().Empty(app.IBCHooksKeeper.GetPacketCallback(suite.ChainA.GetContext(), channel, packet.GetSequence()))
+	suite.Require().JSONEq(
+		fmt.Sprintf(
+			`{"ibc_lifecycle_complete":{"ibc_ack":{"channel":%q,"sequence":%d,"ack":%q,"success":true,"sender":%q,"receiver":%q,"denom":%q,"amount":"1"}}}`,
+			channel, packet.GetSequence(), ack, contract.String(), data.Receiver, params.DefaultDenom,
+		),
+		suite.lastContractFailure(contract),
+	)
+}
+
+func (suite *HooksTestSuite) TestCallbackIsCalledOnTimeout() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	contract := suite.instantiateEchoContract()
+
+	// the transfer is sent on behalf of the contract by a cron schedule
+	cron := authtypes.NewModuleAddress(crontypes.ModuleName)
+	timeoutHeight := clienttypes.GetSelfHeight(suite.ChainB.GetContext()).Increment().(clienttypes.Height)
+	packet, err := suite.sendTransferWithMemo(cron, fmt.Sprintf(`{"ibc_callback":%q}`, contract.String()), timeoutHeight)
+	suite.Require().NoError(err)
+
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+	suite.Require().Empty(data.Memo)
+
+	// let the packet time out on chain B
+	suite.Coordinator.CommitNBlocks(suite.ChainB, 2)
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointA.TimeoutPacket(packet))
+
+	channel := suite.TransferPath.EndpointA.ChannelID
+	suite.Require().Empty(app.IBCHooksKeeper.GetPacketCallback(suite.ChainA.GetContext(), channel, packet.GetSequence()))
+	suite.Require().JSONEq(
+		fmt.Sprintf(
+			`{"ibc_lifecycle_complete":{"ibc_timeout":{"channel":%q,"sequence":%d,"sender":%q,"receiver":%q,"denom":%q,"amount":"1"}}}`,
+			channel, packet.GetSequence(), cron.String(), data.Receiver, params.DefaultDenom,
+		),
+		suite.lastContractFailure(contract),
+	)
+}
+
+func (suite *HooksTestSuite) TestInvalidCallbackIsRejected() {
+	suite.ConfigureTransferChannel()
+	sender := suite.ChainA.SenderAccount.GetAddress()
+
+	for _, memo := range []string{
+		`{"ibc_callback": 1}`,
+		`{"ibc_callback": "not an address"}`,
+		// not a contract
+		fmt.Sprintf(`{"ibc_callback": %q}`, sender.String()),
+	} {
+		_, err := suite.sendTransferWithMemo(sender, memo, clienttypes.NewHeight(1, 110))
+		suite.Require().ErrorIs(err, hookstypes.ErrMsgValidation, memo)
+	}
+}
+
+func (suite *HooksTestSuite) TestThirdPartyCallbackIsIgnored() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	contract := suite.instantiateEchoContract()
+	other := suite.instantiateEchoContract()
+	channel := suite.TransferPath.EndpointA.ChannelID
+
+	// neither an account nor another contract can register a callback for a contract that did not send the
+	// transfer, the callback is dropped and the transfer is sent without it
+	for _, sender := range []sdk.AccAddress{suite.ChainA.SenderAccount.GetAddress(), other} {
+		packet, err := suite.sendTransferWithMemo(sender, fmt.Sprintf(`{"ibc_callback":%q,"other":"value"}`, contract.String()), clienttypes.NewHeight(1, 110))
+		suite.Require().NoError(err)
+
+		var data transfertypes.FungibleTokenPacketData
+		suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+		suite.Require().JSONEq(`{"other":"value"}`, data.Memo)
+		suite.Require().Empty(app.IBCHooksKeeper.GetPacketCallback(suite.ChainA.GetContext(), channel, packet.GetSequence()))
+	}
+}
+
+func (suite *HooksTestSuite) TestForwardWithCallbackIsSent() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	contract := suite.instantiateEchoContract()
+	channel := suite.TransferPath.EndpointA.ChannelID
+
+	// a transfer from chain B forwarded back to it by the packet forward middleware of chain A, with a callback
+	// in the memo of the forward
+	forwardReceiver := suite.ChainB.SenderAccount.GetAddress().String()
+	memo := fmt.Sprintf(`{"forward":{"receiver":%q,"port":"transfer","channel":%q,"next":{"ibc_callback":%q}}}`, forwardReceiver, channel, contract.String())
+	packet := suite.makeMockPacket(suite.ChainA.SenderAccount.GetAddress().String(), memo, 0)
+	// the mock packet is sent without escrowing its token on chain B, which the returning forward unescrows
+	appB, ctxB := suite.GetNeutronZoneApp(suite.ChainB), suite.ChainB.GetContext()
+	escrowed := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)
+	suite.Require().NoError(appB.BankKeeper.MintCoins(ctxB, gammtypes.ModuleName, sdk.NewCoins(escrowed)))
+	escrow := transfertypes.GetEscrowAddress(suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID)
+	suite.Require().NoError(appB.BankKeeper.SendCoinsFromModuleToAccount(ctxB, gammtypes.ModuleName, escrow, sdk.NewCoins(escrowed)))
+	appB.TransferKeeper.SetTotalEscrowForDenom(ctxB, escrowed)
+	channelCap := suite.ChainB.GetChannelCapability(suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID)
+	_, err := suite.GetNeutronZoneApp(suite.ChainB).HooksICS4Wrapper.SendPacket(
+		suite.ChainB.GetContext(), channelCap, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+
+	res, err := suite.TransferPath.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the forward is sent from the PFM intermediate account rather than refunded, without the callback
+	forward, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(forward.GetData(), &data))
+	suite.Require().Equal(forwardReceiver, data.Receiver)
+	suite.Require().NotEqual(contract.String(), data.Sender)
+	suite.Require().Empty(data.Memo)
+	suite.Require().Empty(app.IBCHooksKeeper.GetPacketCallback(suite.ChainA.GetContext(), channel, forward.GetSequence()))
+
+	// the forward is delivered on chain B
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	res, err = suite.TransferPath.EndpointB.RecvPacketWithResult(forward)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+}
+
 type Direction int64
 
 const (
@@ -42,7 +42,7 @@ func (i ICS4Middleware) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.
 	packet := channeltypes.NewPacket(data, sequence, sourcePort, sourceChannel,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId, timeoutHeight, timeoutTimestamp)
 	if hook, ok := i.Hooks.(SendPacketOverrideHooks); ok {
-		return 0, hook.SendPacketOverride(i, ctx, channelCap, packet)
+		return hook.SendPacketOverride(i, ctx, channelCap, packet)
 	}
 
 	if hook, ok := i.Hooks.(SendPacketBeforeHooks); ok {
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/types"
+)
+
+// Keeper stores the contracts that asked to be notified about the outcome of outgoing ICS-20 packets
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+func NewKeeper(storeKey storetypes.StoreKey) *Keeper {
+	return &Keeper{
+		storeKey: storeKey,
+	}
+}
+
+// StorePacketCallback registers the contract to be called back when the packet is acknowledged or timed out
+func (k Keeper) StorePacketCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPacketCallbackKey(channel, packetSequence), []byte(contract))
+}
+
+// GetPacketCallback returns the contract registered for the packet, or an empty string if there is none
+func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.GetPacketCallbackKey(channel, packetSequence)))
+}
+
+// DeletePacketCallback removes the contract registered for the packet
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPacketCallbackKey(channel, packetSequence))
+}
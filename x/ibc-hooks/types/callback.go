@@ -0,0 +1,32 @@
+package types
+
+// IBCLifecycleCompleteMsg is the sudo message sent to the contract registered with the `ibc_callback` memo key
+// once the outgoing packet is either acknowledged or timed out.
+type IBCLifecycleCompleteMsg struct {
+	IBCLifecycleComplete IBCLifecycleComplete `json:"ibc_lifecycle_complete"`
+}
+
+type IBCLifecycleComplete struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      string `json:"ack"`
+	Success  bool   `json:"success"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+}
+
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+}
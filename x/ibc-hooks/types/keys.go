@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	ModuleName     = "ibchooks"
 	RouteKey       = ModuleName
@@ -7,3 +9,14 @@ const (
 	IBCCallbackKey = "ibc_callback"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
 )
+
+const (
+	prefixPacketCallback = iota + 1
+)
+
+var PacketCallbackKey = []byte{prefixPacketCallback}
+
+// GetPacketCallbackKey returns the store key of the callback contract registered for an outgoing packet
+func GetPacketCallbackKey(channel string, packetSequence uint64) []byte {
+	return append(PacketCallbackKey, []byte(fmt.Sprintf("%s::%d", channel, packetSequence))...)
+}
@@ -4,15 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/keeper"
 	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
@@ -26,13 +30,27 @@ type ContractAck struct {
 
 type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
+	ibcHooksKeeper      *keeper.Keeper
+	sudoKeeper          types.SudoKeeper
 	bech32PrefixAccAddr string
+	// callbackRelayers are the module accounts sending transfers on behalf of contracts, which can register
+	// an `ibc_callback` for another contract than themselves
+	callbackRelayers []sdk.AccAddress
 }
 
-func NewWasmHooks(contractKeeper *wasmkeeper.Keeper, bech32PrefixAccAddr string) WasmHooks {
+func NewWasmHooks(
+	ibcHooksKeeper *keeper.Keeper,
+	contractKeeper *wasmkeeper.Keeper,
+	sudoKeeper types.SudoKeeper,
+	bech32PrefixAccAddr string,
+	callbackRelayers []sdk.AccAddress,
+) WasmHooks {
 	return WasmHooks{
 		ContractKeeper:      contractKeeper,
+		ibcHooksKeeper:      ibcHooksKeeper,
+		sudoKeeper:          sudoKeeper,
 		bech32PrefixAccAddr: bech32PrefixAccAddr,
+		callbackRelayers:    callbackRelayers,
 	}
 }
 
@@ -40,6 +58,11 @@ func (h WasmHooks) ProperlyConfigured() bool {
 	return h.ContractKeeper != nil
 }
 
+// CallbacksConfigured returns true if the hooks are able to register and deliver `ibc_callback` callbacks
+func (h WasmHooks) CallbacksConfigured() bool {
+	return h.ibcHooksKeeper != nil && h.sudoKeeper != nil
+}
+
 func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
 	if !h.ProperlyConfigured() {
 		// Not configured
@@ -121,6 +144,155 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// SendPacketOverride registers the contract set in the `ibc_callback` key of an outgoing ICS-20 packet's memo,
+// so that it gets notified once the packet is acknowledged or timed out. The callback is only honoured when the
+// contract sends the packet itself or when the packet is sent by a callback relayer (PFM, cron), so that no one
+// can make a contract believe it triggered a transfer. The `ibc_callback` key is removed from the memo before
+// the packet is sent.
+func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) (uint64, error) {
+	timeoutHeight := clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight())
+	send := func(data []byte) (uint64, error) {
+		return i.channel.SendPacket(ctx, chanCap, packet.GetSourcePort(), packet.GetSourceChannel(), timeoutHeight, packet.GetTimeoutTimestamp(), data)
+	}
+
+	if !h.CallbacksConfigured() {
+		return send(packet.GetData())
+	}
+	concretePacket, ok := packet.(channeltypes.Packet)
+	if !ok {
+		return send(packet.GetData())
+	}
+	isIcs20, data := isIcs20Packet(concretePacket)
+	if !isIcs20 {
+		return send(packet.GetData())
+	}
+
+	isCallbackRouted, metadata := jsonStringHasKey(data.GetMemo(), types.IBCCallbackKey)
+	if !isCallbackRouted {
+		return send(packet.GetData())
+	}
+
+	contractAddr, err := validateCallbackContract(metadata[types.IBCCallbackKey])
+	if err != nil {
+		return 0, errors.Wrap(types.ErrMsgValidation, fmt.Sprintf(types.ErrBadMetadataFormatMsg, data.GetMemo(), err.Error()))
+	}
+	if !h.sudoKeeper.HasContractInfo(ctx, contractAddr) {
+		return 0, errors.Wrap(types.ErrMsgValidation, fmt.Sprintf(types.ErrBadMetadataFormatMsg, data.GetMemo(), "ibc_callback is not a contract"))
+	}
+	// Only the contract itself, or a callback relayer on its behalf, can register a callback for it. The callback of
+	// other senders, e.g. the intermediate accounts the packet forward middleware forwards from, is dropped without
+	// failing their transfer.
+	registerCallback := data.GetSender() == contractAddr.String() || h.isCallbackRelayer(data.GetSender())
+
+	// The callback is handled on this chain, there is no need to forward it to the counterparty
+	delete(metadata, types.IBCCallbackKey)
+	data.Memo = ""
+	if len(metadata) > 0 {
+		memo, err := json.Marshal(metadata)
+		if err != nil {
+			return 0, errors.Wrap(types.ErrMarshaling, err.Error())
+		}
+		data.Memo = string(memo)
+	}
+
+	sequence, err := send(data.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	if registerCallback {
+		h.ibcHooksKeeper.StorePacketCallback(ctx, packet.GetSourceChannel(), sequence, contractAddr.String())
+	}
+	return sequence, nil
+}
+
+// OnAcknowledgementPacketOverride notifies the contract registered for the packet, if any, about its acknowledgement
+func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	_, data := isIcs20Packet(packet)
+	h.sudoPacketCallback(ctx, packet, types.IBCLifecycleComplete{IBCAck: &types.IBCAck{
+		Channel:  packet.GetSourceChannel(),
+		Sequence: packet.GetSequence(),
+		Ack:      string(acknowledgement),
+		Success:  !utils.IsAckError(acknowledgement),
+		Sender:   data.GetSender(),
+		Receiver: data.GetReceiver(),
+		Denom:    data.GetDenom(),
+		Amount:   data.GetAmount(),
+	}})
+	return nil
+}
+
+// OnTimeoutPacketOverride notifies the contract registered for the packet, if any, about its timeout
+func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.App.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	_, data := isIcs20Packet(packet)
+	h.sudoPacketCallback(ctx, packet, types.IBCLifecycleComplete{IBCTimeout: &types.IBCTimeout{
+		Channel:  packet.GetSourceChannel(),
+		Sequence: packet.GetSequence(),
+		Sender:   data.GetSender(),
+		Receiver: data.GetReceiver(),
+		Denom:    data.GetDenom(),
+		Amount:   data.GetAmount(),
+	}})
+	return nil
+}
+
+// sudoPacketCallback delivers the packet lifecycle result to the registered contract and removes the registration.
+// The sudo keeper limits the gas and records the failures, so an erroring contract never blocks the packet lifecycle.
+func (h WasmHooks) sudoPacketCallback(ctx sdk.Context, packet channeltypes.Packet, result types.IBCLifecycleComplete) {
+	if !h.CallbacksConfigured() {
+		return
+	}
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if contract == "" {
+		return
+	}
+	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil { // This should never happen, the address is validated when the callback is registered
+		ctx.Logger().Error("invalid ibc callback contract", "contract", contract, "error", err)
+		return
+	}
+	msg, err := json.Marshal(types.IBCLifecycleCompleteMsg{IBCLifecycleComplete: result})
+	if err != nil {
+		ctx.Logger().Error("failed to marshal ibc callback", "contract", contract, "error", err)
+		return
+	}
+	if _, err := h.sudoKeeper.Sudo(ctx, contractAddr, msg); err != nil {
+		ctx.Logger().Debug("ibc callback failed", "contract", contract, "channel", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err)
+	}
+}
+
+// isCallbackRelayer returns true if the sender is a module account sending transfers on behalf of contracts
+func (h WasmHooks) isCallbackRelayer(sender string) bool {
+	for _, relayer := range h.callbackRelayers {
+		if relayer.String() == sender {
+			return true
+		}
+	}
+	return false
+}
+
+func validateCallbackContract(callback interface{}) (sdk.AccAddress, error) {
+	contract, ok := callback.(string)
+	if !ok {
+		return nil, fmt.Errorf("ibc_callback is not a string")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, fmt.Errorf("ibc_callback is not a valid bech32 address")
+	}
+	return contractAddr, nil
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
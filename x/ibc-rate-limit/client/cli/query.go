@@ -23,9 +23,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParams(),
+		GetRateLimits(),
+		GetRateLimit(),
 	)
 
-	return nil
+	return cmd
 }
 
 // GetParams returns the params for the module
@@ -54,3 +56,69 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetRateLimits returns all rate limits of the module
+func GetRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "List all rate limits enforced by the x/ibc-rate-limit module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRateLimit returns a rate limit with the remaining capacity of its quotas
+func GetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [channel-id] [denom]",
+		Short: "Show a rate limit with the remaining capacity of its quotas, the channel rate limit if the denom is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{ChannelId: args[0]}
+			if len(args) == 2 {
+				req.Denom = args[1]
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
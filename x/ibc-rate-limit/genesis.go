@@ -7,17 +7,23 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address and the rate limits.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	err := i.IbcratelimitKeeper.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
 	}
+
+	for _, rateLimit := range genState.RateLimits {
+		i.IbcratelimitKeeper.SetRateLimit(ctx, rateLimit)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
+// The flows of the rate limits are not exported, so they start over from the genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:     i.GetParams(ctx),
+		RateLimits: i.IbcratelimitKeeper.GetAllRateLimits(ctx),
 	}
 }
@@ -135,6 +135,10 @@ func (im *IBCModule) OnRecvPacket(
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrBadMessage, err.Error())
 	}
 
+	if err := im.ics4Middleware.checkAndUpdateRecvFlow(ctx, packet); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
@@ -181,6 +185,9 @@ func (im *IBCModule) OnAcknowledgementPacket(
 				),
 			)
 		}
+	} else {
+		// the packet was delivered, its outflow is never undone
+		im.ics4Middleware.IbcratelimitKeeper.RemovePendingSend(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	}
 
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
@@ -206,11 +213,13 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket undoes the outflow of a sent packet that wasn't properly received, and notifies the contract
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
+	im.ics4Middleware.IbcratelimitKeeper.UndoSendFlow(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
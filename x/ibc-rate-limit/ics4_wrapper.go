@@ -52,10 +52,11 @@ func NewICS4Middleware(
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
-// the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// This method checks the transfer against the rate limits of the keeper and retrieves the contract from the
+// middleware's parameters to check if its limits have been exceeded for the current transfer, in which case it
+// returns an error preventing the IBC send from taking place.
+// If neither the keeper nor the contract have a rate limit for the (channel+denom) or channel being used, transfers
+// are not prevented and handled by the wrapped IBC app
 func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	var packetdata transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(data, &packetdata); err != nil {
@@ -64,30 +65,42 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 	if packetdata.Denom == "" || packetdata.Amount == "" {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
-	contract := i.GetContractAddress(ctx)
-	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+
+	pending, limited, err := i.checkAndUpdateSendFlow(ctx, sourcePort, sourceChannel, packetdata)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
 
-	// setting 0 as a default so it can be properly parsed by cosmwasm
-	fullPacket := channeltypes.Packet{
-		Sequence:           0,
-		SourcePort:         sourcePort,
-		SourceChannel:      sourceChannel,
-		DestinationPort:    "omitted",
-		DestinationChannel: "omitted",
-		Data:               data,
-		TimeoutTimestamp:   timeoutTimestamp,
-		TimeoutHeight:      timeoutHeight,
+	contract := i.GetContractAddress(ctx)
+	if contract != "" {
+		// setting 0 as a default so it can be properly parsed by cosmwasm
+		fullPacket := channeltypes.Packet{
+			Sequence:           0,
+			SourcePort:         sourcePort,
+			SourceChannel:      sourceChannel,
+			DestinationPort:    "omitted",
+			DestinationChannel: "omitted",
+			Data:               data,
+			TimeoutTimestamp:   timeoutTimestamp,
+			TimeoutHeight:      timeoutHeight,
+		}
+
+		err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, contract, fullPacket)
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+		}
 	}
 
-	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, contract, fullPacket)
+	sequence, err := i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	if err != nil {
-		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+		return 0, err
+	}
+	if limited {
+		// kept until the packet is acknowledged, so that the outflow can be undone if it fails
+		i.IbcratelimitKeeper.SetPendingSend(ctx, sourceChannel, sequence, pending)
 	}
 
-	return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	return sequence, nil
 }
 
 func (i *ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/types"
+)
+
+// flowUpdate is the amount a transfer adds to the flow of a quota once all quotas allow it.
+type flowUpdate struct {
+	rateLimit types.RateLimit
+	quota     types.Quota
+	flow      types.Flow
+	amount    math.Int
+}
+
+// CheckAndUpdateSendFlow returns an error if sending the amount of denom through the channel
+// exceeds a quota of the rate limit of the denom or of the channel, and counts the amount in
+// the flows of their quotas otherwise. burned is whether the transfer burned the amount, which
+// is then counted in the supply the denom quotas are a percentage of.
+// It returns the pending send to store once the packet has a sequence, and whether any rate
+// limit applies to the transfer.
+func (k Keeper) CheckAndUpdateSendFlow(ctx sdk.Context, channelID, denom string, amount math.Int, burned bool) (types.PendingSend, bool, error) {
+	value, applied, err := k.checkAndUpdateFlow(ctx, channelID, denom, amount, burned, true)
+	if err != nil || !applied {
+		return types.PendingSend{}, false, err
+	}
+
+	return types.PendingSend{
+		Denom:  denom,
+		Amount: amount,
+		Value:  value,
+		SentAt: ctx.BlockTime(),
+	}, true, nil
+}
+
+// CheckAndUpdateRecvFlow returns an error if receiving the amount of denom through the channel
+// exceeds a quota of the rate limit of the denom or of the channel, and counts the amount in
+// the flows of their quotas otherwise. minted is whether the transfer mints the amount, which is
+// then counted in the supply the denom quotas are a percentage of.
+func (k Keeper) CheckAndUpdateRecvFlow(ctx sdk.Context, channelID, denom string, amount math.Int, minted bool) error {
+	_, _, err := k.checkAndUpdateFlow(ctx, channelID, denom, amount, minted, false)
+	return err
+}
+
+// checkAndUpdateFlow checks the transfer against every quota before updating any flow, so that a
+// rejected transfer leaves all flows untouched. It returns the value of the amount in the quote
+// denom, and whether any rate limit applies to the transfer.
+func (k Keeper) checkAndUpdateFlow(ctx sdk.Context, channelID, denom string, amount math.Int, outsideSupply, send bool) (math.Int, bool, error) {
+	denomRateLimit, hasDenomRateLimit := k.GetRateLimit(ctx, channelID, denom)
+	channelRateLimit, hasChannelRateLimit := k.GetRateLimit(ctx, channelID, "")
+	if !hasDenomRateLimit && !hasChannelRateLimit {
+		return math.ZeroInt(), false, nil
+	}
+
+	var updates []flowUpdate
+	if hasDenomRateLimit {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if outsideSupply {
+			supply = supply.Add(amount)
+		}
+		denomUpdates, err := k.checkQuotas(ctx, denomRateLimit, supply, amount, send)
+		if err != nil {
+			return math.ZeroInt(), false, err
+		}
+		updates = append(updates, denomUpdates...)
+	}
+
+	value := math.ZeroInt()
+	if hasChannelRateLimit {
+		var err error
+		if value, err = k.valueInQuoteDenom(ctx, k.GetParams(ctx), denom, amount); err != nil {
+			return math.ZeroInt(), false, err
+		}
+		channelUpdates, err := k.checkQuotas(ctx, channelRateLimit, math.ZeroInt(), value, send)
+		if err != nil {
+			return math.ZeroInt(), false, err
+		}
+		updates = append(updates, channelUpdates...)
+	}
+
+	for _, update := range updates {
+		if update.amount.IsZero() {
+			continue
+		}
+		start := update.quota.BucketStart(ctx.BlockTime())
+		if send {
+			update.flow.Add(start, math.ZeroInt(), update.amount)
+		} else {
+			update.flow.Add(start, update.amount, math.ZeroInt())
+		}
+		k.setFlow(ctx, update.rateLimit, update.quota, update.flow)
+	}
+	return value, true, nil
+}
+
+// checkQuotas returns an error if the amount exceeds the remaining capacity of a quota of the
+// rate limit, and the flow updates of its quotas otherwise.
+func (k Keeper) checkQuotas(ctx sdk.Context, rateLimit types.RateLimit, channelValue, amount math.Int, send bool) ([]flowUpdate, error) {
+	updates := make([]flowUpdate, 0, len(rateLimit.Quotas))
+	for _, quota := range rateLimit.Quotas {
+		capacity := k.quotaCapacity(ctx, rateLimit, quota, channelValue)
+		if send && amount.GT(capacity.RemainingSend) {
+			return nil, types.ErrRateLimitExceeded.Wrapf("quota %s of %s allows sending %s more, got %s",
+				quota.Name, describeRateLimit(rateLimit.ChannelId, rateLimit.Denom), capacity.RemainingSend, amount)
+		}
+		if !send && amount.GT(capacity.RemainingRecv) {
+			return nil, types.ErrRateLimitExceeded.Wrapf("quota %s of %s allows receiving %s more, got %s",
+				quota.Name, describeRateLimit(rateLimit.ChannelId, rateLimit.Denom), capacity.RemainingRecv, amount)
+		}
+		updates = append(updates, flowUpdate{
+			rateLimit: rateLimit,
+			quota:     quota,
+			flow:      k.GetFlow(ctx, rateLimit, quota),
+			amount:    amount,
+		})
+	}
+	return updates, nil
+}
+
+// valueInQuoteDenom returns the value of the amount of denom in the quote denom, priced with the
+// TWAP of its price pool. Channel rate limits fail closed: a denom without a price pool or a TWAP
+// cannot be transferred through a channel with a channel rate limit.
+func (k Keeper) valueInQuoteDenom(ctx sdk.Context, params types.Params, denom string, amount math.Int) (math.Int, error) {
+	if denom == params.QuoteDenom {
+		return amount, nil
+	}
+	if !k.canPriceChannelValue(params) {
+		return math.Int{}, types.ErrUnpricedDenom.Wrap("no twap pricing is configured")
+	}
+
+	poolId, ok := params.PricePool(denom)
+	if !ok {
+		return math.Int{}, types.ErrUnpricedDenom.Wrapf("%s has no price pool", denom)
+	}
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, denom, params.QuoteDenom, ctx.BlockTime().Add(-params.TwapDuration))
+	if err != nil {
+		return math.Int{}, types.ErrUnpricedDenom.Wrapf("%s has no twap in pool %d: %s", denom, poolId, err)
+	}
+
+	return osmomath.BigDecFromSDKInt(amount).Mul(osmomath.BigDecFromDec(twap)).Dec().TruncateInt(), nil
+}
+
+// GetPendingSend returns the pending send of the packet sent through the channel with the sequence.
+func (k Keeper) GetPendingSend(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSend, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendKey)
+	bz := store.Get(types.GetPendingSendKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSend{}, false
+	}
+
+	var pending types.PendingSend
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// SetPendingSend stores the pending send of the packet sent through the channel with the sequence
+// until the packet is acknowledged or times out.
+func (k Keeper) SetPendingSend(ctx sdk.Context, channelID string, sequence uint64, pending types.PendingSend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendKey)
+	store.Set(types.GetPendingSendKey(channelID, sequence), k.cdc.MustMarshal(&pending))
+}
+
+// RemovePendingSend removes the pending send of a packet that was delivered.
+func (k Keeper) RemovePendingSend(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendKey)
+	store.Delete(types.GetPendingSendKey(channelID, sequence))
+}
+
+// UndoSendFlow removes the outflow of a packet that failed to be delivered from the flows of the
+// rate limits it was counted in. Outflow that already left the window of a quota is not removed.
+func (k Keeper) UndoSendFlow(ctx sdk.Context, channelID string, sequence uint64) {
+	pending, found := k.GetPendingSend(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemovePendingSend(ctx, channelID, sequence)
+
+	if rateLimit, found := k.GetRateLimit(ctx, channelID, pending.Denom); found {
+		k.undoOutflow(ctx, rateLimit, pending, pending.Amount)
+	}
+	if rateLimit, found := k.GetRateLimit(ctx, channelID, ""); found {
+		k.undoOutflow(ctx, rateLimit, pending, pending.Value)
+	}
+}
+
+func (k Keeper) undoOutflow(ctx sdk.Context, rateLimit types.RateLimit, pending types.PendingSend, amount math.Int) {
+	if amount.IsZero() {
+		return
+	}
+	for _, quota := range rateLimit.Quotas {
+		flow := k.GetFlow(ctx, rateLimit, quota)
+		flow.UndoOutflow(quota.BucketStart(pending.SentAt), amount)
+		k.setFlow(ctx, rateLimit, quota, flow)
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/types"
+)
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.RateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(value, &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "rate limit not found")
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit:  rateLimit,
+		Capacities: k.GetQuotaCapacities(ctx, rateLimit),
+	}, nil
+}
@@ -10,8 +10,10 @@ import (
 
 // Keeper of the globalfee store
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	twapKeeper types.TwapKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/adminmodule module account.
@@ -21,15 +23,29 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  key,
-		authority: authority,
+		cdc:        cdc,
+		storeKey:   key,
+		bankKeeper: bankKeeper,
+		authority:  authority,
 	}
 }
 
+// SetTwapKeeper sets the twap keeper used to price denoms for channel rate limits. It is set after
+// the keeper is created since the twap module is created after the IBC stack.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
+// canPriceChannelValue returns whether transfers can be valued for channel rate limits: it takes a
+// quote denom and a TWAP, the spot price of a pool can be moved within the transaction.
+func (k Keeper) canPriceChannelValue(params types.Params) bool {
+	return params.QuoteDenom != "" && params.TwapDuration > 0 && k.twapKeeper != nil
+}
+
 // GetAuthority returns the x/ibcratelimit module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
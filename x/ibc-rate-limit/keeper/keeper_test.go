@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/testutil/apptesting"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/types"
+)
+
+const (
+	channelID = "channel-0"
+	denom     = "uatom"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+	// the supply the denom quotas are a percentage of
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, transfertypes.ModuleName, coins))
+}
+
+func (suite *KeeperTestSuite) keeper() *keeper.Keeper {
+	return suite.App.RateLimitingICS4Wrapper.IbcratelimitKeeper
+}
+
+func newQuota(name string, duration time.Duration, maxSend, maxRecv int64) types.Quota {
+	return types.Quota{
+		Name:     name,
+		Duration: duration,
+		MaxSend:  math.LegacyNewDec(maxSend),
+		MaxRecv:  math.LegacyNewDec(maxRecv),
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomRateLimit() {
+	k := suite.keeper()
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: []types.Quota{newQuota("day", 24*time.Hour, 10, 20)}}
+	suite.Require().NoError(rateLimit.Validate())
+	k.SetRateLimit(suite.Ctx, rateLimit)
+
+	// 10% of the 10_000 supply can be sent
+	_, limited, err := k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(600), false)
+	suite.Require().NoError(err)
+	suite.Require().True(limited)
+	_, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(401), false)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	_, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(400), false)
+	suite.Require().NoError(err)
+
+	// received transfers offset the sent ones
+	suite.Require().NoError(k.CheckAndUpdateRecvFlow(suite.Ctx, channelID, denom, math.NewInt(300), false))
+	capacity := k.GetQuotaCapacities(suite.Ctx, rateLimit)[0]
+	suite.Require().Equal(math.NewInt(700), capacity.NetOutflow)
+	suite.Require().Equal(math.NewInt(300), capacity.RemainingSend)
+	suite.Require().Equal(math.NewInt(2_700), capacity.RemainingRecv)
+
+	// other denoms and channels are not limited
+	_, limited, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, "untrn", math.NewInt(1_000_000), false)
+	suite.Require().NoError(err)
+	suite.Require().False(limited)
+	_, limited, err = k.CheckAndUpdateSendFlow(suite.Ctx, "channel-1", denom, math.NewInt(1_000_000), false)
+	suite.Require().NoError(err)
+	suite.Require().False(limited)
+}
+
+func (suite *KeeperTestSuite) TestRollingWindow() {
+	k := suite.keeper()
+	quota := newQuota("day", 24*time.Hour, 10, 10)
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: []types.Quota{quota}}
+	k.SetRateLimit(suite.Ctx, rateLimit)
+
+	start := quota.BucketStart(suite.Ctx.BlockTime())
+	ctx := suite.Ctx.WithBlockTime(start)
+	_, _, err := k.CheckAndUpdateSendFlow(ctx, channelID, denom, math.NewInt(600), false)
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockTime(start.Add(12 * time.Hour))
+	_, _, err = k.CheckAndUpdateSendFlow(ctx, channelID, denom, math.NewInt(400), false)
+	suite.Require().NoError(err)
+
+	// the window slides by one bucket at a time rather than resetting at once
+	ctx = ctx.WithBlockTime(start.Add(24*time.Hour - time.Second))
+	suite.Require().Equal(math.ZeroInt(), k.GetQuotaCapacities(ctx, rateLimit)[0].RemainingSend)
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	suite.Require().Equal(math.NewInt(600), k.GetQuotaCapacities(ctx, rateLimit)[0].RemainingSend)
+	ctx = ctx.WithBlockTime(start.Add(36 * time.Hour))
+	suite.Require().Equal(math.NewInt(1_000), k.GetQuotaCapacities(ctx, rateLimit)[0].RemainingSend)
+}
+
+// createPricePool creates a balancer pool of a and b. The block time is set first, a twap record at
+// the zero time reads as a spot price error.
+func (suite *KeeperTestSuite) createPricePool(a, b sdk.Coin) uint64 {
+	suite.Ctx = suite.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	poolmanagerParams := suite.App.PoolManagerKeeper.GetParams(suite.Ctx)
+	poolmanagerParams.PoolCreationFee = sdk.NewCoins()
+	suite.App.PoolManagerKeeper.SetParams(suite.Ctx, poolmanagerParams)
+
+	creator := sdk.AccAddress("pool_creator")
+	coins := sdk.NewCoins(a, b)
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, gammtypes.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, gammtypes.ModuleName, creator, coins))
+	poolID, err := suite.App.PoolManagerKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: math.LegacyNewDecWithPrec(3, 3),
+		ExitFee: math.LegacyZeroDec(),
+	}, []balancer.PoolAsset{{Token: a, Weight: math.NewInt(1)}, {Token: b, Weight: math.NewInt(1)}}, ""))
+	suite.Require().NoError(err)
+	return poolID
+}
+
+func (suite *KeeperTestSuite) TestChannelRateLimit() {
+	k := suite.keeper()
+	poolID := suite.createPricePool(sdk.NewInt64Coin(denom, 1_000_000), sdk.NewInt64Coin("uusdc", 2_000_000))
+	params := k.GetParams(suite.Ctx)
+	params.QuoteDenom = "uusdc"
+	params.PricePools = []types.DenomPricePool{{Denom: denom, PoolId: poolID}}
+	params.TwapDuration = time.Hour
+	suite.Require().NoError(k.SetParams(suite.Ctx, params))
+
+	rateLimit := types.RateLimit{ChannelId: channelID, Quotas: []types.Quota{newQuota("day", 24*time.Hour, 1_000, 1_000)}}
+	suite.Require().NoError(rateLimit.Validate())
+	k.SetRateLimit(suite.Ctx, rateLimit)
+
+	pending, limited, err := k.CheckAndUpdateSendFlow(suite.Ctx, channelID, "uusdc", math.NewInt(800), false)
+	suite.Require().NoError(err)
+	suite.Require().True(limited)
+	suite.Require().Equal(math.NewInt(800), pending.Value)
+	_, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, "uusdc", math.NewInt(201), false)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// a denom is blocked until its price pool has a twap over the twap duration
+	_, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(50), false)
+	suite.Require().ErrorIs(err, types.ErrUnpricedDenom)
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	pending, _, err = k.CheckAndUpdateSendFlow(ctx, channelID, denom, math.NewInt(50), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), pending.Value)
+
+	// denoms without a price pool are blocked
+	_, _, err = k.CheckAndUpdateSendFlow(ctx, channelID, "uosmo", math.NewInt(1), false)
+	suite.Require().ErrorIs(err, types.ErrUnpricedDenom)
+	suite.Require().ErrorIs(k.CheckAndUpdateRecvFlow(ctx, channelID, "uosmo", math.NewInt(1), false), types.ErrUnpricedDenom)
+
+	// a denom rate limit on the same channel must also allow the transfer
+	k.SetRateLimit(ctx, types.RateLimit{ChannelId: channelID, Denom: "uusdc", Quotas: []types.Quota{newQuota("hour", time.Hour, 0, 100)}})
+	_, _, err = k.CheckAndUpdateSendFlow(ctx, channelID, "uusdc", math.NewInt(100), false)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	suite.Require().Equal(math.NewInt(100), k.GetQuotaCapacities(ctx, rateLimit)[0].RemainingSend)
+}
+
+func (suite *KeeperTestSuite) TestUndoSendFlow() {
+	k := suite.keeper()
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: []types.Quota{newQuota("day", 24*time.Hour, 10, 10)}}
+	k.SetRateLimit(suite.Ctx, rateLimit)
+
+	pending, _, err := k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(1_000), false)
+	suite.Require().NoError(err)
+	k.SetPendingSend(suite.Ctx, channelID, 1, pending)
+	pending, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.ZeroInt(), false)
+	suite.Require().NoError(err)
+	k.SetPendingSend(suite.Ctx, channelID, 2, pending)
+	suite.Require().True(k.GetQuotaCapacities(suite.Ctx, rateLimit)[0].RemainingSend.IsZero())
+
+	// a failed packet gives its outflow back, and only once
+	k.UndoSendFlow(suite.Ctx, channelID, 1)
+	k.UndoSendFlow(suite.Ctx, channelID, 1)
+	suite.Require().Equal(math.NewInt(1_000), k.GetQuotaCapacities(suite.Ctx, rateLimit)[0].RemainingSend)
+	_, found := k.GetPendingSend(suite.Ctx, channelID, 1)
+	suite.Require().False(found)
+
+	// a delivered packet keeps its outflow
+	k.RemovePendingSend(suite.Ctx, channelID, 2)
+	k.UndoSendFlow(suite.Ctx, channelID, 2)
+	suite.Require().Equal(math.NewInt(1_000), k.GetQuotaCapacities(suite.Ctx, rateLimit)[0].RemainingSend)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	k := suite.keeper()
+	msgServer := keeper.NewMsgServerImpl(*k)
+	authority := authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String()
+
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: []types.Quota{
+		newQuota("hour", time.Hour, 5, 5),
+		newQuota("day", 24*time.Hour, 10, 10),
+	}}
+	_, err := msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authtypes.NewModuleAddress("other").String(), RateLimit: rateLimit})
+	suite.Require().ErrorContains(err, "invalid authority")
+	_, err = msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: types.RateLimit{ChannelId: channelID, Denom: denom}})
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+	_, err = msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: rateLimit})
+	suite.Require().NoError(err)
+
+	// channel rate limits are refused without twap pricing
+	channelRateLimit := types.RateLimit{ChannelId: channelID, Quotas: []types.Quota{newQuota("hour", time.Hour, 1_000, 1_000)}}
+	_, err = msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: channelRateLimit})
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+	params := k.GetParams(suite.Ctx)
+	params.TwapDuration = time.Hour
+	suite.Require().NoError(k.SetParams(suite.Ctx, params))
+	_, err = msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: channelRateLimit})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.RemoveRateLimit(suite.Ctx, channelID, ""))
+
+	_, _, err = k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(500), false)
+	suite.Require().NoError(err)
+
+	// quotas keep their flow unless their duration changes
+	rateLimit.Quotas = []types.Quota{newQuota("hour", 2*time.Hour, 5, 5), newQuota("day", 24*time.Hour, 20, 20)}
+	_, err = msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: rateLimit})
+	suite.Require().NoError(err)
+	capacities := k.GetQuotaCapacities(suite.Ctx, rateLimit)
+	suite.Require().True(capacities[0].NetOutflow.IsZero())
+	suite.Require().Equal(math.NewInt(500), capacities[1].NetOutflow)
+
+	_, err = msgServer.ResetRateLimitFlow(suite.Ctx, &types.MsgResetRateLimitFlow{Authority: authority, ChannelId: channelID, Denom: denom, QuotaName: "week"})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	_, err = msgServer.ResetRateLimitFlow(suite.Ctx, &types.MsgResetRateLimitFlow{Authority: authority, ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().True(k.GetQuotaCapacities(suite.Ctx, rateLimit)[1].NetOutflow.IsZero())
+
+	_, err = msgServer.RemoveRateLimit(suite.Ctx, &types.MsgRemoveRateLimit{Authority: authority, ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	_, found := k.GetRateLimit(suite.Ctx, channelID, denom)
+	suite.Require().False(found)
+	_, err = msgServer.RemoveRateLimit(suite.Ctx, &types.MsgRemoveRateLimit{Authority: authority, ChannelId: channelID, Denom: denom})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRateLimitQuery() {
+	k := suite.keeper()
+	rateLimit := types.RateLimit{ChannelId: channelID, Denom: denom, Quotas: []types.Quota{newQuota("day", 24*time.Hour, 10, 10)}}
+	k.SetRateLimit(suite.Ctx, rateLimit)
+	_, _, err := k.CheckAndUpdateSendFlow(suite.Ctx, channelID, denom, math.NewInt(250), false)
+	suite.Require().NoError(err)
+
+	res, err := k.RateLimit(suite.Ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimit, res.RateLimit)
+	suite.Require().Len(res.Capacities, 1)
+	suite.Require().Equal(math.NewInt(1_000), res.Capacities[0].MaxSendAmount)
+	suite.Require().Equal(math.NewInt(750), res.Capacities[0].RemainingSend)
+
+	_, err = k.RateLimit(suite.Ctx, &types.QueryRateLimitRequest{ChannelId: channelID})
+	suite.Require().Error(err)
+
+	all, err := k.RateLimits(suite.Ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit}, all.RateLimits)
+}
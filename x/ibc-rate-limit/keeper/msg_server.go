@@ -39,3 +39,58 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRateLimit adds a rate limit or replaces the quotas of an existing one
+func (k msgServer) SetRateLimit(goCtx context.Context, req *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.RateLimit.IsChannelRateLimit() && !k.canPriceChannelValue(k.GetParams(ctx)) {
+		return nil, errors.Wrap(types.ErrInvalidRateLimit, "channel rate limits need a quote denom and a twap duration")
+	}
+	k.Keeper.SetRateLimit(ctx, req.RateLimit)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes a rate limit and its flows
+func (k msgServer) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveRateLimit(ctx, req.ChannelId, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimitFlow resets the flow of the quotas of a rate limit
+func (k msgServer) ResetRateLimitFlow(goCtx context.Context, req *types.MsgResetRateLimitFlow) (*types.MsgResetRateLimitFlowResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgResetRateLimitFlow")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResetRateLimitFlow(ctx, req.ChannelId, req.Denom, req.QuotaName); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetRateLimitFlowResponse{}, nil
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/types"
+)
+
+// GetRateLimit returns the rate limit of the denom through the channel, or of the channel
+// itself if the denom is empty.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// GetAllRateLimits returns all rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// SetRateLimit sets the rate limit. The flows of the quotas that are removed or have a new
+// duration are removed, the flows of the other quotas are kept.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	if previous, found := k.GetRateLimit(ctx, rateLimit.ChannelId, rateLimit.Denom); found {
+		for _, quota := range previous.Quotas {
+			if updated, ok := rateLimit.GetQuota(quota.Name); !ok || updated.Duration != quota.Duration {
+				k.removeFlow(ctx, rateLimit.ChannelId, rateLimit.Denom, quota.Name)
+			}
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// RemoveRateLimit removes the rate limit of the denom through the channel and its flows.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, channelID, denom string) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.ErrRateLimitNotFound.Wrapf("no rate limit of %s", describeRateLimit(channelID, denom))
+	}
+
+	for _, quota := range rateLimit.Quotas {
+		k.removeFlow(ctx, channelID, denom, quota.Name)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+	return nil
+}
+
+// ResetRateLimitFlow resets the flow of the quota of the rate limit, or of all of its quotas if
+// quotaName is empty.
+func (k Keeper) ResetRateLimitFlow(ctx sdk.Context, channelID, denom, quotaName string) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.ErrRateLimitNotFound.Wrapf("no rate limit of %s", describeRateLimit(channelID, denom))
+	}
+
+	if quotaName != "" {
+		if _, ok := rateLimit.GetQuota(quotaName); !ok {
+			return types.ErrRateLimitNotFound.Wrapf("no quota %s in the rate limit of %s", quotaName, describeRateLimit(channelID, denom))
+		}
+		k.removeFlow(ctx, channelID, denom, quotaName)
+		return nil
+	}
+
+	for _, quota := range rateLimit.Quotas {
+		k.removeFlow(ctx, channelID, denom, quota.Name)
+	}
+	return nil
+}
+
+// GetFlow returns the flow of the quota of the rate limit during its window at the block time.
+func (k Keeper) GetFlow(ctx sdk.Context, rateLimit types.RateLimit, quota types.Quota) types.Flow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowKey)
+	bz := store.Get(types.GetFlowKey(rateLimit.ChannelId, rateLimit.Denom, quota.Name))
+	if bz == nil {
+		return types.Flow{}
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	flow.Prune(quota.WindowStart(ctx.BlockTime()))
+	return flow
+}
+
+func (k Keeper) setFlow(ctx sdk.Context, rateLimit types.RateLimit, quota types.Quota, flow types.Flow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowKey)
+	store.Set(types.GetFlowKey(rateLimit.ChannelId, rateLimit.Denom, quota.Name), k.cdc.MustMarshal(&flow))
+}
+
+func (k Keeper) removeFlow(ctx sdk.Context, channelID, denom, quotaName string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowKey)
+	store.Delete(types.GetFlowKey(channelID, denom, quotaName))
+}
+
+// GetQuotaCapacities returns the remaining capacity of the quotas of the rate limit at the block time.
+func (k Keeper) GetQuotaCapacities(ctx sdk.Context, rateLimit types.RateLimit) []types.QuotaCapacity {
+	channelValue := math.ZeroInt()
+	if !rateLimit.IsChannelRateLimit() {
+		channelValue = k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount
+	}
+
+	capacities := make([]types.QuotaCapacity, 0, len(rateLimit.Quotas))
+	for _, quota := range rateLimit.Quotas {
+		capacities = append(capacities, k.quotaCapacity(ctx, rateLimit, quota, channelValue))
+	}
+	return capacities
+}
+
+func (k Keeper) quotaCapacity(ctx sdk.Context, rateLimit types.RateLimit, quota types.Quota, channelValue math.Int) types.QuotaCapacity {
+	maxSend, maxRecv := quota.MaxAmounts(rateLimit.IsChannelRateLimit(), channelValue)
+	return types.NewQuotaCapacity(quota, maxSend, maxRecv, k.GetFlow(ctx, rateLimit, quota).NetOutflow())
+}
+
+// describeRateLimit returns a description of the rate limit of the denom through the channel for errors.
+func describeRateLimit(channelID, denom string) string {
+	if denom == "" {
+		return fmt.Sprintf("channel %s", channelID)
+	}
+	return fmt.Sprintf("%s through channel %s", denom, channelID)
+}
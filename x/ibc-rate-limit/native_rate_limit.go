@@ -0,0 +1,58 @@
+package ibcratelimit
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-rate-limit/types"
+)
+
+// checkAndUpdateSendFlow checks a transfer sent through the source channel against the rate limits
+// of the keeper. It returns the pending send to store once the packet has a sequence, and whether
+// any rate limit applies to the transfer.
+func (i *ICS4Wrapper) checkAndUpdateSendFlow(ctx sdk.Context, sourcePort, sourceChannel string, data transfertypes.FungibleTokenPacketData) (types.PendingSend, bool, error) {
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return types.PendingSend{}, false, errorsmod.Wrapf(types.ErrBadMessage, "invalid transfer amount %s", data.Amount)
+	}
+
+	// the packet denom is the full denom path of the local denom, which is escrowed if this chain
+	// is its source and was burned otherwise
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	burned := !transfertypes.SenderChainIsSource(sourcePort, sourceChannel, data.Denom)
+
+	return i.IbcratelimitKeeper.CheckAndUpdateSendFlow(ctx, sourceChannel, denom, amount, burned)
+}
+
+// checkAndUpdateRecvFlow checks a transfer received through the destination channel of the packet
+// against the rate limits of the keeper. Packets that are not valid transfers are left to the
+// transfer module to reject.
+func (i *ICS4Wrapper) checkAndUpdateRecvFlow(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return nil
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok || data.Denom == "" {
+		return nil
+	}
+
+	// the received denom is unescrowed if this chain is its source, and minted as a voucher
+	// prefixed with the destination port and channel otherwise
+	var denom string
+	minted := !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom)
+	if minted {
+		prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	} else {
+		unprefixedDenom := data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		denom = transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	return i.IbcratelimitKeeper.CheckAndUpdateRecvFlow(ctx, packet.GetDestChannel(), denom, amount, minted)
+}
@@ -9,12 +9,18 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron/ibc-rate-limit/update-params", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "neutron/ibc-rate-limit/MsgSetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "neutron/ibc-rate-limit/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgResetRateLimitFlow{}, "neutron/ibc-rate-limit/MsgResetRateLimitFlow", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimitFlow{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
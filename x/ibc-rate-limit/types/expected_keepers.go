@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+)
+
+// BankKeeper defines the expected bank keeper used to value the channel of a denom.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TwapKeeper defines the expected twap keeper used to price denoms in the quote denom.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -13,5 +15,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		key := string(GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit of %s through %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = true
+	}
 	return nil
 }
@@ -1,9 +1,15 @@
 package types
 
-import "strings"
+import (
+	"encoding/binary"
+	"strings"
+)
 
 const (
 	prefixParamsKey = iota + 1
+	prefixRateLimitKey
+	prefixFlowKey
+	prefixPendingSendKey
 )
 
 const (
@@ -11,8 +17,38 @@ const (
 
 )
 
-var ParamsKey = []byte{prefixParamsKey}
+var (
+	ParamsKey      = []byte{prefixParamsKey}
+	RateLimitKey   = []byte{prefixRateLimitKey}
+	FlowKey        = []byte{prefixFlowKey}
+	PendingSendKey = []byte{prefixPendingSendKey}
+)
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+// lengthPrefix prefixes s with its length so that keys of different parts do not collide.
+func lengthPrefix(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+// GetRateLimitKey returns the store key of the rate limit of the denom through the channel.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return append(lengthPrefix(channelID), denom...)
+}
+
+// GetFlowPrefix returns the store prefix of the flows of the quotas of a rate limit.
+func GetFlowPrefix(channelID, denom string) []byte {
+	return append(lengthPrefix(channelID), lengthPrefix(denom)...)
+}
+
+// GetFlowKey returns the store key of the flow of a quota of a rate limit.
+func GetFlowKey(channelID, denom, quotaName string) []byte {
+	return append(GetFlowPrefix(channelID, denom), quotaName...)
+}
+
+// GetPendingSendKey returns the store key of the packet sent through the channel with the sequence.
+func GetPendingSendKey(channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(lengthPrefix(channelID), sequence)
+}
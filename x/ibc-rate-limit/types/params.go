@@ -1,15 +1,22 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/maany-xyz/maany-dex/v5/app/config"
 )
 
 // Parameter store keys.
 var (
 	KeyContractAddress = []byte("contract")
+	KeyQuoteDenom      = []byte("QuoteDenom")
+	KeyPricePools      = []byte("PricePools")
+	KeyTwapDuration    = []byte("TwapDuration")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -18,9 +25,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(contractAddress string) (Params, error) {
+func NewParams(contractAddress, quoteDenom string, pricePools []DenomPricePool, twapDuration time.Duration) (Params, error) {
 	return Params{
 		ContractAddress: contractAddress,
+		QuoteDenom:      quoteDenom,
+		PricePools:      pricePools,
+		TwapDuration:    twapDuration,
 	}, nil
 }
 
@@ -28,14 +38,40 @@ func NewParams(contractAddress string) (Params, error) {
 func DefaultParams() Params {
 	return Params{
 		ContractAddress: "",
+		QuoteDenom:      appparams.BaseCoinUnit,
 	}
 }
 
+// PricePool returns the pool pricing denom in the quote denom, if any.
+func (p Params) PricePool(denom string) (uint64, bool) {
+	for _, pricePool := range p.PricePools {
+		if pricePool.Denom == denom {
+			return pricePool.PoolId, true
+		}
+	}
+	return 0, false
+}
+
 // validate params.
 func (p Params) Validate() error {
 	if err := validateContractAddress(p.ContractAddress); err != nil {
 		return err
 	}
+	if err := validateQuoteDenom(p.QuoteDenom); err != nil {
+		return err
+	}
+	if err := validatePricePools(p.PricePools); err != nil {
+		return err
+	}
+	if len(p.PricePools) > 0 && p.QuoteDenom == "" {
+		return errors.New("price pools need a quote denom")
+	}
+	if _, ok := p.PricePool(p.QuoteDenom); ok {
+		return fmt.Errorf("the quote denom %s needs no price pool", p.QuoteDenom)
+	}
+	if err := validateTwapDuration(p.TwapDuration); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -44,6 +80,9 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyContractAddress, &p.ContractAddress, validateContractAddress),
+		paramtypes.NewParamSetPair(KeyQuoteDenom, &p.QuoteDenom, validateQuoteDenom),
+		paramtypes.NewParamSetPair(KeyPricePools, &p.PricePools, validatePricePools),
+		paramtypes.NewParamSetPair(KeyTwapDuration, &p.TwapDuration, validateTwapDuration),
 	}
 }
 
@@ -71,3 +110,52 @@ func validateContractAddress(i interface{}) error {
 
 	return nil
 }
+
+// validateQuoteDenom returns an error if the quote denom is invalid. It can be empty when no
+// channel rate limits are used.
+func validateQuoteDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(v)
+}
+
+// validatePricePools returns an error if the price pools have an invalid or duplicate denom.
+func validatePricePools(i interface{}) error {
+	pricePools, ok := i.([]DenomPricePool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(pricePools))
+	for _, pricePool := range pricePools {
+		if err := sdk.ValidateDenom(pricePool.Denom); err != nil {
+			return err
+		}
+		if seen[pricePool.Denom] {
+			return fmt.Errorf("duplicate price pool for %s", pricePool.Denom)
+		}
+		seen[pricePool.Denom] = true
+		if pricePool.PoolId == 0 {
+			return errors.New("price pool id cannot be 0")
+		}
+	}
+	return nil
+}
+
+func validateTwapDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return errors.New("twap duration cannot be negative")
+	}
+	return nil
+}
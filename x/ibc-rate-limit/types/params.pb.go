@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the ibc-rate-limit module.
 type Params struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Denom the channel rate limits are valued in
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// Pools used to price denoms in the quote denom
+	PricePools []DenomPricePool `protobuf:"bytes,3,rep,name=price_pools,json=pricePools,proto3" json:"price_pools" yaml:"price_pools"`
+	// Duration of the TWAP used to price denoms, the spot price is used if it is zero
+	TwapDuration time.Duration `protobuf:"bytes,4,opt,name=twap_duration,json=twapDuration,proto3,stdduration" json:"twap_duration" yaml:"twap_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +78,83 @@ func (m *Params) GetContractAddress() string {
 	return ""
 }
 
+func (m *Params) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *Params) GetPricePools() []DenomPricePool {
+	if m != nil {
+		return m.PricePools
+	}
+	return nil
+}
+
+func (m *Params) GetTwapDuration() time.Duration {
+	if m != nil {
+		return m.TwapDuration
+	}
+	return 0
+}
+
+// DenomPricePool defines the pool used to price a denom in the quote denom.
+type DenomPricePool struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *DenomPricePool) Reset()         { *m = DenomPricePool{} }
+func (m *DenomPricePool) String() string { return proto.CompactTextString(m) }
+func (*DenomPricePool) ProtoMessage()    {}
+func (*DenomPricePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b2a3ecd8a27c06, []int{1}
+}
+func (m *DenomPricePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPricePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPricePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPricePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPricePool.Merge(m, src)
+}
+func (m *DenomPricePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPricePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPricePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPricePool proto.InternalMessageInfo
+
+func (m *DenomPricePool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomPricePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.ibcratelimit.v1beta1.Params")
+	proto.RegisterType((*DenomPricePool)(nil), "neutron.ibcratelimit.v1beta1.DenomPricePool")
 }
 
 func init() {
@@ -77,21 +162,33 @@ func init() {
 }
 
 var fileDescriptor_96b2a3ecd8a27c06 = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x28, 0x85, 0x70, 0xb1,
-	0x05, 0x80, 0xcd, 0x10, 0xf2, 0xe2, 0x12, 0x48, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x89,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0x3f,
-	0x71, 0x4f, 0x9e, 0xf1, 0xd3, 0x3d, 0x79, 0xf1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x74, 0x55,
-	0x4a, 0x41, 0xfc, 0x30, 0x21, 0x47, 0x88, 0x88, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x43, 0x9d, 0xab, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x99, 0xea, 0x57, 0x80, 0xbc, 0xaa,
-	0x0b, 0xf2, 0x80, 0x2e, 0xc4, 0xb3, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x07, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x65, 0xd8, 0x69, 0x11, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xbb, 0xa3, 0x08, 0x97, 0x7f, 0xb2, 0x2a, 0xae, 0x54, 0x28, 0xa9, 0x32, 0x15,
+	0x89, 0xda, 0xba, 0x43, 0x08, 0xc4, 0x82, 0xa8, 0x6e, 0x81, 0xa9, 0xca, 0xc8, 0x12, 0x9c, 0xc4,
+	0x04, 0x4b, 0x49, 0xde, 0xe0, 0x38, 0x47, 0xcb, 0xa7, 0x60, 0xe4, 0x23, 0x75, 0xbc, 0x11, 0x31,
+	0x14, 0xd4, 0x7e, 0x83, 0xfb, 0x04, 0xc8, 0x76, 0x22, 0x5a, 0x86, 0xdb, 0xfc, 0xbc, 0xfe, 0x3d,
+	0x8f, 0xed, 0x47, 0xc6, 0x4f, 0x4b, 0xd1, 0x68, 0x05, 0x25, 0x93, 0x71, 0xa2, 0xb8, 0x16, 0xb9,
+	0x2c, 0xa4, 0x66, 0x97, 0x67, 0xb1, 0xd0, 0xfc, 0x8c, 0x55, 0x5c, 0xf1, 0xa2, 0xa6, 0x95, 0x02,
+	0x0d, 0xe4, 0x49, 0x8b, 0xd2, 0x7d, 0x94, 0xb6, 0xe8, 0x78, 0x98, 0x41, 0x06, 0x16, 0x64, 0x66,
+	0xe5, 0x3c, 0x63, 0x2f, 0x03, 0xc8, 0x72, 0xc1, 0xac, 0x8a, 0x9b, 0x4f, 0x2c, 0x6d, 0x14, 0xd7,
+	0x12, 0x4a, 0xb7, 0x1f, 0xfc, 0x3a, 0xc2, 0xfd, 0x85, 0x3d, 0x84, 0xbc, 0xc7, 0x0f, 0x13, 0x28,
+	0xb5, 0xe2, 0x89, 0x8e, 0x78, 0x9a, 0x2a, 0x51, 0xd7, 0x23, 0x34, 0x41, 0xd3, 0x3b, 0x73, 0x7f,
+	0xbd, 0xf1, 0xd1, 0xf5, 0xc6, 0x3f, 0x5d, 0xf1, 0x22, 0x7f, 0x1d, 0xfc, 0x4f, 0x05, 0xe1, 0x83,
+	0x6e, 0xf4, 0xd6, 0x4d, 0xc8, 0x4b, 0x3c, 0xf8, 0xd2, 0x80, 0x16, 0x51, 0x2a, 0x4a, 0x28, 0x46,
+	0x47, 0x36, 0xe6, 0xd1, 0xf5, 0xc6, 0x27, 0x2e, 0x62, 0x6f, 0x33, 0x08, 0xb1, 0x55, 0x17, 0x46,
+	0x10, 0x89, 0x07, 0x95, 0x92, 0x89, 0x88, 0x2a, 0x80, 0xbc, 0x1e, 0x1d, 0x4f, 0x8e, 0xa7, 0x83,
+	0xf3, 0x67, 0xf4, 0xa6, 0x97, 0x53, 0xeb, 0x5c, 0x18, 0xd7, 0x02, 0x20, 0x9f, 0x8f, 0xd7, 0x1b,
+	0xbf, 0xf7, 0xef, 0xa8, 0xbd, 0xb8, 0x20, 0xc4, 0x55, 0x87, 0xd5, 0xe4, 0x23, 0xbe, 0xa7, 0xbf,
+	0xf2, 0x2a, 0xea, 0x1a, 0x19, 0x9d, 0x4c, 0xd0, 0x74, 0x70, 0xfe, 0x98, 0xba, 0xca, 0x68, 0x57,
+	0x19, 0xbd, 0x68, 0x81, 0xf9, 0xa4, 0x4d, 0x1e, 0xba, 0xe4, 0x03, 0x77, 0xf0, 0xe3, 0xb7, 0x8f,
+	0xc2, 0xbb, 0x66, 0xd6, 0xf1, 0xc1, 0x1b, 0x7c, 0xff, 0xf0, 0x6e, 0x64, 0x88, 0x6f, 0xb9, 0x46,
+	0x6c, 0xb1, 0xa1, 0x13, 0xe4, 0x14, 0xdf, 0x36, 0xf7, 0x8b, 0x64, 0x6a, 0x9b, 0x3a, 0x09, 0xfb,
+	0x46, 0xbe, 0x4b, 0xe7, 0xe1, 0x7a, 0xeb, 0xa1, 0xab, 0xad, 0x87, 0xfe, 0x6c, 0x3d, 0xf4, 0x7d,
+	0xe7, 0xf5, 0xae, 0x76, 0x5e, 0xef, 0xe7, 0xce, 0xeb, 0x7d, 0x78, 0x95, 0x49, 0xfd, 0xb9, 0x89,
+	0x69, 0x02, 0x05, 0x2b, 0x38, 0x2f, 0x57, 0xb3, 0xe5, 0xea, 0x5b, 0xbb, 0x4a, 0xc5, 0x92, 0x5d,
+	0xbe, 0x60, 0x4b, 0xf3, 0xa5, 0x66, 0xa6, 0xae, 0x99, 0xfb, 0x54, 0x7a, 0x55, 0x89, 0x3a, 0xee,
+	0xdb, 0x77, 0x3d, 0xff, 0x3b, 0x00, 0x1e, 0xfa, 0xa4, 0x93, 0x79, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +211,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PricePools) > 0 {
+		for iNdEx := len(m.PricePools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -124,6 +250,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomPricePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPricePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPricePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -145,6 +306,34 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.PricePools) > 0 {
+		for _, e := range m.PricePools {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DenomPricePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovParams(uint64(m.PoolId))
+	}
 	return n
 }
 
@@ -215,6 +404,206 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricePools = append(m.PricePools, DenomPricePool{})
+			if err := m.PricePools[len(m.PricePools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPricePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPricePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPricePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -113,9 +113,219 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type QueryRateLimitsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{2}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{3}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *QueryRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRateLimitRequest is the request type for the Query/RateLimit RPC method.
+type QueryRateLimitRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Denom of a denom rate limit, empty for the channel rate limit
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRateLimitRequest) Reset()         { *m = QueryRateLimitRequest{} }
+func (m *QueryRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitRequest) ProtoMessage()    {}
+func (*QueryRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{4}
+}
+func (m *QueryRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitRequest.Merge(m, src)
+}
+func (m *QueryRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryRateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRateLimitResponse is the response type for the Query/RateLimit RPC method.
+type QueryRateLimitResponse struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// Remaining capacity of each quota of the rate limit
+	Capacities []QuotaCapacity `protobuf:"bytes,2,rep,name=capacities,proto3" json:"capacities"`
+}
+
+func (m *QueryRateLimitResponse) Reset()         { *m = QueryRateLimitResponse{} }
+func (m *QueryRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitResponse) ProtoMessage()    {}
+func (*QueryRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{5}
+}
+func (m *QueryRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitResponse.Merge(m, src)
+}
+func (m *QueryRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+func (m *QueryRateLimitResponse) GetCapacities() []QuotaCapacity {
+	if m != nil {
+		return m.Capacities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitRequest")
+	proto.RegisterType((*QueryRateLimitResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitResponse")
 }
 
 func init() {
@@ -123,27 +333,43 @@ func init() {
 }
 
 var fileDescriptor_a6095f726b1d3aec = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xcd, 0x4a, 0x33, 0x31,
-	0x14, 0x86, 0x27, 0x1f, 0x9f, 0x5d, 0x8c, 0xbb, 0xd8, 0x85, 0x94, 0x12, 0xa5, 0x88, 0x54, 0xa1,
-	0x89, 0xad, 0x08, 0xae, 0x7b, 0x05, 0xda, 0x9d, 0xee, 0x32, 0x25, 0xc4, 0x40, 0x27, 0x27, 0x4d,
-	0x32, 0xc5, 0x6e, 0xbd, 0x02, 0xc1, 0xb5, 0x6b, 0x6f, 0xa5, 0xcb, 0x82, 0x1b, 0x57, 0x22, 0xad,
-	0x17, 0x22, 0x9d, 0xc4, 0x52, 0x7f, 0x18, 0x70, 0x77, 0x38, 0x79, 0x9f, 0x87, 0x37, 0x27, 0x6d,
-	0x6b, 0x51, 0x78, 0x0b, 0x9a, 0xa9, 0x6c, 0x68, 0xb9, 0x17, 0x23, 0x95, 0x2b, 0xcf, 0x26, 0xdd,
-	0x4c, 0x78, 0xde, 0x65, 0xe3, 0x42, 0xd8, 0x29, 0x35, 0x16, 0x3c, 0xe0, 0x66, 0x4c, 0xd2, 0xcd,
-	0x24, 0x8d, 0xc9, 0xc6, 0xf1, 0x10, 0x5c, 0x0e, 0x8e, 0x65, 0xdc, 0x89, 0x80, 0xad, 0x25, 0x86,
-	0x4b, 0xa5, 0xb9, 0x57, 0xa0, 0x83, 0xa9, 0x51, 0x97, 0x20, 0xa1, 0x1c, 0xd9, 0x6a, 0x8a, 0xdb,
-	0xa6, 0x04, 0x90, 0x23, 0xc1, 0xb8, 0x51, 0x8c, 0x6b, 0x0d, 0xbe, 0x44, 0x5c, 0x7c, 0x3d, 0xaa,
-	0xec, 0x69, 0xb8, 0xe5, 0x79, 0x8c, 0xb6, 0xea, 0x29, 0xbe, 0x5c, 0x15, 0xb8, 0x28, 0x97, 0x03,
-	0x31, 0x2e, 0x84, 0xf3, 0xad, 0xab, 0x74, 0xe7, 0xcb, 0xd6, 0x19, 0xd0, 0x4e, 0xe0, 0x7e, 0x5a,
-	0x0b, 0xf0, 0x2e, 0xda, 0x47, 0xed, 0xed, 0xde, 0x01, 0xad, 0xfa, 0x26, 0x0d, 0x74, 0xff, 0xff,
-	0xec, 0x75, 0x2f, 0x19, 0x44, 0xb2, 0xf7, 0x84, 0xd2, 0xad, 0xd2, 0x8d, 0x1f, 0x51, 0x5a, 0x0b,
-	0x11, 0x7c, 0x52, 0x2d, 0xfa, 0xd9, 0xb0, 0xd1, 0xfd, 0x03, 0x11, 0xda, 0xb7, 0xe8, 0xdd, 0xf3,
-	0xfb, 0xc3, 0xbf, 0x36, 0x3e, 0x64, 0x1b, 0xe7, 0xe9, 0xac, 0xd8, 0xce, 0x6f, 0x07, 0xea, 0x0f,
-	0x66, 0x0b, 0x82, 0xe6, 0x0b, 0x82, 0xde, 0x16, 0x04, 0xdd, 0x2f, 0x49, 0x32, 0x5f, 0x92, 0xe4,
-	0x65, 0x49, 0x92, 0xeb, 0x73, 0xa9, 0xfc, 0x4d, 0x91, 0xd1, 0x21, 0xe4, 0x9f, 0xae, 0x0e, 0x58,
-	0xb9, 0xf6, 0x4e, 0xce, 0xd8, 0xed, 0x77, 0xb9, 0x9f, 0x1a, 0xe1, 0xb2, 0x5a, 0x79, 0xf5, 0xd3,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd8, 0x97, 0x25, 0x4a, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x12, 0x4f,
+	0x18, 0xc6, 0x59, 0xfe, 0x2d, 0x09, 0x2f, 0xb7, 0xf9, 0xa3, 0x36, 0xa4, 0xae, 0x66, 0x63, 0x5a,
+	0xb4, 0x61, 0x47, 0xa0, 0x4d, 0x1a, 0x4f, 0x06, 0x13, 0x8d, 0x09, 0x31, 0xb2, 0x37, 0xbd, 0xd4,
+	0x61, 0x99, 0x6c, 0x37, 0x61, 0x67, 0xb6, 0xbb, 0x43, 0x03, 0x1a, 0x2f, 0x7e, 0x02, 0x93, 0x9e,
+	0xfd, 0x0a, 0x1e, 0x3c, 0xe9, 0x37, 0xe8, 0xb1, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x1f, 0xc4, 0xec,
+	0xcc, 0xb0, 0x0b, 0xd4, 0x80, 0x7b, 0x1b, 0x86, 0xf7, 0x79, 0xde, 0xdf, 0xfb, 0xf0, 0x0e, 0x50,
+	0x67, 0x74, 0x24, 0x22, 0xce, 0xb0, 0xdf, 0x77, 0x23, 0x22, 0xe8, 0xd0, 0x0f, 0x7c, 0x81, 0xcf,
+	0x9b, 0x7d, 0x2a, 0x48, 0x13, 0x9f, 0x8d, 0x68, 0x34, 0xb1, 0xc3, 0x88, 0x0b, 0x8e, 0x76, 0x75,
+	0xa5, 0xbd, 0x58, 0x69, 0xeb, 0xca, 0xda, 0x03, 0x97, 0xc7, 0x01, 0x8f, 0x71, 0x9f, 0xc4, 0x54,
+	0xc9, 0x52, 0x93, 0x90, 0x78, 0x3e, 0x23, 0xc2, 0xe7, 0x4c, 0x39, 0xd5, 0xaa, 0x1e, 0xf7, 0xb8,
+	0x3c, 0xe2, 0xe4, 0xa4, 0x6f, 0x77, 0x3d, 0xce, 0xbd, 0x21, 0xc5, 0x24, 0xf4, 0x31, 0x61, 0x8c,
+	0x0b, 0x29, 0x89, 0xf5, 0xb7, 0xf7, 0xd7, 0x72, 0x86, 0x24, 0x22, 0xc1, 0xbc, 0xb4, 0xb1, 0xb6,
+	0x34, 0xb9, 0x39, 0x51, 0xec, 0xb2, 0xdc, 0xaa, 0x02, 0xea, 0x25, 0xbc, 0x2f, 0xa5, 0x87, 0x43,
+	0xcf, 0x46, 0x34, 0x16, 0xd6, 0x2b, 0xf8, 0x7f, 0xe9, 0x36, 0x0e, 0x39, 0x8b, 0x29, 0xea, 0x40,
+	0x49, 0xf5, 0xda, 0x31, 0xee, 0x1a, 0xf5, 0x4a, 0xeb, 0x9e, 0xbd, 0x2e, 0x15, 0x5b, 0xa9, 0x3b,
+	0x5b, 0x97, 0x3f, 0xef, 0x14, 0x1c, 0xad, 0xb4, 0xde, 0xc0, 0x4d, 0x69, 0xed, 0x10, 0x41, 0xbb,
+	0x49, 0xf9, 0xbc, 0x29, 0x7a, 0x0a, 0x90, 0x85, 0xa5, 0x3b, 0xec, 0xd9, 0x2a, 0x59, 0x3b, 0x49,
+	0xd6, 0x56, 0x3f, 0x48, 0x66, 0xef, 0x51, 0xad, 0x75, 0x16, 0x94, 0xd6, 0x17, 0x03, 0x6e, 0x5d,
+	0x6b, 0xa1, 0x27, 0x78, 0x01, 0x95, 0x2c, 0x82, 0x64, 0x8c, 0xff, 0xea, 0x95, 0xd6, 0xfe, 0xfa,
+	0x31, 0x52, 0x1b, 0x3d, 0x09, 0x44, 0xa9, 0x2f, 0x7a, 0xb6, 0xc4, 0x5c, 0x94, 0xcc, 0xfb, 0x1b,
+	0x99, 0x15, 0xcc, 0x12, 0x74, 0x17, 0x6e, 0x2c, 0x33, 0xcf, 0x53, 0xb9, 0x0d, 0xe0, 0x9e, 0x12,
+	0xc6, 0xe8, 0xf0, 0xc4, 0x1f, 0xc8, 0x54, 0xca, 0x4e, 0x59, 0xdf, 0x3c, 0x1f, 0xa0, 0x2a, 0x6c,
+	0x0f, 0x28, 0xe3, 0x81, 0xec, 0x5d, 0x76, 0xd4, 0x07, 0xeb, 0x9b, 0xb1, 0x9a, 0x72, 0x9a, 0x40,
+	0x17, 0x20, 0x4b, 0x40, 0xa7, 0x9c, 0x33, 0x80, 0x72, 0x1a, 0x00, 0xea, 0x01, 0xb8, 0x24, 0x24,
+	0xae, 0x2f, 0x7c, 0x1a, 0xef, 0x14, 0x65, 0x9c, 0x07, 0xeb, 0xdd, 0x7a, 0x23, 0x2e, 0xc8, 0x13,
+	0x25, 0x9a, 0xcc, 0x23, 0xcd, 0x4c, 0x5a, 0x17, 0x5b, 0xb0, 0x2d, 0xd9, 0xd1, 0x27, 0x03, 0x4a,
+	0x6a, 0x87, 0xd0, 0xc3, 0x4d, 0x9e, 0xab, 0x2b, 0x5c, 0x6b, 0xe6, 0x50, 0xa8, 0x68, 0x2c, 0xfb,
+	0xc3, 0xf7, 0xdf, 0x17, 0xc5, 0x3a, 0xda, 0xc3, 0x0b, 0x6f, 0xa8, 0x91, 0x68, 0x1b, 0x7f, 0x7b,
+	0x70, 0xe8, 0xb3, 0x01, 0x90, 0xed, 0x18, 0x3a, 0xfc, 0x87, 0x8e, 0xd7, 0xb6, 0xbe, 0x76, 0x94,
+	0x53, 0xa5, 0x59, 0xdb, 0x92, 0xb5, 0x81, 0x0e, 0x36, 0xb1, 0x2e, 0xac, 0x3b, 0xfa, 0x6a, 0x40,
+	0x39, 0xf5, 0x42, 0xed, 0x3c, 0x9d, 0xe7, 0xb8, 0x87, 0xf9, 0x44, 0x9a, 0xf6, 0xb1, 0xa4, 0x7d,
+	0x84, 0x8e, 0x73, 0xd0, 0xe2, 0x77, 0xd9, 0xde, 0xbf, 0xef, 0x38, 0x97, 0x53, 0xd3, 0xb8, 0x9a,
+	0x9a, 0xc6, 0xaf, 0xa9, 0x69, 0x7c, 0x9c, 0x99, 0x85, 0xab, 0x99, 0x59, 0xf8, 0x31, 0x33, 0x0b,
+	0xaf, 0x8f, 0x3d, 0x5f, 0x9c, 0x8e, 0xfa, 0xb6, 0xcb, 0x03, 0x1c, 0x10, 0xc2, 0x26, 0x8d, 0xf1,
+	0xe4, 0xad, 0x3e, 0x0d, 0xe8, 0x18, 0x9f, 0x1f, 0xe1, 0xf1, 0x6a, 0x3b, 0x31, 0x09, 0x69, 0xdc,
+	0x2f, 0xc9, 0xbf, 0xc0, 0xf6, 0x9f, 0x01, 0x00, 0xe4, 0x96, 0xb7, 0x71, 0x06, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -161,6 +387,11 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RateLimits returns all rate limits enforced by the module.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimit returns a rate limit with the remaining capacity of its quotas.
+	// The denom is passed as a query parameter since it can contain slashes.
+	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
 }
 
 type queryClient struct {
@@ -180,11 +411,34 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RateLimits returns all rate limits enforced by the module.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimit returns a rate limit with the remaining capacity of its quotas.
+	// The denom is passed as a query parameter since it can contain slashes.
+	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -194,6 +448,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -217,6 +477,43 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -225,6 +522,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/ibcratelimit/v1beta1/query.proto",
@@ -286,95 +591,329 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+				return 0, err
 			}
-			iNdEx += skippy
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return dAtA[:n], nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for iNdEx := len(m.Capacities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capacities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Capacities) > 0 {
+		for _, e := range m.Capacities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -456,6 +995,443 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capacities = append(m.Capacities, QuotaCapacity{})
+			if err := m.Capacities[len(m.Capacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
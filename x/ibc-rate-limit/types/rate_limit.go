@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// FlowBucketsPerWindow is the number of buckets the rolling window of a quota is split into.
+// The window slides by one bucket at a time, so a transfer stops counting towards a quota
+// between duration - duration/FlowBucketsPerWindow and duration after it happened.
+const FlowBucketsPerWindow = 24
+
+// MinQuotaDuration is the shortest quota window, for buckets to last at least a second.
+const MinQuotaDuration = FlowBucketsPerWindow * time.Second
+
+var hundred = math.LegacyNewDec(100)
+
+// IsChannelRateLimit returns whether the rate limit limits the value of all transfers through its channel.
+func (r RateLimit) IsChannelRateLimit() bool {
+	return r.Denom == ""
+}
+
+// GetQuota returns the quota of the rate limit with the name, if any.
+func (r RateLimit) GetQuota(name string) (Quota, bool) {
+	for _, quota := range r.Quotas {
+		if quota.Name == name {
+			return quota, true
+		}
+	}
+	return Quota{}, false
+}
+
+// Validate returns an error if the rate limit has an invalid channel, denom or quota.
+func (r RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+	if !r.IsChannelRateLimit() {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return err
+		}
+	}
+	if len(r.Quotas) == 0 {
+		return fmt.Errorf("rate limit of %s through %s has no quotas", r.Denom, r.ChannelId)
+	}
+
+	seen := make(map[string]bool, len(r.Quotas))
+	for _, quota := range r.Quotas {
+		if seen[quota.Name] {
+			return fmt.Errorf("duplicate quota %s", quota.Name)
+		}
+		seen[quota.Name] = true
+		if err := quota.Validate(r.IsChannelRateLimit()); err != nil {
+			return fmt.Errorf("invalid quota %s: %w", quota.Name, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the quota has no name, a too short window or invalid maximums.
+// The maximums are percentages of the denom supply unless the quota is of a channel rate limit.
+func (q Quota) Validate(channelRateLimit bool) error {
+	if q.Name == "" {
+		return fmt.Errorf("quota name cannot be empty")
+	}
+	if q.Duration < MinQuotaDuration {
+		return fmt.Errorf("quota duration must be at least %s", MinQuotaDuration)
+	}
+	for _, max := range []math.LegacyDec{q.MaxSend, q.MaxRecv} {
+		if max.IsNil() || max.IsNegative() {
+			return fmt.Errorf("quota maximum cannot be negative")
+		}
+		if !channelRateLimit && max.GT(hundred) {
+			return fmt.Errorf("quota maximum cannot exceed 100 percent of the supply")
+		}
+	}
+	return nil
+}
+
+// BucketDuration returns the duration of the buckets of the quota window.
+func (q Quota) BucketDuration() time.Duration {
+	return q.Duration / FlowBucketsPerWindow
+}
+
+// BucketStart returns the start of the bucket the flow at t is recorded in.
+func (q Quota) BucketStart(t time.Time) time.Time {
+	return t.Truncate(q.BucketDuration())
+}
+
+// WindowStart returns the start of the oldest bucket of the quota window at t.
+func (q Quota) WindowStart(t time.Time) time.Time {
+	return q.BucketStart(t).Add(-(FlowBucketsPerWindow - 1) * q.BucketDuration())
+}
+
+// MaxAmounts returns the maximum net outflow and inflow of the quota. They are a percentage of
+// the channel value for a denom rate limit, and the maximums themselves for a channel rate limit.
+func (q Quota) MaxAmounts(channelRateLimit bool, channelValue math.Int) (maxSend, maxRecv math.Int) {
+	if channelRateLimit {
+		return q.MaxSend.TruncateInt(), q.MaxRecv.TruncateInt()
+	}
+	value := math.LegacyNewDecFromInt(channelValue)
+	return value.Mul(q.MaxSend).Quo(hundred).TruncateInt(), value.Mul(q.MaxRecv).Quo(hundred).TruncateInt()
+}
+
+// Prune removes the buckets that started before windowStart.
+func (f *Flow) Prune(windowStart time.Time) {
+	buckets := f.Buckets[:0]
+	for _, bucket := range f.Buckets {
+		if !bucket.Start.Before(windowStart) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	f.Buckets = buckets
+}
+
+// NetOutflow returns the outflow minus the inflow of the buckets of the flow.
+func (f Flow) NetOutflow() math.Int {
+	net := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		net = net.Add(bucket.Outflow).Sub(bucket.Inflow)
+	}
+	return net
+}
+
+// Add adds the inflow and outflow to the bucket starting at start, which is appended if the
+// flow has no such bucket.
+func (f *Flow) Add(start time.Time, inflow, outflow math.Int) {
+	for i := range f.Buckets {
+		if f.Buckets[i].Start.Equal(start) {
+			f.Buckets[i].Inflow = f.Buckets[i].Inflow.Add(inflow)
+			f.Buckets[i].Outflow = f.Buckets[i].Outflow.Add(outflow)
+			return
+		}
+	}
+	f.Buckets = append(f.Buckets, FlowBucket{Start: start, Inflow: inflow, Outflow: outflow})
+}
+
+// UndoOutflow removes the outflow from the bucket starting at start. Nothing is removed if the
+// bucket already left the window, and the outflow of the bucket never becomes negative.
+func (f *Flow) UndoOutflow(start time.Time, outflow math.Int) {
+	for i := range f.Buckets {
+		if f.Buckets[i].Start.Equal(start) {
+			f.Buckets[i].Outflow = math.MaxInt(f.Buckets[i].Outflow.Sub(outflow), math.ZeroInt())
+			return
+		}
+	}
+}
+
+// NewQuotaCapacity returns the remaining capacity of the quota given its maximums and the net
+// outflow during its window.
+func NewQuotaCapacity(quota Quota, maxSend, maxRecv, netOutflow math.Int) QuotaCapacity {
+	return QuotaCapacity{
+		Quota:         quota,
+		MaxSendAmount: maxSend,
+		MaxRecvAmount: maxRecv,
+		NetOutflow:    netOutflow,
+		RemainingSend: math.MaxInt(maxSend.Sub(netOutflow), math.ZeroInt()),
+		RemainingRecv: math.MaxInt(maxRecv.Add(netOutflow), math.ZeroInt()),
+	}
+}
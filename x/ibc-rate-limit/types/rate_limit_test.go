@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRateLimit(t *testing.T) {
+	quota := func(name string, duration time.Duration, maxSend int64) Quota {
+		return Quota{Name: name, Duration: duration, MaxSend: math.LegacyNewDec(maxSend), MaxRecv: math.LegacyNewDec(maxSend)}
+	}
+
+	testCases := map[string]struct {
+		rateLimit RateLimit
+		expected  bool
+	}{
+		"denom rate limit": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom", Quotas: []Quota{quota("day", 24*time.Hour, 10)}},
+			expected:  true,
+		},
+		"channel rate limit above 100": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Quotas: []Quota{quota("day", 24*time.Hour, 1_000_000)}},
+			expected:  true,
+		},
+		"denom rate limit above 100 percent": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom", Quotas: []Quota{quota("day", 24*time.Hour, 101)}},
+			expected:  false,
+		},
+		"invalid channel": {
+			rateLimit: RateLimit{ChannelId: "c", Denom: "uatom", Quotas: []Quota{quota("day", 24*time.Hour, 10)}},
+			expected:  false,
+		},
+		"no quotas": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom"},
+			expected:  false,
+		},
+		"duplicate quota": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom", Quotas: []Quota{quota("day", 24*time.Hour, 10), quota("day", time.Hour, 10)}},
+			expected:  false,
+		},
+		"too short quota": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom", Quotas: []Quota{quota("minute", time.Second, 10)}},
+			expected:  false,
+		},
+		"negative maximum": {
+			rateLimit: RateLimit{ChannelId: "channel-0", Denom: "uatom", Quotas: []Quota{quota("day", 24*time.Hour, -1)}},
+			expected:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+
+			if !tc.expected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -33,11 +33,112 @@ func (msg *MsgUpdateParams) Validate() error {
 	}
 
 	// we allow unsetting the contract
-	if msg.Params.ContractAddress == "" {
-		return nil
+	if msg.Params.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
+			return errorsmod.Wrap(err, "contract_address is invalid")
+		}
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
-		return errorsmod.Wrap(err, "contract_address is invalid")
+
+	return msg.Params.Validate()
+}
+
+var _ sdk.Msg = &MsgSetRateLimit{}
+
+func (msg *MsgSetRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRateLimit) Type() string {
+	return "set-rate-limit"
+}
+
+func (msg *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := msg.RateLimit.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveRateLimit{}
+
+func (msg *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRateLimit) Type() string {
+	return "remove-rate-limit"
+}
+
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.ChannelId == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "channel_id cannot be empty")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgResetRateLimitFlow{}
+
+func (msg *MsgResetRateLimitFlow) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetRateLimitFlow) Type() string {
+	return "reset-rate-limit-flow"
+}
+
+func (msg *MsgResetRateLimitFlow) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResetRateLimitFlow) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgResetRateLimitFlow) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.ChannelId == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "channel_id cannot be empty")
 	}
 
 	return nil
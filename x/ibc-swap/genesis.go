@@ -0,0 +1,23 @@
+package ibcswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, forward := range genState.PendingForwards {
+		k.SetPendingForward(ctx, forward)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.PendingForwards = k.GetAllPendingForwards(ctx)
+
+	return genesis
+}
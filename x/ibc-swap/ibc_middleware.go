@@ -0,0 +1,178 @@
+package ibcswap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/keeper"
+	swaptypes "github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+)
+
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	// call underlying callback
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// The funds of packets with a swap memo are received by the intermediate sender of the packet sender,
+// and swapped and delivered or forwarded as requested by the memo. Other packets are passed to the next
+// layer untouched.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var memo swaptypes.Memo
+	if err := json.Unmarshal([]byte(data.GetMemo()), &memo); err != nil || memo.Swap == nil {
+		// Not a packet that should be handled by the swap middleware
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err := memo.Swap.Validate(); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+	if err := im.keeper.ValidateMemoAddresses(*memo.Swap); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	// The funds are hijacked to the intermediate sender, which swaps them
+	sender := swaptypes.IntermediateSender(packet.GetDestChannel(), data.GetSender())
+	data.Receiver = sender.String()
+	dataBytes, err := types.ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
+	}
+	packet.Data = dataBytes
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := math.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket
+		return utils.NewEmitErrorAcknowledgement(ctx, swaptypes.ErrInvalidSwapMemo, "amount is not an int")
+	}
+	tokenIn := sdk.NewCoin(utils.MustExtractDenomFromPacketOnRecv(packet), amount)
+
+	if err := im.keeper.SwapAndForward(ctx, sender, tokenIn, *memo.Swap); err != nil {
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet.GetSourceChannel(), packet.GetSequence(), acknowledgement)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
@@ -0,0 +1,229 @@
+package ibcswap_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/app/params"
+	"github.com/maany-xyz/maany-dex/v5/testutil"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+type SwapTestSuite struct {
+	testutil.IBCConnectionTestSuite
+
+	poolID uint64
+	// events of the last received packet
+	lastRecvEvents []abci.Event
+}
+
+func TestSwapTestSuite(t *testing.T) {
+	suite.Run(t, new(SwapTestSuite))
+}
+
+func (suite *SwapTestSuite) SetupTest() {
+	suite.IBCConnectionTestSuite.SetupTest()
+	suite.ConfigureTransferChannel()
+
+	// pool of the received stake voucher and the native denom
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	poolManagerParams := app.PoolManagerKeeper.GetParams(ctx)
+	poolManagerParams.PoolCreationFee = sdk.NewCoins()
+	app.PoolManagerKeeper.SetParams(ctx, poolManagerParams)
+
+	creator := suite.ChainA.SenderAccount.GetAddress()
+	a, b := sdk.NewInt64Coin(suite.receivedDenom(), 1_000_000), sdk.NewInt64Coin(params.DefaultDenom, 1_000_000)
+	suite.FundAcc(creator, sdk.NewCoins(a, b))
+	poolID, err := app.PoolManagerKeeper.CreatePool(ctx, balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: math.LegacyNewDecWithPrec(3, 3),
+		ExitFee: math.LegacyZeroDec(),
+	}, []balancer.PoolAsset{
+		{Token: a, Weight: math.NewInt(1)},
+		{Token: b, Weight: math.NewInt(1)},
+	}, ""))
+	suite.Require().NoError(err)
+	suite.poolID = poolID
+}
+
+func (suite *SwapTestSuite) receivedDenom() string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+}
+
+func (suite *SwapTestSuite) swapMemo(modify func(*types.SwapMemo)) string {
+	swap := types.SwapMemo{
+		Routes:          []poolmanagertypes.SwapAmountInRoute{{PoolId: suite.poolID, TokenOutDenom: params.DefaultDenom}},
+		MinAmountOut:    "900",
+		Receiver:        suite.receiver().String(),
+		RecoveryAddress: suite.recoveryAddress().String(),
+	}
+	modify(&swap)
+	bz, err := json.Marshal(types.Memo{Swap: &swap})
+	suite.Require().NoError(err)
+	return string(bz)
+}
+
+func (suite *SwapTestSuite) receiver() sdk.AccAddress {
+	return sdk.AccAddress("receiver____________")
+}
+
+func (suite *SwapTestSuite) recoveryAddress() sdk.AccAddress {
+	return sdk.AccAddress("recovery____________")
+}
+
+func (suite *SwapTestSuite) balance(addr sdk.AccAddress, denom string) int64 {
+	return suite.GetNeutronZoneApp(suite.ChainA).BankKeeper.GetBalance(suite.ChainA.GetContext(), addr, denom).Amount.Int64()
+}
+
+// receivePacket sends a transfer of 1000 stake from chain B to chain A and returns its ack
+func (suite *SwapTestSuite) receivePacket(memo string) []byte {
+	packetData := transfertypes.FungibleTokenPacketData{
+		Denom:    sdk.DefaultBondDenom,
+		Amount:   "1000",
+		Sender:   suite.ChainB.SenderAccount.GetAddress().String(),
+		Receiver: suite.ChainA.SenderAccount.GetAddress().String(),
+		Memo:     memo,
+	}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		1,
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		clienttypes.NewHeight(0, 150),
+		0,
+	)
+
+	channelCap := suite.ChainB.GetChannelCapability(suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID)
+	_, err := suite.GetNeutronZoneApp(suite.ChainB).HooksICS4Wrapper.SendPacket(
+		suite.ChainB.GetContext(), channelCap, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+
+	res, err := suite.TransferPath.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	suite.lastRecvEvents = res.GetEvents()
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return ack
+}
+
+// relayForward relays the forward packet sent by chain A in the events to chain B and acknowledges it
+func (suite *SwapTestSuite) relayForward(events []abci.Event) {
+	packet, err := ibctesting.ParsePacketFromEvents(events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointB.UpdateClient())
+	res, err := suite.TransferPath.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.TransferPath.EndpointA.AcknowledgePacket(packet, ack))
+}
+
+func (suite *SwapTestSuite) TestSwapToReceiver() {
+	ack := suite.receivePacket(suite.swapMemo(func(_ *types.SwapMemo) {}))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+
+	// 1000 in a 1_000_000 pool with a 0.3% spread factor
+	suite.Require().Equal(int64(996), suite.balance(suite.receiver(), params.DefaultDenom))
+	sender := types.IntermediateSender(suite.TransferPath.EndpointA.ChannelID, suite.ChainB.SenderAccount.GetAddress().String())
+	suite.Require().Zero(suite.balance(sender, suite.receivedDenom()))
+}
+
+func (suite *SwapTestSuite) TestInvalidSwapMemo() {
+	ack := suite.receivePacket(suite.swapMemo(func(m *types.SwapMemo) { m.RecoveryAddress = "" }))
+	suite.Require().True(utils.IsAckError(ack), string(ack))
+	suite.Require().Zero(suite.balance(suite.recoveryAddress(), suite.receivedDenom()))
+}
+
+func (suite *SwapTestSuite) TestBlockedMemoAddress() {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	for _, modify := range []func(*types.SwapMemo){
+		func(m *types.SwapMemo) { m.Receiver = feeCollector },
+		func(m *types.SwapMemo) { m.RecoveryAddress = feeCollector },
+	} {
+		suite.SetupTest()
+		ack := suite.receivePacket(suite.swapMemo(modify))
+		suite.Require().True(utils.IsAckError(ack), string(ack))
+
+		// the funds are neither swapped nor kept by the intermediate sender
+		suite.Require().Zero(suite.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), params.DefaultDenom))
+		sender := types.IntermediateSender(suite.TransferPath.EndpointA.ChannelID, suite.ChainB.SenderAccount.GetAddress().String())
+		suite.Require().Zero(suite.balance(sender, suite.receivedDenom()))
+	}
+}
+
+func (suite *SwapTestSuite) TestFailedSwapIsRecovered() {
+	ack := suite.receivePacket(suite.swapMemo(func(m *types.SwapMemo) { m.MinAmountOut = "1000" }))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+
+	suite.Require().Zero(suite.balance(suite.receiver(), params.DefaultDenom))
+	suite.Require().Equal(int64(1000), suite.balance(suite.recoveryAddress(), suite.receivedDenom()))
+}
+
+func (suite *SwapTestSuite) TestFailedForwardIsRecovered() {
+	ack := suite.receivePacket(suite.swapMemo(func(m *types.SwapMemo) {
+		m.Receiver = ""
+		m.Forward = &types.ForwardMetadata{Receiver: suite.ChainB.SenderAccount.GetAddress().String(), Channel: "channel-99"}
+	}))
+	suite.Require().False(utils.IsAckError(ack), string(ack))
+
+	suite.Require().Equal(int64(996), suite.balance(suite.recoveryAddress(), params.DefaultDenom))
+}
+
+func (suite *SwapTestSuite) TestSwapAndForward() {
+	for _, tc := range []struct {
+		desc     string
+		receiver string
+		success  bool
+	}{
+		{"forward is delivered", suite.ChainB.SenderAccount.GetAddress().String(), true},
+		{"rejected forward is recovered", "invalid receiver", false},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			app := suite.GetNeutronZoneApp(suite.ChainA)
+			forwardChannel := suite.TransferPath.EndpointA.ChannelID
+			ack := suite.receivePacket(suite.swapMemo(func(m *types.SwapMemo) {
+				m.Receiver = ""
+				m.Forward = &types.ForwardMetadata{Receiver: tc.receiver, Channel: forwardChannel, Timeout: "10m"}
+			}))
+			suite.Require().False(utils.IsAckError(ack), string(ack))
+
+			forwards := app.IBCSwapKeeper.GetAllPendingForwards(suite.ChainA.GetContext())
+			suite.Require().Len(forwards, 1)
+			suite.Require().Equal(sdk.NewInt64Coin(params.DefaultDenom, 996), forwards[0].Token)
+
+			suite.relayForward(suite.lastRecvEvents)
+			suite.Require().Empty(app.IBCSwapKeeper.GetAllPendingForwards(suite.ChainA.GetContext()))
+
+			recovered := suite.balance(suite.recoveryAddress(), params.DefaultDenom)
+			if tc.success {
+				suite.Require().Zero(recovered)
+			} else {
+				suite.Require().Equal(int64(996), recovered)
+			}
+		})
+	}
+}
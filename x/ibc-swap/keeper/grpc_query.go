@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) PendingForwards(goCtx context.Context, req *types.QueryPendingForwardsRequest) (*types.QueryPendingForwardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingForwardKey)
+	forwards := make([]types.PendingForward, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var forward types.PendingForward
+		if err := k.cdc.Unmarshal(value, &forward); err != nil {
+			return err
+		}
+		forwards = append(forwards, forward)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingForwardsResponse{PendingForwards: forwards, Pagination: pageRes}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+
+		bankKeeper        types.BankKeeper
+		poolManagerKeeper types.PoolManagerKeeper
+		transferKeeper    types.TransferKeeper
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+) *Keeper {
+	return &Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+	}
+}
+
+// SetPoolManagerKeeper sets the keeper swapping the received funds, which is created after the
+// IBC transfer stack
+func (k *Keeper) SetPoolManagerKeeper(poolManagerKeeper types.PoolManagerKeeper) {
+	k.poolManagerKeeper = poolManagerKeeper
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+)
+
+// GetPendingForward gets the pending forward sent in a packet
+func (k Keeper) GetPendingForward(ctx sdk.Context, channelID string, sequence uint64) (types.PendingForward, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPendingForwardKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingForward{}, false
+	}
+
+	var forward types.PendingForward
+	k.cdc.MustUnmarshal(bz, &forward)
+	return forward, true
+}
+
+// SetPendingForward sets a pending forward until its packet is acknowledged or timed out
+func (k Keeper) SetPendingForward(ctx sdk.Context, forward types.PendingForward) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPendingForwardKey(forward.ChannelId, forward.Sequence), k.cdc.MustMarshal(&forward))
+}
+
+// RemovePendingForward removes the pending forward sent in a packet
+func (k Keeper) RemovePendingForward(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPendingForwardKey(channelID, sequence))
+}
+
+// GetAllPendingForwards returns all the pending forwards
+func (k Keeper) GetAllPendingForwards(ctx sdk.Context) []types.PendingForward {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingForwardKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	forwards := make([]types.PendingForward, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var forward types.PendingForward
+		k.cdc.MustUnmarshal(iterator.Value(), &forward)
+		forwards = append(forwards, forward)
+	}
+
+	return forwards
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-hooks/utils"
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// ValidateMemoAddresses rejects a memo delivering funds to a blocked address, e.g. a module account,
+// as the swap output and the recovered funds are sent to the memo addresses with a plain bank send.
+func (k Keeper) ValidateMemoAddresses(memo types.SwapMemo) error {
+	recoveryAddress, err := sdk.AccAddressFromBech32(memo.RecoveryAddress)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+	}
+	if k.bankKeeper.BlockedAddr(recoveryAddress) {
+		return errors.Wrapf(types.ErrInvalidSwapMemo, "recovery_address %s is not allowed to receive funds", memo.RecoveryAddress)
+	}
+	if memo.Receiver != "" {
+		receiver, err := sdk.AccAddressFromBech32(memo.Receiver)
+		if err != nil {
+			return errors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+		}
+		if k.bankKeeper.BlockedAddr(receiver) {
+			return errors.Wrapf(types.ErrInvalidSwapMemo, "receiver %s is not allowed to receive funds", memo.Receiver)
+		}
+	}
+	return nil
+}
+
+// SwapAndForward swaps the funds received by the intermediate sender through the routes of the memo,
+// and delivers the output to the memo receiver or forwards it to the next hop. The funds are sent to
+// the recovery address if the swap or the forward fails, an error is only returned if they cannot be.
+func (k Keeper) SwapAndForward(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, memo types.SwapMemo) error {
+	recoveryAddress, err := sdk.AccAddressFromBech32(memo.RecoveryAddress)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	tokenOut, err := k.swap(cacheCtx, sender, tokenIn, memo)
+	if err != nil {
+		return k.recover(ctx, sender, recoveryAddress, tokenIn, errors.Wrap(types.ErrSwapFailed, err.Error()))
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwap,
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenOut, tokenOut.String()),
+	))
+
+	if memo.Forward == nil {
+		receiver, err := sdk.AccAddressFromBech32(memo.Receiver)
+		if err != nil {
+			return errors.Wrap(types.ErrInvalidSwapMemo, err.Error())
+		}
+		return k.bankKeeper.SendCoins(ctx, sender, receiver, sdk.NewCoins(tokenOut))
+	}
+
+	cacheCtx, writeCache = ctx.CacheContext()
+	if err := k.forward(cacheCtx, sender, recoveryAddress, tokenOut, *memo.Forward); err != nil {
+		return k.recover(ctx, sender, recoveryAddress, tokenOut, errors.Wrap(types.ErrForwardFailed, err.Error()))
+	}
+	writeCache()
+
+	return nil
+}
+
+func (k Keeper) swap(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, memo types.SwapMemo) (sdk.Coin, error) {
+	minAmountOut, err := memo.GetMinAmountOut()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountOut, err := k.poolManagerKeeper.RouteExactAmountIn(ctx, sender, memo.Routes, tokenIn, minAmountOut, poolmanagertypes.SwapProtection{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(memo.Routes[len(memo.Routes)-1].TokenOutDenom, amountOut), nil
+}
+
+// forward sends the swap output to the next hop. The output is kept as a pending forward until the
+// packet is acknowledged or timed out.
+func (k Keeper) forward(ctx sdk.Context, sender, recoveryAddress sdk.AccAddress, token sdk.Coin, forward types.ForwardMetadata) error {
+	timeout, err := forward.GetTimeout()
+	if err != nil {
+		return err
+	}
+	memo, err := forward.GetNextMemo()
+	if err != nil {
+		return err
+	}
+
+	res, err := k.transferKeeper.Transfer(ctx, ibctransfertypes.NewMsgTransfer(
+		forward.GetPort(),
+		forward.Channel,
+		token,
+		sender.String(),
+		forward.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		memo,
+	))
+	if err != nil {
+		return err
+	}
+
+	k.SetPendingForward(ctx, types.PendingForward{
+		ChannelId:       forward.Channel,
+		Sequence:        res.Sequence,
+		Sender:          sender.String(),
+		RecoveryAddress: recoveryAddress.String(),
+		Token:           token,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeForward,
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, forward.Receiver),
+		sdk.NewAttribute(types.AttributeKeyToken, token.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, forward.Channel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(res.Sequence, 10)),
+	))
+
+	return nil
+}
+
+// recover sends the funds of a failed swap or forward to the recovery address
+func (k Keeper) recover(ctx sdk.Context, sender, recoveryAddress sdk.AccAddress, token sdk.Coin, cause error) error {
+	if err := k.bankKeeper.SendCoins(ctx, sender, recoveryAddress, sdk.NewCoins(token)); err != nil {
+		return errors.Wrapf(err, "failed to recover funds after %s", cause)
+	}
+
+	k.Logger(ctx).Info("Recovered ibc swap funds", "recovery_address", recoveryAddress.String(), "token", token.String(), "error", cause.Error())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecovery,
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRecoveryAddress, recoveryAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyToken, token.String()),
+		sdk.NewAttribute(types.AttributeKeyError, cause.Error()),
+	))
+
+	return nil
+}
+
+// OnAcknowledgementPacket removes the pending forward sent in an acknowledged packet. A failed forward
+// is refunded to the intermediate sender by the transfer module, and the refund is sent to the
+// recovery address.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, channelID string, sequence uint64, acknowledgement []byte) error {
+	forward, found := k.GetPendingForward(ctx, channelID, sequence)
+	if !found {
+		return nil
+	}
+	k.RemovePendingForward(ctx, channelID, sequence)
+
+	success := !utils.IsAckError(acknowledgement)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeForwardAck,
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+	))
+	if success {
+		return nil
+	}
+
+	return k.recoverForward(ctx, forward, errors.Wrap(types.ErrForwardFailed, "error acknowledgement"))
+}
+
+// OnTimeoutPacket removes the pending forward sent in a timed out packet and sends its refund to the
+// recovery address.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, channelID string, sequence uint64) error {
+	forward, found := k.GetPendingForward(ctx, channelID, sequence)
+	if !found {
+		return nil
+	}
+	k.RemovePendingForward(ctx, channelID, sequence)
+
+	return k.recoverForward(ctx, forward, errors.Wrap(types.ErrForwardFailed, "timeout"))
+}
+
+func (k Keeper) recoverForward(ctx sdk.Context, forward types.PendingForward, cause error) error {
+	sender, err := sdk.AccAddressFromBech32(forward.Sender)
+	if err != nil {
+		return err
+	}
+	recoveryAddress, err := sdk.AccAddressFromBech32(forward.RecoveryAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.recover(ctx, sender, recoveryAddress, forward.Token, cause)
+}
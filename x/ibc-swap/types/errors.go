@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/ibc-swap module sentinel errors
+var (
+	ErrInvalidSwapMemo = errors.Register(ModuleName, 1100, "invalid swap memo")
+	ErrSwapFailed      = errors.Register(ModuleName, 1101, "swap failed")
+	ErrForwardFailed   = errors.Register(ModuleName, 1102, "forward failed")
+)
@@ -0,0 +1,20 @@
+package types
+
+// ibc-swap module event types
+const (
+	EventTypeSwap       = "ibc_swap"
+	EventTypeForward    = "ibc_swap_forward"
+	EventTypeForwardAck = "ibc_swap_forward_ack"
+	EventTypeRecovery   = "ibc_swap_recovery"
+
+	AttributeKeySender          = "sender"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyRecoveryAddress = "recovery_address"
+	AttributeKeyTokenIn         = "token_in"
+	AttributeKeyTokenOut        = "token_out"
+	AttributeKeyToken           = "token"
+	AttributeKeyChannelID       = "channel_id"
+	AttributeKeySequence        = "sequence"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// BankKeeper defines the expected interface needed to deliver swap outputs.
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// PoolManagerKeeper defines the expected interface needed to swap the received funds.
+type PoolManagerKeeper interface {
+	RouteExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		route []poolmanagertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount osmomath.Int,
+		protection poolmanagertypes.SwapProtection,
+	) (tokenOutAmount osmomath.Int, err error)
+}
+
+// TransferKeeper defines the expected interface needed to forward swap outputs to the next hop.
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
+}
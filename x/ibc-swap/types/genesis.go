@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PendingForwards: []PendingForward{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.PendingForwards))
+	for _, forward := range gs.PendingForwards {
+		if err := forward.Validate(); err != nil {
+			return err
+		}
+		key := string(GetPendingForwardKey(forward.ChannelId, forward.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending forward for channel %s and sequence %d", forward.ChannelId, forward.Sequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate validates a pending forward
+func (f PendingForward) Validate() error {
+	if f.ChannelId == "" {
+		return fmt.Errorf("pending forward channel_id must not be empty")
+	}
+	if f.Sequence == 0 {
+		return fmt.Errorf("pending forward sequence must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Sender); err != nil {
+		return fmt.Errorf("invalid pending forward sender: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(f.RecoveryAddress); err != nil {
+		return fmt.Errorf("invalid pending forward recovery_address: %w", err)
+	}
+	if !f.Token.IsValid() {
+		return fmt.Errorf("invalid pending forward token %s", f.Token)
+	}
+	return nil
+}
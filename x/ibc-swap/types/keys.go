@@ -0,0 +1,31 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "swap-ibc" // IBC at the end to avoid conflicts with the ibc prefix
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// SenderPrefix is the prefix of the intermediate accounts receiving the funds to swap
+	SenderPrefix = "ibc-swap-intermediary"
+)
+
+const (
+	prefixPendingForwardKey = iota + 1
+)
+
+var PendingForwardKey = []byte{prefixPendingForwardKey}
+
+// GetPendingForwardKey returns the store key of the pending forward sent in a packet
+func GetPendingForwardKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, PendingForwardKey...)
+	key = append(key, byte(len(channelID)))
+	key = append(key, channelID...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
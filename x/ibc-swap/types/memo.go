@@ -0,0 +1,146 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// DefaultForwardTimeout is the timeout of forwards that do not set one, following the IBC defaults
+var DefaultForwardTimeout = time.Duration(ibctransfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+// Memo is the ICS20 packet memo of a swap
+type Memo struct {
+	Swap *SwapMemo `json:"swap"`
+}
+
+// SwapMemo swaps the received funds through the routes and delivers the output to the receiver, or
+// forwards it to the next hop. The funds are sent to the recovery address if the swap or the forward fails.
+type SwapMemo struct {
+	Routes          []poolmanagertypes.SwapAmountInRoute `json:"routes"`
+	MinAmountOut    string                               `json:"min_amount_out"`
+	Receiver        string                               `json:"receiver,omitempty"`
+	RecoveryAddress string                               `json:"recovery_address"`
+	Forward         *ForwardMetadata                     `json:"forward,omitempty"`
+}
+
+// ForwardMetadata forwards the swap output to the next hop, with the same layout as the
+// packet-forward-middleware metadata
+type ForwardMetadata struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port,omitempty"`
+	Channel  string `json:"channel"`
+	// Timeout of the forward packet relative to the block time, e.g. "10m"
+	Timeout string `json:"timeout,omitempty"`
+	// Next is the memo of the forward packet, e.g. the forward metadata of the following hop
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Validate validates a swap memo
+func (m SwapMemo) Validate() error {
+	if err := poolmanagertypes.SwapAmountInRoutes(m.Routes).Validate(); err != nil {
+		return errors.Wrap(ErrInvalidSwapMemo, err.Error())
+	}
+	if _, err := m.GetMinAmountOut(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecoveryAddress); err != nil {
+		return errors.Wrapf(ErrInvalidSwapMemo, "invalid recovery_address: %s", err)
+	}
+
+	if (m.Receiver == "") == (m.Forward == nil) {
+		return errors.Wrap(ErrInvalidSwapMemo, "exactly one of receiver and forward must be set")
+	}
+	if m.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+			return errors.Wrapf(ErrInvalidSwapMemo, "invalid receiver: %s", err)
+		}
+	}
+	if m.Forward != nil {
+		return m.Forward.Validate()
+	}
+
+	return nil
+}
+
+// GetMinAmountOut returns the minimum amount of the swap output
+func (m SwapMemo) GetMinAmountOut() (math.Int, error) {
+	minAmountOut, ok := math.NewIntFromString(m.MinAmountOut)
+	if !ok || !minAmountOut.IsPositive() {
+		return math.Int{}, errors.Wrapf(ErrInvalidSwapMemo, "min_amount_out %s must be a positive integer", m.MinAmountOut)
+	}
+	return minAmountOut, nil
+}
+
+// Validate validates a forward metadata
+func (f ForwardMetadata) Validate() error {
+	if f.Receiver == "" {
+		return errors.Wrap(ErrInvalidSwapMemo, "forward receiver must not be empty")
+	}
+	if err := host.PortIdentifierValidator(f.GetPort()); err != nil {
+		return errors.Wrapf(ErrInvalidSwapMemo, "invalid forward port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(f.Channel); err != nil {
+		return errors.Wrapf(ErrInvalidSwapMemo, "invalid forward channel: %s", err)
+	}
+	if _, err := f.GetTimeout(); err != nil {
+		return err
+	}
+	if _, err := f.GetNextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPort returns the port of the forward, the transfer port if not set
+func (f ForwardMetadata) GetPort() string {
+	if f.Port == "" {
+		return ibctransfertypes.PortID
+	}
+	return f.Port
+}
+
+// GetTimeout returns the timeout of the forward, the default forward timeout if not set
+func (f ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if f.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+	timeout, err := time.ParseDuration(f.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, errors.Wrapf(ErrInvalidSwapMemo, "forward timeout %s must be a positive duration", f.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forward packet. Next is either a JSON object, used as is,
+// or a JSON string holding the memo.
+func (f ForwardMetadata) GetNextMemo() (string, error) {
+	if len(f.Next) == 0 {
+		return "", nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(f.Next, &memo); err == nil {
+		return memo, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(f.Next, &object); err != nil {
+		return "", errors.Wrap(ErrInvalidSwapMemo, "forward next must be a JSON object or string")
+	}
+	return string(f.Next), nil
+}
+
+// IntermediateSender returns the account receiving the funds to swap of the packets received from
+// a sender through a channel
+func IntermediateSender(channelID, originalSender string) sdk.AccAddress {
+	return address.Hash(SenderPrefix, []byte(fmt.Sprintf("%s/%s", channelID, originalSender)))
+}
@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/ibc-swap/types"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+func TestSwapMemoValidate(t *testing.T) {
+	addr := sdk.AccAddress("address_____________").String()
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(m *types.SwapMemo)
+		errMsg string
+	}{
+		{
+			desc:   "deliver to receiver",
+			modify: func(_ *types.SwapMemo) {},
+		},
+		{
+			desc: "forward to the next hop",
+			modify: func(m *types.SwapMemo) {
+				m.Receiver = ""
+				m.Forward = &types.ForwardMetadata{Receiver: "cosmos1receiver", Channel: "channel-1", Timeout: "10m", Next: json.RawMessage(`{"forward":{}}`)}
+			},
+		},
+		{
+			desc:   "no route",
+			modify: func(m *types.SwapMemo) { m.Routes = nil },
+			errMsg: "invalid swap memo",
+		},
+		{
+			desc:   "zero min amount out",
+			modify: func(m *types.SwapMemo) { m.MinAmountOut = "0" },
+			errMsg: "min_amount_out 0 must be a positive integer",
+		},
+		{
+			desc:   "no recovery address",
+			modify: func(m *types.SwapMemo) { m.RecoveryAddress = "" },
+			errMsg: "invalid recovery_address",
+		},
+		{
+			desc:   "no receiver nor forward",
+			modify: func(m *types.SwapMemo) { m.Receiver = "" },
+			errMsg: "exactly one of receiver and forward must be set",
+		},
+		{
+			desc: "receiver and forward",
+			modify: func(m *types.SwapMemo) {
+				m.Forward = &types.ForwardMetadata{Receiver: "cosmos1receiver", Channel: "channel-1"}
+			},
+			errMsg: "exactly one of receiver and forward must be set",
+		},
+		{
+			desc: "invalid forward timeout",
+			modify: func(m *types.SwapMemo) {
+				m.Receiver = ""
+				m.Forward = &types.ForwardMetadata{Receiver: "cosmos1receiver", Channel: "channel-1", Timeout: "-1m"}
+			},
+			errMsg: "forward timeout -1m must be a positive duration",
+		},
+		{
+			desc: "invalid forward next",
+			modify: func(m *types.SwapMemo) {
+				m.Receiver = ""
+				m.Forward = &types.ForwardMetadata{Receiver: "cosmos1receiver", Channel: "channel-1", Next: json.RawMessage(`[]`)}
+			},
+			errMsg: "forward next must be a JSON object or string",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memo := types.SwapMemo{
+				Routes:          []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uatom"}},
+				MinAmountOut:    "100",
+				Receiver:        addr,
+				RecoveryAddress: addr,
+			}
+			tc.modify(&memo)
+			err := memo.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/ibcswap/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPendingForwardsRequest is request type for the Query/PendingForwards RPC method.
+type QueryPendingForwardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingForwardsRequest) Reset()         { *m = QueryPendingForwardsRequest{} }
+func (m *QueryPendingForwardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingForwardsRequest) ProtoMessage()    {}
+func (*QueryPendingForwardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed98289e49e533d3, []int{0}
+}
+func (m *QueryPendingForwardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingForwardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingForwardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingForwardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingForwardsRequest.Merge(m, src)
+}
+func (m *QueryPendingForwardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingForwardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingForwardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingForwardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingForwardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingForwardsResponse is response type for the Query/PendingForwards RPC method.
+type QueryPendingForwardsResponse struct {
+	PendingForwards []PendingForward    `protobuf:"bytes,1,rep,name=pending_forwards,json=pendingForwards,proto3" json:"pending_forwards"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingForwardsResponse) Reset()         { *m = QueryPendingForwardsResponse{} }
+func (m *QueryPendingForwardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingForwardsResponse) ProtoMessage()    {}
+func (*QueryPendingForwardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed98289e49e533d3, []int{1}
+}
+func (m *QueryPendingForwardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingForwardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingForwardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingForwardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingForwardsResponse.Merge(m, src)
+}
+func (m *QueryPendingForwardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingForwardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingForwardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingForwardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingForwardsResponse) GetPendingForwards() []PendingForward {
+	if m != nil {
+		return m.PendingForwards
+	}
+	return nil
+}
+
+func (m *QueryPendingForwardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPendingForwardsRequest)(nil), "maany.ibcswap.v1.QueryPendingForwardsRequest")
+	proto.RegisterType((*QueryPendingForwardsResponse)(nil), "maany.ibcswap.v1.QueryPendingForwardsResponse")
+}
+
+func init() { proto.RegisterFile("maany/ibcswap/v1/query.proto", fileDescriptor_ed98289e49e533d3) }
+
+var fileDescriptor_ed98289e49e533d3 = []byte{
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xea, 0x30,
+	0x14, 0xc6, 0x63, 0xee, 0x9f, 0xc1, 0x0c, 0xa0, 0xe8, 0x0e, 0x08, 0x50, 0x2e, 0x8a, 0xae, 0x6e,
+	0x11, 0x12, 0xb6, 0x92, 0xaa, 0x2f, 0xc0, 0x40, 0x97, 0x0e, 0xc0, 0xd8, 0xa5, 0x72, 0xc0, 0x75,
+	0x23, 0x15, 0xdb, 0xc4, 0x21, 0x90, 0x8e, 0x7d, 0x82, 0x4a, 0x5d, 0x3b, 0xf7, 0x21, 0xfa, 0x04,
+	0x8c, 0x48, 0x5d, 0x3a, 0x55, 0x15, 0xf4, 0x41, 0xaa, 0xd8, 0x91, 0xca, 0x9f, 0xaa, 0xed, 0x76,
+	0x94, 0xef, 0x7c, 0xdf, 0xf9, 0x9d, 0x13, 0xc3, 0xfa, 0x98, 0x10, 0x9e, 0xe2, 0x30, 0x18, 0xaa,
+	0x19, 0x91, 0x38, 0xf1, 0xf0, 0x64, 0x4a, 0xa3, 0x14, 0xc9, 0x48, 0xc4, 0xc2, 0x2e, 0x6b, 0x15,
+	0xe5, 0x2a, 0x4a, 0xbc, 0x6a, 0x6b, 0x28, 0xd4, 0x58, 0x28, 0x1c, 0x10, 0x45, 0x4d, 0x2b, 0x4e,
+	0xbc, 0x80, 0xc6, 0xc4, 0xc3, 0x92, 0xb0, 0x90, 0x93, 0x38, 0x14, 0xdc, 0xb8, 0xab, 0x7f, 0x98,
+	0x60, 0x42, 0x97, 0x38, 0xab, 0xf2, 0xaf, 0x75, 0x26, 0x04, 0xbb, 0xa4, 0x98, 0xc8, 0x10, 0x13,
+	0xce, 0x45, 0xac, 0x2d, 0x2a, 0x57, 0x6b, 0x7b, 0x3c, 0x7a, 0xb2, 0x16, 0x5d, 0x0a, 0x6b, 0xfd,
+	0x6c, 0x64, 0x8f, 0xf2, 0x51, 0xc8, 0x59, 0x57, 0x44, 0x33, 0x12, 0x8d, 0xd4, 0x80, 0x4e, 0xa6,
+	0x54, 0xc5, 0x76, 0x17, 0xc2, 0x77, 0x86, 0x0a, 0x68, 0x80, 0x66, 0xd1, 0xff, 0x8f, 0x0c, 0x30,
+	0xca, 0x80, 0x91, 0xd9, 0x2d, 0x07, 0x46, 0x3d, 0xc2, 0x68, 0xee, 0x1d, 0x6c, 0x38, 0xdd, 0x07,
+	0x00, 0xeb, 0x1f, 0xcf, 0x51, 0x52, 0x70, 0x45, 0xed, 0x3e, 0x2c, 0x4b, 0x23, 0x9d, 0x9d, 0xe7,
+	0x5a, 0x05, 0x34, 0x7e, 0x34, 0x8b, 0x7e, 0x03, 0xed, 0x5e, 0x0c, 0x6d, 0x87, 0x74, 0x7e, 0x2e,
+	0x9e, 0xff, 0x5a, 0x83, 0x92, 0xdc, 0x8e, 0xb6, 0x8f, 0xb7, 0xd8, 0x0b, 0x9a, 0xfd, 0xe0, 0x4b,
+	0x76, 0xc3, 0xb3, 0x09, 0xef, 0xdf, 0x03, 0xf8, 0x4b, 0xc3, 0xdb, 0x77, 0x00, 0x96, 0x76, 0x36,
+	0xb0, 0xdb, 0xfb, 0x7c, 0x9f, 0x5c, 0xb4, 0x8a, 0xbe, 0xdb, 0x6e, 0x40, 0xdc, 0xd6, 0xf5, 0xe3,
+	0xeb, 0x6d, 0xe1, 0x9f, 0xed, 0xe2, 0xbd, 0xdf, 0xb8, 0x7b, 0xb0, 0xce, 0xc9, 0x62, 0xe5, 0x80,
+	0xe5, 0xca, 0x01, 0x2f, 0x2b, 0x07, 0xdc, 0xac, 0x1d, 0x6b, 0xb9, 0x76, 0xac, 0xa7, 0xb5, 0x63,
+	0x9d, 0xfa, 0x2c, 0x8c, 0x2f, 0xa6, 0x01, 0x1a, 0x8a, 0xb1, 0xc9, 0x69, 0xcf, 0xd3, 0xab, 0xbc,
+	0x1a, 0xd1, 0x39, 0x4e, 0x8e, 0xf0, 0x3c, 0x8b, 0x6e, 0xeb, 0xec, 0x38, 0x95, 0x54, 0x05, 0xbf,
+	0xf5, 0x0b, 0x39, 0x7c, 0x1b, 0x00, 0xb3, 0x7b, 0x5d, 0xc9, 0xd0, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingForwards queries the swap outputs forwarded to the next hop that have not been
+	// acknowledged yet.
+	PendingForwards(ctx context.Context, in *QueryPendingForwardsRequest, opts ...grpc.CallOption) (*QueryPendingForwardsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingForwards(ctx context.Context, in *QueryPendingForwardsRequest, opts ...grpc.CallOption) (*QueryPendingForwardsResponse, error) {
+	out := new(QueryPendingForwardsResponse)
+	err := c.cc.Invoke(ctx, "/maany.ibcswap.v1.Query/PendingForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingForwards queries the swap outputs forwarded to the next hop that have not been
+	// acknowledged yet.
+	PendingForwards(context.Context, *QueryPendingForwardsRequest) (*QueryPendingForwardsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingForwards(ctx context.Context, req *QueryPendingForwardsRequest) (*QueryPendingForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingForwards not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.ibcswap.v1.Query/PendingForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingForwards(ctx, req.(*QueryPendingForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.ibcswap.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingForwards",
+			Handler:    _Query_PendingForwards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/ibcswap/v1/query.proto",
+}
+
+func (m *QueryPendingForwardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingForwardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingForwardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingForwards) > 0 {
+		for iNdEx := len(m.PendingForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingForwardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingForwards) > 0 {
+		for _, e := range m.PendingForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingForwardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingForwardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingForwardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingForwardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingForwardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingForwards = append(m.PendingForwards, PendingForward{})
+			if err := m.PendingForwards[len(m.PendingForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,522 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/ibcswap/v1/swap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingForward defines the swap output forwarded to the next hop that has not been
+// acknowledged yet. The output is sent to the recovery address if the forward fails.
+type PendingForward struct {
+	// Channel and sequence of the forward packet
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Intermediate account that sent the forward packet and is refunded on failure
+	Sender          string     `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	RecoveryAddress string     `protobuf:"bytes,4,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	Token           types.Coin `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+}
+
+func (m *PendingForward) Reset()         { *m = PendingForward{} }
+func (m *PendingForward) String() string { return proto.CompactTextString(m) }
+func (*PendingForward) ProtoMessage()    {}
+func (*PendingForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c97b55bb9d82d394, []int{0}
+}
+func (m *PendingForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingForward.Merge(m, src)
+}
+func (m *PendingForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingForward proto.InternalMessageInfo
+
+func (m *PendingForward) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingForward) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingForward) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingForward) GetRecoveryAddress() string {
+	if m != nil {
+		return m.RecoveryAddress
+	}
+	return ""
+}
+
+func (m *PendingForward) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*PendingForward)(nil), "maany.ibcswap.v1.PendingForward")
+}
+
+func init() { proto.RegisterFile("maany/ibcswap/v1/swap.proto", fileDescriptor_c97b55bb9d82d394) }
+
+var fileDescriptor_c97b55bb9d82d394 = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xff, 0x6f, 0x2b, 0x6a, 0x24, 0x28, 0x11, 0x42, 0xa1, 0x88, 0x50, 0x31, 0x15,
+	0xa4, 0xda, 0x4a, 0x11, 0x0b, 0x1b, 0x45, 0x42, 0x42, 0x62, 0x40, 0x1d, 0x59, 0x2a, 0x27, 0xbe,
+	0x4a, 0x2d, 0x88, 0x5d, 0xec, 0x34, 0x6d, 0x78, 0x0a, 0x1e, 0x83, 0x91, 0xc7, 0xa8, 0x98, 0x3a,
+	0x32, 0x21, 0xd4, 0x0e, 0xbc, 0x06, 0x8a, 0x13, 0x58, 0xec, 0x73, 0xcf, 0xb9, 0x77, 0x38, 0x1f,
+	0x3e, 0x48, 0x18, 0x93, 0x39, 0x15, 0x61, 0x64, 0x66, 0x6c, 0x42, 0xb3, 0x80, 0x16, 0x3f, 0x99,
+	0x68, 0x95, 0x2a, 0xb7, 0x65, 0x43, 0x52, 0x85, 0x24, 0x0b, 0xda, 0x3b, 0x2c, 0x11, 0x52, 0x51,
+	0xfb, 0x96, 0x4b, 0x6d, 0x3f, 0x52, 0x26, 0x51, 0x86, 0x86, 0xcc, 0x00, 0xcd, 0x82, 0x10, 0x52,
+	0x16, 0xd0, 0x48, 0x09, 0x59, 0xe5, 0xbb, 0xb1, 0x8a, 0x95, 0x95, 0xb4, 0x50, 0xa5, 0x7b, 0xfc,
+	0x8e, 0xf0, 0xd6, 0x1d, 0x48, 0x2e, 0x64, 0x7c, 0xad, 0xf4, 0x8c, 0x69, 0xee, 0x1e, 0x62, 0x1c,
+	0x8d, 0x99, 0x94, 0xf0, 0x38, 0x12, 0xdc, 0x43, 0x1d, 0xd4, 0x6d, 0x0e, 0x9b, 0x95, 0x73, 0xc3,
+	0xdd, 0x36, 0xde, 0x30, 0xf0, 0x34, 0x05, 0x19, 0x81, 0xf7, 0xaf, 0x83, 0xba, 0xb5, 0xe1, 0xdf,
+	0xec, 0xee, 0xe1, 0x86, 0x01, 0xc9, 0x41, 0x7b, 0xff, 0xed, 0x59, 0x35, 0xb9, 0x27, 0xb8, 0xa5,
+	0x21, 0x52, 0x19, 0xe8, 0x7c, 0xc4, 0x38, 0xd7, 0x60, 0x8c, 0x57, 0xb3, 0x1b, 0xdb, 0xbf, 0xfe,
+	0x65, 0x69, 0xbb, 0x17, 0xb8, 0x9e, 0xaa, 0x07, 0x90, 0x5e, 0xbd, 0x83, 0xba, 0x9b, 0xfd, 0x7d,
+	0x52, 0xd6, 0x22, 0x45, 0x2d, 0x52, 0xd5, 0x22, 0x57, 0x4a, 0xc8, 0x41, 0x73, 0xf1, 0x79, 0xe4,
+	0xbc, 0x7e, 0xbf, 0x9d, 0xa2, 0x61, 0x79, 0x32, 0xb8, 0x5d, 0xac, 0x7c, 0xb4, 0x5c, 0xf9, 0xe8,
+	0x6b, 0xe5, 0xa3, 0x97, 0xb5, 0xef, 0x2c, 0xd7, 0xbe, 0xf3, 0xb1, 0xf6, 0x9d, 0xfb, 0x7e, 0x2c,
+	0xd2, 0xf1, 0x34, 0x24, 0x91, 0x4a, 0xa8, 0x85, 0xd9, 0x9b, 0xe7, 0xcf, 0x95, 0xe2, 0x30, 0xa7,
+	0xd9, 0x39, 0x9d, 0x17, 0xf0, 0x7b, 0x96, 0x7e, 0x9a, 0x4f, 0xc0, 0x84, 0x0d, 0x4b, 0xe8, 0xec,
+	0x67, 0x00, 0x32, 0xa1, 0xff, 0x79, 0x9b, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RecoveryAddress) > 0 {
+		i -= len(m.RecoveryAddress)
+		copy(dAtA[i:], m.RecoveryAddress)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.RecoveryAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSwap(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.RecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func sovSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwap(x uint64) (n int) {
+	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -419,6 +419,48 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// RegisterModuleKVQuery registers a KV interchain query on behalf of another module. Unlike
+// MsgRegisterInterchainQuery, the owner is not required to be a contract and no deposit is
+// collected. The owner module reads the query results via GetQueryResultByID, no sudo callback
+// is ever made for the query.
+func (k Keeper) RegisterModuleKVQuery(ctx sdk.Context, owner, connectionID string, keys []*types.KVKey, updatePeriod uint64) (uint64, error) {
+	params := k.GetParams(ctx)
+	msg := types.MsgRegisterInterchainQuery{
+		QueryType:    string(types.InterchainQueryTypeKV),
+		Keys:         keys,
+		ConnectionId: connectionID,
+		UpdatePeriod: updatePeriod,
+		Sender:       owner,
+	}
+	if err := msg.Validate(params); err != nil {
+		return 0, errors.Wrap(err, "failed to validate module KV query")
+	}
+	if _, found := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID); !found {
+		return 0, errors.Wrapf(types.ErrInvalidConnectionID, "connection with ID '%s' not found", connectionID)
+	}
+
+	lastID := k.GetLastRegisteredQueryKey(ctx) + 1
+	registeredQuery := &types.RegisteredQuery{
+		Id:                 lastID,
+		Owner:              owner,
+		Keys:               keys,
+		QueryType:          string(types.InterchainQueryTypeKV),
+		UpdatePeriod:       updatePeriod,
+		ConnectionId:       connectionID,
+		SubmitTimeout:      params.QuerySubmitTimeout,
+		RegisteredAtHeight: uint64(ctx.BlockHeader().Height),
+	}
+	k.SetLastRegisteredQueryKey(ctx, lastID)
+
+	if err := k.SaveQuery(ctx, registeredQuery); err != nil {
+		return 0, errors.Wrapf(err, "failed to save query: %v", err)
+	}
+
+	ctx.EventManager().EmitEvents(getEventsQueryUpdated(registeredQuery))
+
+	return lastID, nil
+}
+
 // TxQueryToRemove contains data related to a single query listed for removal and needed in the
 // removal process.
 type TxQueryToRemove struct {
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+const (
+	flagTimeoutTimestamp = "timeout-timestamp"
+	flagMemo             = "memo"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdReturnToProvider())
+
+	return cmd
+}
+
+func CmdReturnToProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "return-to-provider [channel-id] [receiver] [amount]",
+		Short: "Return native tokens to the provider, they are burned once the provider acknowledges them",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %w", err)
+			}
+			timeout, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReturnToProvider{
+				Sender:           clientCtx.GetFromAddress().String(),
+				ChannelId:        args[0],
+				Receiver:         args[1],
+				Amount:           amount,
+				TimeoutTimestamp: timeout,
+				Memo:             memo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "absolute timeout in unix nanoseconds, 10 minutes from the block time if not set")
+	cmd.Flags().String(flagMemo, "", "ICS-20 packet memo")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package mintburn
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// BurnReturned burns the native tokens escrowed by a DEX→provider transfer once the provider
+// acknowledged it, and decrements the mirror supply. If the burn fails, the tokens stay in the
+// escrow, the mirror supply is left as is and the burn is queued for EndBlock retries.
+func (k Keeper) BurnReturned(ctx sdk.Context, channelID string, sequence uint64, escrowAddr sdk.AccAddress, coin sdk.Coin) {
+	if err := k.burnEscrowed(ctx, escrowAddr, coin); err != nil {
+		pending := types.PendingBurn{
+			ChannelId:     channelID,
+			Sequence:      sequence,
+			EscrowAddress: escrowAddr.String(),
+			Amount:        coin,
+			Attempts:      1,
+			LastError:     err.Error(),
+			CreatedHeight: ctx.BlockHeight(),
+		}
+		k.SetPendingBurn(ctx, pending)
+		k.Logger(ctx).Error("mintburn: burn escrow on DEX failed; queued for retry",
+			"channel_id", channelID, "sequence", sequence, "amount", coin.String(), "err", err)
+		emitBurnFailed(ctx, pending)
+		return
+	}
+
+	k.Logger(ctx).Info("mintburn: burned mirrored on DEX after ACK",
+		"amount", coin.String(), "escrow", escrowAddr.String())
+	emitBurnReturned(ctx, channelID, sequence, coin)
+}
+
+// RetryPendingBurns retries up to MaxBurnRetriesPerBlock queued burns, resuming after the last one
+// retried so that burns failing every time cannot starve the rest of the queue. A burn failing
+// MaxBurnAttempts times is parked and skipped until the authority retries it.
+func (k Keeper) RetryPendingBurns(ctx sdk.Context) {
+	for _, pending := range k.nextPendingBurns(ctx, types.MaxBurnRetriesPerBlock) {
+		escrowAddr, err := sdk.AccAddressFromBech32(pending.EscrowAddress)
+		if err == nil {
+			err = k.burnEscrowed(ctx, escrowAddr, pending.Amount)
+		}
+		if err != nil {
+			pending.Attempts++
+			pending.LastError = err.Error()
+			if pending.Attempts >= types.MaxBurnAttempts {
+				pending.Parked = true
+			}
+			k.SetPendingBurn(ctx, pending)
+			emitBurnFailed(ctx, pending)
+			if pending.Parked {
+				k.Logger(ctx).Error("mintburn: pending burn parked after too many attempts",
+					"channel_id", pending.ChannelId, "sequence", pending.Sequence, "attempts", pending.Attempts, "err", err)
+				emitBurnParked(ctx, pending)
+			}
+			continue
+		}
+
+		k.RemovePendingBurn(ctx, pending.ChannelId, pending.Sequence)
+		k.Logger(ctx).Info("mintburn: burned mirrored on DEX on retry",
+			"channel_id", pending.ChannelId, "sequence", pending.Sequence, "attempts", pending.Attempts+1)
+		emitBurnReturned(ctx, pending.ChannelId, pending.Sequence, pending.Amount)
+	}
+}
+
+// nextPendingBurns returns up to limit unparked pending burns, starting after the cursor and wrapping
+// around to the start of the queue, and moves the cursor to the last one returned.
+func (k Keeper) nextPendingBurns(ctx sdk.Context, limit int) []types.PendingBurn {
+	store := k.pendingBurnStore(ctx)
+	cursor := ctx.KVStore(k.StoreKey).Get(types.KeyPendingBurnCursor)
+
+	out := make([]types.PendingBurn, 0, limit)
+	collect := func(it storetypes.Iterator) {
+		defer it.Close()
+		for ; it.Valid() && len(out) < limit; it.Next() {
+			var pending types.PendingBurn
+			k.Cdc.MustUnmarshal(it.Value(), &pending)
+			if pending.Parked {
+				continue
+			}
+			out = append(out, pending)
+			cursor = it.Key()
+		}
+	}
+	if cursor == nil {
+		collect(store.Iterator(nil, nil))
+	} else {
+		// the keys after the cursor, then the ones up to the cursor included
+		afterCursor := append(append([]byte{}, cursor...), 0x00)
+		collect(store.Iterator(afterCursor, nil))
+		collect(store.Iterator(nil, afterCursor))
+	}
+
+	if len(out) > 0 {
+		ctx.KVStore(k.StoreKey).Set(types.KeyPendingBurnCursor, cursor)
+	}
+	return out
+}
+
+// burnEscrowed burns the escrowed coin and decrements the mirror supply, all or nothing.
+func (k Keeper) burnEscrowed(ctx sdk.Context, escrowAddr sdk.AccAddress, coin sdk.Coin) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.BurnEscrowedTokens(cacheCtx, escrowAddr, coin); err != nil {
+		return err
+	}
+	k.SubMirrorSupply(cacheCtx, coin.Amount)
+	writeFn()
+	return nil
+}
+
+func (k Keeper) pendingBurnStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyPendingBurnPrefix)
+}
+
+func (k Keeper) SetPendingBurn(ctx sdk.Context, pending types.PendingBurn) {
+	k.pendingBurnStore(ctx).Set(types.PendingBurnKey(pending.ChannelId, pending.Sequence), k.Cdc.MustMarshal(&pending))
+}
+
+// GetPendingBurn returns the pending burn of the transfer with the given source channel and sequence.
+func (k Keeper) GetPendingBurn(ctx sdk.Context, channelID string, sequence uint64) (types.PendingBurn, bool) {
+	bz := k.pendingBurnStore(ctx).Get(types.PendingBurnKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingBurn{}, false
+	}
+	var pending types.PendingBurn
+	k.Cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+func (k Keeper) RemovePendingBurn(ctx sdk.Context, channelID string, sequence uint64) {
+	k.pendingBurnStore(ctx).Delete(types.PendingBurnKey(channelID, sequence))
+}
+
+// GetPendingBurns returns up to limit pending burns, all of them if limit is 0.
+func (k Keeper) GetPendingBurns(ctx sdk.Context, limit int) []types.PendingBurn {
+	it := storetypes.KVStorePrefixIterator(k.pendingBurnStore(ctx), nil)
+	defer it.Close()
+
+	out := make([]types.PendingBurn, 0)
+	for ; it.Valid() && (limit == 0 || len(out) < limit); it.Next() {
+		var pending types.PendingBurn
+		k.Cdc.MustUnmarshal(it.Value(), &pending)
+		out = append(out, pending)
+	}
+	return out
+}
+
+func emitBurnReturned(ctx sdk.Context, channelID string, sequence uint64, coin sdk.Coin) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnReturned,
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+	))
+}
+
+func emitBurnFailed(ctx sdk.Context, pending types.PendingBurn) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnFailed,
+		sdk.NewAttribute(types.AttributeKeyChannelID, pending.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(pending.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyEscrowAddress, pending.EscrowAddress),
+		sdk.NewAttribute(types.AttributeKeyAmount, pending.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(pending.Attempts, 10)),
+		sdk.NewAttribute(types.AttributeKeyError, pending.LastError),
+	))
+}
+
+func emitBurnParked(ctx sdk.Context, pending types.PendingBurn) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnParked,
+		sdk.NewAttribute(types.AttributeKeyChannelID, pending.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(pending.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, pending.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(pending.Attempts, 10)),
+	))
+}
@@ -0,0 +1,120 @@
+package mintburn_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnReturnedQueuesAndRetries() {
+	k := suite.keeper()
+	escrow := sdk.AccAddress("escrow")
+	coin := suite.nativeCoin(50)
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+
+	// the escrow does not hold the tokens: the burn is queued, the mirror supply is left as is
+	k.BurnReturned(suite.Ctx, "channel-0", 1, escrow, coin)
+	pending, found := k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), pending.Attempts)
+	suite.Require().Equal(coin, pending.Amount)
+	suite.Require().Equal(sdkmath.NewInt(100), k.GetMirrorSupply(suite.Ctx))
+
+	// still failing, the attempt is recorded
+	k.RetryPendingBurns(suite.Ctx)
+	pending, found = k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), pending.Attempts)
+
+	// the retry goes through once the escrow holds the tokens
+	suite.fund(escrow, coin)
+	supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, coin.Denom)
+	k.RetryPendingBurns(suite.Ctx)
+	_, found = k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().False(found)
+	suite.Require().Equal(sdkmath.NewInt(50), k.GetMirrorSupply(suite.Ctx))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, escrow, coin.Denom).IsZero())
+	suite.Require().True(supplyBefore.Sub(coin).Amount.Equal(suite.App.BankKeeper.GetSupply(suite.Ctx, coin.Denom).Amount))
+}
+
+func (suite *KeeperTestSuite) TestRetryPendingBurnsIsNotStarved() {
+	k := suite.keeper()
+	coin := suite.nativeCoin(10)
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+
+	// a full block of burns that keep failing sorts before a burn that can go through
+	for seq := uint64(1); seq <= types.MaxBurnRetriesPerBlock; seq++ {
+		k.BurnReturned(suite.Ctx, "channel-0", seq, sdk.AccAddress(fmt.Sprintf("escrow-%d", seq)), coin)
+	}
+	escrow := sdk.AccAddress("escrow")
+	k.BurnReturned(suite.Ctx, "channel-1", 1, escrow, coin)
+	suite.fund(escrow, coin)
+
+	k.RetryPendingBurns(suite.Ctx)
+	_, found := k.GetPendingBurn(suite.Ctx, "channel-1", 1)
+	suite.Require().True(found)
+
+	// the next block resumes after the failing ones
+	k.RetryPendingBurns(suite.Ctx)
+	_, found = k.GetPendingBurn(suite.Ctx, "channel-1", 1)
+	suite.Require().False(found)
+	suite.Require().Equal(sdkmath.NewInt(90), k.GetMirrorSupply(suite.Ctx))
+
+	// and then wrapped around to the start of the queue
+	for seq := uint64(1); seq <= types.MaxBurnRetriesPerBlock; seq++ {
+		pending, found := k.GetPendingBurn(suite.Ctx, "channel-0", seq)
+		suite.Require().True(found)
+		if seq < types.MaxBurnRetriesPerBlock {
+			suite.Require().Equal(uint64(3), pending.Attempts)
+		} else {
+			suite.Require().Equal(uint64(2), pending.Attempts)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestPendingBurnIsParked() {
+	k := suite.keeper()
+	msgServer := mintburn.NewMsgServerImpl(k)
+	escrow := sdk.AccAddress("escrow")
+	coin := suite.nativeCoin(10)
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+	k.SetPendingBurn(suite.Ctx, types.PendingBurn{
+		ChannelId:     "channel-0",
+		Sequence:      1,
+		EscrowAddress: escrow.String(),
+		Amount:        coin,
+		Attempts:      types.MaxBurnAttempts - 1,
+	})
+
+	// the last attempt fails and parks the burn
+	k.RetryPendingBurns(suite.Ctx)
+	pending, found := k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().True(pending.Parked)
+	suite.Require().Equal(uint64(types.MaxBurnAttempts), pending.Attempts)
+
+	// a parked burn is not retried anymore
+	suite.fund(escrow, coin)
+	k.RetryPendingBurns(suite.Ctx)
+	pending, found = k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(types.MaxBurnAttempts), pending.Attempts)
+
+	// only the authority unparks it
+	_, err := msgServer.RetryPendingBurn(suite.Ctx, &types.MsgRetryPendingBurn{Authority: sdk.AccAddress("not_authority").String(), ChannelId: "channel-0", Sequence: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.RetryPendingBurn(suite.Ctx, &types.MsgRetryPendingBurn{Authority: k.GetAuthority(), ChannelId: "channel-0", Sequence: 2})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = msgServer.RetryPendingBurn(suite.Ctx, &types.MsgRetryPendingBurn{Authority: k.GetAuthority(), ChannelId: "channel-0", Sequence: 1})
+	suite.Require().NoError(err)
+
+	k.RetryPendingBurns(suite.Ctx)
+	_, found = k.GetPendingBurn(suite.Ctx, "channel-0", 1)
+	suite.Require().False(found)
+	suite.Require().Equal(sdkmath.NewInt(90), k.GetMirrorSupply(suite.Ctx))
+}
@@ -0,0 +1,17 @@
+package mintburn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// SetAllowedChannel allow-lists the channel as the middleware does on a CCV-bound channel open.
+func (k Keeper) SetAllowedChannel(ctx sdk.Context, channelID string) {
+	k.allowedChannelStore(ctx).Set([]byte(channelID), []byte{1})
+}
+
+// SetEscrowQuery stores an escrow query without registering it with the interchain queries module.
+func (k Keeper) SetEscrowQuery(ctx sdk.Context, escrowQuery types.EscrowQuery) {
+	k.escrowQueryStore(ctx).Set(types.EscrowQueryKey(escrowQuery.QueryId), k.Cdc.MustMarshal(&escrowQuery))
+}
@@ -1,6 +1,7 @@
 package mintburn
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
 )
@@ -12,11 +13,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
 	if err := gs.Validate(); err != nil {
 		panic(err)
 	}
-	k.SetParams(ctx, gs.Params)
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	for _, pending := range gs.PendingBurns {
+		k.SetPendingBurn(ctx, pending)
+	}
+	inFlight := sdkmath.ZeroInt()
+	for _, ret := range gs.Returns {
+		k.SetReturn(ctx, ret)
+		if ret.Status == types.ReturnStatusInFlight {
+			inFlight = inFlight.Add(ret.Amount.Amount)
+		}
+	}
+	k.setReturnsInFlight(ctx, inFlight)
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:       k.GetParams(ctx),
+		PendingBurns: k.GetPendingBurns(ctx, 0),
+		Returns:      k.GetReturns(ctx),
 	}
 }
@@ -0,0 +1,185 @@
+package mintburn
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) MirrorSupply(goCtx context.Context, req *types.QueryMirrorSupplyRequest) (*types.QueryMirrorSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryMirrorSupplyResponse{
+		MirrorSupply: sdk.NewCoin(k.GetParams(ctx).DexNativeDenom, k.GetMirrorSupply(ctx)),
+	}, nil
+}
+
+func (k Keeper) AllowedChannels(goCtx context.Context, req *types.QueryAllowedChannelsRequest) (*types.QueryAllowedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelIDs := make([]string, 0)
+	pageRes, err := query.Paginate(k.allowedChannelStore(ctx), req.Pagination, func(key, _ []byte) error {
+		channelIDs = append(channelIDs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedChannelsResponse{ChannelIds: channelIDs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProofConsumed(goCtx context.Context, req *types.QueryProofConsumedRequest) (*types.QueryProofConsumedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PortId == "" || req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "port id and channel id are required")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryProofConsumedResponse{
+		Consumed: k.HasProof(ctx, types.ProofID(req.PortId, req.ChannelId, req.Sequence)),
+	}, nil
+}
+
+func (k Keeper) PendingBurns(goCtx context.Context, req *types.QueryPendingBurnsRequest) (*types.QueryPendingBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingBurns := make([]types.PendingBurn, 0)
+	pageRes, err := query.Paginate(k.pendingBurnStore(ctx), req.Pagination, func(_, value []byte) error {
+		var pending types.PendingBurn
+		if err := k.Cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		pendingBurns = append(pendingBurns, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingBurnsResponse{PendingBurns: pendingBurns, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Return(goCtx context.Context, req *types.QueryReturnRequest) (*types.QueryReturnResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ret, found := k.GetReturn(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "return %s/%d not found", req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryReturnResponse{Return: ret}, nil
+}
+
+func (k Keeper) Returns(goCtx context.Context, req *types.QueryReturnsRequest) (*types.QueryReturnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	returns := make([]types.ProviderReturn, 0)
+	pageRes, err := query.Paginate(k.returnStore(ctx), req.Pagination, func(_, value []byte) error {
+		var ret types.ProviderReturn
+		if err := k.Cdc.Unmarshal(value, &ret); err != nil {
+			return err
+		}
+		returns = append(returns, ret)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReturnsResponse{
+		Returns:    returns,
+		InFlight:   sdk.NewCoin(k.GetParams(ctx).DexNativeDenom, k.GetReturnsInFlight(ctx)),
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) SupplyDrift(goCtx context.Context, req *types.QuerySupplyDriftRequest) (*types.QuerySupplyDriftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := k.GetParams(ctx).DexNativeDenom
+	bankSupply, mirrorSupply, genesisMinted, drift := k.supplyDrift(ctx)
+	resp := &types.QuerySupplyDriftResponse{
+		BankSupply:    sdk.NewCoin(denom, bankSupply),
+		MirrorSupply:  sdk.NewCoin(denom, mirrorSupply),
+		GenesisMinted: sdk.NewCoin(denom, genesisMinted),
+		Drift:         drift,
+	}
+	if reconciliation, found := k.GetLastReconciliation(ctx); found {
+		resp.LastReconciliation = &reconciliation
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) EscrowQueries(goCtx context.Context, req *types.QueryEscrowQueriesRequest) (*types.QueryEscrowQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryEscrowQueriesResponse{EscrowQueries: k.GetEscrowQueries(ctx)}, nil
+}
+
+func (k Keeper) MintWindow(goCtx context.Context, req *types.QueryMintWindowRequest) (*types.QueryMintWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		var err error
+		if recipient, err = sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// the window is pruned on a cached context that is never written
+	cacheCtx, _ := ctx.CacheContext()
+	params := k.GetParams(ctx)
+	blockMinted, windowMinted, recipientMinted := k.GetMintWindow(cacheCtx, recipient)
+
+	return &types.QueryMintWindowResponse{
+		BlockMinted:     sdk.NewCoin(params.DexNativeDenom, blockMinted),
+		WindowMinted:    sdk.NewCoin(params.DexNativeDenom, windowMinted),
+		RecipientMinted: sdk.NewCoin(params.DexNativeDenom, recipientMinted),
+		EpochBlocks:     params.EpochBlocks,
+	}, nil
+}
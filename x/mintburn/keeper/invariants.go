@@ -0,0 +1,32 @@
+package mintburn
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+const mirrorSupplyInvariantName = "mirror-supply-backs-native-supply"
+
+// RegisterInvariants registers all mintburn invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, mirrorSupplyInvariantName, MirrorSupplyInvariant(keeper))
+}
+
+// MirrorSupplyInvariant checks that the bank supply of the native denom does not exceed the mirror
+// supply plus the amount minted by genesismint, i.e. no native token exists without a provider side
+// escrow or genesis allocation backing it. Native tokens burned outside of mintburn (fees, taker fees)
+// only make the supply over-backed, which is not a violation. Native balances allocated directly in the
+// bank genesis are not backed either and break the invariant.
+func MirrorSupplyInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bankSupply, mirrorSupply, genesisMinted, drift := keeper.supplyDrift(ctx)
+		broken := drift.IsPositive()
+
+		return sdk.FormatInvariant(types.ModuleName, mirrorSupplyInvariantName,
+			fmt.Sprintf("\tbank supply: %s\n\tmirror supply: %s\n\tgenesis minted: %s\n\tunbacked: %s\n",
+				bankSupply, mirrorSupply, genesisMinted, drift)), broken
+	}
+}
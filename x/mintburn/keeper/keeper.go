@@ -1,8 +1,6 @@
 package mintburn
 
 import (
-	"encoding/json"
-
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -12,9 +10,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	icqtypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
 	types "github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
 )
 
@@ -22,6 +23,20 @@ import (
 
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChannel string) (channeltypes.Channel, bool)
+	SendPacket(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		sourcePort string,
+		sourceChannel string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		data []byte,
+	) (uint64, error)
+}
+
+// Scoped keeper of the transfer port, MsgReturnToProvider sends its packets with the transfer channel capability
+type ScopedTransferKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
 }
 
 type ConnectionKeeper interface {
@@ -38,6 +53,18 @@ type CCVConsumerKeeper interface {
     GetProviderClientID(ctx sdk.Context) (string, bool)
 }
 
+// Genesismint keeper, the genesis allocation of the native denom is not mirrored supply
+type GenesisMintKeeper interface {
+    GetTotalMinted(ctx sdk.Context, denom string) sdkmath.Int
+}
+
+// Interchain queries keeper, reads the provider escrow balances for reconciliation
+type InterchainQueriesKeeper interface {
+    RegisterModuleKVQuery(ctx sdk.Context, owner, connectionID string, keys []*icqtypes.KVKey, updatePeriod uint64) (uint64, error)
+    GetQueryByID(ctx sdk.Context, id uint64) (*icqtypes.RegisteredQuery, error)
+    GetQueryResultByID(ctx sdk.Context, id uint64) (*icqtypes.QueryResult, error)
+}
+
 // ---- Keeper ----
 
 type Keeper struct {
@@ -49,6 +76,12 @@ type Keeper struct {
     ConnectionKeeper ConnectionKeeper
     ClientKeeper     ClientKeeper
     ConsumerKeeper   CCVConsumerKeeper
+    ScopedKeeper     ScopedTransferKeeper
+    GenesisMintKeeper GenesisMintKeeper
+    ICQKeeper         InterchainQueriesKeeper
+
+    // the address capable of executing MsgUpdateParams, MsgPause and MsgUnpause
+    authority string
 }
 
 // Constructor
@@ -61,6 +94,10 @@ func NewKeeper(
     connectionKeeper ConnectionKeeper,
     clientKeeper ClientKeeper,
     consumerKeeper CCVConsumerKeeper,
+    scopedKeeper ScopedTransferKeeper,
+    genesisMintKeeper GenesisMintKeeper,
+    icqKeeper InterchainQueriesKeeper,
+    authority string,
 ) Keeper {
     return Keeper{
         ModuleName:       moduleName,
@@ -71,9 +108,17 @@ func NewKeeper(
         ConnectionKeeper: connectionKeeper,
         ClientKeeper:     clientKeeper,
         ConsumerKeeper:   consumerKeeper,
+        ScopedKeeper:     scopedKeeper,
+        GenesisMintKeeper: genesisMintKeeper,
+        ICQKeeper:         icqKeeper,
+        authority:        authority,
     }
 }
 
+func (k Keeper) GetAuthority() string {
+    return k.authority
+}
+
 
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
     store := ctx.KVStore(k.StoreKey)
@@ -82,24 +127,21 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
         return types.DefaultParams()
     }
     var p types.Params
-    if err := json.Unmarshal(bz, &p); err != nil {
-        // defensive fallback
-        ctx.Logger().Error("mintburn: failed to unmarshal params; using defaults", "err", err)
-        return types.DefaultParams()
-    }
+    k.Cdc.MustUnmarshal(bz, &p)
     return p
 }
 
-func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
     if err := p.Validate(); err != nil {
-        panic(err)
+        return err
     }
-    bz, err := json.Marshal(p)
+    bz, err := k.Cdc.Marshal(&p)
     if err != nil {
-        panic(err)
+        return err
     }
     store := ctx.KVStore(k.StoreKey)
     store.Set(types.KeyParams, bz)
+    return nil
 }
 
 // Logger
@@ -158,6 +200,11 @@ func (k Keeper) AddMirrorSupply(ctx sdk.Context, amt sdkmath.Int) {
 	ctx.KVStore(k.StoreKey).Set(KeyMirrorSupply, []byte(next.String()))
 }
 
+// SetMirrorSupply overwrites the mirrored supply, used by the reconciliation only.
+func (k Keeper) SetMirrorSupply(ctx sdk.Context, amt sdkmath.Int) {
+	ctx.KVStore(k.StoreKey).Set(KeyMirrorSupply, []byte(amt.String()))
+}
+
 // (optional) SubMirrorSupply if you need it for the return path later
 func (k Keeper) SubMirrorSupply(ctx sdk.Context, amt sdkmath.Int) {
 	cur := k.GetMirrorSupply(ctx)
@@ -183,8 +230,11 @@ func (k Keeper) MintTokens(ctx sdk.Context, recipient sdk.AccAddress, amount sdk
 
 // IsAllowedChannel checks the allowlist (populated by middleware on channel open).
 func (k Keeper) IsAllowedChannel(ctx sdk.Context, channelID string) bool {
-	store := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
-	return store.Has([]byte(channelID))
+	return k.allowedChannelStore(ctx).Has([]byte(channelID))
+}
+
+func (k Keeper) allowedChannelStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyAllowedChan)
 }
 
 func (k Keeper) BurnEscrowedTokens(ctx sdk.Context, escrowAddr sdk.AccAddress, coin sdk.Coin) error {
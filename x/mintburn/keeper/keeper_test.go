@@ -0,0 +1,39 @@
+package mintburn_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maany-xyz/maany-dex/v5/testutil/apptesting"
+	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func (suite *KeeperTestSuite) keeper() mintburn.Keeper {
+	return suite.App.MintBurnKeeper
+}
+
+func (suite *KeeperTestSuite) nativeCoin(amount int64) sdk.Coin {
+	return sdk.NewCoin(suite.keeper().GetParams(suite.Ctx).DexNativeDenom, sdkmath.NewInt(amount))
+}
+
+// fund mints the coins to the address.
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, addr, coins))
+}
@@ -0,0 +1,168 @@
+package mintburn
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+const (
+	MintCapBlock     = "block"
+	MintCapEpoch     = "epoch"
+	MintCapRecipient = "recipient"
+
+	LabelMintCapExceeded = "mint_cap_exceeded"
+)
+
+// CheckAndRecordMint enforces the mint caps of the privileged path and, if amt fits in all of them,
+// records it in the sliding window accumulator. The window covers the last EpochBlocks blocks and is
+// pruned from its start on every call, so that the running totals only hold the minted amounts in it.
+func (k Keeper) CheckAndRecordMint(ctx sdk.Context, recipient sdk.AccAddress, amt sdkmath.Int) error {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	window := mintWindow(params)
+	k.pruneMintWindow(ctx, height-window)
+
+	blockMinted := getInt(k.mintBucketStore(ctx), types.MintBucketKey(height))
+	windowMinted := getInt(ctx.KVStore(k.StoreKey), types.KeyMintWindowTotal)
+	recipientMinted := getInt(k.recipientMintTotalStore(ctx), recipient)
+
+	for _, c := range []struct {
+		name   string
+		limit  sdkmath.Int
+		minted sdkmath.Int
+	}{
+		{MintCapBlock, params.MaxMintPerBlock, blockMinted},
+		{MintCapEpoch, params.MaxMintPerEpoch, windowMinted},
+		{MintCapRecipient, params.MaxMintPerRecipient, recipientMinted},
+	} {
+		if types.IsCapSet(c.limit) && c.minted.Add(amt).GT(c.limit) {
+			telemetry.IncrCounterWithLabels([]string{LabelMintCapExceeded}, 1, []metrics.Label{
+				telemetry.NewLabel(telemetry.MetricLabelNameModule, types.ModuleName),
+				telemetry.NewLabel("cap", c.name),
+			})
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeMintCapped,
+				sdk.NewAttribute(types.AttributeKeyCap, c.name),
+				sdk.NewAttribute(types.AttributeKeyLimit, c.limit.String()),
+				sdk.NewAttribute(types.AttributeKeyMinted, c.minted.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyEpochBlocks, strconv.FormatInt(window, 10)),
+			))
+			return errors.Wrapf(types.ErrMintCapExceeded, "per-%s cap %s: already minted %s, requested %s", c.name, c.limit, c.minted, amt)
+		}
+	}
+
+	setInt(k.mintBucketStore(ctx), types.MintBucketKey(height), blockMinted.Add(amt))
+	setInt(ctx.KVStore(k.StoreKey), types.KeyMintWindowTotal, windowMinted.Add(amt))
+	recipientKey := types.RecipientMintKey(height, recipient)
+	setInt(k.recipientMintStore(ctx), recipientKey, getInt(k.recipientMintStore(ctx), recipientKey).Add(amt))
+	setInt(k.recipientMintTotalStore(ctx), recipient, recipientMinted.Add(amt))
+
+	return nil
+}
+
+// GetMintWindow returns the amounts minted in the current block, in the sliding window and, if
+// recipient is not empty, by recipient in the sliding window. The window is pruned first, so the
+// context should be a cached one when the caller does not mint.
+func (k Keeper) GetMintWindow(ctx sdk.Context, recipient sdk.AccAddress) (blockMinted, windowMinted, recipientMinted sdkmath.Int) {
+	k.pruneMintWindow(ctx, ctx.BlockHeight()-mintWindow(k.GetParams(ctx)))
+
+	blockMinted = getInt(k.mintBucketStore(ctx), types.MintBucketKey(ctx.BlockHeight()))
+	windowMinted = getInt(ctx.KVStore(k.StoreKey), types.KeyMintWindowTotal)
+	recipientMinted = sdkmath.ZeroInt()
+	if !recipient.Empty() {
+		recipientMinted = getInt(k.recipientMintTotalStore(ctx), recipient)
+	}
+	return blockMinted, windowMinted, recipientMinted
+}
+
+// mintWindow returns the length in blocks of the sliding window, at least a block.
+func mintWindow(params types.Params) int64 {
+	if params.EpochBlocks < 1 {
+		return 1
+	}
+	return int64(params.EpochBlocks)
+}
+
+// pruneMintWindow drops the minted amounts at or below the cutoff height from the window totals.
+func (k Keeper) pruneMintWindow(ctx sdk.Context, cutoff int64) {
+	if cutoff < 0 {
+		return
+	}
+	end := storetypes.PrefixEndBytes(types.MintBucketKey(cutoff))
+
+	buckets := k.mintBucketStore(ctx)
+	windowMinted := getInt(ctx.KVStore(k.StoreKey), types.KeyMintWindowTotal)
+	it := buckets.Iterator(nil, end)
+	var expired [][]byte
+	for ; it.Valid(); it.Next() {
+		windowMinted = windowMinted.Sub(getInt(buckets, it.Key()))
+		expired = append(expired, it.Key())
+	}
+	it.Close()
+	if len(expired) == 0 {
+		return
+	}
+	for _, key := range expired {
+		buckets.Delete(key)
+	}
+	setInt(ctx.KVStore(k.StoreKey), types.KeyMintWindowTotal, sdkmath.MaxInt(windowMinted, sdkmath.ZeroInt()))
+
+	recipients := k.recipientMintStore(ctx)
+	totals := k.recipientMintTotalStore(ctx)
+	it = recipients.Iterator(nil, end)
+	expired = expired[:0]
+	for ; it.Valid(); it.Next() {
+		// key: 8 bytes height | length prefixed recipient
+		recipient := it.Key()[9:]
+		total := getInt(totals, recipient).Sub(getInt(recipients, it.Key()))
+		if total.IsPositive() {
+			setInt(totals, recipient, total)
+		} else {
+			totals.Delete(recipient)
+		}
+		expired = append(expired, it.Key())
+	}
+	it.Close()
+	for _, key := range expired {
+		recipients.Delete(key)
+	}
+}
+
+func (k Keeper) mintBucketStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyMintBucketPrefix)
+}
+
+func (k Keeper) recipientMintStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyRecipientMintPrefix)
+}
+
+func (k Keeper) recipientMintTotalStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyRecipientMintTotalPrefix)
+}
+
+func getInt(store storetypes.KVStore, key []byte) sdkmath.Int {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	amt, ok := sdkmath.NewIntFromString(string(bz))
+	if !ok {
+		return sdkmath.ZeroInt()
+	}
+	return amt
+}
+
+func setInt(store storetypes.KVStore, key []byte, amt sdkmath.Int) {
+	store.Set(key, []byte(amt.String()))
+}
@@ -0,0 +1,72 @@
+package mintburn_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+func TestCheckAndRecordMint(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	k := mintburn.Keeper{
+		ModuleName: types.ModuleName,
+		StoreKey:   storeKey,
+		Cdc:        codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	params := types.DefaultParams()
+	params.MaxMintPerBlock = sdkmath.NewInt(100)
+	params.MaxMintPerEpoch = sdkmath.NewInt(250)
+	params.MaxMintPerRecipient = sdkmath.NewInt(150)
+	params.EpochBlocks = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, k.CheckAndRecordMint(ctx, alice, sdkmath.NewInt(80)))
+	// per-block cap
+	require.ErrorIs(t, k.CheckAndRecordMint(ctx, bob, sdkmath.NewInt(21)), types.ErrMintCapExceeded)
+	require.NoError(t, k.CheckAndRecordMint(ctx, bob, sdkmath.NewInt(20)))
+
+	ctx = ctx.WithBlockHeight(2)
+	// per-recipient cap
+	require.ErrorIs(t, k.CheckAndRecordMint(ctx, alice, sdkmath.NewInt(71)), types.ErrMintCapExceeded)
+	require.NoError(t, k.CheckAndRecordMint(ctx, alice, sdkmath.NewInt(70)))
+
+	ctx = ctx.WithBlockHeight(3)
+	// per-epoch cap
+	require.ErrorIs(t, k.CheckAndRecordMint(ctx, bob, sdkmath.NewInt(81)), types.ErrMintCapExceeded)
+	require.NoError(t, k.CheckAndRecordMint(ctx, bob, sdkmath.NewInt(80)))
+
+	blockMinted, windowMinted, aliceMinted := k.GetMintWindow(ctx, alice)
+	require.Equal(t, sdkmath.NewInt(80), blockMinted)
+	require.Equal(t, sdkmath.NewInt(250), windowMinted)
+	require.Equal(t, sdkmath.NewInt(150), aliceMinted)
+
+	// the mints of height 1 leave the window
+	ctx = ctx.WithBlockHeight(11)
+	blockMinted, windowMinted, aliceMinted = k.GetMintWindow(ctx, alice)
+	require.True(t, blockMinted.IsZero())
+	require.Equal(t, sdkmath.NewInt(150), windowMinted)
+	require.Equal(t, sdkmath.NewInt(70), aliceMinted)
+	require.NoError(t, k.CheckAndRecordMint(ctx, alice, sdkmath.NewInt(80)))
+
+	// zero caps are disabled
+	params.MaxMintPerBlock = sdkmath.ZeroInt()
+	params.MaxMintPerEpoch = sdkmath.ZeroInt()
+	params.MaxMintPerRecipient = sdkmath.ZeroInt()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.CheckAndRecordMint(ctx, alice, sdkmath.NewInt(1_000_000)))
+}
@@ -1,9 +1,165 @@
 package mintburn
 
-type MsgServer struct {
-    Keeper
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams updates the module parameters
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
 }
 
-func NewMsgServer(keeper Keeper) MsgServer {
-    return MsgServer{Keeper: keeper}
+// Pause flips the emergency kill-switch: privileged mints are rejected until Unpause
+func (k msgServer) Pause(goCtx context.Context, req *types.MsgPause) (*types.MsgPauseResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPause")
+	}
+
+	if err := k.setPause(sdk.UnwrapSDKContext(goCtx), req.Authority, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseResponse{}, nil
+}
+
+// Unpause resumes privileged mints
+func (k msgServer) Unpause(goCtx context.Context, req *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUnpause")
+	}
+
+	if err := k.setPause(sdk.UnwrapSDKContext(goCtx), req.Authority, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+// RegisterEscrowQuery registers the interchain query of a provider escrow balance used by ReconcileMirrorSupply
+func (k msgServer) RegisterEscrowQuery(goCtx context.Context, req *types.MsgRegisterEscrowQuery) (*types.MsgRegisterEscrowQueryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRegisterEscrowQuery")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	escrowQuery, err := k.Keeper.RegisterEscrowQuery(sdk.UnwrapSDKContext(goCtx), req.ChannelId, req.BaseDenom, req.UpdatePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterEscrowQueryResponse{QueryId: escrowQuery.QueryId}, nil
+}
+
+// ReconcileMirrorSupply resets the mirror supply to the provider escrow balances
+func (k msgServer) ReconcileMirrorSupply(goCtx context.Context, req *types.MsgReconcileMirrorSupply) (*types.MsgReconcileMirrorSupplyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgReconcileMirrorSupply")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	reconciliation, err := k.Keeper.ReconcileMirrorSupply(sdk.UnwrapSDKContext(goCtx), req.QueryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReconcileMirrorSupplyResponse{Reconciliation: reconciliation}, nil
+}
+
+// ReturnToProvider sends native tokens back to the provider, they are burned once the provider acknowledges them
+func (k msgServer) ReturnToProvider(goCtx context.Context, req *types.MsgReturnToProvider) (*types.MsgReturnToProviderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgReturnToProvider")
+	}
+
+	sequence, err := k.Keeper.ReturnToProvider(sdk.UnwrapSDKContext(goCtx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReturnToProviderResponse{Sequence: sequence}, nil
+}
+
+// RetryPendingBurn resets the attempts of a pending burn, so that a parked burn is retried again in EndBlock
+func (k msgServer) RetryPendingBurn(goCtx context.Context, req *types.MsgRetryPendingBurn) (*types.MsgRetryPendingBurnResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRetryPendingBurn")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pending, found := k.GetPendingBurn(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no pending burn for %s/%d", req.ChannelId, req.Sequence)
+	}
+	pending.Attempts = 0
+	pending.Parked = false
+	k.SetPendingBurn(ctx, pending)
+
+	return &types.MsgRetryPendingBurnResponse{}, nil
+}
+
+func (k msgServer) setPause(ctx sdk.Context, reqAuthority string, pause bool) error {
+	authority := k.GetAuthority()
+	if authority != reqAuthority {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, reqAuthority)
+	}
+
+	params := k.GetParams(ctx)
+	params.Pause = pause
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePause,
+		sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(pause)),
+	))
+	ctx.Logger().Info("mintburn: pause updated", "pause", pause)
+
+	return nil
 }
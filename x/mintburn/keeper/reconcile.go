@@ -0,0 +1,230 @@
+package mintburn
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	icqtypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// RegisterEscrowQuery registers an interchain KV query reading the provider side ICS-20 escrow
+// balance of baseDenom for the counterparty of the allowed DEX transfer channel channelID.
+func (k Keeper) RegisterEscrowQuery(ctx sdk.Context, channelID, baseDenom string, updatePeriod uint64) (types.EscrowQuery, error) {
+	if !k.IsAllowedChannel(ctx, channelID) {
+		return types.EscrowQuery{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is not allowed", channelID)
+	}
+	allowed := false
+	for _, d := range k.GetParams(ctx).AllowedBaseDenoms {
+		if d == baseDenom {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return types.EscrowQuery{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "base denom %s is not allowed", baseDenom)
+	}
+
+	channel, found := k.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return types.EscrowQuery{}, errors.Wrapf(sdkerrors.ErrNotFound, "channel %s/%s", transfertypes.PortID, channelID)
+	}
+	escrowAddr := transfertypes.GetEscrowAddress(channel.Counterparty.PortId, channel.Counterparty.ChannelId)
+
+	owner := authtypes.NewModuleAddress(k.ModuleName).String()
+	keys := []*icqtypes.KVKey{{
+		Path: types.ProviderBankStoreKey,
+		Key:  types.ProviderBalanceKey(escrowAddr, baseDenom),
+	}}
+	queryID, err := k.ICQKeeper.RegisterModuleKVQuery(ctx, owner, channel.ConnectionHops[0], keys, updatePeriod)
+	if err != nil {
+		return types.EscrowQuery{}, errors.Wrap(err, "failed to register escrow query")
+	}
+
+	escrowQuery := types.EscrowQuery{
+		QueryId:       queryID,
+		ChannelId:     channelID,
+		BaseDenom:     baseDenom,
+		EscrowAddress: fmt.Sprintf("%X", escrowAddr.Bytes()),
+	}
+	k.escrowQueryStore(ctx).Set(types.EscrowQueryKey(queryID), k.Cdc.MustMarshal(&escrowQuery))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEscrowQuery,
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(queryID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeyBaseDenom, baseDenom),
+		sdk.NewAttribute(types.AttributeKeyEscrowAddress, escrowQuery.EscrowAddress),
+	))
+
+	return escrowQuery, nil
+}
+
+// ReconcileMirrorSupply sets the mirror supply to the sum of the provider escrow balances read by
+// the given escrow queries, which must cover every allowed channel. Returns still waiting for their
+// burn (pending burns) are added on top, since the provider has already released them from escrow.
+func (k Keeper) ReconcileMirrorSupply(ctx sdk.Context, queryIDs []uint64) (types.Reconciliation, error) {
+	covered := make(map[string]bool)
+	escrowed := sdkmath.ZeroInt()
+	var remoteHeight uint64
+	for i, queryID := range queryIDs {
+		escrowQuery, found := k.GetEscrowQuery(ctx, queryID)
+		if !found {
+			return types.Reconciliation{}, errors.Wrapf(sdkerrors.ErrNotFound, "escrow query %d", queryID)
+		}
+		if covered[escrowQuery.ChannelId] {
+			return types.Reconciliation{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is queried twice", escrowQuery.ChannelId)
+		}
+		covered[escrowQuery.ChannelId] = true
+
+		amount, height, err := k.escrowBalance(ctx, queryID)
+		if err != nil {
+			return types.Reconciliation{}, errors.Wrapf(err, "escrow query %d", queryID)
+		}
+		escrowed = escrowed.Add(amount)
+		if i == 0 || height < remoteHeight {
+			remoteHeight = height
+		}
+	}
+
+	var missing []string
+	iter := k.allowedChannelStore(ctx).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if !covered[string(iter.Key())] {
+			missing = append(missing, string(iter.Key()))
+		}
+	}
+	iter.Close()
+	if len(missing) > 0 {
+		return types.Reconciliation{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "no escrow query for allowed channels %s", strings.Join(missing, ", "))
+	}
+
+	nativeDenom := k.GetParams(ctx).DexNativeDenom
+	for _, pending := range k.GetPendingBurns(ctx, 0) {
+		if pending.Amount.Denom == nativeDenom {
+			escrowed = escrowed.Add(pending.Amount.Amount)
+		}
+	}
+
+	reconciliation := types.Reconciliation{
+		QueryIds:             queryIDs,
+		RemoteHeight:         remoteHeight,
+		Height:               ctx.BlockHeight(),
+		PreviousMirrorSupply: k.GetMirrorSupply(ctx),
+		MirrorSupply:         escrowed,
+	}
+	k.SetMirrorSupply(ctx, escrowed)
+	ctx.KVStore(k.StoreKey).Set(types.KeyLastReconciliation, k.Cdc.MustMarshal(&reconciliation))
+
+	k.Logger(ctx).Info("mintburn: mirror supply reconciled",
+		"previous", reconciliation.PreviousMirrorSupply.String(), "new", escrowed.String(), "remote_height", remoteHeight)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReconcile,
+		sdk.NewAttribute(types.AttributeKeyRemoteHeight, strconv.FormatUint(remoteHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyPrevSupply, reconciliation.PreviousMirrorSupply.String()),
+		sdk.NewAttribute(types.AttributeKeyMirrorSupply, escrowed.String()),
+	))
+
+	return reconciliation, nil
+}
+
+// escrowBalance decodes the last result of an escrow query into the escrowed amount and the
+// provider height it was read at. A missing balance is a zero balance.
+func (k Keeper) escrowBalance(ctx sdk.Context, queryID uint64) (sdkmath.Int, uint64, error) {
+	query, err := k.ICQKeeper.GetQueryByID(ctx, queryID)
+	if err != nil {
+		return sdkmath.Int{}, 0, err
+	}
+	result, err := k.ICQKeeper.GetQueryResultByID(ctx, queryID)
+	if err != nil {
+		return sdkmath.Int{}, 0, err
+	}
+	if len(query.Keys) != 1 {
+		return sdkmath.Int{}, 0, fmt.Errorf("expected a single key, got %d", len(query.Keys))
+	}
+
+	for _, kv := range result.KvResults {
+		if kv.StoragePrefix != query.Keys[0].Path || !bytes.Equal(kv.Key, query.Keys[0].Key) {
+			continue
+		}
+		if len(kv.Value) == 0 {
+			return sdkmath.ZeroInt(), result.Height, nil
+		}
+
+		// SDK v0.50 stores the balance amount as is, older versions store the whole coin
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(kv.Value); err == nil {
+			return amount, result.Height, nil
+		}
+		var coin sdk.Coin
+		if err := k.Cdc.Unmarshal(kv.Value, &coin); err != nil {
+			return sdkmath.Int{}, 0, fmt.Errorf("failed to decode escrow balance: %w", err)
+		}
+		return coin.Amount, result.Height, nil
+	}
+
+	return sdkmath.Int{}, 0, fmt.Errorf("no result for the escrow balance key")
+}
+
+// GetEscrowQuery returns the escrow query registered under queryID.
+func (k Keeper) GetEscrowQuery(ctx sdk.Context, queryID uint64) (types.EscrowQuery, bool) {
+	bz := k.escrowQueryStore(ctx).Get(types.EscrowQueryKey(queryID))
+	if bz == nil {
+		return types.EscrowQuery{}, false
+	}
+	var escrowQuery types.EscrowQuery
+	k.Cdc.MustUnmarshal(bz, &escrowQuery)
+	return escrowQuery, true
+}
+
+// GetEscrowQueries returns all the escrow queries ordered by query id.
+func (k Keeper) GetEscrowQueries(ctx sdk.Context) []types.EscrowQuery {
+	escrowQueries := make([]types.EscrowQuery, 0)
+	iter := storetypes.KVStorePrefixIterator(k.escrowQueryStore(ctx), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var escrowQuery types.EscrowQuery
+		k.Cdc.MustUnmarshal(iter.Value(), &escrowQuery)
+		escrowQueries = append(escrowQueries, escrowQuery)
+	}
+	return escrowQueries
+}
+
+// GetLastReconciliation returns the last mirror supply reconciliation, if any.
+func (k Keeper) GetLastReconciliation(ctx sdk.Context) (types.Reconciliation, bool) {
+	bz := ctx.KVStore(k.StoreKey).Get(types.KeyLastReconciliation)
+	if bz == nil {
+		return types.Reconciliation{}, false
+	}
+	var reconciliation types.Reconciliation
+	k.Cdc.MustUnmarshal(bz, &reconciliation)
+	return reconciliation, true
+}
+
+// supplyDrift returns the bank supply of the native denom minus its backing: the mirror supply
+// and the amount minted by genesismint. A positive drift is native supply nothing accounts for.
+func (k Keeper) supplyDrift(ctx sdk.Context) (bankSupply, mirrorSupply, genesisMinted, drift sdkmath.Int) {
+	denom := k.GetParams(ctx).DexNativeDenom
+	bankSupply = k.BankKeeper.GetSupply(ctx, denom).Amount
+	mirrorSupply = k.GetMirrorSupply(ctx)
+	genesisMinted = sdkmath.ZeroInt()
+	if k.GenesisMintKeeper != nil {
+		genesisMinted = k.GenesisMintKeeper.GetTotalMinted(ctx, denom)
+	}
+	return bankSupply, mirrorSupply, genesisMinted, bankSupply.Sub(mirrorSupply).Sub(genesisMinted)
+}
+
+func (k Keeper) escrowQueryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyEscrowQueryPrefix)
+}
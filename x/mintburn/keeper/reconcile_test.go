@@ -0,0 +1,97 @@
+package mintburn_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icqtypes "github.com/maany-xyz/maany-dex/v5/x/interchainqueries/types"
+	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// setEscrowQueryResult registers the escrow query of the channel with an escrowed balance as its last result.
+func (suite *KeeperTestSuite) setEscrowQueryResult(queryID uint64, channelID string, escrowed sdkmath.Int) {
+	escrowAddr := sdk.AccAddress("provider_escrow")
+	key := types.ProviderBalanceKey(escrowAddr, "uprovider")
+	suite.keeper().SetEscrowQuery(suite.Ctx, types.EscrowQuery{QueryId: queryID, ChannelId: channelID, BaseDenom: "uprovider"})
+
+	icqKeeper := suite.App.InterchainQueriesKeeper
+	suite.Require().NoError(icqKeeper.SaveQuery(suite.Ctx, &icqtypes.RegisteredQuery{
+		Id:        queryID,
+		QueryType: string(icqtypes.InterchainQueryTypeKV),
+		Keys:      []*icqtypes.KVKey{{Path: types.ProviderBankStoreKey, Key: key}},
+	}))
+	value, err := escrowed.Marshal()
+	suite.Require().NoError(err)
+	suite.Require().NoError(icqKeeper.SaveKVQueryResult(suite.Ctx, queryID, &icqtypes.QueryResult{
+		KvResults: []*icqtypes.StorageValue{{StoragePrefix: types.ProviderBankStoreKey, Key: key, Value: value}},
+		Height:    10,
+	}))
+}
+
+func (suite *KeeperTestSuite) TestMirrorSupplyDriftIsReconciled() {
+	k := suite.keeper()
+	msgServer := mintburn.NewMsgServerImpl(k)
+	invariant := mintburn.MirrorSupplyInvariant(k)
+
+	// the native supply is fully backed by the mirror supply
+	unbacked := suite.nativeCoin(100)
+	suite.fund(sdk.AccAddress("holder"), suite.nativeCoin(1_000))
+	genesisMinted := suite.App.GenesisMintKeeper.GetTotalMinted(suite.Ctx, unbacked.Denom)
+	backed := suite.App.BankKeeper.GetSupply(suite.Ctx, unbacked.Denom).Amount.Sub(genesisMinted)
+	k.SetMirrorSupply(suite.Ctx, backed)
+	_, broken := invariant(suite.Ctx)
+	suite.Require().False(broken)
+
+	// native tokens minted outside of mintburn break the invariant and show up as drift
+	suite.fund(sdk.AccAddress("holder"), unbacked)
+	msg, broken := invariant(suite.Ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "unbacked: 100")
+
+	drift, err := k.SupplyDrift(suite.Ctx, &types.QuerySupplyDriftRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(100), drift.Drift)
+	suite.Require().Equal(backed, drift.MirrorSupply.Amount)
+	suite.Require().Nil(drift.LastReconciliation)
+
+	// the provider escrow does back them, along with a return waiting for its burn
+	suite.App.MintBurnKeeper.SetAllowedChannel(suite.Ctx, "channel-0")
+	suite.setEscrowQueryResult(1, "channel-0", backed.AddRaw(60))
+	k.SetPendingBurn(suite.Ctx, types.PendingBurn{ChannelId: "channel-0", Sequence: 1, EscrowAddress: sdk.AccAddress("escrow").String(), Amount: suite.nativeCoin(40)})
+
+	// only the authority reconciles
+	_, err = msgServer.ReconcileMirrorSupply(suite.Ctx, &types.MsgReconcileMirrorSupply{Authority: sdk.AccAddress("not_authority").String(), QueryIds: []uint64{1}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Equal(backed, k.GetMirrorSupply(suite.Ctx))
+
+	resp, err := msgServer.ReconcileMirrorSupply(suite.Ctx, &types.MsgReconcileMirrorSupply{Authority: k.GetAuthority(), QueryIds: []uint64{1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(backed, resp.Reconciliation.PreviousMirrorSupply)
+	suite.Require().Equal(backed.AddRaw(100), resp.Reconciliation.MirrorSupply)
+	suite.Require().Equal(uint64(10), resp.Reconciliation.RemoteHeight)
+
+	_, broken = invariant(suite.Ctx)
+	suite.Require().False(broken)
+	drift, err = k.SupplyDrift(suite.Ctx, &types.QuerySupplyDriftRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(drift.Drift.IsZero())
+	suite.Require().Equal(resp.Reconciliation, *drift.LastReconciliation)
+}
+
+func (suite *KeeperTestSuite) TestReconcileMirrorSupplyCoversAllowedChannels() {
+	k := suite.keeper()
+	suite.App.MintBurnKeeper.SetAllowedChannel(suite.Ctx, "channel-0")
+	suite.App.MintBurnKeeper.SetAllowedChannel(suite.Ctx, "channel-1")
+	suite.setEscrowQueryResult(1, "channel-0", sdkmath.NewInt(100))
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(10))
+
+	// an allowed channel without escrow query leaves the mirror supply as is
+	_, err := k.ReconcileMirrorSupply(suite.Ctx, []uint64{1})
+	suite.Require().ErrorContains(err, "no escrow query for allowed channels channel-1")
+	suite.Require().Equal(sdkmath.NewInt(10), k.GetMirrorSupply(suite.Ctx))
+
+	_, err = k.ReconcileMirrorSupply(suite.Ctx, []uint64{1, 2})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
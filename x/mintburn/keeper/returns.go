@@ -0,0 +1,204 @@
+package mintburn
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// ReturnToProvider escrows the native tokens of the sender in the module account and sends them to the
+// provider as an ICS-20 packet over the allow-listed channel. The tokens are burned on a success ack and
+// refunded on an error ack or a timeout. The returns in flight are bounded by the mirror supply, since
+// the provider escrow cannot release more than was mirrored.
+func (k Keeper) ReturnToProvider(ctx sdk.Context, msg *types.MsgReturnToProvider) (uint64, error) {
+	params := k.GetParams(ctx)
+	if params.Pause {
+		return 0, errors.Wrap(sdkerrors.ErrInvalidRequest, "mintburn paused")
+	}
+	if msg.Amount.Denom != params.DexNativeDenom {
+		return 0, errors.Wrapf(sdkerrors.ErrInvalidCoins, "only %s can be returned, got %s", params.DexNativeDenom, msg.Amount.Denom)
+	}
+	if err := k.ValidateProviderChannel(ctx, msg.ChannelId); err != nil {
+		return 0, err
+	}
+
+	inFlight := k.GetReturnsInFlight(ctx).Add(msg.Amount.Amount)
+	if mirrorSupply := k.GetMirrorSupply(ctx); inFlight.GT(mirrorSupply) {
+		return 0, errors.Wrapf(sdkerrors.ErrInvalidRequest, "returns in flight %s exceed the mirror supply %s", inFlight, mirrorSupply)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, errors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, k.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return 0, errors.Wrap(err, "failed to escrow returned tokens")
+	}
+
+	chanCap, found := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(ibctransfertypes.PortID, msg.ChannelId))
+	if !found {
+		return 0, errors.Wrapf(sdkerrors.ErrNotFound, "capability of channel %s", msg.ChannelId)
+	}
+	timeout := msg.TimeoutTimestamp
+	if timeout == 0 {
+		timeout = uint64(ctx.BlockTime().Add(types.DefaultReturnTimeout).UnixNano())
+	}
+	data := ibctransfertypes.NewFungibleTokenPacketData(msg.Amount.Denom, msg.Amount.Amount.String(), msg.Sender, msg.Receiver, msg.Memo)
+	sequence, err := k.ChannelKeeper.SendPacket(ctx, chanCap, ibctransfertypes.PortID, msg.ChannelId, clienttypes.ZeroHeight(), timeout, data.GetBytes())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to send return packet")
+	}
+
+	ret := types.ProviderReturn{
+		ChannelId:     msg.ChannelId,
+		Sequence:      sequence,
+		Sender:        msg.Sender,
+		Receiver:      msg.Receiver,
+		Amount:        msg.Amount,
+		Status:        types.ReturnStatusInFlight,
+		CreatedHeight: ctx.BlockHeight(),
+	}
+	k.SetReturn(ctx, ret)
+	k.setReturnsInFlight(ctx, inFlight)
+
+	k.Logger(ctx).Info("mintburn: return to provider sent",
+		"channel_id", msg.ChannelId, "sequence", sequence, "amount", msg.Amount.String(), "sender", msg.Sender)
+	emitReturn(ctx, types.EventTypeReturnSent, ret)
+
+	return sequence, nil
+}
+
+// ValidateProviderChannel checks the transfer channel is allow-listed and still bound to the CCV provider client.
+func (k Keeper) ValidateProviderChannel(ctx sdk.Context, channelID string) error {
+	if !k.IsAllowedChannel(ctx, channelID) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is not allowed", channelID)
+	}
+	channel, found := k.ChannelKeeper.GetChannel(ctx, ibctransfertypes.PortID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return errors.Wrapf(sdkerrors.ErrNotFound, "channel %s/%s", ibctransfertypes.PortID, channelID)
+	}
+	connection, found := k.ConnectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrNotFound, "connection %s", channel.ConnectionHops[0])
+	}
+	providerClientID, ok := k.ConsumerKeeper.GetProviderClientID(ctx)
+	if !ok || providerClientID == "" || connection.ClientId != providerClientID {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "channel %s is not bound to the CCV provider client", channelID)
+	}
+	return nil
+}
+
+// OnReturnResolved settles an in-flight return on its ack or timeout: a success burns the escrowed
+// tokens (queueing a pending burn if that fails), an error ack or a timeout refunds the sender.
+func (k Keeper) OnReturnResolved(ctx sdk.Context, ret types.ProviderReturn, success bool, reason string) error {
+	if ret.Status != types.ReturnStatusInFlight {
+		return fmt.Errorf("return %s/%d is already resolved", ret.ChannelId, ret.Sequence)
+	}
+
+	if success {
+		k.BurnReturned(ctx, ret.ChannelId, ret.Sequence, authtypes.NewModuleAddress(k.ModuleName), ret.Amount)
+		ret.Status = types.ReturnStatusCompleted
+	} else {
+		sender, err := sdk.AccAddressFromBech32(ret.Sender)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, k.ModuleName, sender, sdk.NewCoins(ret.Amount)); err != nil {
+			return errors.Wrapf(err, "failed to refund return %s/%d", ret.ChannelId, ret.Sequence)
+		}
+		ret.Status = types.ReturnStatusRefunded
+		ret.Error = reason
+	}
+	ret.ResolvedHeight = ctx.BlockHeight()
+	k.SetReturn(ctx, ret)
+
+	inFlight := k.GetReturnsInFlight(ctx).Sub(ret.Amount.Amount)
+	if inFlight.IsNegative() {
+		inFlight = sdkmath.ZeroInt() // defensive clamp
+	}
+	k.setReturnsInFlight(ctx, inFlight)
+
+	if success {
+		k.Logger(ctx).Info("mintburn: return to provider completed", "channel_id", ret.ChannelId, "sequence", ret.Sequence)
+		emitReturn(ctx, types.EventTypeReturnComplete, ret)
+	} else {
+		k.Logger(ctx).Info("mintburn: return to provider refunded", "channel_id", ret.ChannelId, "sequence", ret.Sequence, "reason", reason)
+		emitReturn(ctx, types.EventTypeReturnRefund, ret)
+	}
+	return nil
+}
+
+func (k Keeper) returnStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.StoreKey), types.KeyReturnPrefix)
+}
+
+func (k Keeper) SetReturn(ctx sdk.Context, ret types.ProviderReturn) {
+	k.returnStore(ctx).Set(types.ReturnKey(ret.ChannelId, ret.Sequence), k.Cdc.MustMarshal(&ret))
+}
+
+func (k Keeper) GetReturn(ctx sdk.Context, channelID string, sequence uint64) (types.ProviderReturn, bool) {
+	bz := k.returnStore(ctx).Get(types.ReturnKey(channelID, sequence))
+	if bz == nil {
+		return types.ProviderReturn{}, false
+	}
+	var ret types.ProviderReturn
+	k.Cdc.MustUnmarshal(bz, &ret)
+	return ret, true
+}
+
+// GetReturns returns all the returns ordered by channel and sequence.
+func (k Keeper) GetReturns(ctx sdk.Context) []types.ProviderReturn {
+	it := storetypes.KVStorePrefixIterator(k.returnStore(ctx), nil)
+	defer it.Close()
+
+	out := make([]types.ProviderReturn, 0)
+	for ; it.Valid(); it.Next() {
+		var ret types.ProviderReturn
+		k.Cdc.MustUnmarshal(it.Value(), &ret)
+		out = append(out, ret)
+	}
+	return out
+}
+
+// GetReturnsInFlight returns the total amount of the returns waiting for their ack or timeout.
+func (k Keeper) GetReturnsInFlight(ctx sdk.Context) sdkmath.Int {
+	bz := ctx.KVStore(k.StoreKey).Get(types.KeyReturnsInFlight)
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	amt, ok := sdkmath.NewIntFromString(string(bz))
+	if !ok {
+		return sdkmath.ZeroInt()
+	}
+	return amt
+}
+
+func (k Keeper) setReturnsInFlight(ctx sdk.Context, amt sdkmath.Int) {
+	ctx.KVStore(k.StoreKey).Set(types.KeyReturnsInFlight, []byte(amt.String()))
+}
+
+func emitReturn(ctx sdk.Context, eventType string, ret types.ProviderReturn) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyChannelID, ret.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(ret.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, ret.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, ret.Receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, ret.Amount.String()),
+	}
+	if ret.Error != "" {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, ret.Error))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attrs...))
+}
@@ -0,0 +1,168 @@
+package mintburn_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+const (
+	providerChannel = "channel-0"
+	providerClient  = "07-tendermint-0"
+)
+
+// providerIBC binds providerChannel to the CCV provider client and records the packets sent over it.
+type providerIBC struct {
+	sent uint64
+}
+
+func (p *providerIBC) GetChannel(_ sdk.Context, _, channelID string) (channeltypes.Channel, bool) {
+	return channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, channelID == providerChannel
+}
+
+func (p *providerIBC) SendPacket(sdk.Context, *capabilitytypes.Capability, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	p.sent++
+	return p.sent, nil
+}
+
+func (p *providerIBC) GetConnection(sdk.Context, string) (connectiontypes.ConnectionEnd, bool) {
+	return connectiontypes.ConnectionEnd{ClientId: providerClient}, true
+}
+
+func (p *providerIBC) GetProviderClientID(sdk.Context) (string, bool) {
+	return providerClient, true
+}
+
+func (p *providerIBC) GetCapability(sdk.Context, string) (*capabilitytypes.Capability, bool) {
+	return capabilitytypes.NewCapability(1), true
+}
+
+// returnKeeper returns the mintburn keeper sending its returns over providerChannel.
+func (suite *KeeperTestSuite) returnKeeper() mintburn.Keeper {
+	k := suite.keeper()
+	ibc := &providerIBC{}
+	k.ChannelKeeper = ibc
+	k.ConnectionKeeper = ibc
+	k.ConsumerKeeper = ibc
+	k.ScopedKeeper = ibc
+	k.SetAllowedChannel(suite.Ctx, providerChannel)
+	return k
+}
+
+func (suite *KeeperTestSuite) sendReturn(k mintburn.Keeper, sender sdk.AccAddress, amount int64) (types.ProviderReturn, error) {
+	sequence, err := k.ReturnToProvider(suite.Ctx, &types.MsgReturnToProvider{
+		Sender:    sender.String(),
+		ChannelId: providerChannel,
+		Receiver:  "provider_receiver",
+		Amount:    suite.nativeCoin(amount),
+	})
+	if err != nil {
+		return types.ProviderReturn{}, err
+	}
+	ret, found := k.GetReturn(suite.Ctx, providerChannel, sequence)
+	suite.Require().True(found)
+	return ret, nil
+}
+
+func (suite *KeeperTestSuite) TestReturnToProviderSuccessAck() {
+	k := suite.returnKeeper()
+	sender := sdk.AccAddress("sender")
+	suite.fund(sender, suite.nativeCoin(100))
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+	denom := suite.nativeCoin(0).Denom
+
+	// the tokens are escrowed in the module account while in flight
+	ret, err := suite.sendReturn(k, sender, 60)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ReturnStatusInFlight, ret.Status)
+	suite.Require().Equal(sdkmath.NewInt(60), k.GetReturnsInFlight(suite.Ctx))
+	suite.Require().Equal(sdkmath.NewInt(40), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	// the success ack burns them and decrements the mirror supply
+	supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount
+	suite.Require().NoError(k.OnReturnResolved(suite.Ctx, ret, true, ""))
+	ret, _ = k.GetReturn(suite.Ctx, providerChannel, ret.Sequence)
+	suite.Require().Equal(types.ReturnStatusCompleted, ret.Status)
+	suite.Require().True(k.GetReturnsInFlight(suite.Ctx).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(40), k.GetMirrorSupply(suite.Ctx))
+	suite.Require().Equal(supplyBefore.SubRaw(60), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, authtypes.NewModuleAddress(types.ModuleName), denom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(40), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	// the return is resolved only once
+	suite.Require().Error(k.OnReturnResolved(suite.Ctx, ret, true, ""))
+	suite.Require().Error(k.OnReturnResolved(suite.Ctx, ret, false, "timeout"))
+	suite.Require().Equal(sdkmath.NewInt(40), k.GetMirrorSupply(suite.Ctx))
+	suite.Require().Equal(sdkmath.NewInt(40), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestReturnToProviderRefunds() {
+	k := suite.returnKeeper()
+	sender := sdk.AccAddress("sender")
+	suite.fund(sender, suite.nativeCoin(100))
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+	denom := suite.nativeCoin(0).Denom
+
+	errAcked, err := suite.sendReturn(k, sender, 30)
+	suite.Require().NoError(err)
+	timedOut, err := suite.sendReturn(k, sender, 50)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(80), k.GetReturnsInFlight(suite.Ctx))
+	suite.Require().Equal(sdkmath.NewInt(20), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	// an error ack and a timeout refund the sender and leave the mirror supply as is
+	suite.Require().NoError(k.OnReturnResolved(suite.Ctx, errAcked, false, "ack error"))
+	suite.Require().NoError(k.OnReturnResolved(suite.Ctx, timedOut, false, "timeout"))
+	for _, tc := range []struct {
+		ret    types.ProviderReturn
+		reason string
+	}{{errAcked, "ack error"}, {timedOut, "timeout"}} {
+		ret, found := k.GetReturn(suite.Ctx, providerChannel, tc.ret.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(types.ReturnStatusRefunded, ret.Status)
+		suite.Require().Equal(tc.reason, ret.Error)
+	}
+	suite.Require().True(k.GetReturnsInFlight(suite.Ctx).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(100), k.GetMirrorSupply(suite.Ctx))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	// a refunded return is not refunded twice
+	errAcked, _ = k.GetReturn(suite.Ctx, providerChannel, errAcked.Sequence)
+	suite.Require().Error(k.OnReturnResolved(suite.Ctx, errAcked, false, "timeout"))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestReturnToProviderIsBoundedByMirrorSupply() {
+	k := suite.returnKeeper()
+	sender := sdk.AccAddress("sender")
+	suite.fund(sender, suite.nativeCoin(200))
+	k.SetMirrorSupply(suite.Ctx, sdkmath.NewInt(100))
+
+	ret, err := suite.sendReturn(k, sender, 70)
+	suite.Require().NoError(err)
+
+	// the returns in flight cannot exceed the mirror supply
+	_, err = suite.sendReturn(k, sender, 31)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Equal(sdkmath.NewInt(70), k.GetReturnsInFlight(suite.Ctx))
+	_, err = suite.sendReturn(k, sender, 30)
+	suite.Require().NoError(err)
+
+	// a resolved return frees its share
+	suite.Require().NoError(k.OnReturnResolved(suite.Ctx, ret, false, "timeout"))
+	_, err = suite.sendReturn(k, sender, 70)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(100), k.GetReturnsInFlight(suite.Ctx))
+
+	// only the allowed channel is used
+	_, err = k.ReturnToProvider(suite.Ctx, &types.MsgReturnToProvider{Sender: sender.String(), ChannelId: "channel-1", Receiver: "provider_receiver", Amount: suite.nativeCoin(1)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
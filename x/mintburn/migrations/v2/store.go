@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// legacyParams is the JSON encoded params of the consensus version 1.
+type legacyParams struct {
+	Authority         string   `json:"authority"`
+	Pause             bool     `json:"pause"`
+	AllowedBaseDenoms []string `json:"allowed_base_denoms"`
+	ProviderChainIDs  []string `json:"provider_chain_ids"`
+	DexNativeDenom    string   `json:"dex_native_denom"`
+}
+
+// MigrateStore performs in-place store migrations.
+// The migration re-encodes the params from JSON to protobuf. The params authority is dropped,
+// the module authority is set by the keeper.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating mintburn Params...")
+
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyParams)
+	if len(bz) == 0 {
+		ctx.Logger().Info("No mintburn Params to migrate")
+		return nil
+	}
+
+	var legacy legacyParams
+	if err := json.Unmarshal(bz, &legacy); err != nil {
+		return errors.Wrap(err, "failed to unmarshal legacy mintburn params")
+	}
+
+	params := types.Params{
+		Pause:             legacy.Pause,
+		AllowedBaseDenoms: legacy.AllowedBaseDenoms,
+		ProviderChainIDs:  legacy.ProviderChainIDs,
+		DexNativeDenom:    legacy.DexNativeDenom,
+	}
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "invalid legacy mintburn params")
+	}
+
+	store.Set(types.KeyParams, cdc.MustMarshal(&params))
+
+	ctx.Logger().Info("Finished migrating mintburn Params...")
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/maany-xyz/maany-dex/v5/x/mintburn/migrations/v2"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+func TestParamsUpgrade(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	legacy := map[string]any{
+		"authority":           "maany1admin",
+		"pause":               true,
+		"allowed_base_denoms": []string{"stake"},
+		"provider_chain_ids":  []string{"maany-local-1"},
+		"dex_native_denom":    "umaany",
+	}
+	bz, err := json.Marshal(legacy)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.KeyParams, bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, cdc, storeKey))
+
+	var params types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(types.KeyParams), &params)
+	require.Equal(t, types.Params{
+		Pause:               true,
+		AllowedBaseDenoms:   []string{"stake"},
+		ProviderChainIDs:    []string{"maany-local-1"},
+		DexNativeDenom:      "umaany",
+		MaxMintPerBlock:     sdkmath.ZeroInt(),
+		MaxMintPerEpoch:     sdkmath.ZeroInt(),
+		MaxMintPerRecipient: sdkmath.ZeroInt(),
+	}, params)
+}
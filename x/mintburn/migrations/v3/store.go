@@ -0,0 +1,56 @@
+package v3
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration sets the mint caps added in the consensus version 3 to their defaults,
+// which leave the privileged mint path uncapped.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating mintburn Params...")
+
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyParams)
+	if len(bz) == 0 {
+		ctx.Logger().Info("No mintburn Params to migrate")
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return errors.Wrap(err, "failed to unmarshal mintburn params")
+	}
+
+	if params.MaxMintPerBlock.IsNil() {
+		params.MaxMintPerBlock = sdkmath.ZeroInt()
+	}
+	if params.MaxMintPerEpoch.IsNil() {
+		params.MaxMintPerEpoch = sdkmath.ZeroInt()
+	}
+	if params.MaxMintPerRecipient.IsNil() {
+		params.MaxMintPerRecipient = sdkmath.ZeroInt()
+	}
+	if params.EpochBlocks == 0 {
+		params.EpochBlocks = types.DefaultEpochBlocks
+	}
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "invalid mintburn params")
+	}
+
+	store.Set(types.KeyParams, cdc.MustMarshal(&params))
+
+	ctx.Logger().Info("Finished migrating mintburn Params...")
+
+	return nil
+}
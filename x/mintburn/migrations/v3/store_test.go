@@ -0,0 +1,45 @@
+package v3_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/maany-xyz/maany-dex/v5/x/mintburn/migrations/v3"
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
+)
+
+func TestParamsUpgrade(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// params as stored by the consensus version 2, without the mint caps
+	legacy := types.Params{
+		Pause:             true,
+		AllowedBaseDenoms: []string{"stake"},
+		ProviderChainIDs:  []string{"maany-local-1"},
+		DexNativeDenom:    "umaany",
+	}
+	ctx.KVStore(storeKey).Set(types.KeyParams, cdc.MustMarshal(&legacy))
+
+	require.NoError(t, v3.MigrateStore(ctx, cdc, storeKey))
+
+	var params types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(types.KeyParams), &params)
+	require.Equal(t, types.Params{
+		Pause:               true,
+		AllowedBaseDenoms:   []string{"stake"},
+		ProviderChainIDs:    []string{"maany-local-1"},
+		DexNativeDenom:      "umaany",
+		MaxMintPerBlock:     sdkmath.ZeroInt(),
+		MaxMintPerEpoch:     sdkmath.ZeroInt(),
+		MaxMintPerRecipient: sdkmath.ZeroInt(),
+		EpochBlocks:         types.DefaultEpochBlocks,
+	}, params)
+}
@@ -174,13 +174,25 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	// MsgReturnToProvider packets are escrowed by mintburn, not by ICS-20: refund them here
+	if ret, found := im.getReturn(ctx, packet); found {
+		return im.keeper.OnReturnResolved(ctx, ret, false, "timeout")
+	}
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
+// getReturn finds the MsgReturnToProvider record of a packet sent by DEX.
+func (im IBCMiddleware) getReturn(ctx sdk.Context, packet channeltypes.Packet) (types.ProviderReturn, bool) {
+	if packet.SourcePort != ibctransfertypes.PortID {
+		return types.ProviderReturn{}, false
+	}
+	return im.keeper.GetReturn(ctx, packet.SourceChannel, packet.Sequence)
+}
+
 // proofID is a unique key for a packet on the receiving chain.
 func proofID(pkt channeltypes.Packet) []byte {
 	// DestinationPort/Channel because we're on the receiver
-	return []byte(fmt.Sprintf("%s/%s/%d", pkt.DestinationPort, pkt.DestinationChannel, pkt.Sequence))
+	return types.ProofID(pkt.DestinationPort, pkt.DestinationChannel, pkt.Sequence)
 }
 
 func (im IBCMiddleware) OnRecvPacket(
@@ -276,6 +288,12 @@ func (im IBCMiddleware) OnRecvPacket(
         return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid token amount"))
     }
 
+	// Rate limit the privileged mint
+	if err := im.keeper.CheckAndRecordMint(ctx, rcpt, amt); err != nil {
+		ctx.Logger().Info("mintburn: mint cap exceeded", "amount", amt.String(), "receiver", rcpt.String(), "err", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	// Mint mirrored native on DEX (use your DEX minimal denom here)
 	mintCoin := sdk.NewCoin(params.DexNativeDenom, amt) // or "umaany" if that's your DEX denom
 	if err := im.keeper.MintTokens(ctx, rcpt, mintCoin); err != nil {
@@ -304,9 +322,20 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
     // Parse ack
     var ack channeltypes.Acknowledgement
     if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+        if _, found := im.getReturn(ctx, packet); found {
+            return fmt.Errorf("cannot unmarshal ICS-20 transfer packet acknowledgement: %w", err)
+        }
         return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
     }
 
+    // MsgReturnToProvider packets are escrowed by mintburn, not by ICS-20: settle them here
+    if ret, found := im.getReturn(ctx, packet); found {
+        if errAck, isErr := ack.Response.(*channeltypes.Acknowledgement_Error); isErr {
+            return im.keeper.OnReturnResolved(ctx, ret, false, errAck.Error)
+        }
+        return im.keeper.OnReturnResolved(ctx, ret, true, "")
+    }
+
     // Only act on success
     if _, ok := ack.Response.(*channeltypes.Acknowledgement_Result); !ok {
         // error/timeouts -> ICS-20 handles refund; we do nothing
@@ -324,15 +353,13 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
         return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
     }
 
-    // Resolve base denom (trace-aware) and verify it is our DEX native (e.g., "umaany")
+    // Resolve base denom (trace-aware) and verify it is our DEX native denom
     baseDenom := data.Denom
     if !ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
         trace := ibctransfertypes.ParseDenomTrace(data.Denom)
         baseDenom = trace.BaseDenom
     }
-	//TODO:
-    // If you have params: compare with params.DexNativeDenom instead of hardcoding
-    if baseDenom != "umaany" { // or "stake" depending on your DEX denom
+    if baseDenom != im.keeper.GetParams(ctx).DexNativeDenom {
         return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
     }
 
@@ -342,20 +369,10 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
         return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
     }
 
-    // Burn tokens from the DEX escrow to remove mirrored supply
+    // Burn tokens from the DEX escrow to remove mirrored supply. A failed burn never blocks
+    // the core app ack flow: it is queued and retried in EndBlock.
     escrowAddr := ibctransfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
-    coin := sdk.NewCoin(baseDenom, amt)
-    if err := im.keeper.BurnEscrowedTokens(ctx, escrowAddr, coin); err != nil {
-        // If burn fails, don't block the core app ack flow; just log and continue
-        ctx.Logger().Error("mintburn: burn escrow on DEX failed", "err", err)
-        return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-    }
-
-    // Decrement mirror supply
-    im.keeper.SubMirrorSupply(ctx, amt)
-
-    ctx.Logger().Info("mintburn: burned mirrored on DEX after ACK",
-        "amount", coin.String(), "escrow", escrowAddr.String())
+    im.keeper.BurnReturned(ctx, packet.SourceChannel, packet.Sequence, escrowAddr, sdk.NewCoin(baseDenom, amt))
 
     return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
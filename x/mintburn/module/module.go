@@ -1,9 +1,13 @@
 package mintburn
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"cosmossdk.io/core/appmodule"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -12,14 +16,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/maany-xyz/maany-dex/v5/x/mintburn/client/cli"
 	mintburn "github.com/maany-xyz/maany-dex/v5/x/mintburn/keeper"
 	"github.com/maany-xyz/maany-dex/v5/x/mintburn/types"
 )
 
 var (
-	_ module.AppModuleBasic = (*AppModuleBasic)(nil)
-	_ module.AppModule      = (*AppModule)(nil)
-	_ module.HasABCIGenesis = (*AppModule)(nil)
+	_ module.AppModuleBasic   = (*AppModuleBasic)(nil)
+	_ module.AppModule        = (*AppModule)(nil)
+	_ module.HasABCIGenesis   = (*AppModule)(nil)
+	_ module.HasInvariants    = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // -----------------------------
@@ -30,36 +37,40 @@ type AppModuleBasic struct{}
 
 func (AppModuleBasic) Name() string { return types.ModuleName }
 
-func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterCodec(cdc)
+}
 
-func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
 
 func (am AppModule) IsOnePerModuleType() {}
 
 func (am AppModule) IsAppModule() {}
 
-// DefaultGenesis returns the module's default genesis as raw JSON bytes (no proto needed).
-func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	bz, err := json.Marshal(types.DefaultGenesis())
-	if err != nil {
-		panic(err)
-	}
-	return bz
+// DefaultGenesis returns the module's default genesis as raw JSON bytes.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var gs types.GenesisState
-	if err := json.Unmarshal(bz, &gs); err != nil {
-		return err
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 	return gs.Validate()
 }
 
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
 
-// (Optional for CLI)
-// func (AppModuleBasic) GetTxCmd() *cobra.Command    { return nil }
-// func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
 
 // -----------------------------
 // AppModule
@@ -80,26 +91,41 @@ func NewAppModule(cdc codec.Codec, k mintburn.Keeper) AppModule {
 	}
 }
 
-func (am AppModule) RegisterServices(_ module.Configurator) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), mintburn.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-// HasABCIGenesis: InitGenesis/ExportGenesis using JSON (no proto)
-func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var gs types.GenesisState
-	if err := json.Unmarshal(data, &gs); err != nil {
-		panic(err)
+	m := mintburn.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
 	}
+}
+
+// RegisterInvariants registers the mirror supply invariant
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	mintburn.RegisterInvariants(ir, am.keeper)
+}
+
+// HasABCIGenesis: InitGenesis/ExportGenesis
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(data, &gs)
 	am.keeper.InitGenesis(ctx, &gs)
 	return nil
 }
 
-func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
-	gs := am.keeper.ExportGenesis(ctx)
-	bz, err := json.Marshal(gs)
-	if err != nil {
-		panic(err)
-	}
-	return bz
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // Optional but recommended: advertise a consensus version for migrations.
-func (am AppModule) ConsensusVersion() uint64 { return 1 }
+func (am AppModule) ConsensusVersion() uint64 { return 3 }
+
+// EndBlock retries the escrow burns that failed on ack
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.RetryPendingBurns(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "mintburn/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "mintburn/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "mintburn/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgRegisterEscrowQuery{}, "mintburn/MsgRegisterEscrowQuery", nil)
+	cdc.RegisterConcrete(&MsgReconcileMirrorSupply{}, "mintburn/MsgReconcileMirrorSupply", nil)
+	cdc.RegisterConcrete(&MsgReturnToProvider{}, "mintburn/MsgReturnToProvider", nil)
+	cdc.RegisterConcrete(&MsgRetryPendingBurn{}, "mintburn/MsgRetryPendingBurn", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgRegisterEscrowQuery{},
+		&MsgReconcileMirrorSupply{},
+		&MsgReturnToProvider{},
+		&MsgRetryPendingBurn{},
+		// provider message, only packed into the genesismint ICA txs
+		&MsgMarkEscrowClaimed{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,12 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/mintburn module sentinel errors
+var (
+	ErrMintCapExceeded = errors.Register(ModuleName, 1100, "mint cap exceeded")
+)
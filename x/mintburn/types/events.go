@@ -0,0 +1,33 @@
+package types
+
+const (
+	EventTypePause          = "mintburn_pause"
+	EventTypeBurnReturned   = "mintburn_burn_returned"
+	EventTypeBurnFailed     = "mintburn_burn_failed"
+	EventTypeBurnParked     = "mintburn_burn_parked"
+	EventTypeEscrowQuery    = "mintburn_escrow_query"
+	EventTypeReconcile      = "mintburn_reconcile"
+	EventTypeReturnSent     = "mintburn_return_sent"
+	EventTypeReturnComplete = "mintburn_return_completed"
+	EventTypeReturnRefund   = "mintburn_return_refunded"
+	EventTypeMintCapped     = "mintburn_mint_capped"
+
+	AttributeKeyPaused        = "paused"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyEscrowAddress = "escrow_address"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyAttempts      = "attempts"
+	AttributeKeyError         = "error"
+	AttributeKeyQueryID       = "query_id"
+	AttributeKeyBaseDenom     = "base_denom"
+	AttributeKeyRemoteHeight  = "remote_height"
+	AttributeKeyPrevSupply    = "previous_mirror_supply"
+	AttributeKeyMirrorSupply  = "mirror_supply"
+	AttributeKeySender        = "sender"
+	AttributeKeyReceiver      = "receiver"
+	AttributeKeyCap           = "cap"
+	AttributeKeyLimit         = "limit"
+	AttributeKeyMinted        = "minted"
+	AttributeKeyEpochBlocks   = "epoch_blocks"
+)
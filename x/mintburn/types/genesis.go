@@ -1,13 +1,46 @@
 package types
 
-type GenesisState struct {
-	Params Params `json:"params" yaml:"params"`
-}
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{Params: DefaultParams()}
 }
 
 func (gs *GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.PendingBurns))
+	for _, pending := range gs.PendingBurns {
+		key := string(PendingBurnKey(pending.ChannelId, pending.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending burn for channel %s sequence %d", pending.ChannelId, pending.Sequence)
+		}
+		seen[key] = true
+		if _, err := sdk.AccAddressFromBech32(pending.EscrowAddress); err != nil {
+			return fmt.Errorf("invalid escrow address of pending burn %s/%d: %w", pending.ChannelId, pending.Sequence, err)
+		}
+		if !pending.Amount.IsValid() || !pending.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount of pending burn %s/%d: %s", pending.ChannelId, pending.Sequence, pending.Amount)
+		}
+	}
+	seen = make(map[string]bool, len(gs.Returns))
+	for _, ret := range gs.Returns {
+		key := string(ReturnKey(ret.ChannelId, ret.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate return for channel %s sequence %d", ret.ChannelId, ret.Sequence)
+		}
+		seen[key] = true
+		if _, err := sdk.AccAddressFromBech32(ret.Sender); err != nil {
+			return fmt.Errorf("invalid sender of return %s/%d: %w", ret.ChannelId, ret.Sequence, err)
+		}
+		if !ret.Amount.IsValid() || !ret.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount of return %s/%d: %s", ret.ChannelId, ret.Sequence, ret.Amount)
+		}
+		if _, ok := ReturnStatus_name[int32(ret.Status)]; !ok || ret.Status == ReturnStatusUnspecified {
+			return fmt.Errorf("invalid status of return %s/%d: %d", ret.ChannelId, ret.Sequence, ret.Status)
+		}
+	}
 	return gs.Params.Validate()
 }
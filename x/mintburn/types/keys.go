@@ -1,5 +1,14 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 const (
 	ModuleName            = "mintburn"
 	StoreKey              = ModuleName
@@ -19,4 +28,78 @@ var (
 
 	// String-encoded sdk.Int total of mirrored supply on DEX
 	KeyMirrorSupply = []byte("mirror_supply")
+
+	// Returned tokens whose escrow burn failed, keyed by source channel and sequence
+	KeyPendingBurnPrefix = []byte("pending-burn/")
+
+	// Key of the last pending burn retried, the next EndBlock resumes after it
+	KeyPendingBurnCursor = []byte("pending-burn-cursor")
+
+	// Interchain queries of the provider escrow balances, keyed by query id
+	KeyEscrowQueryPrefix = []byte("escrow-query/")
+
+	// Last mirror supply reconciliation against the provider escrow
+	KeyLastReconciliation = []byte("last-reconciliation")
+
+	// DEX→provider returns sent with MsgReturnToProvider, keyed by source channel and sequence
+	KeyReturnPrefix = []byte("return/")
+
+	// String-encoded sdk.Int total of the returns in flight
+	KeyReturnsInFlight = []byte("returns-inflight")
+
+	// Sliding mint window: amount minted per height, per height and recipient, and the running totals
+	KeyMintBucketPrefix         = []byte("mint-bucket/")
+	KeyMintWindowTotal          = []byte("mint-window-total")
+	KeyRecipientMintPrefix      = []byte("mint-recipient/")
+	KeyRecipientMintTotalPrefix = []byte("mint-recipient-total/")
 )
+
+// DefaultReturnTimeout is the timeout of a MsgReturnToProvider packet that sets none.
+const DefaultReturnTimeout = 10 * time.Minute
+
+// ProviderBankStoreKey is the provider store the escrow queries read from.
+const ProviderBankStoreKey = banktypes.StoreKey
+
+// MaxBurnRetriesPerBlock bounds the pending burns retried in a single EndBlock.
+const MaxBurnRetriesPerBlock = 10
+
+// MaxBurnAttempts is the number of failed attempts after which a pending burn is parked.
+const MaxBurnAttempts = 100
+
+// ProofID is the replay guard key of a packet received on DEX, built from its
+// destination port, destination channel and sequence.
+func ProofID(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))
+}
+
+// PendingBurnKey is the key of a pending burn within the KeyPendingBurnPrefix store.
+func PendingBurnKey(channelID string, sequence uint64) []byte {
+	return append([]byte(channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// EscrowQueryKey is the key of an escrow query within the KeyEscrowQueryPrefix store.
+func EscrowQueryKey(queryID uint64) []byte {
+	return sdk.Uint64ToBigEndian(queryID)
+}
+
+// ProviderBalanceKey is the provider bank store key of the balance of denom held by addr.
+func ProviderBalanceKey(addr sdk.AccAddress, denom string) []byte {
+	key := append(banktypes.BalancesPrefix.Bytes(), address.MustLengthPrefix(addr)...)
+	return append(key, []byte(denom)...)
+}
+
+// ReturnKey is the key of a return within the KeyReturnPrefix store.
+func ReturnKey(channelID string, sequence uint64) []byte {
+	return PendingBurnKey(channelID, sequence)
+}
+
+// MintBucketKey is the key of the amount minted at height within the KeyMintBucketPrefix store.
+func MintBucketKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+// RecipientMintKey is the key of the amount minted to recipient at height within the
+// KeyRecipientMintPrefix store. Keys are ordered by height so that the window is pruned from the start.
+func RecipientMintKey(height int64, recipient sdk.AccAddress) []byte {
+	return append(MintBucketKey(height), address.MustLengthPrefix(recipient)...)
+}
@@ -0,0 +1,1932 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/mintburn/v1/mintburn.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReturnStatus is the lifecycle of a DEX→provider return sent with MsgReturnToProvider.
+type ReturnStatus int32
+
+const (
+	ReturnStatusUnspecified ReturnStatus = 0
+	// the packet is sent, the tokens are escrowed in the module account
+	ReturnStatusInFlight ReturnStatus = 1
+	// success ack: the escrowed tokens are burned (or queued as a pending burn)
+	ReturnStatusCompleted ReturnStatus = 2
+	// error ack or timeout: the escrowed tokens are refunded to the sender
+	ReturnStatusRefunded ReturnStatus = 3
+)
+
+var ReturnStatus_name = map[int32]string{
+	0: "RETURN_STATUS_UNSPECIFIED",
+	1: "RETURN_STATUS_IN_FLIGHT",
+	2: "RETURN_STATUS_COMPLETED",
+	3: "RETURN_STATUS_REFUNDED",
+}
+
+var ReturnStatus_value = map[string]int32{
+	"RETURN_STATUS_UNSPECIFIED": 0,
+	"RETURN_STATUS_IN_FLIGHT":   1,
+	"RETURN_STATUS_COMPLETED":   2,
+	"RETURN_STATUS_REFUNDED":    3,
+}
+
+func (x ReturnStatus) String() string {
+	return proto.EnumName(ReturnStatus_name, int32(x))
+}
+
+func (ReturnStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_080a452d386465a5, []int{0}
+}
+
+// PendingBurn is a DEX→provider return whose escrowed native tokens could not be
+// burned on the success ack. It is retried at the end of every block until the burn
+// goes through; the mirror supply is only decremented then. A burn failing
+// MaxBurnAttempts times is parked until the authority retries it.
+type PendingBurn struct {
+	// source channel and sequence of the acknowledged transfer packet
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// ICS-20 escrow address the tokens are burned from
+	EscrowAddress string     `protobuf:"bytes,3,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// number of failed burn attempts, the first one included
+	Attempts  uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// height of the ack the burn failed on
+	CreatedHeight int64 `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// the burn is no longer retried in EndBlock, see MsgRetryPendingBurn
+	Parked bool `protobuf:"varint,8,opt,name=parked,proto3" json:"parked,omitempty"`
+}
+
+func (m *PendingBurn) Reset()         { *m = PendingBurn{} }
+func (m *PendingBurn) String() string { return proto.CompactTextString(m) }
+func (*PendingBurn) ProtoMessage()    {}
+func (*PendingBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_080a452d386465a5, []int{0}
+}
+func (m *PendingBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBurn.Merge(m, src)
+}
+func (m *PendingBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBurn proto.InternalMessageInfo
+
+func (m *PendingBurn) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingBurn) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingBurn) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PendingBurn) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *PendingBurn) GetParked() bool {
+	if m != nil {
+		return m.Parked
+	}
+	return false
+}
+
+// EscrowQuery is an interchain KV query registered by mintburn to read the provider-side ICS-20
+// escrow balance backing the mirror supply minted through a DEX transfer channel.
+type EscrowQuery struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// DEX side transfer channel, its counterparty channel escrow is queried on the provider
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// provider denom held in the escrow
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// hex encoded provider side escrow address
+	EscrowAddress string `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+}
+
+func (m *EscrowQuery) Reset()         { *m = EscrowQuery{} }
+func (m *EscrowQuery) String() string { return proto.CompactTextString(m) }
+func (*EscrowQuery) ProtoMessage()    {}
+func (*EscrowQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_080a452d386465a5, []int{1}
+}
+func (m *EscrowQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowQuery.Merge(m, src)
+}
+func (m *EscrowQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowQuery proto.InternalMessageInfo
+
+func (m *EscrowQuery) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *EscrowQuery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *EscrowQuery) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EscrowQuery) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+// Reconciliation records the last time the mirror supply counter was set from the provider escrow.
+type Reconciliation struct {
+	QueryIds []uint64 `protobuf:"varint,1,rep,packed,name=query_ids,json=queryIds,proto3" json:"query_ids,omitempty"`
+	// the lowest provider height among the used query results
+	RemoteHeight         uint64                `protobuf:"varint,2,opt,name=remote_height,json=remoteHeight,proto3" json:"remote_height,omitempty"`
+	Height               int64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	PreviousMirrorSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=previous_mirror_supply,json=previousMirrorSupply,proto3,customtype=cosmossdk.io/math.Int" json:"previous_mirror_supply"`
+	MirrorSupply         cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=mirror_supply,json=mirrorSupply,proto3,customtype=cosmossdk.io/math.Int" json:"mirror_supply"`
+}
+
+func (m *Reconciliation) Reset()         { *m = Reconciliation{} }
+func (m *Reconciliation) String() string { return proto.CompactTextString(m) }
+func (*Reconciliation) ProtoMessage()    {}
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_080a452d386465a5, []int{2}
+}
+func (m *Reconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reconciliation.Merge(m, src)
+}
+func (m *Reconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reconciliation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reconciliation proto.InternalMessageInfo
+
+func (m *Reconciliation) GetQueryIds() []uint64 {
+	if m != nil {
+		return m.QueryIds
+	}
+	return nil
+}
+
+func (m *Reconciliation) GetRemoteHeight() uint64 {
+	if m != nil {
+		return m.RemoteHeight
+	}
+	return 0
+}
+
+func (m *Reconciliation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ProviderReturn is the record of a DEX→provider return, keyed by its source channel and sequence.
+type ProviderReturn struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// DEX account the tokens were taken from and refunded to
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// provider account receiving the base denom
+	Receiver      string       `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount        types.Coin   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	Status        ReturnStatus `protobuf:"varint,6,opt,name=status,proto3,enum=maany.mintburn.v1.ReturnStatus" json:"status,omitempty"`
+	CreatedHeight int64        `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// height of the ack or timeout, zero while in flight
+	ResolvedHeight int64 `protobuf:"varint,8,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// error ack or timeout reason of a refunded return
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ProviderReturn) Reset()         { *m = ProviderReturn{} }
+func (m *ProviderReturn) String() string { return proto.CompactTextString(m) }
+func (*ProviderReturn) ProtoMessage()    {}
+func (*ProviderReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_080a452d386465a5, []int{3}
+}
+func (m *ProviderReturn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderReturn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderReturn.Merge(m, src)
+}
+func (m *ProviderReturn) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderReturn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderReturn proto.InternalMessageInfo
+
+func (m *ProviderReturn) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ProviderReturn) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ProviderReturn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ProviderReturn) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ProviderReturn) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderReturn) GetStatus() ReturnStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReturnStatusUnspecified
+}
+
+func (m *ProviderReturn) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *ProviderReturn) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func (m *ProviderReturn) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("maany.mintburn.v1.ReturnStatus", ReturnStatus_name, ReturnStatus_value)
+	proto.RegisterType((*PendingBurn)(nil), "maany.mintburn.v1.PendingBurn")
+	proto.RegisterType((*EscrowQuery)(nil), "maany.mintburn.v1.EscrowQuery")
+	proto.RegisterType((*Reconciliation)(nil), "maany.mintburn.v1.Reconciliation")
+	proto.RegisterType((*ProviderReturn)(nil), "maany.mintburn.v1.ProviderReturn")
+}
+
+func init() { proto.RegisterFile("maany/mintburn/v1/mintburn.proto", fileDescriptor_080a452d386465a5) }
+
+var fileDescriptor_080a452d386465a5 = []byte{
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x25, 0x59, 0x91, 0xd6, 0xb6, 0xea, 0x12, 0x8e, 0x43, 0x29, 0x88, 0x4c, 0xb8, 0x28,
+	0x2a, 0xb4, 0x08, 0x09, 0xbb, 0x4d, 0x03, 0xf4, 0x16, 0x5b, 0x74, 0x43, 0xc0, 0x71, 0x55, 0x4a,
+	0xba, 0xf4, 0x42, 0x50, 0xdc, 0x89, 0xb4, 0x88, 0xb8, 0xcb, 0xec, 0x2e, 0x59, 0xab, 0x5f, 0x50,
+	0x18, 0x28, 0xd0, 0x1f, 0x30, 0x0a, 0xb4, 0xbf, 0xd0, 0x8f, 0xc8, 0x31, 0xe8, 0xa5, 0x45, 0x0f,
+	0x41, 0x61, 0x7f, 0x43, 0xef, 0x05, 0xc9, 0x95, 0x2d, 0x3b, 0x39, 0xd4, 0xc8, 0x6d, 0xdf, 0xe3,
+	0xbc, 0xd9, 0x99, 0x37, 0xc3, 0x45, 0x66, 0x14, 0x04, 0x74, 0x6e, 0x47, 0x84, 0xca, 0x71, 0xc2,
+	0xa9, 0x9d, 0xee, 0x5e, 0x9e, 0xad, 0x98, 0x33, 0xc9, 0xf4, 0x0f, 0xf3, 0x08, 0xeb, 0x92, 0x4d,
+	0x77, 0xdb, 0x9d, 0x90, 0x89, 0x88, 0x09, 0x7b, 0x1c, 0x08, 0xb0, 0xd3, 0xdd, 0x31, 0xc8, 0x60,
+	0xd7, 0x0e, 0x19, 0x51, 0x92, 0x76, 0xab, 0xf8, 0xee, 0xe7, 0xc8, 0x2e, 0x80, 0xfa, 0xb4, 0x39,
+	0x61, 0x13, 0x56, 0xf0, 0xd9, 0xa9, 0x60, 0x77, 0x7e, 0x2d, 0xa3, 0xd5, 0x3e, 0x50, 0x4c, 0xe8,
+	0x64, 0x3f, 0xe1, 0x54, 0x7f, 0x80, 0x50, 0x38, 0x0d, 0x28, 0x85, 0x99, 0x4f, 0xb0, 0xa1, 0x99,
+	0x5a, 0xb7, 0xe1, 0x35, 0x14, 0xe3, 0x62, 0xbd, 0x8d, 0xea, 0x02, 0x5e, 0x26, 0x40, 0x43, 0x30,
+	0xca, 0xa6, 0xd6, 0xad, 0x7a, 0x97, 0x58, 0xff, 0x18, 0x35, 0x41, 0x84, 0x9c, 0x7d, 0xef, 0x07,
+	0x18, 0x73, 0x10, 0xc2, 0xa8, 0xe4, 0xf2, 0xf5, 0x82, 0x7d, 0x52, 0x90, 0xfa, 0x63, 0x54, 0x0b,
+	0x22, 0x96, 0x50, 0x69, 0x54, 0x4d, 0xad, 0xbb, 0xba, 0xd7, 0xb2, 0x54, 0x99, 0x59, 0x4f, 0x96,
+	0xea, 0xc9, 0x3a, 0x60, 0x84, 0xee, 0x57, 0x5f, 0xbd, 0xd9, 0x2e, 0x79, 0x2a, 0x3c, 0xbb, 0x3b,
+	0x90, 0x12, 0xa2, 0x58, 0x0a, 0x63, 0xa5, 0xb8, 0x7b, 0x81, 0xb3, 0xb2, 0x67, 0x81, 0x90, 0x3e,
+	0x70, 0xce, 0xb8, 0x51, 0x2b, 0xca, 0xce, 0x18, 0x27, 0x23, 0xb2, 0xd2, 0x42, 0x0e, 0x81, 0x04,
+	0xec, 0x4f, 0x81, 0x4c, 0xa6, 0xd2, 0xb8, 0x63, 0x6a, 0xdd, 0x8a, 0xb7, 0xae, 0xd8, 0xa7, 0x39,
+	0xa9, 0x6f, 0xa1, 0x5a, 0x1c, 0xf0, 0x17, 0x80, 0x8d, 0xba, 0xa9, 0x75, 0xeb, 0x9e, 0x42, 0x3b,
+	0x3f, 0x69, 0x68, 0xd5, 0xc9, 0x9b, 0xf8, 0x36, 0x01, 0x3e, 0xd7, 0x5b, 0xa8, 0xfe, 0x32, 0x3b,
+	0x2c, 0x2c, 0xaa, 0x7a, 0x77, 0x72, 0xec, 0xe2, 0x1b, 0xfe, 0x95, 0x6f, 0xfa, 0xf7, 0x00, 0xa1,
+	0xac, 0x4d, 0x1f, 0x03, 0x65, 0x91, 0xf2, 0xa7, 0x91, 0x31, 0xbd, 0x8c, 0x78, 0x87, 0x85, 0xd5,
+	0x77, 0x58, 0xb8, 0xf3, 0x4b, 0x19, 0x35, 0x3d, 0x08, 0x19, 0x0d, 0xc9, 0x8c, 0x04, 0x92, 0x30,
+	0xaa, 0xdf, 0x47, 0x8d, 0x45, 0x49, 0xc2, 0xd0, 0xcc, 0x4a, 0xe6, 0x8e, 0xaa, 0x49, 0xe8, 0x1f,
+	0xa1, 0x75, 0x0e, 0x11, 0x93, 0xb0, 0xe8, 0xbe, 0x18, 0xdd, 0x5a, 0x41, 0x5e, 0x35, 0xaf, 0xbe,
+	0x56, 0x72, 0x6f, 0x14, 0xd2, 0x03, 0xb4, 0x15, 0x73, 0x48, 0x09, 0x4b, 0x84, 0x1f, 0x91, 0xcc,
+	0x4e, 0x5f, 0x24, 0x71, 0x3c, 0x9b, 0x17, 0xb5, 0xed, 0x7f, 0x96, 0x0d, 0xe9, 0xef, 0x37, 0xdb,
+	0x77, 0x8b, 0x31, 0x0a, 0xfc, 0xc2, 0x22, 0xcc, 0x8e, 0x02, 0x39, 0xb5, 0x5c, 0x2a, 0xff, 0xf8,
+	0xfd, 0x21, 0x52, 0xf3, 0x75, 0xa9, 0xf4, 0x36, 0x17, 0xa9, 0x9e, 0xe5, 0x99, 0x06, 0x79, 0x22,
+	0xbd, 0x8f, 0xd6, 0xaf, 0x67, 0x5e, 0xb9, 0x7d, 0xe6, 0xb5, 0x68, 0x29, 0xe3, 0xce, 0x9f, 0x65,
+	0xd4, 0xec, 0x73, 0x96, 0x12, 0x0c, 0xdc, 0x03, 0xf9, 0x9e, 0x9b, 0xbd, 0x85, 0x6a, 0x02, 0x28,
+	0x06, 0xae, 0x26, 0xa6, 0x50, 0xa6, 0xe1, 0x10, 0x02, 0x49, 0x81, 0xab, 0x41, 0x5d, 0xe2, 0xa5,
+	0x35, 0x5f, 0xb9, 0xdd, 0x9a, 0x3f, 0x46, 0x35, 0x21, 0x03, 0x99, 0x88, 0x7c, 0x8d, 0x9b, 0x7b,
+	0xdb, 0xd6, 0x5b, 0xcf, 0x80, 0x55, 0xb4, 0x34, 0xc8, 0xc3, 0x3c, 0x15, 0xfe, 0x7f, 0x97, 0xfc,
+	0x13, 0xf4, 0x01, 0x07, 0xc1, 0x66, 0xe9, 0x55, 0x5c, 0x3d, 0x8f, 0x6b, 0x2e, 0x68, 0x15, 0xb8,
+	0x89, 0x56, 0x8a, 0xdf, 0xa9, 0x91, 0xb7, 0x56, 0x80, 0x4f, 0xff, 0xd5, 0xd0, 0xda, 0xf2, 0xf5,
+	0xfa, 0x57, 0xa8, 0xe5, 0x39, 0xc3, 0x91, 0x77, 0xec, 0x0f, 0x86, 0x4f, 0x86, 0xa3, 0x81, 0x3f,
+	0x3a, 0x1e, 0xf4, 0x9d, 0x03, 0xf7, 0xd0, 0x75, 0x7a, 0x1b, 0xa5, 0xf6, 0xfd, 0xd3, 0x33, 0xf3,
+	0xde, 0xb2, 0x60, 0x44, 0x45, 0x0c, 0x21, 0x79, 0x4e, 0x00, 0xeb, 0x8f, 0xd0, 0xbd, 0xeb, 0x5a,
+	0xf7, 0xd8, 0x3f, 0x3c, 0x72, 0xbf, 0x7e, 0x3a, 0xdc, 0xd0, 0xda, 0xc6, 0xe9, 0x99, 0xb9, 0xb9,
+	0xac, 0x74, 0xe9, 0xe1, 0x2c, 0xaf, 0xec, 0xcb, 0x9b, 0xb2, 0x83, 0x6f, 0x9e, 0xf5, 0x8f, 0x9c,
+	0xa1, 0xd3, 0xdb, 0x28, 0xb7, 0x5b, 0xa7, 0x67, 0xe6, 0xdd, 0x65, 0xd9, 0x01, 0x8b, 0xe2, 0x19,
+	0x48, 0xc0, 0xfa, 0x17, 0x68, 0xeb, 0xba, 0xce, 0x73, 0x0e, 0x47, 0xc7, 0x3d, 0xa7, 0xb7, 0x51,
+	0x79, 0xfb, 0x36, 0x0f, 0x9e, 0x27, 0x14, 0x03, 0x6e, 0x57, 0x7f, 0xfc, 0xad, 0x53, 0xda, 0x3f,
+	0x7a, 0x75, 0xde, 0xd1, 0x5e, 0x9f, 0x77, 0xb4, 0x7f, 0xce, 0x3b, 0xda, 0xcf, 0x17, 0x9d, 0xd2,
+	0xeb, 0x8b, 0x4e, 0xe9, 0xaf, 0x8b, 0x4e, 0xe9, 0xbb, 0xbd, 0x09, 0x91, 0xd3, 0x64, 0x6c, 0x85,
+	0x2c, 0xb2, 0xf3, 0x51, 0x3d, 0x3c, 0x99, 0xff, 0xa0, 0x4e, 0x18, 0x4e, 0xec, 0xf4, 0x91, 0x7d,
+	0x72, 0xf5, 0xcc, 0xcb, 0x79, 0x0c, 0x62, 0x5c, 0xcb, 0x5f, 0xdf, 0xcf, 0xff, 0x1b, 0x00, 0x0b,
+	0x3e, 0xb3, 0x52, 0x05, 0x06, 0x00, 0x00,
+}
+
+func (m *PendingBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Parked {
+		i--
+		if m.Parked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reconciliation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reconciliation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reconciliation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MirrorSupply.Size()
+		i -= size
+		if _, err := m.MirrorSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PreviousMirrorSupply.Size()
+		i -= size
+		if _, err := m.PreviousMirrorSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RemoteHeight != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.RemoteHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueryIds) > 0 {
+		dAtA3 := make([]byte, len(m.QueryIds)*10)
+		var j2 int
+		for _, num := range m.QueryIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintMintburn(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderReturn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderReturn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderReturn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintburn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMintburn(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMintburn(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintburn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintburn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMintburn(uint64(m.Sequence))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMintburn(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovMintburn(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovMintburn(uint64(m.CreatedHeight))
+	}
+	if m.Parked {
+		n += 2
+	}
+	return n
+}
+
+func (m *EscrowQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovMintburn(uint64(m.QueryId))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	return n
+}
+
+func (m *Reconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueryIds) > 0 {
+		l = 0
+		for _, e := range m.QueryIds {
+			l += sovMintburn(uint64(e))
+		}
+		n += 1 + sovMintburn(uint64(l)) + l
+	}
+	if m.RemoteHeight != 0 {
+		n += 1 + sovMintburn(uint64(m.RemoteHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMintburn(uint64(m.Height))
+	}
+	l = m.PreviousMirrorSupply.Size()
+	n += 1 + l + sovMintburn(uint64(l))
+	l = m.MirrorSupply.Size()
+	n += 1 + l + sovMintburn(uint64(l))
+	return n
+}
+
+func (m *ProviderReturn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMintburn(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMintburn(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovMintburn(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovMintburn(uint64(m.CreatedHeight))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovMintburn(uint64(m.ResolvedHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMintburn(uint64(l))
+	}
+	return n
+}
+
+func sovMintburn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintburn(x uint64) (n int) {
+	return sovMintburn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reconciliation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reconciliation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMintburn
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.QueryIds = append(m.QueryIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMintburn
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMintburn
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMintburn
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.QueryIds) == 0 {
+					m.QueryIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMintburn
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.QueryIds = append(m.QueryIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryIds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+			}
+			m.RemoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMirrorSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousMirrorSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MirrorSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderReturn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintburn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderReturn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderReturn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReturnStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintburn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintburn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintburn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintburn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintburn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintburn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintburn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintburn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintburn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintburn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintburn = fmt.Errorf("proto: unexpected end of group")
+)
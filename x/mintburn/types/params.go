@@ -4,32 +4,23 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
-)
-
-type Params struct {
-	// Optional: who can update params later (gov module addr or multisig)
-	Authority string `json:"authority" yaml:"authority"`
-
-	// Emergency kill-switch for minting on DEX receive
-	Pause bool `json:"pause" yaml:"pause"`
 
-	// Base denoms accepted from Provider on Provider→DEX (e.g., ["stake"])
-	AllowedBaseDenoms []string `json:"allowed_base_denoms" yaml:"allowed_base_denoms"`
-
-	// Counterparty chain-ids allowed during channel open (Provider side for this direction)
-	ProviderChainIDs []string `json:"provider_chain_ids" yaml:"provider_chain_ids"`
+	sdkmath "cosmossdk.io/math"
+)
 
-	// Denom to mint on DEX when receiving from Provider (e.g., "umaany")
-	DexNativeDenom string `json:"dex_native_denom" yaml:"dex_native_denom"`
-}
+// DefaultEpochBlocks is a day of 6 seconds blocks.
+const DefaultEpochBlocks = uint64(14400)
 
 func DefaultParams() Params {
 	return Params{
-		Authority:         "",
-		Pause:             false,
-		AllowedBaseDenoms: []string{"stake"},
-		ProviderChainIDs:  []string{"maany-mainnet"},
-		DexNativeDenom:    "umaany",
+		Pause:               false,
+		AllowedBaseDenoms:   []string{"stake"},
+		ProviderChainIDs:    []string{"maany-mainnet"},
+		DexNativeDenom:      "umaany",
+		MaxMintPerBlock:     sdkmath.ZeroInt(),
+		MaxMintPerEpoch:     sdkmath.ZeroInt(),
+		MaxMintPerRecipient: sdkmath.ZeroInt(),
+		EpochBlocks:         DefaultEpochBlocks,
 	}
 }
 
@@ -54,5 +45,26 @@ func (p Params) Validate() error {
 	if strings.TrimSpace(p.DexNativeDenom) == "" {
 		return fmt.Errorf("dex_native_denom must not be empty")
 	}
+	// caps are not set in the params stored before consensus version 3
+	for _, c := range []struct {
+		name  string
+		value sdkmath.Int
+	}{
+		{"max_mint_per_block", p.MaxMintPerBlock},
+		{"max_mint_per_epoch", p.MaxMintPerEpoch},
+		{"max_mint_per_recipient", p.MaxMintPerRecipient},
+	} {
+		if !c.value.IsNil() && c.value.IsNegative() {
+			return fmt.Errorf("%s must not be negative: %s", c.name, c.value)
+		}
+	}
+	if p.EpochBlocks == 0 && (IsCapSet(p.MaxMintPerEpoch) || IsCapSet(p.MaxMintPerRecipient)) {
+		return fmt.Errorf("epoch_blocks must be positive when an epoch cap is set")
+	}
 	return nil
 }
+
+// IsCapSet reports whether the mint cap c is enabled.
+func IsCapSet(c sdkmath.Int) bool {
+	return !c.IsNil() && c.IsPositive()
+}
@@ -0,0 +1,672 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/mintburn/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the mintburn module.
+type Params struct {
+	// Emergency kill-switch for minting on DEX receive
+	Pause bool `protobuf:"varint,2,opt,name=pause,proto3" json:"pause,omitempty"`
+	// Base denoms accepted from Provider on Provider→DEX (e.g., ["stake"])
+	AllowedBaseDenoms []string `protobuf:"bytes,3,rep,name=allowed_base_denoms,json=allowedBaseDenoms,proto3" json:"allowed_base_denoms,omitempty"`
+	// Counterparty chain-ids allowed during channel open (Provider side for this direction)
+	ProviderChainIDs []string `protobuf:"bytes,4,rep,name=provider_chain_ids,json=providerChainIds,proto3" json:"provider_chain_ids,omitempty"`
+	// Denom to mint on DEX when receiving from Provider (e.g., "umaany")
+	DexNativeDenom string `protobuf:"bytes,5,opt,name=dex_native_denom,json=dexNativeDenom,proto3" json:"dex_native_denom,omitempty"`
+	// Mint caps of the privileged path, a zero cap is disabled.
+	// Maximum amount minted within a single block
+	MaxMintPerBlock cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_mint_per_block,json=maxMintPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_per_block"`
+	// Maximum amount minted within the sliding window of the last epoch_blocks blocks
+	MaxMintPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_mint_per_epoch,json=maxMintPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_per_epoch"`
+	// Maximum amount minted to a single recipient within the sliding window
+	MaxMintPerRecipient cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=max_mint_per_recipient,json=maxMintPerRecipient,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_per_recipient"`
+	// Length of the sliding window in blocks
+	EpochBlocks uint64 `protobuf:"varint,9,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d649f35625fbab, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *Params) GetAllowedBaseDenoms() []string {
+	if m != nil {
+		return m.AllowedBaseDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetProviderChainIDs() []string {
+	if m != nil {
+		return m.ProviderChainIDs
+	}
+	return nil
+}
+
+func (m *Params) GetDexNativeDenom() string {
+	if m != nil {
+		return m.DexNativeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "maany.mintburn.v1.Params")
+}
+
+func init() { proto.RegisterFile("maany/mintburn/v1/params.proto", fileDescriptor_73d649f35625fbab) }
+
+var fileDescriptor_73d649f35625fbab = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
+	0x18, 0xc5, 0xe3, 0x36, 0x0d, 0xc9, 0x80, 0x20, 0x9d, 0x06, 0x64, 0xba, 0x70, 0x02, 0x2b, 0x4b,
+	0xa8, 0xb6, 0x0a, 0xe2, 0x02, 0xa6, 0x2c, 0x82, 0x00, 0x45, 0x5e, 0x21, 0x36, 0xc3, 0xd8, 0x33,
+	0x8a, 0x47, 0xcd, 0xfc, 0xd1, 0xcc, 0xc4, 0x38, 0x1c, 0x80, 0x35, 0x87, 0xe1, 0x10, 0x5d, 0x56,
+	0xac, 0x10, 0x8b, 0x08, 0x39, 0x17, 0x41, 0x1e, 0xbb, 0xaa, 0x22, 0x56, 0x88, 0xdd, 0x7c, 0xef,
+	0x3d, 0xfd, 0x3e, 0xfb, 0xe9, 0x03, 0x01, 0xc7, 0x58, 0x6c, 0x62, 0xce, 0x84, 0xcd, 0xd6, 0x5a,
+	0xc4, 0xe5, 0x79, 0xac, 0xb0, 0xc6, 0xdc, 0x44, 0x4a, 0x4b, 0x2b, 0xe1, 0xb1, 0xf3, 0xa3, 0x1b,
+	0x3f, 0x2a, 0xcf, 0x4f, 0x1f, 0xe7, 0xd2, 0x70, 0x69, 0x90, 0x0b, 0xc4, 0xed, 0xd0, 0xa6, 0x4f,
+	0x27, 0x4b, 0xb9, 0x94, 0xad, 0xde, 0xbc, 0x5a, 0xf5, 0xe9, 0xd7, 0x3e, 0x18, 0x2c, 0x1c, 0x14,
+	0x4e, 0xc0, 0x91, 0xc2, 0x6b, 0x43, 0xfd, 0x83, 0x99, 0x17, 0x0e, 0xd3, 0x76, 0x80, 0x11, 0x38,
+	0xc1, 0xab, 0x95, 0xfc, 0x4c, 0x09, 0xca, 0xb0, 0xa1, 0x88, 0x50, 0x21, 0xb9, 0xf1, 0x0f, 0x67,
+	0x87, 0xe1, 0x28, 0x3d, 0xee, 0xac, 0x04, 0x1b, 0x7a, 0xe1, 0x0c, 0x98, 0x00, 0xa8, 0xb4, 0x2c,
+	0x19, 0xa1, 0x1a, 0xe5, 0x05, 0x66, 0x02, 0x31, 0x62, 0xfc, 0x7e, 0x13, 0x4f, 0x26, 0xf5, 0x76,
+	0x3a, 0x5e, 0x74, 0xee, 0xab, 0xc6, 0x9c, 0x5f, 0x98, 0x74, 0xac, 0xf6, 0x14, 0x62, 0x60, 0x08,
+	0xc6, 0x84, 0x56, 0x48, 0x60, 0xcb, 0xca, 0x6e, 0xa3, 0x7f, 0x34, 0xf3, 0xc2, 0x51, 0x7a, 0x9f,
+	0xd0, 0xea, 0xbd, 0x93, 0xdd, 0x3a, 0xf8, 0x01, 0x40, 0x8e, 0x2b, 0xd4, 0x54, 0x80, 0x14, 0xd5,
+	0x28, 0x5b, 0xc9, 0xfc, 0xd2, 0x1f, 0x34, 0xd9, 0xe4, 0xd9, 0xd5, 0x76, 0xda, 0xfb, 0xb5, 0x9d,
+	0x3e, 0x6c, 0x6b, 0x30, 0xe4, 0x32, 0x62, 0x32, 0xe6, 0xd8, 0x16, 0xd1, 0x5c, 0xd8, 0x1f, 0xdf,
+	0xcf, 0x40, 0xd7, 0xcf, 0x5c, 0xd8, 0xf4, 0x01, 0xc7, 0xd5, 0x3b, 0x26, 0xec, 0x82, 0xea, 0xa4,
+	0x61, 0xfc, 0x45, 0xa6, 0x4a, 0xe6, 0x85, 0x7f, 0xe7, 0xbf, 0xc8, 0xaf, 0x1b, 0x06, 0xfc, 0x04,
+	0x1e, 0xed, 0x91, 0x35, 0xcd, 0x99, 0x62, 0x54, 0x58, 0x7f, 0xf8, 0xef, 0xf4, 0x93, 0x5b, 0x7a,
+	0x7a, 0xc3, 0x81, 0x4f, 0xc0, 0x3d, 0xf7, 0xb9, 0x6d, 0x1d, 0xc6, 0x1f, 0xcd, 0xbc, 0xb0, 0x9f,
+	0xde, 0x75, 0x9a, 0xfb, 0x3b, 0xf3, 0xa6, 0x3f, 0xf4, 0xc6, 0x07, 0xe9, 0x08, 0xaf, 0x6d, 0x21,
+	0x35, 0xb3, 0x9b, 0xe4, 0xed, 0x55, 0x1d, 0x78, 0xd7, 0x75, 0xe0, 0xfd, 0xae, 0x03, 0xef, 0xdb,
+	0x2e, 0xe8, 0x5d, 0xef, 0x82, 0xde, 0xcf, 0x5d, 0xd0, 0xfb, 0xf8, 0x7c, 0xc9, 0x6c, 0xb1, 0xce,
+	0xa2, 0x5c, 0xf2, 0xd8, 0x5d, 0xdc, 0x59, 0xb5, 0xf9, 0xd2, 0xbd, 0x08, 0xad, 0xe2, 0xf2, 0x65,
+	0x5c, 0xdd, 0x1e, 0xa9, 0xdd, 0x28, 0x6a, 0xb2, 0x81, 0xbb, 0xae, 0x17, 0x7f, 0x06, 0x00, 0x7f,
+	0x88, 0xc5, 0xe0, 0xc3, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxMintPerRecipient.Size()
+		i -= size
+		if _, err := m.MaxMintPerRecipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxMintPerEpoch.Size()
+		i -= size
+		if _, err := m.MaxMintPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxMintPerBlock.Size()
+		i -= size
+		if _, err := m.MaxMintPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DexNativeDenom) > 0 {
+		i -= len(m.DexNativeDenom)
+		copy(dAtA[i:], m.DexNativeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DexNativeDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProviderChainIDs) > 0 {
+		for iNdEx := len(m.ProviderChainIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProviderChainIDs[iNdEx])
+			copy(dAtA[i:], m.ProviderChainIDs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ProviderChainIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedBaseDenoms) > 0 {
+		for iNdEx := len(m.AllowedBaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBaseDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedBaseDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedBaseDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pause {
+		i--
+		if m.Pause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pause {
+		n += 2
+	}
+	if len(m.AllowedBaseDenoms) > 0 {
+		for _, s := range m.AllowedBaseDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ProviderChainIDs) > 0 {
+		for _, s := range m.ProviderChainIDs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.DexNativeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxMintPerBlock.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxMintPerEpoch.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxMintPerRecipient.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EpochBlocks))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pause = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBaseDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBaseDenoms = append(m.AllowedBaseDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChainIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderChainIDs = append(m.ProviderChainIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexNativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DexNativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintPerRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
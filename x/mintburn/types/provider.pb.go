@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/mintburn/v1/provider.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgMarkEscrowClaimed is handled by the mintburn module of the provider chain, it is not routed
+// on the DEX. genesismint sends it through its interchain account to confirm that the provider
+// escrow was minted on the consumer. The field layout must match the provider definition.
+type MsgMarkEscrowClaimed struct {
+	// sender is the genesismint interchain account on the provider
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	EscrowId        string `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	ConsumerChainId string `protobuf:"bytes,3,opt,name=consumer_chain_id,json=consumerChainId,proto3" json:"consumer_chain_id,omitempty"`
+}
+
+func (m *MsgMarkEscrowClaimed) Reset()         { *m = MsgMarkEscrowClaimed{} }
+func (m *MsgMarkEscrowClaimed) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkEscrowClaimed) ProtoMessage()    {}
+func (*MsgMarkEscrowClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5716595aada1c0, []int{0}
+}
+func (m *MsgMarkEscrowClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarkEscrowClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarkEscrowClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarkEscrowClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkEscrowClaimed.Merge(m, src)
+}
+func (m *MsgMarkEscrowClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarkEscrowClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkEscrowClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarkEscrowClaimed proto.InternalMessageInfo
+
+func (m *MsgMarkEscrowClaimed) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMarkEscrowClaimed) GetEscrowId() string {
+	if m != nil {
+		return m.EscrowId
+	}
+	return ""
+}
+
+func (m *MsgMarkEscrowClaimed) GetConsumerChainId() string {
+	if m != nil {
+		return m.ConsumerChainId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgMarkEscrowClaimed)(nil), "maany.mintburn.v1.MsgMarkEscrowClaimed")
+}
+
+func init() { proto.RegisterFile("maany/mintburn/v1/provider.proto", fileDescriptor_8a5716595aada1c0) }
+
+var fileDescriptor_8a5716595aada1c0 = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4d, 0x4c, 0xcc,
+	0xab, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0x49, 0x2a, 0x2d, 0xca, 0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x28,
+	0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xab,
+	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf,
+	0x2d, 0x4e, 0x07, 0x69, 0xc8, 0x2d, 0x4e, 0x87, 0xa8, 0x95, 0x92, 0x84, 0x48, 0xc4, 0x83, 0x79,
+	0xfa, 0x10, 0x0e, 0x44, 0x4a, 0x69, 0x1e, 0x23, 0x97, 0x88, 0x6f, 0x71, 0xba, 0x6f, 0x62, 0x51,
+	0xb6, 0x6b, 0x71, 0x72, 0x51, 0x7e, 0xb9, 0x73, 0x4e, 0x62, 0x66, 0x6e, 0x6a, 0x8a, 0x90, 0x01,
+	0x17, 0x5b, 0x71, 0x6a, 0x5e, 0x4a, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4,
+	0xa5, 0x2d, 0xba, 0x22, 0x50, 0xad, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45,
+	0x99, 0x79, 0xe9, 0x41, 0x50, 0x75, 0x42, 0xd2, 0x5c, 0x9c, 0xa9, 0x60, 0x23, 0xe2, 0x33, 0x53,
+	0x24, 0x98, 0x40, 0x9a, 0x82, 0x38, 0x20, 0x02, 0x9e, 0x29, 0x42, 0x5a, 0x5c, 0x82, 0xc9, 0xf9,
+	0x79, 0xc5, 0xa5, 0xb9, 0xa9, 0x45, 0xf1, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x20, 0x45, 0xcc, 0x60,
+	0x45, 0xfc, 0x30, 0x09, 0x67, 0x90, 0xb8, 0x67, 0x8a, 0x15, 0x77, 0xd3, 0xf3, 0x0d, 0x5a, 0x50,
+	0x53, 0x9d, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
+	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x28, 0x3d,
+	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1c, 0x18, 0xba, 0x15, 0x95, 0x55,
+	0x50, 0x56, 0x4a, 0x6a, 0x85, 0x7e, 0x99, 0xa9, 0x7e, 0x05, 0x22, 0x04, 0x4b, 0x2a, 0x0b, 0x52,
+	0x8b, 0x93, 0xd8, 0xc0, 0xbe, 0x36, 0x06, 0x0c, 0x00, 0x2c, 0xb9, 0xf4, 0x2d, 0x60, 0x01, 0x00,
+	0x00,
+}
+
+func (m *MsgMarkEscrowClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkEscrowClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkEscrowClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerChainId) > 0 {
+		i -= len(m.ConsumerChainId)
+		copy(dAtA[i:], m.ConsumerChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EscrowId) > 0 {
+		i -= len(m.EscrowId)
+		copy(dAtA[i:], m.EscrowId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.EscrowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMarkEscrowClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMarkEscrowClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarkEscrowClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarkEscrowClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProvider(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProvider
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProvider
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProvider
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProvider        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProvider          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProvider = fmt.Errorf("proto: unexpected end of group")
+)
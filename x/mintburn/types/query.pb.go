@@ -0,0 +1,4625 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/mintburn/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryMirrorSupplyRequest struct {
+}
+
+func (m *QueryMirrorSupplyRequest) Reset()         { *m = QueryMirrorSupplyRequest{} }
+func (m *QueryMirrorSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMirrorSupplyRequest) ProtoMessage()    {}
+func (*QueryMirrorSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{2}
+}
+func (m *QueryMirrorSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMirrorSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMirrorSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMirrorSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMirrorSupplyRequest.Merge(m, src)
+}
+func (m *QueryMirrorSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMirrorSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMirrorSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMirrorSupplyRequest proto.InternalMessageInfo
+
+type QueryMirrorSupplyResponse struct {
+	MirrorSupply types.Coin `protobuf:"bytes,1,opt,name=mirror_supply,json=mirrorSupply,proto3" json:"mirror_supply"`
+}
+
+func (m *QueryMirrorSupplyResponse) Reset()         { *m = QueryMirrorSupplyResponse{} }
+func (m *QueryMirrorSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMirrorSupplyResponse) ProtoMessage()    {}
+func (*QueryMirrorSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{3}
+}
+func (m *QueryMirrorSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMirrorSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMirrorSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMirrorSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMirrorSupplyResponse.Merge(m, src)
+}
+func (m *QueryMirrorSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMirrorSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMirrorSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMirrorSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMirrorSupplyResponse) GetMirrorSupply() types.Coin {
+	if m != nil {
+		return m.MirrorSupply
+	}
+	return types.Coin{}
+}
+
+type QueryAllowedChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedChannelsRequest) Reset()         { *m = QueryAllowedChannelsRequest{} }
+func (m *QueryAllowedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsRequest) ProtoMessage()    {}
+func (*QueryAllowedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{4}
+}
+func (m *QueryAllowedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsRequest.Merge(m, src)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllowedChannelsResponse struct {
+	ChannelIds []string            `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedChannelsResponse) Reset()         { *m = QueryAllowedChannelsResponse{} }
+func (m *QueryAllowedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsResponse) ProtoMessage()    {}
+func (*QueryAllowedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{5}
+}
+func (m *QueryAllowedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsResponse.Merge(m, src)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsResponse) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
+func (m *QueryAllowedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The proof id of a packet is built from its destination port, destination channel and sequence
+type QueryProofConsumedRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryProofConsumedRequest) Reset()         { *m = QueryProofConsumedRequest{} }
+func (m *QueryProofConsumedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProofConsumedRequest) ProtoMessage()    {}
+func (*QueryProofConsumedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{6}
+}
+func (m *QueryProofConsumedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofConsumedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofConsumedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProofConsumedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofConsumedRequest.Merge(m, src)
+}
+func (m *QueryProofConsumedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofConsumedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofConsumedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProofConsumedRequest proto.InternalMessageInfo
+
+func (m *QueryProofConsumedRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryProofConsumedRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryProofConsumedRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryProofConsumedResponse struct {
+	Consumed bool `protobuf:"varint,1,opt,name=consumed,proto3" json:"consumed,omitempty"`
+}
+
+func (m *QueryProofConsumedResponse) Reset()         { *m = QueryProofConsumedResponse{} }
+func (m *QueryProofConsumedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProofConsumedResponse) ProtoMessage()    {}
+func (*QueryProofConsumedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{7}
+}
+func (m *QueryProofConsumedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofConsumedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofConsumedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProofConsumedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofConsumedResponse.Merge(m, src)
+}
+func (m *QueryProofConsumedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofConsumedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofConsumedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProofConsumedResponse proto.InternalMessageInfo
+
+func (m *QueryProofConsumedResponse) GetConsumed() bool {
+	if m != nil {
+		return m.Consumed
+	}
+	return false
+}
+
+type QueryPendingBurnsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingBurnsRequest) Reset()         { *m = QueryPendingBurnsRequest{} }
+func (m *QueryPendingBurnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBurnsRequest) ProtoMessage()    {}
+func (*QueryPendingBurnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{8}
+}
+func (m *QueryPendingBurnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBurnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBurnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBurnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBurnsRequest.Merge(m, src)
+}
+func (m *QueryPendingBurnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBurnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBurnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBurnsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingBurnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingBurnsResponse struct {
+	PendingBurns []PendingBurn       `protobuf:"bytes,1,rep,name=pending_burns,json=pendingBurns,proto3" json:"pending_burns"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingBurnsResponse) Reset()         { *m = QueryPendingBurnsResponse{} }
+func (m *QueryPendingBurnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBurnsResponse) ProtoMessage()    {}
+func (*QueryPendingBurnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{9}
+}
+func (m *QueryPendingBurnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBurnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBurnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBurnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBurnsResponse.Merge(m, src)
+}
+func (m *QueryPendingBurnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBurnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBurnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBurnsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingBurnsResponse) GetPendingBurns() []PendingBurn {
+	if m != nil {
+		return m.PendingBurns
+	}
+	return nil
+}
+
+func (m *QueryPendingBurnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryReturnRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryReturnRequest) Reset()         { *m = QueryReturnRequest{} }
+func (m *QueryReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReturnRequest) ProtoMessage()    {}
+func (*QueryReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{10}
+}
+func (m *QueryReturnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReturnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReturnRequest.Merge(m, src)
+}
+func (m *QueryReturnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReturnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReturnRequest proto.InternalMessageInfo
+
+func (m *QueryReturnRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryReturnRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryReturnResponse struct {
+	Return ProviderReturn `protobuf:"bytes,1,opt,name=return,proto3" json:"return"`
+}
+
+func (m *QueryReturnResponse) Reset()         { *m = QueryReturnResponse{} }
+func (m *QueryReturnResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReturnResponse) ProtoMessage()    {}
+func (*QueryReturnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{11}
+}
+func (m *QueryReturnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReturnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReturnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReturnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReturnResponse.Merge(m, src)
+}
+func (m *QueryReturnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReturnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReturnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReturnResponse proto.InternalMessageInfo
+
+func (m *QueryReturnResponse) GetReturn() ProviderReturn {
+	if m != nil {
+		return m.Return
+	}
+	return ProviderReturn{}
+}
+
+type QueryReturnsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReturnsRequest) Reset()         { *m = QueryReturnsRequest{} }
+func (m *QueryReturnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReturnsRequest) ProtoMessage()    {}
+func (*QueryReturnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{12}
+}
+func (m *QueryReturnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReturnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReturnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReturnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReturnsRequest.Merge(m, src)
+}
+func (m *QueryReturnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReturnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReturnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReturnsRequest proto.InternalMessageInfo
+
+func (m *QueryReturnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryReturnsResponse struct {
+	Returns []ProviderReturn `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns"`
+	// total amount of the returns in flight
+	InFlight   types.Coin          `protobuf:"bytes,2,opt,name=in_flight,json=inFlight,proto3" json:"in_flight"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReturnsResponse) Reset()         { *m = QueryReturnsResponse{} }
+func (m *QueryReturnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReturnsResponse) ProtoMessage()    {}
+func (*QueryReturnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{13}
+}
+func (m *QueryReturnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReturnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReturnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReturnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReturnsResponse.Merge(m, src)
+}
+func (m *QueryReturnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReturnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReturnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReturnsResponse proto.InternalMessageInfo
+
+func (m *QueryReturnsResponse) GetReturns() []ProviderReturn {
+	if m != nil {
+		return m.Returns
+	}
+	return nil
+}
+
+func (m *QueryReturnsResponse) GetInFlight() types.Coin {
+	if m != nil {
+		return m.InFlight
+	}
+	return types.Coin{}
+}
+
+func (m *QueryReturnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySupplyDriftRequest struct {
+}
+
+func (m *QuerySupplyDriftRequest) Reset()         { *m = QuerySupplyDriftRequest{} }
+func (m *QuerySupplyDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDriftRequest) ProtoMessage()    {}
+func (*QuerySupplyDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{14}
+}
+func (m *QuerySupplyDriftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDriftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDriftRequest.Merge(m, src)
+}
+func (m *QuerySupplyDriftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyDriftRequest proto.InternalMessageInfo
+
+type QuerySupplyDriftResponse struct {
+	BankSupply    types.Coin `protobuf:"bytes,1,opt,name=bank_supply,json=bankSupply,proto3" json:"bank_supply"`
+	MirrorSupply  types.Coin `protobuf:"bytes,2,opt,name=mirror_supply,json=mirrorSupply,proto3" json:"mirror_supply"`
+	GenesisMinted types.Coin `protobuf:"bytes,3,opt,name=genesis_minted,json=genesisMinted,proto3" json:"genesis_minted"`
+	// bank supply minus mirror supply and genesis minted amount, a positive drift is unbacked supply
+	Drift cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=drift,proto3,customtype=cosmossdk.io/math.Int" json:"drift"`
+	// empty if the mirror supply was never reconciled
+	LastReconciliation *Reconciliation `protobuf:"bytes,5,opt,name=last_reconciliation,json=lastReconciliation,proto3" json:"last_reconciliation,omitempty"`
+}
+
+func (m *QuerySupplyDriftResponse) Reset()         { *m = QuerySupplyDriftResponse{} }
+func (m *QuerySupplyDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDriftResponse) ProtoMessage()    {}
+func (*QuerySupplyDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{15}
+}
+func (m *QuerySupplyDriftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDriftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDriftResponse.Merge(m, src)
+}
+func (m *QuerySupplyDriftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyDriftResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyDriftResponse) GetBankSupply() types.Coin {
+	if m != nil {
+		return m.BankSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySupplyDriftResponse) GetMirrorSupply() types.Coin {
+	if m != nil {
+		return m.MirrorSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySupplyDriftResponse) GetGenesisMinted() types.Coin {
+	if m != nil {
+		return m.GenesisMinted
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySupplyDriftResponse) GetLastReconciliation() *Reconciliation {
+	if m != nil {
+		return m.LastReconciliation
+	}
+	return nil
+}
+
+type QueryEscrowQueriesRequest struct {
+}
+
+func (m *QueryEscrowQueriesRequest) Reset()         { *m = QueryEscrowQueriesRequest{} }
+func (m *QueryEscrowQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowQueriesRequest) ProtoMessage()    {}
+func (*QueryEscrowQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{16}
+}
+func (m *QueryEscrowQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowQueriesRequest.Merge(m, src)
+}
+func (m *QueryEscrowQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowQueriesRequest proto.InternalMessageInfo
+
+type QueryEscrowQueriesResponse struct {
+	EscrowQueries []EscrowQuery `protobuf:"bytes,1,rep,name=escrow_queries,json=escrowQueries,proto3" json:"escrow_queries"`
+}
+
+func (m *QueryEscrowQueriesResponse) Reset()         { *m = QueryEscrowQueriesResponse{} }
+func (m *QueryEscrowQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowQueriesResponse) ProtoMessage()    {}
+func (*QueryEscrowQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{17}
+}
+func (m *QueryEscrowQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowQueriesResponse.Merge(m, src)
+}
+func (m *QueryEscrowQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowQueriesResponse) GetEscrowQueries() []EscrowQuery {
+	if m != nil {
+		return m.EscrowQueries
+	}
+	return nil
+}
+
+type QueryMintWindowRequest struct {
+	// recipient is optional, its minted amount is zero if empty
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *QueryMintWindowRequest) Reset()         { *m = QueryMintWindowRequest{} }
+func (m *QueryMintWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintWindowRequest) ProtoMessage()    {}
+func (*QueryMintWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{18}
+}
+func (m *QueryMintWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintWindowRequest.Merge(m, src)
+}
+func (m *QueryMintWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintWindowRequest proto.InternalMessageInfo
+
+func (m *QueryMintWindowRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type QueryMintWindowResponse struct {
+	BlockMinted     types.Coin `protobuf:"bytes,1,opt,name=block_minted,json=blockMinted,proto3" json:"block_minted"`
+	WindowMinted    types.Coin `protobuf:"bytes,2,opt,name=window_minted,json=windowMinted,proto3" json:"window_minted"`
+	RecipientMinted types.Coin `protobuf:"bytes,3,opt,name=recipient_minted,json=recipientMinted,proto3" json:"recipient_minted"`
+	EpochBlocks     uint64     `protobuf:"varint,4,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+}
+
+func (m *QueryMintWindowResponse) Reset()         { *m = QueryMintWindowResponse{} }
+func (m *QueryMintWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintWindowResponse) ProtoMessage()    {}
+func (*QueryMintWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{19}
+}
+func (m *QueryMintWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintWindowResponse.Merge(m, src)
+}
+func (m *QueryMintWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintWindowResponse proto.InternalMessageInfo
+
+func (m *QueryMintWindowResponse) GetBlockMinted() types.Coin {
+	if m != nil {
+		return m.BlockMinted
+	}
+	return types.Coin{}
+}
+
+func (m *QueryMintWindowResponse) GetWindowMinted() types.Coin {
+	if m != nil {
+		return m.WindowMinted
+	}
+	return types.Coin{}
+}
+
+func (m *QueryMintWindowResponse) GetRecipientMinted() types.Coin {
+	if m != nil {
+		return m.RecipientMinted
+	}
+	return types.Coin{}
+}
+
+func (m *QueryMintWindowResponse) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "maany.mintburn.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "maany.mintburn.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMirrorSupplyRequest)(nil), "maany.mintburn.v1.QueryMirrorSupplyRequest")
+	proto.RegisterType((*QueryMirrorSupplyResponse)(nil), "maany.mintburn.v1.QueryMirrorSupplyResponse")
+	proto.RegisterType((*QueryAllowedChannelsRequest)(nil), "maany.mintburn.v1.QueryAllowedChannelsRequest")
+	proto.RegisterType((*QueryAllowedChannelsResponse)(nil), "maany.mintburn.v1.QueryAllowedChannelsResponse")
+	proto.RegisterType((*QueryProofConsumedRequest)(nil), "maany.mintburn.v1.QueryProofConsumedRequest")
+	proto.RegisterType((*QueryProofConsumedResponse)(nil), "maany.mintburn.v1.QueryProofConsumedResponse")
+	proto.RegisterType((*QueryPendingBurnsRequest)(nil), "maany.mintburn.v1.QueryPendingBurnsRequest")
+	proto.RegisterType((*QueryPendingBurnsResponse)(nil), "maany.mintburn.v1.QueryPendingBurnsResponse")
+	proto.RegisterType((*QueryReturnRequest)(nil), "maany.mintburn.v1.QueryReturnRequest")
+	proto.RegisterType((*QueryReturnResponse)(nil), "maany.mintburn.v1.QueryReturnResponse")
+	proto.RegisterType((*QueryReturnsRequest)(nil), "maany.mintburn.v1.QueryReturnsRequest")
+	proto.RegisterType((*QueryReturnsResponse)(nil), "maany.mintburn.v1.QueryReturnsResponse")
+	proto.RegisterType((*QuerySupplyDriftRequest)(nil), "maany.mintburn.v1.QuerySupplyDriftRequest")
+	proto.RegisterType((*QuerySupplyDriftResponse)(nil), "maany.mintburn.v1.QuerySupplyDriftResponse")
+	proto.RegisterType((*QueryEscrowQueriesRequest)(nil), "maany.mintburn.v1.QueryEscrowQueriesRequest")
+	proto.RegisterType((*QueryEscrowQueriesResponse)(nil), "maany.mintburn.v1.QueryEscrowQueriesResponse")
+	proto.RegisterType((*QueryMintWindowRequest)(nil), "maany.mintburn.v1.QueryMintWindowRequest")
+	proto.RegisterType((*QueryMintWindowResponse)(nil), "maany.mintburn.v1.QueryMintWindowResponse")
+}
+
+func init() { proto.RegisterFile("maany/mintburn/v1/query.proto", fileDescriptor_b50a42a3fb7c069a) }
+
+var fileDescriptor_b50a42a3fb7c069a = []byte{
+	// 1265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6f, 0x1b, 0xc5,
+	0x13, 0xcf, 0x39, 0xa9, 0x1b, 0x8f, 0xe3, 0xf6, 0xfb, 0xdd, 0x16, 0x6a, 0x5f, 0x53, 0x27, 0xb9,
+	0xaa, 0x69, 0x9a, 0x34, 0x3e, 0x25, 0xfc, 0x14, 0x3f, 0x04, 0x71, 0x42, 0x50, 0x80, 0x42, 0x38,
+	0x24, 0x90, 0x90, 0xd0, 0xe9, 0x7c, 0xb7, 0x71, 0x56, 0xb1, 0x77, 0x2f, 0x77, 0xe7, 0x24, 0x6e,
+	0x14, 0x24, 0x78, 0x42, 0xe2, 0x01, 0x44, 0x1f, 0x78, 0xe4, 0x4f, 0x40, 0x48, 0xfc, 0x11, 0x7d,
+	0xac, 0x40, 0x48, 0x88, 0x87, 0xaa, 0x4a, 0xf8, 0x43, 0xd0, 0xed, 0xee, 0xd9, 0xbe, 0xf8, 0xae,
+	0xb9, 0x56, 0x79, 0xf3, 0xce, 0xce, 0x7c, 0xe6, 0xb3, 0x33, 0x73, 0x33, 0x63, 0xb8, 0xd1, 0xb6,
+	0x2c, 0xda, 0xd5, 0xdb, 0x84, 0x06, 0x8d, 0x8e, 0x47, 0xf5, 0xbd, 0x25, 0x7d, 0xb7, 0x83, 0xbd,
+	0x6e, 0xcd, 0xf5, 0x58, 0xc0, 0xd0, 0xff, 0xf9, 0x75, 0x2d, 0xba, 0xae, 0xed, 0x2d, 0xa9, 0xf3,
+	0x36, 0xf3, 0xdb, 0xcc, 0xd7, 0x1b, 0x96, 0x8f, 0x85, 0xae, 0xbe, 0xb7, 0xd4, 0xc0, 0x81, 0xb5,
+	0xa4, 0xbb, 0x56, 0x93, 0x50, 0x2b, 0x20, 0x8c, 0x0a, 0x73, 0xb5, 0x3a, 0xa8, 0x1b, 0x69, 0xd9,
+	0x8c, 0x44, 0xf7, 0x15, 0x71, 0x6f, 0xf2, 0x93, 0x2e, 0x0e, 0xf2, 0xea, 0x6a, 0x93, 0x35, 0x99,
+	0x90, 0x87, 0xbf, 0xa4, 0x74, 0xb2, 0xc9, 0x58, 0xb3, 0x85, 0x75, 0xcb, 0x25, 0xba, 0x45, 0x29,
+	0x0b, 0xb8, 0xb7, 0xc8, 0x66, 0x7a, 0xf8, 0x31, 0x3d, 0xe6, 0x92, 0xd0, 0xb0, 0x86, 0x6b, 0x79,
+	0x56, 0x5b, 0x22, 0x68, 0x57, 0x01, 0x7d, 0x1a, 0x3e, 0x69, 0x93, 0x0b, 0x0d, 0xbc, 0xdb, 0xc1,
+	0x7e, 0xa0, 0x7d, 0x0c, 0x57, 0x62, 0x52, 0xdf, 0x65, 0xd4, 0xc7, 0xe8, 0x35, 0xc8, 0x0b, 0xe3,
+	0xb2, 0x32, 0xad, 0xcc, 0x15, 0x97, 0x2b, 0xb5, 0xa1, 0x68, 0xd5, 0x84, 0x49, 0x7d, 0xec, 0xe1,
+	0xe3, 0xa9, 0x11, 0x43, 0xaa, 0x6b, 0x2a, 0x94, 0x39, 0xde, 0x3d, 0xe2, 0x79, 0xcc, 0xfb, 0xac,
+	0xe3, 0xba, 0xad, 0x6e, 0xe4, 0xcb, 0x82, 0x4a, 0xc2, 0x9d, 0xf4, 0xb8, 0x06, 0xa5, 0x36, 0x97,
+	0x9b, 0x3e, 0xbf, 0xe8, 0x39, 0x96, 0xa1, 0x0b, 0xe3, 0x5c, 0x93, 0x71, 0xae, 0xad, 0x32, 0x42,
+	0xa5, 0xe3, 0x89, 0xf6, 0x00, 0x9a, 0x86, 0xe1, 0x3a, 0x77, 0xb1, 0xd2, 0x6a, 0xb1, 0x7d, 0xec,
+	0xac, 0x6e, 0x5b, 0x94, 0xe2, 0x56, 0xf4, 0x5a, 0xb4, 0x0e, 0xd0, 0x4f, 0xa4, 0xf4, 0x30, 0x1b,
+	0xf3, 0x20, 0x2a, 0x24, 0xf2, 0xb3, 0x69, 0x35, 0xb1, 0xb4, 0x35, 0x06, 0x2c, 0xb5, 0xef, 0x14,
+	0x98, 0x4c, 0xf6, 0x23, 0x5f, 0x33, 0x05, 0x45, 0x5b, 0xc8, 0x4c, 0xe2, 0x84, 0x41, 0x1c, 0x9d,
+	0x2b, 0x18, 0x20, 0x45, 0x1b, 0x8e, 0x8f, 0xde, 0x8f, 0x31, 0xc9, 0x71, 0x26, 0xb7, 0xcf, 0x64,
+	0x22, 0xd0, 0x63, 0x54, 0x98, 0x0c, 0xea, 0xa6, 0xc7, 0xd8, 0xd6, 0x2a, 0xa3, 0x7e, 0xa7, 0x8d,
+	0x9d, 0xe8, 0xbd, 0xd7, 0xe0, 0xa2, 0xcb, 0xbc, 0xc0, 0x24, 0x0e, 0x7f, 0x6c, 0xc1, 0xc8, 0x87,
+	0xc7, 0x0d, 0x07, 0xdd, 0x00, 0xe8, 0xf3, 0xe3, 0xee, 0x0b, 0x46, 0xa1, 0x47, 0x0f, 0xa9, 0x30,
+	0xee, 0x87, 0x10, 0xd4, 0xc6, 0xe5, 0xd1, 0x69, 0x65, 0x6e, 0xcc, 0xe8, 0x9d, 0xb5, 0xd7, 0x41,
+	0x4d, 0x72, 0x28, 0x1f, 0xae, 0xc2, 0xb8, 0x2d, 0x65, 0xdc, 0xe5, 0xb8, 0xd1, 0x3b, 0x6b, 0x0d,
+	0x59, 0x1b, 0x9b, 0x98, 0x3a, 0x84, 0x36, 0xeb, 0x1d, 0x8f, 0x9e, 0x7b, 0x66, 0x7e, 0x55, 0xa0,
+	0x92, 0xe0, 0x44, 0xb2, 0xdb, 0x80, 0x92, 0x2b, 0xe4, 0x66, 0x58, 0xc5, 0x22, 0x31, 0xc5, 0xe5,
+	0x6a, 0x52, 0x75, 0xf7, 0xed, 0xa3, 0x4a, 0x73, 0x07, 0x20, 0xcf, 0x2f, 0x81, 0x9f, 0xc8, 0xef,
+	0xd2, 0xc0, 0x41, 0xc7, 0xa3, 0x51, 0x3c, 0xe2, 0x09, 0x52, 0x9e, 0x96, 0xa0, 0xdc, 0xa9, 0x04,
+	0x7d, 0x0e, 0x57, 0x62, 0x80, 0xf2, 0xed, 0xef, 0x40, 0xde, 0xe3, 0x12, 0x19, 0xdd, 0x99, 0xa4,
+	0x47, 0x7b, 0x6c, 0x8f, 0x38, 0xd8, 0x13, 0xa6, 0xd1, 0xa7, 0x2d, 0xcc, 0xb4, 0xaf, 0x62, 0xb8,
+	0xe7, 0x9e, 0xb9, 0x27, 0x0a, 0x5c, 0x8d, 0xe3, 0x4b, 0xe2, 0x2b, 0x70, 0x51, 0x30, 0x88, 0xd2,
+	0x95, 0x99, 0x79, 0x64, 0x87, 0xde, 0x82, 0x02, 0xa1, 0xe6, 0x56, 0x8b, 0x34, 0xb7, 0x83, 0x72,
+	0x2e, 0x5b, 0x63, 0x19, 0x27, 0x74, 0x9d, 0x1b, 0x9c, 0x4a, 0xf5, 0xe8, 0xf3, 0xa7, 0xba, 0x02,
+	0xd7, 0xf8, 0x0b, 0x45, 0xb3, 0x5a, 0xf3, 0xc8, 0x56, 0x10, 0xf5, 0xc6, 0x6f, 0x46, 0xa1, 0x3c,
+	0x7c, 0x27, 0x23, 0xf0, 0x2e, 0x14, 0x1b, 0x16, 0xdd, 0x79, 0xc6, 0xce, 0x08, 0xa1, 0x8d, 0x80,
+	0x1b, 0xee, 0xae, 0xb9, 0xe7, 0xe8, 0xae, 0x68, 0x1d, 0x2e, 0x35, 0x31, 0xc5, 0x3e, 0xf1, 0xcd,
+	0x30, 0xf6, 0xd8, 0x29, 0x8f, 0x66, 0x83, 0x29, 0x49, 0xb3, 0x7b, 0xdc, 0x0a, 0xad, 0xc0, 0x05,
+	0x27, 0x7c, 0x60, 0x79, 0x2c, 0xac, 0xeb, 0xfa, 0x42, 0xa8, 0xf3, 0xcf, 0xe3, 0xa9, 0x17, 0x04,
+	0x8a, 0xef, 0xec, 0xd4, 0x08, 0xd3, 0xdb, 0x56, 0xb0, 0x5d, 0xdb, 0xa0, 0xc1, 0x1f, 0xbf, 0x2f,
+	0x82, 0x84, 0xdf, 0xa0, 0x81, 0x21, 0x2c, 0x91, 0x01, 0x57, 0x5a, 0x96, 0x1f, 0x98, 0x1e, 0xb6,
+	0x19, 0xb5, 0x49, 0x8b, 0x88, 0xe4, 0x5c, 0x48, 0x2d, 0x6d, 0x23, 0xa6, 0x68, 0xa0, 0xd0, 0x3a,
+	0x2e, 0xd3, 0xae, 0xcb, 0xd6, 0xf1, 0x9e, 0x6f, 0x7b, 0x6c, 0x3f, 0xfc, 0x49, 0x70, 0x6f, 0x50,
+	0x12, 0x50, 0x93, 0x2e, 0x65, 0x86, 0x3e, 0x84, 0x4b, 0x98, 0x5f, 0x98, 0xbb, 0xe2, 0xe6, 0x29,
+	0x9d, 0xa5, 0x8f, 0xd0, 0x8d, 0xc2, 0x83, 0x07, 0x41, 0xb5, 0x57, 0xe1, 0x45, 0x39, 0x27, 0x69,
+	0xf0, 0x05, 0xa1, 0x0e, 0xdb, 0x8f, 0xbe, 0xb5, 0x49, 0x28, 0x78, 0xd8, 0x26, 0x2e, 0xc1, 0x34,
+	0x88, 0x9a, 0x42, 0x4f, 0xa0, 0xfd, 0x94, 0x83, 0x6b, 0x43, 0x86, 0x92, 0x60, 0x1d, 0x26, 0x1a,
+	0x2d, 0x66, 0xef, 0x44, 0x89, 0xcb, 0x58, 0x43, 0x45, 0x6e, 0x24, 0xd3, 0xb6, 0x06, 0xa5, 0x7d,
+	0x8e, 0x1a, 0x81, 0x64, 0x2d, 0x22, 0x61, 0x25, 0x51, 0x3e, 0x80, 0xff, 0xf5, 0x28, 0x3f, 0x63,
+	0x19, 0x5d, 0xee, 0x19, 0x4a, 0xac, 0x19, 0x98, 0xc0, 0x2e, 0xb3, 0xb7, 0x4d, 0x4e, 0xd3, 0xe7,
+	0xf5, 0x34, 0x66, 0x14, 0xb9, 0xac, 0xce, 0x45, 0xcb, 0x7f, 0x15, 0xe1, 0x02, 0x0f, 0x0a, 0xba,
+	0x0f, 0x79, 0xb1, 0xb2, 0xa0, 0x5b, 0x09, 0x59, 0x19, 0xde, 0x8d, 0xd4, 0xd9, 0xb3, 0xd4, 0x44,
+	0x6c, 0xb5, 0x99, 0x6f, 0xff, 0xfc, 0xf7, 0x41, 0xee, 0x3a, 0xaa, 0xe8, 0x69, 0x2b, 0x18, 0x7a,
+	0xa0, 0xc0, 0xc4, 0xe0, 0xda, 0x83, 0x16, 0xd2, 0xb0, 0x13, 0x16, 0x27, 0xf5, 0x6e, 0x36, 0x65,
+	0x49, 0x67, 0x8e, 0xd3, 0xd1, 0xd0, 0xb4, 0x9e, 0xb4, 0x33, 0x0e, 0x34, 0x01, 0xf4, 0x8b, 0x02,
+	0x97, 0x4f, 0x6d, 0x30, 0xa8, 0x96, 0xe6, 0x2b, 0x79, 0xa5, 0x52, 0xf5, 0xcc, 0xfa, 0x92, 0xde,
+	0x02, 0xa7, 0x77, 0x0b, 0xdd, 0x4c, 0xa0, 0x67, 0x09, 0x1b, 0xd3, 0x8e, 0xd8, 0xfc, 0xa6, 0x40,
+	0x29, 0xb6, 0x68, 0xa0, 0xd4, 0x58, 0x24, 0x2d, 0x40, 0xea, 0x62, 0x46, 0x6d, 0xc9, 0x6d, 0x95,
+	0x73, 0x7b, 0x1b, 0xbd, 0x99, 0x94, 0xc9, 0xd0, 0xc2, 0xd7, 0x0f, 0xe5, 0x42, 0x75, 0xa4, 0x1f,
+	0xf6, 0x07, 0xf4, 0x91, 0x7e, 0x18, 0x8d, 0xdf, 0x23, 0x9e, 0xeb, 0xc1, 0xed, 0x23, 0x3d, 0xd7,
+	0x09, 0x8b, 0x90, 0x7a, 0x37, 0x9b, 0x72, 0x86, 0x5c, 0xc7, 0x36, 0x9d, 0x90, 0x55, 0x5e, 0x0c,
+	0xc7, 0xf4, 0xf2, 0x8f, 0xad, 0x20, 0xea, 0xec, 0x59, 0x6a, 0x92, 0xc3, 0x1b, 0x9c, 0xc3, 0xcb,
+	0x68, 0x39, 0x81, 0x83, 0x1c, 0xc0, 0xa9, 0xb1, 0xfa, 0x1a, 0x2e, 0x1a, 0x72, 0x46, 0x9f, 0xe1,
+	0xae, 0x17, 0xa0, 0xdb, 0x67, 0xea, 0x49, 0x5e, 0x1a, 0xe7, 0x35, 0x89, 0xd4, 0x74, 0x5e, 0xe8,
+	0x07, 0x05, 0x8a, 0x03, 0x13, 0x17, 0xcd, 0xa7, 0x81, 0x0f, 0x8f, 0x6c, 0x75, 0x21, 0x93, 0xae,
+	0x24, 0x73, 0x9b, 0x93, 0x99, 0x41, 0x53, 0x09, 0x64, 0xc4, 0xd7, 0x68, 0x8a, 0xc1, 0xf6, 0xb3,
+	0x02, 0xa5, 0xd8, 0x8c, 0x49, 0xaf, 0xf8, 0xa4, 0x39, 0xa5, 0x2e, 0x66, 0xd4, 0x96, 0xbc, 0xee,
+	0x70, 0x5e, 0x37, 0xd1, 0x4c, 0x02, 0xaf, 0xf8, 0x44, 0x43, 0xdf, 0x2b, 0x00, 0xfd, 0xc9, 0x82,
+	0xee, 0xa4, 0x37, 0xa5, 0x53, 0x63, 0x4b, 0x9d, 0xcf, 0xa2, 0x2a, 0x09, 0xcd, 0x72, 0x42, 0xd3,
+	0xa8, 0xaa, 0x27, 0xff, 0xe3, 0x35, 0xc5, 0x30, 0xa9, 0x7f, 0xf4, 0xf0, 0xb8, 0xaa, 0x3c, 0x3a,
+	0xae, 0x2a, 0x4f, 0x8e, 0xab, 0xca, 0x8f, 0x27, 0xd5, 0x91, 0x47, 0x27, 0xd5, 0x91, 0xbf, 0x4f,
+	0xaa, 0x23, 0x5f, 0x2e, 0x37, 0x49, 0xb0, 0xdd, 0x69, 0xd4, 0x6c, 0xd6, 0x16, 0x18, 0x8b, 0x07,
+	0xdd, 0xfb, 0xf2, 0x97, 0x83, 0x0f, 0xf4, 0xbd, 0x57, 0xf4, 0x83, 0x3e, 0x6c, 0xd0, 0x75, 0xb1,
+	0xdf, 0xc8, 0xf3, 0xff, 0xc8, 0x2f, 0xfd, 0x37, 0x00, 0xf4, 0xea, 0x43, 0xaa, 0x34, 0x10, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the mintburn params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MirrorSupply queries the supply of the native denom minted for provider transfers and not burned yet
+	MirrorSupply(ctx context.Context, in *QueryMirrorSupplyRequest, opts ...grpc.CallOption) (*QueryMirrorSupplyResponse, error)
+	// AllowedChannels queries the transfer channels bound to the CCV provider client
+	AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error)
+	// ProofConsumed queries whether a received packet was already used to mint
+	ProofConsumed(ctx context.Context, in *QueryProofConsumedRequest, opts ...grpc.CallOption) (*QueryProofConsumedResponse, error)
+	// PendingBurns queries the returned tokens waiting for a burn retry
+	PendingBurns(ctx context.Context, in *QueryPendingBurnsRequest, opts ...grpc.CallOption) (*QueryPendingBurnsResponse, error)
+	// Return queries a DEX→provider return by its source channel and sequence
+	Return(ctx context.Context, in *QueryReturnRequest, opts ...grpc.CallOption) (*QueryReturnResponse, error)
+	// Returns queries all the DEX→provider returns and the amount in flight
+	Returns(ctx context.Context, in *QueryReturnsRequest, opts ...grpc.CallOption) (*QueryReturnsResponse, error)
+	// SupplyDrift compares the bank supply of the native denom with its backing:
+	// the mirror supply plus the genesismint minted amount
+	SupplyDrift(ctx context.Context, in *QuerySupplyDriftRequest, opts ...grpc.CallOption) (*QuerySupplyDriftResponse, error)
+	// EscrowQueries queries the interchain queries registered to reconcile the mirror supply
+	EscrowQueries(ctx context.Context, in *QueryEscrowQueriesRequest, opts ...grpc.CallOption) (*QueryEscrowQueriesResponse, error)
+	// MintWindow queries the amounts minted on the privileged path counted against the mint caps
+	MintWindow(ctx context.Context, in *QueryMintWindowRequest, opts ...grpc.CallOption) (*QueryMintWindowResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MirrorSupply(ctx context.Context, in *QueryMirrorSupplyRequest, opts ...grpc.CallOption) (*QueryMirrorSupplyResponse, error) {
+	out := new(QueryMirrorSupplyResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/MirrorSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error) {
+	out := new(QueryAllowedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/AllowedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProofConsumed(ctx context.Context, in *QueryProofConsumedRequest, opts ...grpc.CallOption) (*QueryProofConsumedResponse, error) {
+	out := new(QueryProofConsumedResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/ProofConsumed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBurns(ctx context.Context, in *QueryPendingBurnsRequest, opts ...grpc.CallOption) (*QueryPendingBurnsResponse, error) {
+	out := new(QueryPendingBurnsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/PendingBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Return(ctx context.Context, in *QueryReturnRequest, opts ...grpc.CallOption) (*QueryReturnResponse, error) {
+	out := new(QueryReturnResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/Return", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Returns(ctx context.Context, in *QueryReturnsRequest, opts ...grpc.CallOption) (*QueryReturnsResponse, error) {
+	out := new(QueryReturnsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/Returns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyDrift(ctx context.Context, in *QuerySupplyDriftRequest, opts ...grpc.CallOption) (*QuerySupplyDriftResponse, error) {
+	out := new(QuerySupplyDriftResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/SupplyDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowQueries(ctx context.Context, in *QueryEscrowQueriesRequest, opts ...grpc.CallOption) (*QueryEscrowQueriesResponse, error) {
+	out := new(QueryEscrowQueriesResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/EscrowQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintWindow(ctx context.Context, in *QueryMintWindowRequest, opts ...grpc.CallOption) (*QueryMintWindowResponse, error) {
+	out := new(QueryMintWindowResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/MintWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the mintburn params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MirrorSupply queries the supply of the native denom minted for provider transfers and not burned yet
+	MirrorSupply(context.Context, *QueryMirrorSupplyRequest) (*QueryMirrorSupplyResponse, error)
+	// AllowedChannels queries the transfer channels bound to the CCV provider client
+	AllowedChannels(context.Context, *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error)
+	// ProofConsumed queries whether a received packet was already used to mint
+	ProofConsumed(context.Context, *QueryProofConsumedRequest) (*QueryProofConsumedResponse, error)
+	// PendingBurns queries the returned tokens waiting for a burn retry
+	PendingBurns(context.Context, *QueryPendingBurnsRequest) (*QueryPendingBurnsResponse, error)
+	// Return queries a DEX→provider return by its source channel and sequence
+	Return(context.Context, *QueryReturnRequest) (*QueryReturnResponse, error)
+	// Returns queries all the DEX→provider returns and the amount in flight
+	Returns(context.Context, *QueryReturnsRequest) (*QueryReturnsResponse, error)
+	// SupplyDrift compares the bank supply of the native denom with its backing:
+	// the mirror supply plus the genesismint minted amount
+	SupplyDrift(context.Context, *QuerySupplyDriftRequest) (*QuerySupplyDriftResponse, error)
+	// EscrowQueries queries the interchain queries registered to reconcile the mirror supply
+	EscrowQueries(context.Context, *QueryEscrowQueriesRequest) (*QueryEscrowQueriesResponse, error)
+	// MintWindow queries the amounts minted on the privileged path counted against the mint caps
+	MintWindow(context.Context, *QueryMintWindowRequest) (*QueryMintWindowResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MirrorSupply(ctx context.Context, req *QueryMirrorSupplyRequest) (*QueryMirrorSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MirrorSupply not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannels(ctx context.Context, req *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannels not implemented")
+}
+func (*UnimplementedQueryServer) ProofConsumed(ctx context.Context, req *QueryProofConsumedRequest) (*QueryProofConsumedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofConsumed not implemented")
+}
+func (*UnimplementedQueryServer) PendingBurns(ctx context.Context, req *QueryPendingBurnsRequest) (*QueryPendingBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBurns not implemented")
+}
+func (*UnimplementedQueryServer) Return(ctx context.Context, req *QueryReturnRequest) (*QueryReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Return not implemented")
+}
+func (*UnimplementedQueryServer) Returns(ctx context.Context, req *QueryReturnsRequest) (*QueryReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Returns not implemented")
+}
+func (*UnimplementedQueryServer) SupplyDrift(ctx context.Context, req *QuerySupplyDriftRequest) (*QuerySupplyDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDrift not implemented")
+}
+func (*UnimplementedQueryServer) EscrowQueries(ctx context.Context, req *QueryEscrowQueriesRequest) (*QueryEscrowQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowQueries not implemented")
+}
+func (*UnimplementedQueryServer) MintWindow(ctx context.Context, req *QueryMintWindowRequest) (*QueryMintWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintWindow not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MirrorSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMirrorSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MirrorSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/MirrorSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MirrorSupply(ctx, req.(*QueryMirrorSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/AllowedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannels(ctx, req.(*QueryAllowedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProofConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofConsumedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProofConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/ProofConsumed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProofConsumed(ctx, req.(*QueryProofConsumedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/PendingBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBurns(ctx, req.(*QueryPendingBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Return_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Return(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/Return",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Return(ctx, req.(*QueryReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Returns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Returns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/Returns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Returns(ctx, req.(*QueryReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/SupplyDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyDrift(ctx, req.(*QuerySupplyDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/EscrowQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowQueries(ctx, req.(*QueryEscrowQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/MintWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintWindow(ctx, req.(*QueryMintWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.mintburn.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MirrorSupply",
+			Handler:    _Query_MirrorSupply_Handler,
+		},
+		{
+			MethodName: "AllowedChannels",
+			Handler:    _Query_AllowedChannels_Handler,
+		},
+		{
+			MethodName: "ProofConsumed",
+			Handler:    _Query_ProofConsumed_Handler,
+		},
+		{
+			MethodName: "PendingBurns",
+			Handler:    _Query_PendingBurns_Handler,
+		},
+		{
+			MethodName: "Return",
+			Handler:    _Query_Return_Handler,
+		},
+		{
+			MethodName: "Returns",
+			Handler:    _Query_Returns_Handler,
+		},
+		{
+			MethodName: "SupplyDrift",
+			Handler:    _Query_SupplyDrift_Handler,
+		},
+		{
+			MethodName: "EscrowQueries",
+			Handler:    _Query_EscrowQueries_Handler,
+		},
+		{
+			MethodName: "MintWindow",
+			Handler:    _Query_MintWindow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/mintburn/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMirrorSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMirrorSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMirrorSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMirrorSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMirrorSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMirrorSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MirrorSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofConsumedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofConsumedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofConsumedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofConsumedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofConsumedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofConsumedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumed {
+		i--
+		if m.Consumed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingBurns) > 0 {
+		for iNdEx := len(m.PendingBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReturnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReturnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReturnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReturnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReturnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReturnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Return.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReturnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReturnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReturnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReturnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReturnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReturnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.InFlight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Returns) > 0 {
+		for iNdEx := len(m.Returns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Returns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDriftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDriftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDriftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDriftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDriftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDriftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReconciliation != nil {
+		{
+			size, err := m.LastReconciliation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Drift.Size()
+		i -= size
+		if _, err := m.Drift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.GenesisMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MirrorSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BankSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowQueries) > 0 {
+		for iNdEx := len(m.EscrowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.RecipientMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.WindowMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMirrorSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMirrorSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MirrorSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProofConsumedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryProofConsumedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consumed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPendingBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingBurns) > 0 {
+		for _, e := range m.PendingBurns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReturnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryReturnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Return.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReturnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReturnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Returns) > 0 {
+		for _, e := range m.Returns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.InFlight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyDriftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyDriftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BankSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MirrorSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GenesisMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Drift.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastReconciliation != nil {
+		l = m.LastReconciliation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEscrowQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EscrowQueries) > 0 {
+		for _, e := range m.EscrowQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WindowMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecipientMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EpochBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.EpochBlocks))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMirrorSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMirrorSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMirrorSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMirrorSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMirrorSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMirrorSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MirrorSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProofConsumedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProofConsumedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProofConsumedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProofConsumedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProofConsumedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProofConsumedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consumed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBurnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBurnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBurnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBurnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBurns = append(m.PendingBurns, PendingBurn{})
+			if err := m.PendingBurns[len(m.PendingBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReturnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReturnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReturnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReturnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReturnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReturnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReturnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReturnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReturnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReturnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReturnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReturnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returns = append(m.Returns, ProviderReturn{})
+			if err := m.Returns[len(m.Returns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InFlight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyDriftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyDriftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyDriftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyDriftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyDriftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyDriftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MirrorSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReconciliation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReconciliation == nil {
+				m.LastReconciliation = &Reconciliation{}
+			}
+			if err := m.LastReconciliation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowQueries = append(m.EscrowQueries, EscrowQuery{})
+			if err := m.EscrowQueries[len(m.EscrowQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
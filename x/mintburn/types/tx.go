@@ -0,0 +1,233 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgUnpause{}
+	_ sdk.Msg = &MsgRegisterEscrowQuery{}
+	_ sdk.Msg = &MsgReconcileMirrorSupply{}
+	_ sdk.Msg = &MsgReturnToProvider{}
+	_ sdk.Msg = &MsgRetryPendingBurn{}
+)
+
+func (msg *MsgUpdateParams) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg *MsgPause) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgPause) Type() string {
+	return "pause"
+}
+
+func (msg *MsgPause) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgPause) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgPause) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return nil
+}
+
+func (msg *MsgUnpause) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgUnpause) Type() string {
+	return "unpause"
+}
+
+func (msg *MsgUnpause) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUnpause) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUnpause) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return nil
+}
+
+func (msg *MsgRegisterEscrowQuery) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRegisterEscrowQuery) Type() string {
+	return "register-escrow-query"
+}
+
+func (msg *MsgRegisterEscrowQuery) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterEscrowQuery) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRegisterEscrowQuery) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if strings.TrimSpace(msg.ChannelId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel id cannot be empty")
+	}
+	if strings.TrimSpace(msg.BaseDenom) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "base denom cannot be empty")
+	}
+	if msg.UpdatePeriod == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "update period can not be equal to zero")
+	}
+	return nil
+}
+
+func (msg *MsgReconcileMirrorSupply) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgReconcileMirrorSupply) Type() string {
+	return "reconcile-mirror-supply"
+}
+
+func (msg *MsgReconcileMirrorSupply) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgReconcileMirrorSupply) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgReconcileMirrorSupply) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if len(msg.QueryIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "query ids cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgReturnToProvider) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgReturnToProvider) Type() string {
+	return "return-to-provider"
+}
+
+func (msg *MsgReturnToProvider) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgReturnToProvider) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgReturnToProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	if strings.TrimSpace(msg.ChannelId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel id cannot be empty")
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", msg.Amount)
+	}
+	return nil
+}
+
+func (msg *MsgRetryPendingBurn) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRetryPendingBurn) Type() string {
+	return "retry-pending-burn"
+}
+
+func (msg *MsgRetryPendingBurn) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRetryPendingBurn) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRetryPendingBurn) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if strings.TrimSpace(msg.ChannelId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package poolmanager
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/osmoutils"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// GetAccountVolumeConfig returns the configuration of account volume tracking.
+func (k Keeper) GetAccountVolumeConfig(ctx sdk.Context) types.AccountVolumeConfig {
+	store := ctx.KVStore(k.storeKey)
+	config := types.AccountVolumeConfig{}
+	found, err := osmoutils.Get(store, types.AccountVolumeConfigKey, &config)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.DefaultAccountVolumeConfig()
+	}
+	return config
+}
+
+// SetAccountVolumeConfig sets the configuration of account volume tracking. The tracked volumes
+// are cleared if the quote denom changes, since they are denominated in the previous one.
+func (k Keeper) SetAccountVolumeConfig(ctx sdk.Context, config types.AccountVolumeConfig) {
+	if k.GetAccountVolumeConfig(ctx).QuoteDenom != config.QuoteDenom {
+		k.clearAccountVolumes(ctx)
+	}
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.AccountVolumeConfigKey, &config)
+}
+
+// trackAccountVolume adds a swap hop of tokenIn for tokenOut by sender to its volume in the current
+// window. The hop is valued with tokenIn if it can be priced in the quote denom, else with tokenOut.
+// Hops that cannot be priced are not tracked: volume tracking never fails a swap. The router only
+// tracks the hops that paid a taker fee, so that swaps in pools without one cannot buy a discount.
+func (k Keeper) trackAccountVolume(ctx sdk.Context, sender sdk.AccAddress, tokenIn, tokenOut sdk.Coin) {
+	config := k.GetAccountVolumeConfig(ctx)
+	if !config.Enabled() {
+		return
+	}
+
+	volume, ok := k.valueInQuoteDenom(ctx, config, tokenIn)
+	if !ok {
+		if volume, ok = k.valueInQuoteDenom(ctx, config, tokenOut); !ok {
+			return
+		}
+	}
+	if !volume.IsPositive() {
+		return
+	}
+
+	windowStart := config.WindowStart(ctx.BlockTime())
+	k.setAccountVolume(ctx, sender, windowStart, k.GetAccountVolume(ctx, sender, windowStart).Add(volume))
+	k.pruneAccountVolumes(ctx, sender, windowStart.Add(-time.Duration(config.RetainedWindows-1)*config.WindowDuration))
+}
+
+// valueInQuoteDenom returns the value of coin in the quote denom, priced with the TWAP of its price
+// pool. The volume drives the taker fee discounts, so it is never priced at the spot price, which
+// a swap can move: without a twap keeper or a TWAP for the pool, only the quote denom is valued.
+func (k Keeper) valueInQuoteDenom(ctx sdk.Context, config types.AccountVolumeConfig, coin sdk.Coin) (osmomath.Int, bool) {
+	if coin.Denom == config.QuoteDenom {
+		return coin.Amount, true
+	}
+
+	poolId, ok := config.PricePool(coin.Denom)
+	if !ok || k.twapKeeper == nil {
+		return osmomath.Int{}, false
+	}
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, coin.Denom, config.QuoteDenom, ctx.BlockTime().Add(-config.TwapDuration))
+	if err != nil {
+		return osmomath.Int{}, false
+	}
+
+	// volume is rounded down so that it is never overcounted
+	return osmomath.BigDecFromSDKInt(coin.Amount).Mul(osmomath.BigDecFromDec(twap)).Dec().TruncateInt(), true
+}
+
+// GetAccountVolume returns the volume of the account during the window starting at windowStart.
+func (k Keeper) GetAccountVolume(ctx sdk.Context, addr sdk.AccAddress, windowStart time.Time) osmomath.Int {
+	store := ctx.KVStore(k.storeKey)
+	volume := sdk.IntProto{}
+	found, err := osmoutils.Get(store, types.FormatAccountVolumeKey(addr, windowStart), &volume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return osmomath.ZeroInt()
+	}
+	return volume.Int
+}
+
+// GetPreviousWindowAccountVolume returns the volume of the account during the last complete window.
+func (k Keeper) GetPreviousWindowAccountVolume(ctx sdk.Context, addr sdk.AccAddress) osmomath.Int {
+	config := k.GetAccountVolumeConfig(ctx)
+	if !config.Enabled() {
+		return osmomath.ZeroInt()
+	}
+	return k.GetAccountVolume(ctx, addr, config.WindowStart(ctx.BlockTime()).Add(-config.WindowDuration))
+}
+
+// GetAccountVolumes returns the volume of the account during each retained window it swapped in,
+// the most recent first.
+func (k Keeper) GetAccountVolumes(ctx sdk.Context, addr sdk.AccAddress) ([]types.AccountVolume, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.FormatAccountVolumePrefix(addr))
+	defer iterator.Close()
+	return gatherAccountVolumes(iterator)
+}
+
+// GetAllAccountVolumes returns the volume of every account during each retained window.
+func (k Keeper) GetAllAccountVolumes(ctx sdk.Context) ([]types.AccountVolume, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AccountVolumePrefix)
+	defer iterator.Close()
+	return gatherAccountVolumes(iterator)
+}
+
+// SetAccountVolume sets the volume of an account during a window.
+func (k Keeper) SetAccountVolume(ctx sdk.Context, accountVolume types.AccountVolume) error {
+	addr, err := sdk.AccAddressFromBech32(accountVolume.Address)
+	if err != nil {
+		return err
+	}
+	k.setAccountVolume(ctx, addr, accountVolume.WindowStart, accountVolume.Volume)
+	return nil
+}
+
+func (k Keeper) setAccountVolume(ctx sdk.Context, addr sdk.AccAddress, windowStart time.Time, volume osmomath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatAccountVolumeKey(addr, windowStart), &sdk.IntProto{Int: volume})
+}
+
+// pruneAccountVolumes deletes the volume windows of the account starting before oldestWindowStart.
+func (k Keeper) pruneAccountVolumes(ctx sdk.Context, addr sdk.AccAddress, oldestWindowStart time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.FormatAccountVolumePrefix(addr)
+	iterator := store.Iterator(prefix, types.FormatAccountVolumeKey(addr, oldestWindowStart))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// clearAccountVolumes deletes the volume of every account.
+func (k Keeper) clearAccountVolumes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AccountVolumePrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func gatherAccountVolumes(iterator storetypes.Iterator) ([]types.AccountVolume, error) {
+	var accountVolumes []types.AccountVolume
+	for ; iterator.Valid(); iterator.Next() {
+		addr, windowStart, err := types.ParseAccountVolumeKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		volume := sdk.IntProto{}
+		if err := volume.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		accountVolumes = append(accountVolumes, types.AccountVolume{
+			Address:     addr.String(),
+			WindowStart: windowStart,
+			Volume:      volume.Int,
+		})
+	}
+	return accountVolumes, nil
+}
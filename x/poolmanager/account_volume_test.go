@@ -0,0 +1,170 @@
+package poolmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/client"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+func TestAccountVolume(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	msgServer := poolmanager.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only the swaps that pay a taker fee are tracked
+	params := k.GetParams(ctx)
+	params.TakerFeeParams.DefaultTakerFee = math.LegacyNewDecWithPrec(1, 3)
+	k.SetParams(ctx, params)
+
+	atomNtrn := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 1_000_000))
+	usdcAtom := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+	fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("untrn", 1_000_000), sdk.NewInt64Coin("uusdc", 1_000_000)))
+
+	swapNtrn := func(ctx sdk.Context, amount int64) {
+		_, err := k.RouteExactAmountIn(ctx, sender, []types.SwapAmountInRoute{{PoolId: atomNtrn, TokenOutDenom: "uatom"}},
+			sdk.NewInt64Coin("untrn", amount), math.OneInt(), types.SwapProtection{})
+		require.NoError(t, err)
+	}
+
+	// volume is not tracked until a quote denom is set
+	swapNtrn(ctx, 1_000)
+	volumes, err := k.GetAccountVolumes(ctx, sender)
+	require.NoError(t, err)
+	require.Empty(t, volumes)
+
+	config := types.AccountVolumeConfig{
+		QuoteDenom:      "untrn",
+		WindowDuration:  time.Hour,
+		RetainedWindows: 2,
+		TwapDuration:    time.Hour,
+		PricePools:      []types.DenomPricePool{{Denom: "uatom", PoolId: atomNtrn}},
+	}
+	_, err = msgServer.SetAccountVolumeConfig(ctx, &types.MsgSetAccountVolumeConfig{Sender: sender.String(), Config: config})
+	require.ErrorIs(t, err, types.ErrUnauthorizedGov)
+	_, err = msgServer.SetAccountVolumeConfig(ctx, &types.MsgSetAccountVolumeConfig{Sender: govAddr, Config: config})
+	require.NoError(t, err)
+
+	windowStart := config.WindowStart(ctx.BlockTime())
+
+	// the quote denom is counted as is
+	swapNtrn(ctx, 10_000)
+	require.Equal(t, math.NewInt(10_000), k.GetAccountVolume(ctx, sender, windowStart))
+
+	// a swap in a pool without taker fee is not counted, so that it cannot be wash traded into a tier
+	k.SetPoolTakerFee(ctx, atomNtrn, math.LegacyZeroDec())
+	swapNtrn(ctx, 50_000)
+	require.Equal(t, math.NewInt(10_000), k.GetAccountVolume(ctx, sender, windowStart))
+	k.DeletePoolTakerFee(ctx, atomNtrn)
+
+	// a swap out of an unpriced denom is valued with its token out
+	_, err = k.RouteExactAmountOut(ctx, sender, []types.SwapAmountOutRoute{
+		{PoolId: usdcAtom, TokenInDenom: "uusdc"},
+		{PoolId: atomNtrn, TokenInDenom: "uatom"},
+	}, math.NewInt(100_000), sdk.NewInt64Coin("untrn", 5_000), types.SwapProtection{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(15_000), k.GetAccountVolume(ctx, sender, windowStart))
+
+	// a discount tier by volume applies from the next window on
+	_, err = msgServer.SetTakerFeeDiscountTiers(ctx, &types.MsgSetTakerFeeDiscountTiers{Sender: govAddr, Tiers: []types.TakerFeeDiscountTier{
+		{Name: "volume", Discount: math.LegacyNewDecWithPrec(2, 1), MinVolume: &[]math.Int{math.NewInt(15_000)}[0]},
+	}})
+	require.NoError(t, err)
+	discount, _, err := k.GetTakerFeeDiscount(ctx, sender)
+	require.NoError(t, err)
+	require.True(t, discount.IsZero())
+
+	nextCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	discount, tier, err := k.GetTakerFeeDiscount(nextCtx, sender)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 1), discount)
+	require.Equal(t, "volume", tier)
+
+	// windows beyond the retained ones are pruned
+	swapNtrn(nextCtx, 2_000)
+	swapNtrn(nextCtx.WithBlockTime(nextCtx.BlockTime().Add(time.Hour)), 3_000)
+
+	querier := client.Querier{K: k}
+	res, err := querier.AccountVolume(ctx, queryproto.AccountVolumeRequest{Address: sender.String()})
+	require.NoError(t, err)
+	require.Equal(t, "untrn", res.QuoteDenom)
+	require.Equal(t, []types.AccountVolume{
+		{Address: sender.String(), WindowStart: windowStart.Add(2 * time.Hour), Volume: math.NewInt(3_000)},
+		{Address: sender.String(), WindowStart: windowStart.Add(time.Hour), Volume: math.NewInt(2_000)},
+	}, res.Windows)
+	require.Equal(t, math.NewInt(5_000), res.TotalVolume)
+
+	// volumes are cleared when the quote denom changes
+	config.QuoteDenom = "uatom"
+	config.PricePools = nil
+	_, err = msgServer.SetAccountVolumeConfig(ctx, &types.MsgSetAccountVolumeConfig{Sender: govAddr, Config: config})
+	require.NoError(t, err)
+	volumes, err = k.GetAllAccountVolumes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, volumes)
+}
+
+func TestAccountVolumeTwapPricing(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	msgServer := poolmanager.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only the swaps that pay a taker fee are tracked
+	params := k.GetParams(ctx)
+	params.TakerFeeParams.DefaultTakerFee = math.LegacyNewDecWithPrec(1, 3)
+	k.SetParams(ctx, params)
+
+	atomNtrn := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 2_000_000))
+	usdcAtom := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+	fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("uusdc", 100_000)))
+
+	config := types.AccountVolumeConfig{
+		QuoteDenom:      "untrn",
+		WindowDuration:  time.Hour,
+		RetainedWindows: 2,
+		PricePools:      []types.DenomPricePool{{Denom: "uatom", PoolId: atomNtrn}},
+	}
+	// volume is always priced with a TWAP
+	require.Error(t, types.MsgSetAccountVolumeConfig{Sender: govAddr, Config: config}.ValidateBasic())
+	config.TwapDuration = time.Hour
+	_, err := msgServer.SetAccountVolumeConfig(ctx, &types.MsgSetAccountVolumeConfig{Sender: govAddr, Config: config})
+	require.NoError(t, err)
+
+	swapUsdc := func(ctx sdk.Context) math.Int {
+		out, err := k.RouteExactAmountIn(ctx, sender, []types.SwapAmountInRoute{{PoolId: usdcAtom, TokenOutDenom: "uatom"}},
+			sdk.NewInt64Coin("uusdc", 10_000), math.OneInt(), types.SwapProtection{})
+		require.NoError(t, err)
+		return out
+	}
+
+	// the price pool has no TWAP over the last hour yet, the uatom out is not valued
+	swapUsdc(ctx)
+	volumes, err := k.GetAccountVolumes(ctx, sender)
+	require.NoError(t, err)
+	require.Empty(t, volumes)
+
+	// selling uatom moves the spot price of the price pool, the uatom out is still valued at the TWAP
+	_, err = k.RouteExactAmountIn(ctx, sender, []types.SwapAmountInRoute{{PoolId: atomNtrn, TokenOutDenom: "untrn"}},
+		sdk.NewInt64Coin("uatom", 100_000), math.OneInt(), types.SwapProtection{})
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	out := swapUsdc(later)
+	require.Equal(t, out.MulRaw(2), k.GetAccountVolume(later, sender, config.WindowStart(later.BlockTime())))
+
+	// without a twap keeper only the quote denom is valued
+	k.SetTwapKeeper(nil)
+	defer k.SetTwapKeeper(neutronApp.TwapKeeper)
+	swapUsdc(later)
+	require.Equal(t, out.MulRaw(2), k.GetAccountVolume(later, sender, config.WindowStart(later.BlockTime())))
+}
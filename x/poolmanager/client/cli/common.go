@@ -2,11 +2,15 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/osmoutils/osmocli"
 	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
 )
 
@@ -71,3 +75,47 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	}
 	return routes, nil
 }
+
+func swapDeadline(fs *flag.FlagSet) (*time.Time, error) {
+	deadlineStr, err := fs.GetString(FlagDeadline)
+	if err != nil || deadlineStr == "" {
+		return nil, err
+	}
+	if duration, err := time.ParseDuration(deadlineStr); err == nil {
+		deadline := time.Now().Add(duration)
+		return &deadline, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, deadlineStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s, expected an RFC3339 time or a duration: %w", FlagDeadline, err)
+	}
+	return &deadline, nil
+}
+
+func swapMaxPriceImpact(fs *flag.FlagSet) (*osmomath.Dec, error) {
+	return optionalDecFlag(fs, FlagMaxPriceImpact)
+}
+
+func swapMaxSpotPriceDrift(fs *flag.FlagSet) (*osmomath.Dec, error) {
+	return optionalDecFlag(fs, FlagMaxSpotPriceDrift)
+}
+
+func optionalDecFlag(fs *flag.FlagSet, flagName string) (*osmomath.Dec, error) {
+	decStr, err := fs.GetString(flagName)
+	if err != nil || decStr == "" {
+		return nil, err
+	}
+	dec, err := osmomath.NewDecFromStr(decStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flagName, err)
+	}
+	return &dec, nil
+}
+
+// swapProtectionParsers parses the optional swap protection fields of the swap messages from flags.
+func swapProtectionParsers(parsers map[string]osmocli.CustomFieldParserFn) map[string]osmocli.CustomFieldParserFn {
+	parsers["Deadline"] = osmocli.FlagOnlyParser(swapDeadline)
+	parsers["MaxPriceImpact"] = osmocli.FlagOnlyParser(swapMaxPriceImpact)
+	parsers["MaxSpotPriceDrift"] = osmocli.FlagOnlyParser(swapMaxSpotPriceDrift)
+	return parsers
+}
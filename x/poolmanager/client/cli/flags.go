@@ -27,6 +27,14 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to *time.Time.
+	FlagDeadline = "deadline"
+	// Will be parsed to *osmomath.Dec.
+	FlagMaxPriceImpact = "max-price-impact"
+	// Will be parsed to *osmomath.Dec.
+	FlagMaxSpotPriceDrift = "max-spot-price-drift"
+	// Will be parsed to string.
+	FlagSender = "sender"
 )
 
 type createBalancerPoolInputs struct {
@@ -77,6 +85,12 @@ func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetExplainTakerFee() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSender, "", "address of the swap sender, to account for its taker fee reductions")
+	return fs
+}
+
 func FlagSetQuerySwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -106,3 +120,12 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetSwapProtection() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDeadline, "", "Block time after which the swap is rejected, as an RFC3339 time or a duration from now (e.g. 10m)")
+	fs.String(FlagMaxPriceImpact, "", "Maximum price impact of every hop against the spot price of its pool before the swap (e.g. 0.05)")
+	fs.String(FlagMaxSpotPriceDrift, "", "Maximum relative change of the spot price of every hop's pool caused by the swap (e.g. 0.05)")
+	return fs
+}
@@ -33,6 +33,11 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdOptimalRoute)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdExplainTakerFee)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeDiscountTiers)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAccountVolume)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAccountVolumeConfig)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllTakerFeeShareAgreements)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTakerFeeShareAgreementFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTakerFeeShareDenomsToAccruedValue)
@@ -220,6 +225,80 @@ func GetCmdEstimateTradeBasedOnPriceImpact() (
 	}, &queryproto.EstimateTradeBasedOnPriceImpactRequest{}
 }
 
+// GetCmdOptimalRoute returns the best split route for swapping the token in to the token out denom.
+func GetCmdOptimalRoute() (*osmocli.QueryDescriptor, *queryproto.OptimalRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "optimal-route",
+		Short: "Query optimal-route",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} optimal-route 1000uatom untrn 3 2`,
+		QueryFnName: "OptimalRoute",
+	}, &queryproto.OptimalRouteRequest{}
+}
+
+// GetCmdExplainTakerFee returns the taker fee of each hop of a route and where it comes from.
+func GetCmdExplainTakerFee() (*osmocli.QueryDescriptor, *queryproto.ExplainTakerFeeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "explain-taker-fee",
+		Short: "Query explain-taker-fee",
+		Long: `Query the taker fee of each hop of a route and where it comes from.{{.ExampleHeader}}
+{{.CommandPrefix}} explain-taker-fee uatom --swap-route-pool-ids=2,3 --swap-route-denoms=uusdc,untrn`,
+		ParseQuery: ExplainTakerFeeParseArgs,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetExplainTakerFee()},
+		},
+		QueryFnName:         "ExplainTakerFee",
+		CustomFlagOverrides: customRouterFlagOverride,
+	}, &queryproto.ExplainTakerFeeRequest{}
+}
+
+func ExplainTakerFeeParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := fs.GetString(FlagSender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.ExplainTakerFeeRequest{
+		TokenInDenom: args[0],
+		Routes:       routes,
+		Sender:       sender,
+	}, nil
+}
+
+// GetCmdTakerFeeDiscountTiers returns all the taker fee discount tiers.
+func GetCmdTakerFeeDiscountTiers() (*osmocli.QueryDescriptor, *queryproto.TakerFeeDiscountTiersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-discount-tiers",
+		Short: "Query all taker fee discount tiers",
+		Long:  "{{.Short}}",
+	}, &queryproto.TakerFeeDiscountTiersRequest{}
+}
+
+// GetCmdAccountVolume returns the volume an account swapped during the retained windows.
+func GetCmdAccountVolume() (*osmocli.QueryDescriptor, *queryproto.AccountVolumeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "account-volume",
+		Short: "Query the volume an account swapped during the retained windows",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} account-volume maanydex1...`,
+	}, &queryproto.AccountVolumeRequest{}
+}
+
+// GetCmdAccountVolumeConfig returns the configuration of account volume tracking.
+func GetCmdAccountVolumeConfig() (*osmocli.QueryDescriptor, *queryproto.AccountVolumeConfigRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "account-volume-config",
+		Short: "Query the configuration of account volume tracking",
+		Long:  "{{.Short}}",
+	}, &queryproto.AccountVolumeConfigRequest{}
+}
+
 func GetAllTakerFeeShareAgreements() (*osmocli.QueryDescriptor, *queryproto.AllTakerFeeShareAgreementsRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "all-taker-fee-share-agreements",
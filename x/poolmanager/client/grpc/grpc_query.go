@@ -131,6 +131,56 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) OptimalRoute(grpcCtx context.Context,
+	req *queryproto.OptimalRouteRequest,
+) (*queryproto.OptimalRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OptimalRoute(ctx, *req)
+}
+
+func (q Querier) ExplainTakerFee(grpcCtx context.Context,
+	req *queryproto.ExplainTakerFeeRequest,
+) (*queryproto.ExplainTakerFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ExplainTakerFee(ctx, *req)
+}
+
+func (q Querier) AccountVolume(grpcCtx context.Context,
+	req *queryproto.AccountVolumeRequest,
+) (*queryproto.AccountVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AccountVolume(ctx, *req)
+}
+
+func (q Querier) AccountVolumeConfig(grpcCtx context.Context,
+	req *queryproto.AccountVolumeConfigRequest,
+) (*queryproto.AccountVolumeConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AccountVolumeConfig(ctx, *req)
+}
+
+func (q Querier) TakerFeeDiscountTiers(grpcCtx context.Context,
+	req *queryproto.TakerFeeDiscountTiersRequest,
+) (*queryproto.TakerFeeDiscountTiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeDiscountTiers(ctx, *req)
+}
+
 func (q Querier) NumPools(grpcCtx context.Context,
 	req *queryproto.NumPoolsRequest,
 ) (*queryproto.NumPoolsResponse, error) {
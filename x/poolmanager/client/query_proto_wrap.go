@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -405,6 +407,89 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 	}
 }
 
+// OptimalRoute returns the split route giving the most token out for the token in, along with the
+// expected output and the price impact of the swap.
+func (q Querier) OptimalRoute(ctx sdk.Context, req queryproto.OptimalRouteRequest) (*queryproto.OptimalRouteResponse, error) {
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	routes, tokenOutAmount, priceImpact, err := q.K.OptimalRoute(ctx, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxSplits)
+	if errors.Is(err, types.ErrNoRouteFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.OptimalRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+		PriceImpact:    priceImpact,
+	}, nil
+}
+
+// ExplainTakerFee returns the taker fee of each hop of the route and where it comes from, along
+// with the total taker fee of the route.
+func (q Querier) ExplainTakerFee(ctx sdk.Context, req queryproto.ExplainTakerFeeRequest) (*queryproto.ExplainTakerFeeResponse, error) {
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid sender address")
+		}
+	}
+
+	hops, totalTakerFee, err := q.K.ExplainTakerFee(ctx, req.TokenInDenom, req.Routes, req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.ExplainTakerFeeResponse{
+		Hops:          hops,
+		TotalTakerFee: totalTakerFee,
+	}, nil
+}
+
+// AccountVolume returns the volume the account swapped during the retained windows.
+func (q Querier) AccountVolume(ctx sdk.Context, req queryproto.AccountVolumeRequest) (*queryproto.AccountVolumeResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	windows, err := q.K.GetAccountVolumes(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalVolume := osmomath.ZeroInt()
+	for _, window := range windows {
+		totalVolume = totalVolume.Add(window.Volume)
+	}
+
+	return &queryproto.AccountVolumeResponse{
+		QuoteDenom:  q.K.GetAccountVolumeConfig(ctx).QuoteDenom,
+		Windows:     windows,
+		TotalVolume: totalVolume,
+	}, nil
+}
+
+// AccountVolumeConfig returns the configuration of account volume tracking.
+func (q Querier) AccountVolumeConfig(ctx sdk.Context, req queryproto.AccountVolumeConfigRequest) (*queryproto.AccountVolumeConfigResponse, error) {
+	return &queryproto.AccountVolumeConfigResponse{Config: q.K.GetAccountVolumeConfig(ctx)}, nil
+}
+
+// TakerFeeDiscountTiers returns all the taker fee discount tiers.
+func (q Querier) TakerFeeDiscountTiers(ctx sdk.Context, req queryproto.TakerFeeDiscountTiersRequest) (*queryproto.TakerFeeDiscountTiersResponse, error) {
+	tiers, err := q.K.GetAllTakerFeeDiscountTiers(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &queryproto.TakerFeeDiscountTiersResponse{Tiers: tiers}, nil
+}
+
 func (q Querier) AllTakerFeeShareAgreements(ctx sdk.Context, req queryproto.AllTakerFeeShareAgreementsRequest) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
 	takerFeeShareAgreements, err := q.K.GetAllTakerFeesShareAgreements(ctx)
 	if err != nil {
@@ -1410,41 +1410,26 @@ func (m *TradingPairTakerFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TradingPairTakerFeeResponse proto.InternalMessageInfo
 
-// EstimateTradeBasedOnPriceImpactRequest represents a request to estimate a
-// trade for Balancer/StableSwap/Concentrated liquidity pool types based on the
-// given parameters.
-type EstimateTradeBasedOnPriceImpactRequest struct {
-	// from_coin is the total amount of tokens that the user wants to sell.
-	FromCoin types2.Coin `protobuf:"bytes,1,opt,name=from_coin,json=fromCoin,proto3" json:"from_coin"`
-	// to_coin_denom is the denom identifier of the token that the user wants to
-	// buy.
-	ToCoinDenom string `protobuf:"bytes,2,opt,name=to_coin_denom,json=toCoinDenom,proto3" json:"to_coin_denom,omitempty"`
-	// pool_id is the identifier of the liquidity pool that the trade will occur
-	// on.
-	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// max_price_impact is the maximum percentage that the user is willing
-	// to affect the price of the liquidity pool.
-	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact"`
-	// external_price is an optional external price that the user can enter.
-	// It adjusts the MaxPriceImpact as the SpotPrice of a pool can be changed at
-	// any time.
-	ExternalPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=external_price,json=externalPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"external_price"`
-}
-
-func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
-	*m = EstimateTradeBasedOnPriceImpactRequest{}
-}
-func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
-func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
+// =============================== ExplainTakerFee
+type ExplainTakerFeeRequest struct {
+	TokenInDenom string                    `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	Routes       []types.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// sender is optional. If set, the fees account for the sender's reductions.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+}
+
+func (m *ExplainTakerFeeRequest) Reset()         { *m = ExplainTakerFeeRequest{} }
+func (m *ExplainTakerFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainTakerFeeRequest) ProtoMessage()    {}
+func (*ExplainTakerFeeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{28}
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExplainTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExplainTakerFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExplainTakerFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1454,66 +1439,58 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.Merge(m, src)
+func (m *ExplainTakerFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainTakerFeeRequest.Merge(m, src)
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Size() int {
+func (m *ExplainTakerFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.DiscardUnknown(m)
+func (m *ExplainTakerFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainTakerFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExplainTakerFeeRequest proto.InternalMessageInfo
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) GetFromCoin() types2.Coin {
+func (m *ExplainTakerFeeRequest) GetTokenInDenom() string {
 	if m != nil {
-		return m.FromCoin
+		return m.TokenInDenom
 	}
-	return types2.Coin{}
+	return ""
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) GetToCoinDenom() string {
+func (m *ExplainTakerFeeRequest) GetRoutes() []types.SwapAmountInRoute {
 	if m != nil {
-		return m.ToCoinDenom
+		return m.Routes
 	}
-	return ""
+	return nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) GetPoolId() uint64 {
+func (m *ExplainTakerFeeRequest) GetSender() string {
 	if m != nil {
-		return m.PoolId
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-// EstimateTradeBasedOnPriceImpactResponse represents the response data
-// for an estimated trade based on price impact. If a trade fails to be
-// estimated the response would be 0,0 for input_coin and output_coin and will
-// not error.
-type EstimateTradeBasedOnPriceImpactResponse struct {
-	// input_coin is the actual input amount that would be tradeable
-	// under the specified price impact.
-	InputCoin types2.Coin `protobuf:"bytes,1,opt,name=input_coin,json=inputCoin,proto3" json:"input_coin"`
-	// output_coin is the amount of tokens of the ToCoinDenom type
-	// that will be received for the actual InputCoin trade.
-	OutputCoin types2.Coin `protobuf:"bytes,2,opt,name=output_coin,json=outputCoin,proto3" json:"output_coin"`
+type ExplainTakerFeeResponse struct {
+	Hops []TakerFeeHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops" yaml:"hops"`
+	// total_taker_fee is the share of token in the route charges as taker fees,
+	// 1 - (1 - fee_1) * ... * (1 - fee_n).
+	TotalTakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=total_taker_fee,json=totalTakerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_taker_fee" yaml:"total_taker_fee"`
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
-	*m = EstimateTradeBasedOnPriceImpactResponse{}
-}
-func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
-func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
+func (m *ExplainTakerFeeResponse) Reset()         { *m = ExplainTakerFeeResponse{} }
+func (m *ExplainTakerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainTakerFeeResponse) ProtoMessage()    {}
+func (*ExplainTakerFeeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{29}
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExplainTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExplainTakerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExplainTakerFeeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1523,47 +1500,51 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.Merge(m, src)
+func (m *ExplainTakerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainTakerFeeResponse.Merge(m, src)
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Size() int {
+func (m *ExplainTakerFeeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.DiscardUnknown(m)
+func (m *ExplainTakerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainTakerFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse proto.InternalMessageInfo
-
-func (m *EstimateTradeBasedOnPriceImpactResponse) GetInputCoin() types2.Coin {
-	if m != nil {
-		return m.InputCoin
-	}
-	return types2.Coin{}
-}
+var xxx_messageInfo_ExplainTakerFeeResponse proto.InternalMessageInfo
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) GetOutputCoin() types2.Coin {
+func (m *ExplainTakerFeeResponse) GetHops() []TakerFeeHop {
 	if m != nil {
-		return m.OutputCoin
+		return m.Hops
 	}
-	return types2.Coin{}
-}
-
-type AllTakerFeeShareAgreementsRequest struct {
+	return nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Reset()         { *m = AllTakerFeeShareAgreementsRequest{} }
-func (m *AllTakerFeeShareAgreementsRequest) String() string { return proto.CompactTextString(m) }
-func (*AllTakerFeeShareAgreementsRequest) ProtoMessage()    {}
-func (*AllTakerFeeShareAgreementsRequest) Descriptor() ([]byte, []int) {
+// TakerFeeHop is the taker fee charged on a hop of a route.
+type TakerFeeHop struct {
+	PoolId        uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenInDenom  string                      `protobuf:"bytes,2,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string                      `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TakerFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+	Source        types.TakerFeeSource        `protobuf:"varint,5,opt,name=source,proto3,enum=osmosis.poolmanager.v1beta1.TakerFeeSource" json:"source,omitempty" yaml:"source"`
+	// discount_tier is the name of the discount tier of the sender, if any.
+	// taker_fee is net of its discount.
+	DiscountTier string `protobuf:"bytes,6,opt,name=discount_tier,json=discountTier,proto3" json:"discount_tier,omitempty" yaml:"discount_tier"`
+	// discount is the share of the taker fee waived for the sender.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *TakerFeeHop) Reset()         { *m = TakerFeeHop{} }
+func (m *TakerFeeHop) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeHop) ProtoMessage()    {}
+func (*TakerFeeHop) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{30}
 }
-func (m *AllTakerFeeShareAgreementsRequest) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeHop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllTakerFeeShareAgreementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllTakerFeeShareAgreementsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeHop.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1573,34 +1554,70 @@ func (m *AllTakerFeeShareAgreementsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *AllTakerFeeShareAgreementsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllTakerFeeShareAgreementsRequest.Merge(m, src)
+func (m *TakerFeeHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeHop.Merge(m, src)
 }
-func (m *AllTakerFeeShareAgreementsRequest) XXX_Size() int {
+func (m *TakerFeeHop) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllTakerFeeShareAgreementsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllTakerFeeShareAgreementsRequest.DiscardUnknown(m)
+func (m *TakerFeeHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeHop.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllTakerFeeShareAgreementsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeHop proto.InternalMessageInfo
 
-type AllTakerFeeShareAgreementsResponse struct {
-	TakerFeeShareAgreements []types.TakerFeeShareAgreement `protobuf:"bytes,1,rep,name=taker_fee_share_agreements,json=takerFeeShareAgreements,proto3" json:"taker_fee_share_agreements"`
+func (m *TakerFeeHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Reset()         { *m = AllTakerFeeShareAgreementsResponse{} }
-func (m *AllTakerFeeShareAgreementsResponse) String() string { return proto.CompactTextString(m) }
-func (*AllTakerFeeShareAgreementsResponse) ProtoMessage()    {}
-func (*AllTakerFeeShareAgreementsResponse) Descriptor() ([]byte, []int) {
+func (m *TakerFeeHop) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *TakerFeeHop) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *TakerFeeHop) GetSource() types.TakerFeeSource {
+	if m != nil {
+		return m.Source
+	}
+	return types.TAKER_FEE_SOURCE_DEFAULT
+}
+
+func (m *TakerFeeHop) GetDiscountTier() string {
+	if m != nil {
+		return m.DiscountTier
+	}
+	return ""
+}
+
+// =============================== AccountVolume
+type AccountVolumeRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *AccountVolumeRequest) Reset()         { *m = AccountVolumeRequest{} }
+func (m *AccountVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeRequest) ProtoMessage()    {}
+func (*AccountVolumeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{31}
 }
-func (m *AllTakerFeeShareAgreementsResponse) XXX_Unmarshal(b []byte) error {
+func (m *AccountVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllTakerFeeShareAgreementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllTakerFeeShareAgreementsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountVolumeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1610,43 +1627,47 @@ func (m *AllTakerFeeShareAgreementsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *AllTakerFeeShareAgreementsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllTakerFeeShareAgreementsResponse.Merge(m, src)
+func (m *AccountVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeRequest.Merge(m, src)
 }
-func (m *AllTakerFeeShareAgreementsResponse) XXX_Size() int {
+func (m *AccountVolumeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllTakerFeeShareAgreementsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllTakerFeeShareAgreementsResponse.DiscardUnknown(m)
+func (m *AccountVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllTakerFeeShareAgreementsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AccountVolumeRequest proto.InternalMessageInfo
 
-func (m *AllTakerFeeShareAgreementsResponse) GetTakerFeeShareAgreements() []types.TakerFeeShareAgreement {
+func (m *AccountVolumeRequest) GetAddress() string {
 	if m != nil {
-		return m.TakerFeeShareAgreements
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type TakerFeeShareAgreementFromDenomRequest struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+type AccountVolumeResponse struct {
+	// quote_denom is the denom the volumes are in.
+	QuoteDenom string `protobuf:"bytes,1,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// windows is the volume of the account during each retained window it
+	// swapped in, the most recent first.
+	Windows []types.AccountVolume `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows" yaml:"windows"`
+	// total_volume is the volume of the account during all the retained windows.
+	TotalVolume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_volume,json=totalVolume,proto3,customtype=cosmossdk.io/math.Int" json:"total_volume" yaml:"total_volume"`
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) Reset() {
-	*m = TakerFeeShareAgreementFromDenomRequest{}
-}
-func (m *TakerFeeShareAgreementFromDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*TakerFeeShareAgreementFromDenomRequest) ProtoMessage()    {}
-func (*TakerFeeShareAgreementFromDenomRequest) Descriptor() ([]byte, []int) {
+func (m *AccountVolumeResponse) Reset()         { *m = AccountVolumeResponse{} }
+func (m *AccountVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeResponse) ProtoMessage()    {}
+func (*AccountVolumeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{32}
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountVolumeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1656,43 +1677,48 @@ func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.Merge(m, src)
+func (m *AccountVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeResponse.Merge(m, src)
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Size() int {
+func (m *AccountVolumeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.DiscardUnknown(m)
+func (m *AccountVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountVolumeResponse proto.InternalMessageInfo
 
-func (m *TakerFeeShareAgreementFromDenomRequest) GetDenom() string {
+func (m *AccountVolumeResponse) GetQuoteDenom() string {
 	if m != nil {
-		return m.Denom
+		return m.QuoteDenom
 	}
 	return ""
 }
 
-type TakerFeeShareAgreementFromDenomResponse struct {
-	TakerFeeShareAgreement types.TakerFeeShareAgreement `protobuf:"bytes,1,opt,name=taker_fee_share_agreement,json=takerFeeShareAgreement,proto3" json:"taker_fee_share_agreement"`
+func (m *AccountVolumeResponse) GetWindows() []types.AccountVolume {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) Reset() {
-	*m = TakerFeeShareAgreementFromDenomResponse{}
+// =============================== AccountVolumeConfig
+type AccountVolumeConfigRequest struct {
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*TakerFeeShareAgreementFromDenomResponse) ProtoMessage()    {}
-func (*TakerFeeShareAgreementFromDenomResponse) Descriptor() ([]byte, []int) {
+
+func (m *AccountVolumeConfigRequest) Reset()         { *m = AccountVolumeConfigRequest{} }
+func (m *AccountVolumeConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeConfigRequest) ProtoMessage()    {}
+func (*AccountVolumeConfigRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{33}
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *AccountVolumeConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountVolumeConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountVolumeConfigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1702,44 +1728,34 @@ func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.Merge(m, src)
+func (m *AccountVolumeConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeConfigRequest.Merge(m, src)
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Size() int {
+func (m *AccountVolumeConfigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.DiscardUnknown(m)
+func (m *AccountVolumeConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse proto.InternalMessageInfo
-
-func (m *TakerFeeShareAgreementFromDenomResponse) GetTakerFeeShareAgreement() types.TakerFeeShareAgreement {
-	if m != nil {
-		return m.TakerFeeShareAgreement
-	}
-	return types.TakerFeeShareAgreement{}
-}
+var xxx_messageInfo_AccountVolumeConfigRequest proto.InternalMessageInfo
 
-type TakerFeeShareDenomsToAccruedValueRequest struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	TakerFeeDenom string `protobuf:"bytes,2,opt,name=takerFeeDenom,proto3" json:"takerFeeDenom,omitempty"`
+type AccountVolumeConfigResponse struct {
+	Config types.AccountVolumeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config" yaml:"config"`
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Reset() {
-	*m = TakerFeeShareDenomsToAccruedValueRequest{}
-}
-func (m *TakerFeeShareDenomsToAccruedValueRequest) String() string { return proto.CompactTextString(m) }
-func (*TakerFeeShareDenomsToAccruedValueRequest) ProtoMessage()    {}
-func (*TakerFeeShareDenomsToAccruedValueRequest) Descriptor() ([]byte, []int) {
+func (m *AccountVolumeConfigResponse) Reset()         { *m = AccountVolumeConfigResponse{} }
+func (m *AccountVolumeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeConfigResponse) ProtoMessage()    {}
+func (*AccountVolumeConfigResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{34}
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountVolumeConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountVolumeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountVolumeConfigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1749,52 +1765,41 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.Merge(m, src)
+func (m *AccountVolumeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeConfigResponse.Merge(m, src)
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Size() int {
+func (m *AccountVolumeConfigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.DiscardUnknown(m)
+func (m *AccountVolumeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeConfigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest proto.InternalMessageInfo
-
-func (m *TakerFeeShareDenomsToAccruedValueRequest) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
+var xxx_messageInfo_AccountVolumeConfigResponse proto.InternalMessageInfo
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) GetTakerFeeDenom() string {
+func (m *AccountVolumeConfigResponse) GetConfig() types.AccountVolumeConfig {
 	if m != nil {
-		return m.TakerFeeDenom
+		return m.Config
 	}
-	return ""
+	return types.AccountVolumeConfig{}
 }
 
-type TakerFeeShareDenomsToAccruedValueResponse struct {
-	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+// =============================== TakerFeeDiscountTiers
+type TakerFeeDiscountTiersRequest struct {
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Reset() {
-	*m = TakerFeeShareDenomsToAccruedValueResponse{}
-}
-func (m *TakerFeeShareDenomsToAccruedValueResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*TakerFeeShareDenomsToAccruedValueResponse) ProtoMessage() {}
-func (*TakerFeeShareDenomsToAccruedValueResponse) Descriptor() ([]byte, []int) {
+func (m *TakerFeeDiscountTiersRequest) Reset()         { *m = TakerFeeDiscountTiersRequest{} }
+func (m *TakerFeeDiscountTiersRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountTiersRequest) ProtoMessage()    {}
+func (*TakerFeeDiscountTiersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{35}
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeDiscountTiersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeDiscountTiersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeDiscountTiersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1804,33 +1809,34 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.Merge(m, src)
+func (m *TakerFeeDiscountTiersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountTiersRequest.Merge(m, src)
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Size() int {
+func (m *TakerFeeDiscountTiersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.DiscardUnknown(m)
+func (m *TakerFeeDiscountTiersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountTiersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeDiscountTiersRequest proto.InternalMessageInfo
 
-type AllTakerFeeShareAccumulatorsRequest struct {
+type TakerFeeDiscountTiersResponse struct {
+	Tiers []types.TakerFeeDiscountTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers" yaml:"tiers"`
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) Reset()         { *m = AllTakerFeeShareAccumulatorsRequest{} }
-func (m *AllTakerFeeShareAccumulatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*AllTakerFeeShareAccumulatorsRequest) ProtoMessage()    {}
-func (*AllTakerFeeShareAccumulatorsRequest) Descriptor() ([]byte, []int) {
+func (m *TakerFeeDiscountTiersResponse) Reset()         { *m = TakerFeeDiscountTiersResponse{} }
+func (m *TakerFeeDiscountTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountTiersResponse) ProtoMessage()    {}
+func (*TakerFeeDiscountTiersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{36}
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeDiscountTiersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeDiscountTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeDiscountTiersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1840,34 +1846,60 @@ func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.Merge(m, src)
+func (m *TakerFeeDiscountTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountTiersResponse.Merge(m, src)
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Size() int {
+func (m *TakerFeeDiscountTiersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllTakerFeeShareAccumulatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.DiscardUnknown(m)
+func (m *TakerFeeDiscountTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountTiersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeDiscountTiersResponse proto.InternalMessageInfo
 
-type AllTakerFeeShareAccumulatorsResponse struct {
-	TakerFeeSkimAccumulators []types.TakerFeeSkimAccumulator `protobuf:"bytes,1,rep,name=taker_fee_skim_accumulators,json=takerFeeSkimAccumulators,proto3" json:"taker_fee_skim_accumulators"`
+func (m *TakerFeeDiscountTiersResponse) GetTiers() []types.TakerFeeDiscountTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) Reset()         { *m = AllTakerFeeShareAccumulatorsResponse{} }
-func (m *AllTakerFeeShareAccumulatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*AllTakerFeeShareAccumulatorsResponse) ProtoMessage()    {}
-func (*AllTakerFeeShareAccumulatorsResponse) Descriptor() ([]byte, []int) {
+// EstimateTradeBasedOnPriceImpactRequest represents a request to estimate a
+// trade for Balancer/StableSwap/Concentrated liquidity pool types based on the
+// given parameters.
+type EstimateTradeBasedOnPriceImpactRequest struct {
+	// from_coin is the total amount of tokens that the user wants to sell.
+	FromCoin types2.Coin `protobuf:"bytes,1,opt,name=from_coin,json=fromCoin,proto3" json:"from_coin"`
+	// to_coin_denom is the denom identifier of the token that the user wants to
+	// buy.
+	ToCoinDenom string `protobuf:"bytes,2,opt,name=to_coin_denom,json=toCoinDenom,proto3" json:"to_coin_denom,omitempty"`
+	// pool_id is the identifier of the liquidity pool that the trade will occur
+	// on.
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// max_price_impact is the maximum percentage that the user is willing
+	// to affect the price of the liquidity pool.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact"`
+	// external_price is an optional external price that the user can enter.
+	// It adjusts the MaxPriceImpact as the SpotPrice of a pool can be changed at
+	// any time.
+	ExternalPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=external_price,json=externalPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"external_price"`
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
+	*m = EstimateTradeBasedOnPriceImpactRequest{}
+}
+func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
+func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{37}
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1877,41 +1909,66 @@ func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.Merge(m, src)
+func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.Merge(m, src)
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Size() int {
+func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.DiscardUnknown(m)
+func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateTradeBasedOnPriceImpactRequest proto.InternalMessageInfo
 
-func (m *AllTakerFeeShareAccumulatorsResponse) GetTakerFeeSkimAccumulators() []types.TakerFeeSkimAccumulator {
+func (m *EstimateTradeBasedOnPriceImpactRequest) GetFromCoin() types2.Coin {
 	if m != nil {
-		return m.TakerFeeSkimAccumulators
+		return m.FromCoin
 	}
-	return nil
+	return types2.Coin{}
 }
 
-type RegisteredAlloyedPoolFromDenomRequest struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *EstimateTradeBasedOnPriceImpactRequest) GetToCoinDenom() string {
+	if m != nil {
+		return m.ToCoinDenom
+	}
+	return ""
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) Reset()         { *m = RegisteredAlloyedPoolFromDenomRequest{} }
-func (m *RegisteredAlloyedPoolFromDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisteredAlloyedPoolFromDenomRequest) ProtoMessage()    {}
-func (*RegisteredAlloyedPoolFromDenomRequest) Descriptor() ([]byte, []int) {
+func (m *EstimateTradeBasedOnPriceImpactRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// EstimateTradeBasedOnPriceImpactResponse represents the response data
+// for an estimated trade based on price impact. If a trade fails to be
+// estimated the response would be 0,0 for input_coin and output_coin and will
+// not error.
+type EstimateTradeBasedOnPriceImpactResponse struct {
+	// input_coin is the actual input amount that would be tradeable
+	// under the specified price impact.
+	InputCoin types2.Coin `protobuf:"bytes,1,opt,name=input_coin,json=inputCoin,proto3" json:"input_coin"`
+	// output_coin is the amount of tokens of the ToCoinDenom type
+	// that will be received for the actual InputCoin trade.
+	OutputCoin types2.Coin `protobuf:"bytes,2,opt,name=output_coin,json=outputCoin,proto3" json:"output_coin"`
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
+	*m = EstimateTradeBasedOnPriceImpactResponse{}
+}
+func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
+func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{38}
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Unmarshal(b []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1921,43 +1978,54 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.Merge(m, src)
+func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.Merge(m, src)
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Size() int {
+func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.DiscardUnknown(m)
+func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateTradeBasedOnPriceImpactResponse proto.InternalMessageInfo
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) GetDenom() string {
+func (m *EstimateTradeBasedOnPriceImpactResponse) GetInputCoin() types2.Coin {
 	if m != nil {
-		return m.Denom
+		return m.InputCoin
 	}
-	return ""
+	return types2.Coin{}
 }
 
-type RegisteredAlloyedPoolFromDenomResponse struct {
-	ContractState types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,opt,name=contract_state,json=contractState,proto3" json:"contract_state"`
+func (m *EstimateTradeBasedOnPriceImpactResponse) GetOutputCoin() types2.Coin {
+	if m != nil {
+		return m.OutputCoin
+	}
+	return types2.Coin{}
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) Reset() {
-	*m = RegisteredAlloyedPoolFromDenomResponse{}
+type OptimalRouteRequest struct {
+	// token_in is the coin to swap, e.g. 1000000uatom.
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in a route.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_splits is the maximum number of routes token_in is split across.
+	MaxSplits uint64 `protobuf:"varint,4,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty" yaml:"max_splits"`
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisteredAlloyedPoolFromDenomResponse) ProtoMessage()    {}
-func (*RegisteredAlloyedPoolFromDenomResponse) Descriptor() ([]byte, []int) {
+
+func (m *OptimalRouteRequest) Reset()         { *m = OptimalRouteRequest{} }
+func (m *OptimalRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*OptimalRouteRequest) ProtoMessage()    {}
+func (*OptimalRouteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{39}
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *OptimalRouteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OptimalRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OptimalRouteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1967,43 +2035,69 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.Merge(m, src)
+func (m *OptimalRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimalRouteRequest.Merge(m, src)
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Size() int {
+func (m *OptimalRouteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.DiscardUnknown(m)
+func (m *OptimalRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimalRouteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_OptimalRouteRequest proto.InternalMessageInfo
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) GetContractState() types.AlloyContractTakerFeeShareState {
+func (m *OptimalRouteRequest) GetTokenIn() string {
 	if m != nil {
-		return m.ContractState
+		return m.TokenIn
 	}
-	return types.AlloyContractTakerFeeShareState{}
+	return ""
 }
 
-type RegisteredAlloyedPoolFromPoolIdRequest struct {
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+func (m *OptimalRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Reset() {
-	*m = RegisteredAlloyedPoolFromPoolIdRequest{}
+func (m *OptimalRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisteredAlloyedPoolFromPoolIdRequest) ProtoMessage()    {}
-func (*RegisteredAlloyedPoolFromPoolIdRequest) Descriptor() ([]byte, []int) {
+
+func (m *OptimalRouteRequest) GetMaxSplits() uint64 {
+	if m != nil {
+		return m.MaxSplits
+	}
+	return 0
+}
+
+type OptimalRouteResponse struct {
+	// routes share no pool, so that their estimates hold when swapped in a
+	// single MsgSplitRouteSwapExactAmountIn.
+	Routes []types.SwapAmountInSplitRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// token_out_amount is the expected output, net of spread and taker fees.
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// price_impact is the relative shortfall of token_out_amount against the
+	// output at the spot prices of the routes, fees included.
+	PriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *OptimalRouteResponse) Reset()         { *m = OptimalRouteResponse{} }
+func (m *OptimalRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*OptimalRouteResponse) ProtoMessage()    {}
+func (*OptimalRouteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{40}
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *OptimalRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OptimalRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OptimalRouteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2013,43 +2107,40 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.Merge(m, src)
+func (m *OptimalRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimalRouteResponse.Merge(m, src)
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Size() int {
+func (m *OptimalRouteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.DiscardUnknown(m)
+func (m *OptimalRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimalRouteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_OptimalRouteResponse proto.InternalMessageInfo
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) GetPoolId() uint64 {
+func (m *OptimalRouteResponse) GetRoutes() []types.SwapAmountInSplitRoute {
 	if m != nil {
-		return m.PoolId
+		return m.Routes
 	}
-	return 0
+	return nil
 }
 
-type RegisteredAlloyedPoolFromPoolIdResponse struct {
-	ContractState types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,opt,name=contract_state,json=contractState,proto3" json:"contract_state"`
+type AllTakerFeeShareAgreementsRequest struct {
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Reset() {
-	*m = RegisteredAlloyedPoolFromPoolIdResponse{}
-}
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisteredAlloyedPoolFromPoolIdResponse) ProtoMessage()    {}
-func (*RegisteredAlloyedPoolFromPoolIdResponse) Descriptor() ([]byte, []int) {
+func (m *AllTakerFeeShareAgreementsRequest) Reset()         { *m = AllTakerFeeShareAgreementsRequest{} }
+func (m *AllTakerFeeShareAgreementsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllTakerFeeShareAgreementsRequest) ProtoMessage()    {}
+func (*AllTakerFeeShareAgreementsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{41}
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *AllTakerFeeShareAgreementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllTakerFeeShareAgreementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllTakerFeeShareAgreementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2059,40 +2150,34 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.Merge(m, src)
+func (m *AllTakerFeeShareAgreementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllTakerFeeShareAgreementsRequest.Merge(m, src)
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Size() int {
+func (m *AllTakerFeeShareAgreementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.DiscardUnknown(m)
+func (m *AllTakerFeeShareAgreementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllTakerFeeShareAgreementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse proto.InternalMessageInfo
-
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) GetContractState() types.AlloyContractTakerFeeShareState {
-	if m != nil {
-		return m.ContractState
-	}
-	return types.AlloyContractTakerFeeShareState{}
-}
+var xxx_messageInfo_AllTakerFeeShareAgreementsRequest proto.InternalMessageInfo
 
-type AllRegisteredAlloyedPoolsRequest struct {
+type AllTakerFeeShareAgreementsResponse struct {
+	TakerFeeShareAgreements []types.TakerFeeShareAgreement `protobuf:"bytes,1,rep,name=taker_fee_share_agreements,json=takerFeeShareAgreements,proto3" json:"taker_fee_share_agreements"`
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) Reset()         { *m = AllRegisteredAlloyedPoolsRequest{} }
-func (m *AllRegisteredAlloyedPoolsRequest) String() string { return proto.CompactTextString(m) }
-func (*AllRegisteredAlloyedPoolsRequest) ProtoMessage()    {}
-func (*AllRegisteredAlloyedPoolsRequest) Descriptor() ([]byte, []int) {
+func (m *AllTakerFeeShareAgreementsResponse) Reset()         { *m = AllTakerFeeShareAgreementsResponse{} }
+func (m *AllTakerFeeShareAgreementsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllTakerFeeShareAgreementsResponse) ProtoMessage()    {}
+func (*AllTakerFeeShareAgreementsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{42}
 }
-func (m *AllRegisteredAlloyedPoolsRequest) XXX_Unmarshal(b []byte) error {
+func (m *AllTakerFeeShareAgreementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllRegisteredAlloyedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllTakerFeeShareAgreementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllTakerFeeShareAgreementsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2102,34 +2187,43 @@ func (m *AllRegisteredAlloyedPoolsRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *AllRegisteredAlloyedPoolsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.Merge(m, src)
+func (m *AllTakerFeeShareAgreementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllTakerFeeShareAgreementsResponse.Merge(m, src)
 }
-func (m *AllRegisteredAlloyedPoolsRequest) XXX_Size() int {
+func (m *AllTakerFeeShareAgreementsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllRegisteredAlloyedPoolsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.DiscardUnknown(m)
+func (m *AllTakerFeeShareAgreementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllTakerFeeShareAgreementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllRegisteredAlloyedPoolsRequest proto.InternalMessageInfo
-
-type AllRegisteredAlloyedPoolsResponse struct {
-	ContractStates []types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,rep,name=contract_states,json=contractStates,proto3" json:"contract_states"`
-}
+var xxx_messageInfo_AllTakerFeeShareAgreementsResponse proto.InternalMessageInfo
 
-func (m *AllRegisteredAlloyedPoolsResponse) Reset()         { *m = AllRegisteredAlloyedPoolsResponse{} }
-func (m *AllRegisteredAlloyedPoolsResponse) String() string { return proto.CompactTextString(m) }
-func (*AllRegisteredAlloyedPoolsResponse) ProtoMessage()    {}
-func (*AllRegisteredAlloyedPoolsResponse) Descriptor() ([]byte, []int) {
+func (m *AllTakerFeeShareAgreementsResponse) GetTakerFeeShareAgreements() []types.TakerFeeShareAgreement {
+	if m != nil {
+		return m.TakerFeeShareAgreements
+	}
+	return nil
+}
+
+type TakerFeeShareAgreementFromDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) Reset() {
+	*m = TakerFeeShareAgreementFromDenomRequest{}
+}
+func (m *TakerFeeShareAgreementFromDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeShareAgreementFromDenomRequest) ProtoMessage()    {}
+func (*TakerFeeShareAgreementFromDenomRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6256a4106f701b7d, []int{43}
 }
-func (m *AllRegisteredAlloyedPoolsResponse) XXX_Unmarshal(b []byte) error {
+func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllRegisteredAlloyedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2139,1844 +2233,2002 @@ func (m *AllRegisteredAlloyedPoolsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *AllRegisteredAlloyedPoolsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.Merge(m, src)
+func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.Merge(m, src)
 }
-func (m *AllRegisteredAlloyedPoolsResponse) XXX_Size() int {
+func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllRegisteredAlloyedPoolsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.DiscardUnknown(m)
+func (m *TakerFeeShareAgreementFromDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllRegisteredAlloyedPoolsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TakerFeeShareAgreementFromDenomRequest proto.InternalMessageInfo
 
-func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyContractTakerFeeShareState {
+func (m *TakerFeeShareAgreementFromDenomRequest) GetDenom() string {
 	if m != nil {
-		return m.ContractStates
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
-	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
-	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
-	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
-	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
-	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
-	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
-	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
-	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
-	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
-	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
-	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
-	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
-	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
-	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
-	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
-	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
-	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
-	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
-	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
-	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
-	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
-	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
-	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
-	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
-	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
-	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
-	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
-	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
-	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
-	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
-	proto.RegisterType((*RegisteredAlloyedPoolFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomResponse")
-	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdRequest")
-	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
-	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
-	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+type TakerFeeShareAgreementFromDenomResponse struct {
+	TakerFeeShareAgreement types.TakerFeeShareAgreement `protobuf:"bytes,1,opt,name=taker_fee_share_agreement,json=takerFeeShareAgreement,proto3" json:"taker_fee_share_agreement"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
+func (m *TakerFeeShareAgreementFromDenomResponse) Reset() {
+	*m = TakerFeeShareAgreementFromDenomResponse{}
 }
-
-var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x8f, 0x1d, 0xaf, 0xfd, 0x12, 0xff, 0xa4, 0x36, 0x8e, 0xed, 0x49, 0xf0, 0x38, 0xe5,
-	0xc4, 0x71, 0x36, 0xf1, 0xcc, 0xda, 0xce, 0xb2, 0xbb, 0xd9, 0x75, 0x92, 0x19, 0xff, 0x24, 0x66,
-	0x13, 0xe2, 0x8c, 0x4d, 0x16, 0x96, 0xcd, 0xb6, 0xda, 0x33, 0x95, 0x71, 0xcb, 0xd3, 0xdd, 0x93,
-	0xee, 0x6a, 0xc7, 0x23, 0x94, 0x03, 0x48, 0x08, 0x4e, 0x28, 0xb0, 0x48, 0x8b, 0x04, 0xd2, 0x6a,
-	0x0f, 0x5c, 0xe0, 0x80, 0x90, 0x10, 0x12, 0x17, 0xb8, 0x70, 0x88, 0x90, 0x40, 0x91, 0x38, 0x80,
-	0x90, 0x18, 0x50, 0xc2, 0x01, 0x01, 0xa7, 0x39, 0x72, 0x01, 0x75, 0x55, 0x75, 0x4f, 0xcf, 0x78,
-	0xa6, 0xbb, 0x67, 0x26, 0xa0, 0x3d, 0xc5, 0xae, 0x7a, 0xef, 0xd5, 0xfb, 0xbe, 0x7a, 0xaf, 0xaa,
-	0xfa, 0x8b, 0xe1, 0x9c, 0x61, 0x69, 0x86, 0xa5, 0x5a, 0xa9, 0x92, 0x61, 0x14, 0x35, 0x45, 0x57,
-	0x0a, 0xc4, 0x4c, 0xed, 0xcd, 0x6f, 0x13, 0xaa, 0xcc, 0xa7, 0x1e, 0xd8, 0xc4, 0x2c, 0x27, 0x4b,
-	0xa6, 0x41, 0x0d, 0x74, 0x52, 0x18, 0x26, 0x7d, 0x86, 0x49, 0x61, 0x18, 0x3f, 0x5e, 0x30, 0x0a,
-	0x06, 0xb3, 0x4b, 0x39, 0x3f, 0x71, 0x97, 0xf8, 0xf9, 0xa0, 0xd8, 0x05, 0xa2, 0x13, 0x16, 0x8e,
-	0x99, 0x9e, 0x09, 0x32, 0xa5, 0xfb, 0xc2, 0xea, 0x62, 0x90, 0x95, 0xf5, 0x50, 0x29, 0xc9, 0xa6,
-	0x61, 0x53, 0x22, 0xac, 0xe7, 0x03, 0x63, 0x2a, 0xbb, 0xc4, 0x94, 0xef, 0x13, 0x22, 0x5b, 0x3b,
-	0x8a, 0xe9, 0xba, 0x4c, 0xe6, 0x98, 0x4f, 0x6a, 0x5b, 0xb1, 0x88, 0x67, 0x9a, 0x33, 0x54, 0x5d,
-	0xcc, 0xbf, 0xe2, 0x9f, 0x67, 0xec, 0x78, 0x56, 0x25, 0xa5, 0xa0, 0xea, 0x0a, 0x55, 0x0d, 0xd7,
-	0xf6, 0x54, 0xc1, 0x30, 0x0a, 0x45, 0x92, 0x52, 0x4a, 0x6a, 0x4a, 0xd1, 0x75, 0x83, 0xb2, 0x49,
-	0x17, 0xf0, 0x84, 0x98, 0x65, 0xbf, 0x6d, 0xdb, 0xf7, 0x53, 0x8a, 0x5e, 0x76, 0xa7, 0xf8, 0x22,
-	0x32, 0xe7, 0x93, 0xff, 0x22, 0xa6, 0x12, 0x8d, 0x5e, 0x54, 0xd5, 0x88, 0x45, 0x15, 0xad, 0xc4,
-	0x0d, 0xf0, 0x30, 0x0c, 0x6e, 0x28, 0xa6, 0xa2, 0x59, 0x59, 0xf2, 0xc0, 0x26, 0x16, 0xc5, 0x9b,
-	0x30, 0xe4, 0x0e, 0x58, 0x25, 0x43, 0xb7, 0x08, 0x4a, 0x43, 0x5f, 0x89, 0x8d, 0x8c, 0x4b, 0x53,
-	0xd2, 0xec, 0x91, 0x85, 0xe9, 0x64, 0xc0, 0xce, 0x26, 0xb9, 0x73, 0xa6, 0xf7, 0x49, 0x25, 0x71,
-	0x28, 0x2b, 0x1c, 0xf1, 0x4f, 0x63, 0x30, 0xb5, 0x6a, 0x51, 0x55, 0x53, 0x28, 0xd9, 0x7c, 0xa8,
-	0x94, 0x56, 0xf7, 0x95, 0x1c, 0x4d, 0x6b, 0x86, 0xad, 0xd3, 0x75, 0x5d, 0xac, 0x8c, 0x96, 0xa0,
-	0xcf, 0x22, 0x7a, 0x9e, 0x98, 0x6c, 0x9d, 0x81, 0xcc, 0xd9, 0x6a, 0x25, 0x91, 0x28, 0x2b, 0x5a,
-	0xf1, 0x32, 0xe6, 0xe3, 0xf8, 0x62, 0x9e, 0x94, 0x4c, 0x92, 0x53, 0x28, 0xc9, 0x5f, 0xc6, 0xd4,
-	0xb4, 0x09, 0x1e, 0x97, 0xb2, 0xc2, 0x09, 0x5d, 0x85, 0x97, 0x9c, 0x7c, 0x64, 0x35, 0x3f, 0x1e,
-	0x9b, 0x92, 0x66, 0x7b, 0x33, 0x33, 0xd5, 0x4a, 0x62, 0x8a, 0xfb, 0x8b, 0x89, 0x16, 0x01, 0x9c,
-	0xd9, 0xf5, 0x3c, 0x4a, 0x42, 0x3f, 0x35, 0x76, 0x89, 0x2e, 0xab, 0xfa, 0x78, 0x0f, 0xcb, 0xe0,
-	0xe5, 0x6a, 0x25, 0x31, 0xcc, 0x23, 0xb8, 0x33, 0x38, 0xfb, 0x12, 0xfb, 0x71, 0x5d, 0x47, 0xf7,
-	0xa0, 0x8f, 0x55, 0x8f, 0x35, 0xde, 0x3b, 0xd5, 0x33, 0x7b, 0x64, 0x21, 0x19, 0xc8, 0x8b, 0x03,
-	0xdb, 0x43, 0xec, 0xb8, 0x65, 0x46, 0x1d, 0x8a, 0xaa, 0x95, 0xc4, 0x20, 0x5f, 0x81, 0xc7, 0xc2,
-	0x59, 0x11, 0x14, 0xff, 0x32, 0x06, 0x0b, 0x2d, 0x39, 0x7b, 0x57, 0xa5, 0x3b, 0x1b, 0xa6, 0xaa,
-	0xa9, 0x54, 0xdd, 0x23, 0x5b, 0xe5, 0x12, 0x71, 0xf7, 0xcf, 0x4f, 0x83, 0xd4, 0x35, 0x0d, 0xb1,
-	0x08, 0x34, 0x5c, 0x85, 0x21, 0x9e, 0xb1, 0xec, 0xae, 0xdb, 0x33, 0xd5, 0x33, 0xdb, 0x9b, 0x99,
-	0xa8, 0x56, 0x12, 0xa3, 0x7e, 0x68, 0xee, 0x3c, 0xce, 0x1e, 0xe5, 0x03, 0x1b, 0x7c, 0xc1, 0xbb,
-	0x70, 0x42, 0x18, 0xf0, 0xe8, 0x86, 0x4d, 0xe5, 0x3c, 0xd1, 0x0d, 0x8d, 0xf1, 0x3a, 0x90, 0x39,
-	0x5d, 0xad, 0x24, 0x3e, 0x53, 0x17, 0xa8, 0xc1, 0x0e, 0x67, 0x5f, 0xe6, 0x13, 0x5b, 0xce, 0xf8,
-	0x6d, 0x9b, 0xae, 0xb0, 0xd1, 0xdf, 0x4a, 0xf0, 0x8a, 0x47, 0xa0, 0xaa, 0x17, 0x8a, 0xc4, 0x59,
-	0xb0, 0x65, 0xf9, 0x5d, 0x68, 0x24, 0x0e, 0x55, 0x2b, 0x89, 0xa1, 0x7a, 0xe2, 0x3a, 0x26, 0x29,
-	0x03, 0xc3, 0x8d, 0xe0, 0x78, 0x89, 0xc5, 0xab, 0x95, 0xc4, 0x09, 0xbf, 0x9b, 0x0f, 0xd5, 0x20,
-	0xad, 0xc3, 0xf3, 0x0d, 0x09, 0x4e, 0x07, 0x34, 0x91, 0xe8, 0xd6, 0x6d, 0x18, 0xa9, 0x05, 0x52,
-	0xd8, 0xac, 0xe8, 0xa7, 0x37, 0x9c, 0x7a, 0xfb, 0x53, 0x25, 0x31, 0xca, 0x4f, 0x08, 0x2b, 0xbf,
-	0x9b, 0x54, 0x8d, 0x94, 0xa6, 0xd0, 0x9d, 0xe4, 0xba, 0x4e, 0xab, 0x95, 0xc4, 0x58, 0x63, 0x1e,
-	0xdc, 0x1d, 0x67, 0x87, 0xdc, 0x44, 0xf8, 0x6a, 0xf8, 0xe7, 0xb1, 0x96, 0x99, 0xdc, 0xb6, 0xe9,
-	0xa7, 0xa5, 0x9f, 0x3f, 0xf0, 0xfa, 0xb3, 0x87, 0xf5, 0x67, 0x2a, 0x62, 0x7f, 0x3a, 0x10, 0x22,
-	0x34, 0x28, 0x9a, 0x87, 0x01, 0x8f, 0xaa, 0xf1, 0x5e, 0x06, 0xf1, 0x78, 0xb5, 0x92, 0x18, 0x69,
-	0x60, 0x11, 0x67, 0xfb, 0x5d, 0xfa, 0xf0, 0xaf, 0x62, 0xb0, 0xd8, 0x9a, 0xb8, 0xff, 0x61, 0x53,
-	0x1f, 0x6c, 0xd2, 0x58, 0x7b, 0x4d, 0xba, 0x09, 0xa3, 0x75, 0xcd, 0xa7, 0xea, 0x5e, 0x19, 0x3b,
-	0x3d, 0x3a, 0x55, 0xad, 0x24, 0x4e, 0x35, 0xe9, 0x51, 0xd7, 0x0c, 0x67, 0x91, 0xaf, 0x45, 0xd7,
-	0x75, 0x56, 0xd1, 0x9d, 0x30, 0xf8, 0x3b, 0x09, 0x2e, 0x84, 0x36, 0xb5, 0xaf, 0x08, 0xdb, 0xea,
-	0xea, 0xab, 0x30, 0xd4, 0x80, 0x8e, 0xf7, 0xb6, 0x8f, 0xa5, 0x46, 0x58, 0x47, 0x69, 0x4b, 0x40,
-	0x3d, 0x91, 0x00, 0x7d, 0x5d, 0x02, 0x1c, 0xd4, 0x4b, 0xa2, 0xad, 0x65, 0xf7, 0x00, 0x51, 0xf5,
-	0xfa, 0xae, 0x7e, 0x3d, 0xac, 0xab, 0x4f, 0x34, 0x24, 0xee, 0x36, 0xf5, 0xa0, 0xc8, 0x5c, 0xf4,
-	0xf4, 0x31, 0x18, 0xfe, 0xbc, 0xad, 0x39, 0x64, 0x7a, 0x4f, 0x81, 0x55, 0x18, 0xa9, 0x0d, 0x89,
-	0x3c, 0xe6, 0x61, 0x40, 0xb7, 0x35, 0x56, 0x25, 0x96, 0x60, 0xd4, 0x87, 0xd0, 0x9b, 0xc2, 0xd9,
-	0x7e, 0x5d, 0xb8, 0xe2, 0xcb, 0x70, 0xc4, 0xf9, 0xa1, 0x93, 0x1d, 0xc1, 0xcb, 0x70, 0x94, 0xfb,
-	0x8a, 0xe5, 0x17, 0xa1, 0xd7, 0x99, 0x11, 0x2f, 0x91, 0xe3, 0x49, 0xfe, 0xbc, 0x49, 0xba, 0xcf,
-	0x9b, 0x64, 0x5a, 0x2f, 0x67, 0x06, 0x7e, 0xf3, 0xb3, 0xb9, 0xc3, 0xac, 0x6c, 0xb3, 0xcc, 0xd8,
-	0x81, 0x96, 0x2e, 0x16, 0xeb, 0xa0, 0xad, 0xc3, 0x48, 0x6d, 0x48, 0xc4, 0x7e, 0x0d, 0x0e, 0xbb,
-	0xb0, 0x7a, 0xa2, 0x04, 0xe7, 0xd6, 0x38, 0x0d, 0x63, 0x37, 0x55, 0x8b, 0xb2, 0x58, 0x99, 0x32,
-	0xab, 0x03, 0x17, 0xea, 0x0c, 0x1c, 0xe6, 0x65, 0xc4, 0xb7, 0x6a, 0xa4, 0x5a, 0x49, 0x1c, 0xe5,
-	0x40, 0x45, 0xf5, 0xf0, 0x69, 0x7c, 0x07, 0xc6, 0x0f, 0x86, 0xe8, 0x2e, 0xab, 0xa7, 0x12, 0x8c,
-	0x6c, 0x96, 0x0c, 0xba, 0x61, 0xaa, 0x39, 0xd2, 0x51, 0x33, 0xac, 0xc2, 0x88, 0xf3, 0x6a, 0x95,
-	0x15, 0xcb, 0x22, 0xb4, 0xae, 0x1d, 0x4e, 0xd6, 0xee, 0x8a, 0x46, 0x0b, 0x9c, 0x1d, 0x72, 0x86,
-	0xd2, 0xce, 0x08, 0x6f, 0x89, 0x1b, 0x70, 0xec, 0x81, 0x6d, 0xd0, 0xfa, 0x38, 0xbc, 0x35, 0x4e,
-	0x55, 0x2b, 0x89, 0x71, 0x1e, 0xe7, 0x80, 0x09, 0xce, 0x0e, 0xb3, 0xb1, 0x5a, 0x24, 0xbc, 0x0e,
-	0xc7, 0x7c, 0x88, 0x04, 0x3d, 0x97, 0x00, 0xac, 0x92, 0x41, 0xe5, 0x92, 0x33, 0x2a, 0x78, 0x1e,
-	0xad, 0x56, 0x12, 0xc7, 0xc4, 0x45, 0xe3, 0xcd, 0xe1, 0xec, 0x80, 0xe5, 0x7a, 0xe3, 0x1b, 0x30,
-	0xb1, 0x65, 0x50, 0x85, 0x15, 0xc0, 0x4d, 0xf5, 0x81, 0xad, 0xe6, 0x55, 0x5a, 0xee, 0xa8, 0x40,
-	0xbf, 0x2f, 0x41, 0xbc, 0x59, 0x28, 0x91, 0xde, 0x23, 0x18, 0x28, 0xba, 0x83, 0x62, 0x07, 0x27,
-	0x92, 0xe2, 0x85, 0xee, 0x10, 0xe5, 0x5d, 0x3f, 0xcb, 0x86, 0xaa, 0x67, 0x56, 0xc4, 0x85, 0x23,
-	0xba, 0xc9, 0xf3, 0xc4, 0x3f, 0xfa, 0x4b, 0x62, 0xb6, 0xa0, 0xd2, 0x1d, 0x7b, 0x3b, 0x99, 0x33,
-	0x34, 0xf1, 0xc4, 0x17, 0xff, 0xcc, 0x59, 0xf9, 0xdd, 0x14, 0x75, 0x6e, 0x0b, 0x16, 0xc4, 0xca,
-	0xd6, 0x56, 0xc4, 0x63, 0x30, 0xca, 0x92, 0x6b, 0xc4, 0x88, 0x3f, 0x92, 0xe0, 0x44, 0xe3, 0xcc,
-	0xa7, 0x23, 0x65, 0x77, 0x6b, 0xee, 0x1a, 0x45, 0x5b, 0x23, 0x6b, 0x86, 0xd9, 0xf1, 0xd9, 0xf1,
-	0x1d, 0x77, 0x6b, 0x1a, 0x42, 0x09, 0x9c, 0x14, 0xfa, 0xf6, 0xd8, 0x44, 0x38, 0xc8, 0x74, 0xfd,
-	0x43, 0x80, 0xbb, 0xb5, 0x87, 0x50, 0xac, 0x85, 0xf7, 0x20, 0xbe, 0x65, 0x2a, 0x79, 0x55, 0x2f,
-	0x6c, 0x28, 0xaa, 0xb9, 0xe5, 0x7c, 0x54, 0xae, 0x11, 0x7f, 0x83, 0xb2, 0xea, 0x97, 0x5f, 0x15,
-	0xa5, 0xec, 0xc3, 0x27, 0x26, 0x70, 0xb6, 0x8f, 0xfd, 0xf4, 0x6a, 0xcd, 0x78, 0x5e, 0xf4, 0xe5,
-	0x01, 0xe3, 0x79, 0xd7, 0x78, 0x1e, 0xcb, 0x70, 0xb2, 0xe9, 0xba, 0x82, 0x8c, 0x6b, 0x30, 0xe0,
-	0x7d, 0xe0, 0x8a, 0xa5, 0xa7, 0xc5, 0xc5, 0x72, 0xf2, 0xe0, 0xc5, 0x72, 0x93, 0x14, 0x94, 0x5c,
-	0x79, 0x85, 0xe4, 0xb2, 0xfd, 0x54, 0x44, 0x72, 0x3e, 0x57, 0x66, 0xdc, 0x7b, 0xcc, 0x59, 0x89,
-	0x64, 0x14, 0x8b, 0xe4, 0x6f, 0xeb, 0xac, 0xe1, 0xd6, 0xb5, 0x92, 0x92, 0xf3, 0xee, 0xe4, 0xb7,
-	0x61, 0xe0, 0xbe, 0x69, 0x68, 0xb2, 0xf3, 0x9d, 0x2c, 0x4e, 0xf2, 0x00, 0xf2, 0xf9, 0x97, 0x64,
-	0xbf, 0xe3, 0xe1, 0xfc, 0x8e, 0x30, 0x0c, 0x52, 0x83, 0xf9, 0xfa, 0x0f, 0xa5, 0xec, 0x11, 0x6a,
-	0x38, 0xd3, 0xfc, 0xd0, 0x19, 0xab, 0xd5, 0x89, 0x73, 0xd4, 0xf4, 0x7a, 0x87, 0xda, 0x2d, 0x18,
-	0xd1, 0x94, 0x7d, 0x7e, 0x22, 0xc8, 0x2a, 0xcb, 0x4a, 0x3c, 0x3c, 0x22, 0xc1, 0x1d, 0xd2, 0x94,
-	0x7d, 0x1f, 0x20, 0xf4, 0x39, 0x18, 0x22, 0xfb, 0x94, 0x98, 0xba, 0x52, 0x14, 0x27, 0xd0, 0xe1,
-	0xe8, 0xc1, 0x06, 0x5d, 0x57, 0x7e, 0x26, 0xfd, 0x58, 0x82, 0x73, 0xa1, 0x04, 0x8a, 0xed, 0xba,
-	0x02, 0xa0, 0xea, 0x25, 0x9b, 0xb6, 0x45, 0xe1, 0x00, 0x73, 0x61, 0x1c, 0x5e, 0x83, 0x23, 0x86,
-	0x4d, 0xbd, 0x00, 0xb1, 0x68, 0x01, 0x80, 0xfb, 0x38, 0x23, 0x78, 0x1a, 0x4e, 0xa7, 0x8b, 0x45,
-	0xb7, 0x8e, 0x36, 0x77, 0x14, 0x93, 0xa4, 0x0b, 0x26, 0x21, 0x1a, 0xd1, 0xa9, 0x77, 0xcb, 0xfe,
-	0x40, 0x02, 0x1c, 0x64, 0x25, 0xd0, 0xec, 0x41, 0xbc, 0x41, 0x5d, 0x91, 0x15, 0xcf, 0x4a, 0x74,
-	0xe7, 0x62, 0xe0, 0xe3, 0xbd, 0xf9, 0x0a, 0x22, 0xed, 0x31, 0xda, 0x7c, 0x7d, 0x7c, 0x05, 0x66,
-	0x9a, 0x3b, 0xae, 0x99, 0x86, 0x56, 0x77, 0x91, 0x1f, 0xaf, 0xbb, 0xc8, 0xdd, 0x6b, 0xfb, 0x63,
-	0x09, 0xce, 0x85, 0x06, 0xf0, 0x4e, 0x9b, 0x89, 0x96, 0x18, 0xc5, 0x06, 0x76, 0x01, 0xf1, 0x44,
-	0x73, 0x88, 0xf8, 0x3e, 0xcc, 0xd6, 0xf9, 0xb1, 0x9c, 0xac, 0x2d, 0x23, 0x9d, 0xcb, 0x99, 0x36,
-	0xc9, 0xdf, 0x55, 0x8a, 0x36, 0x09, 0xc4, 0x88, 0xce, 0xc0, 0xa0, 0x1b, 0x7b, 0xc5, 0xd7, 0x6d,
-	0xf5, 0x83, 0xd8, 0x82, 0xf3, 0x11, 0xd6, 0x11, 0x54, 0xac, 0x41, 0x5f, 0xdd, 0x0b, 0x36, 0x19,
-	0xf6, 0x82, 0x15, 0xc7, 0xae, 0xfb, 0x70, 0x15, 0xde, 0xf8, 0x2c, 0x4c, 0x1f, 0x28, 0xae, 0x5c,
-	0xce, 0xd6, 0xec, 0xa2, 0x42, 0x0d, 0xd3, 0x2b, 0xc2, 0x4f, 0x24, 0x38, 0x13, 0x6c, 0x27, 0xf2,
-	0x2a, 0xc3, 0x49, 0xdf, 0x16, 0xed, 0xaa, 0x9a, 0xac, 0xf8, 0xcc, 0x44, 0x1d, 0x5e, 0x8a, 0xb6,
-	0x49, 0xbb, 0xaa, 0xe6, 0x5b, 0x43, 0xec, 0xd2, 0x38, 0x6d, 0x3e, 0x6d, 0xe1, 0x25, 0x38, 0x9b,
-	0x25, 0x05, 0xd5, 0xa2, 0xc4, 0x24, 0xf9, 0x74, 0xb1, 0x68, 0x94, 0x49, 0xde, 0xb9, 0xac, 0x22,
-	0x16, 0xe2, 0x87, 0x12, 0xcc, 0x84, 0xf9, 0x0b, 0x90, 0x2a, 0x0c, 0xe5, 0x0c, 0x9d, 0x9a, 0x4a,
-	0x8e, 0xca, 0x16, 0x55, 0x28, 0x11, 0xc5, 0xf7, 0x76, 0x20, 0x2e, 0x16, 0x72, 0x59, 0xf8, 0xd5,
-	0x31, 0xb9, 0xe9, 0xc4, 0x10, 0xf8, 0x06, 0xdd, 0xc8, 0x6c, 0x10, 0xa7, 0x03, 0x92, 0xe2, 0x5f,
-	0x95, 0x2e, 0xaa, 0xb1, 0x86, 0x6b, 0xdd, 0xbb, 0xc2, 0xbf, 0x2b, 0xc1, 0xb9, 0xd0, 0x18, 0xff,
-	0x7f, 0x64, 0x18, 0xa6, 0xd2, 0xc5, 0x62, 0xd3, 0xc4, 0xbc, 0xb2, 0x7b, 0x2c, 0xb1, 0x13, 0xb2,
-	0x95, 0x91, 0x48, 0x7a, 0x17, 0x86, 0xeb, 0x93, 0x76, 0xeb, 0xec, 0x45, 0x64, 0x3d, 0x54, 0x97,
-	0xb5, 0xb5, 0xf0, 0x87, 0xf3, 0x70, 0xf8, 0x8e, 0x4d, 0xcc, 0x32, 0xfa, 0x96, 0x04, 0x7d, 0x5c,
-	0xa8, 0x45, 0xaf, 0x44, 0x50, 0x73, 0x05, 0xa6, 0xf8, 0x85, 0x48, 0xb6, 0x1c, 0x1a, 0xbe, 0xf0,
-	0xb5, 0xdf, 0xff, 0xed, 0xc3, 0xd8, 0x59, 0x34, 0x9d, 0x0a, 0x92, 0xd5, 0x45, 0x16, 0x7f, 0x97,
-	0x60, 0xa2, 0xa5, 0xb6, 0x85, 0x96, 0x02, 0xd7, 0x0d, 0x13, 0x96, 0xe3, 0x57, 0x3a, 0x75, 0x17,
-	0x48, 0x6e, 0x32, 0x24, 0x6b, 0x68, 0x25, 0x10, 0xc9, 0x57, 0x44, 0x09, 0x3f, 0x4a, 0x11, 0x11,
-	0x91, 0xff, 0x0f, 0x03, 0x71, 0x62, 0x8a, 0xaf, 0x6e, 0x59, 0xd5, 0xd1, 0x27, 0x31, 0x9f, 0x82,
-	0x11, 0xae, 0xeb, 0xa2, 0xdb, 0x9d, 0x65, 0xdf, 0x52, 0x4c, 0xea, 0x9a, 0x0e, 0x85, 0xd1, 0xf1,
-	0x65, 0xf4, 0xa5, 0x17, 0x41, 0x87, 0xfc, 0x50, 0xa5, 0x3b, 0xce, 0xdb, 0x89, 0x27, 0x2a, 0xb3,
-	0x37, 0x33, 0xfa, 0x66, 0x0c, 0xa6, 0x23, 0x48, 0xb7, 0xe8, 0x7a, 0x34, 0x28, 0xa1, 0xe2, 0x6f,
-	0xd7, 0x9c, 0x7c, 0x91, 0x71, 0x92, 0x45, 0x1b, 0x6d, 0x73, 0xc2, 0x72, 0xe3, 0xaa, 0x5b, 0xd3,
-	0x72, 0xf9, 0x97, 0x04, 0xf1, 0xd6, 0xfa, 0x10, 0xea, 0x28, 0xf1, 0x9a, 0x3e, 0x16, 0xbf, 0xda,
-	0xb1, 0xbf, 0x40, 0x7e, 0x8b, 0x21, 0xbf, 0x8e, 0x56, 0xbb, 0xaf, 0x06, 0xc3, 0xa6, 0xe8, 0x87,
-	0x31, 0xb8, 0xd8, 0x8e, 0x42, 0x8a, 0x36, 0x3a, 0x04, 0xd0, 0xba, 0x3f, 0xba, 0xa6, 0x64, 0x9b,
-	0x51, 0xf2, 0x3e, 0x7a, 0xef, 0x85, 0x50, 0xd2, 0xbc, 0x43, 0x1e, 0xc7, 0xe0, 0x4c, 0x14, 0x1d,
-	0x14, 0xdd, 0xe8, 0xae, 0x45, 0x5e, 0x64, 0xa9, 0xdc, 0x63, 0xbc, 0xbc, 0x8b, 0xbe, 0xd0, 0x26,
-	0x2f, 0x0e, 0x0b, 0x21, 0x8d, 0xe2, 0x94, 0xce, 0x47, 0x12, 0xf4, 0xbb, 0x7a, 0x25, 0xba, 0x18,
-	0x98, 0x6c, 0x83, 0xd2, 0x19, 0x9f, 0x8b, 0x68, 0x2d, 0x80, 0x24, 0x19, 0x90, 0x59, 0x34, 0x13,
-	0x08, 0xc4, 0x13, 0x43, 0xd1, 0xb7, 0x25, 0xe8, 0x75, 0x22, 0xa0, 0xd9, 0xe0, 0x0b, 0xb4, 0xa6,
-	0x74, 0xc4, 0xcf, 0x47, 0xb0, 0x14, 0xd9, 0x5c, 0x62, 0xd9, 0x24, 0xd1, 0xc5, 0xc0, 0x6c, 0x58,
-	0x26, 0x35, 0x72, 0x19, 0x5b, 0xae, 0x04, 0x1a, 0xc2, 0x56, 0x83, 0x78, 0x1a, 0xc2, 0x56, 0xa3,
-	0xae, 0x1a, 0x91, 0x2d, 0xa5, 0x58, 0x9c, 0xe3, 0x6c, 0xfd, 0x42, 0x82, 0x91, 0x46, 0x39, 0x14,
-	0x05, 0xbf, 0xbb, 0x5b, 0x08, 0xb0, 0xf1, 0xd7, 0xda, 0xf4, 0x12, 0x19, 0xbf, 0xc1, 0x32, 0x5e,
-	0x40, 0xaf, 0x06, 0x66, 0x5c, 0x54, 0x2d, 0xca, 0x53, 0x9e, 0xdb, 0x2e, 0xcf, 0xf1, 0xcf, 0xa5,
-	0x8f, 0x25, 0x18, 0xf0, 0x44, 0x4a, 0x14, 0x4c, 0x54, 0xa3, 0x3c, 0x1b, 0x4f, 0x46, 0x35, 0x17,
-	0x69, 0x2e, 0xb2, 0x34, 0xe7, 0xd0, 0x85, 0xa6, 0x69, 0x36, 0x6c, 0x78, 0x8a, 0xe9, 0x13, 0x16,
-	0x7a, 0x2a, 0x01, 0x3a, 0x28, 0x58, 0xa2, 0xcf, 0x06, 0x7f, 0xd7, 0xb4, 0x12, 0x4b, 0xe3, 0xaf,
-	0xb7, 0xed, 0x27, 0x92, 0x5f, 0x67, 0xc9, 0x2f, 0xa3, 0x74, 0x3b, 0x55, 0x9b, 0xa2, 0x4e, 0x40,
-	0x7e, 0x08, 0x78, 0x92, 0x21, 0xfa, 0x89, 0x04, 0x43, 0xf5, 0x62, 0x26, 0x5a, 0x08, 0x4f, 0xeb,
-	0x00, 0x94, 0xc5, 0xb6, 0x7c, 0xda, 0x6a, 0x3e, 0x9e, 0x76, 0x2d, 0xe3, 0x27, 0xee, 0x26, 0xd4,
-	0x49, 0x93, 0x51, 0x36, 0xa1, 0x99, 0x2c, 0x1a, 0x65, 0x13, 0x9a, 0x6a, 0xa0, 0x38, 0xcd, 0xb2,
-	0x7f, 0x0b, 0xbd, 0xd9, 0xc1, 0x26, 0x70, 0x41, 0x13, 0xfd, 0x5a, 0x82, 0x97, 0x9b, 0x28, 0x8b,
-	0x28, 0x24, 0xa7, 0x96, 0x1a, 0x68, 0xfc, 0x8d, 0xf6, 0x1d, 0x05, 0x9a, 0xcb, 0x0c, 0xcd, 0x25,
-	0xb4, 0x10, 0xbc, 0x17, 0x3c, 0x82, 0x5c, 0x52, 0x54, 0x53, 0x66, 0x5f, 0xe4, 0xf7, 0x09, 0x41,
-	0xff, 0x94, 0x20, 0x11, 0xa2, 0xbe, 0xa1, 0xe5, 0x48, 0x17, 0x60, 0xb0, 0xf8, 0x19, 0x5f, 0xe9,
-	0x2e, 0x88, 0x80, 0xba, 0xc4, 0xa0, 0xbe, 0x8e, 0x5e, 0x6b, 0xf7, 0x2a, 0x75, 0xd0, 0x13, 0xf4,
-	0x4c, 0x82, 0x78, 0x6b, 0x61, 0x2e, 0xe4, 0x51, 0x19, 0xaa, 0xfb, 0x85, 0xbc, 0x14, 0xc2, 0x15,
-	0x41, 0xbc, 0xcc, 0xe0, 0x2d, 0xa1, 0xb7, 0xc2, 0xae, 0x0c, 0xb9, 0xb5, 0x70, 0x88, 0xfe, 0x23,
-	0x41, 0x22, 0x44, 0x9e, 0x0b, 0xd9, 0xd2, 0x68, 0xea, 0x60, 0xc8, 0x96, 0x46, 0x54, 0x08, 0xf1,
-	0x1d, 0x86, 0xf9, 0x1d, 0xb4, 0x1e, 0xbc, 0xa5, 0xec, 0x9e, 0x79, 0x94, 0x6a, 0x89, 0x5b, 0x66,
-	0xd2, 0x3a, 0xbf, 0x8d, 0xbe, 0x17, 0x83, 0xd3, 0xa1, 0xba, 0x1c, 0x5a, 0x8d, 0x9e, 0x7e, 0x80,
-	0x7e, 0x18, 0x5f, 0xeb, 0x36, 0x8c, 0xe0, 0x21, 0xcf, 0x78, 0xf8, 0x00, 0xbd, 0x1f, 0xcc, 0x43,
-	0x9d, 0x00, 0xf9, 0xa8, 0x25, 0x2f, 0x6c, 0xd8, 0x92, 0xa9, 0x21, 0x2b, 0x7c, 0x31, 0x79, 0x8f,
-	0x81, 0xfe, 0x87, 0x04, 0xa7, 0x82, 0x54, 0x41, 0x74, 0xad, 0xbd, 0x1a, 0x3e, 0x28, 0x3c, 0xc6,
-	0xd3, 0x5d, 0x44, 0x10, 0x5c, 0xac, 0x32, 0x2e, 0xae, 0xa2, 0xa5, 0xf6, 0xfb, 0xc0, 0x8f, 0xe5,
-	0xdf, 0x12, 0x4c, 0x06, 0xeb, 0x83, 0x28, 0x13, 0x98, 0x6c, 0x24, 0x71, 0x32, 0xbe, 0xdc, 0x55,
-	0x0c, 0x01, 0xf9, 0x36, 0x83, 0xbc, 0x8e, 0xae, 0x47, 0x6a, 0x03, 0xd3, 0x0b, 0x2a, 0x2b, 0x3c,
-	0x2a, 0x7f, 0x1c, 0xf8, 0x9a, 0xe0, 0xab, 0x31, 0x48, 0x84, 0x68, 0x88, 0xa8, 0xc3, 0xcc, 0xeb,
-	0x54, 0xcc, 0x90, 0x63, 0x20, 0xa2, 0x8c, 0x89, 0x37, 0x19, 0xfe, 0x5b, 0xe8, 0x9d, 0x88, 0x27,
-	0x7b, 0x20, 0x03, 0xc2, 0x0a, 0xfd, 0x59, 0x82, 0x89, 0x96, 0x62, 0x64, 0x88, 0xbc, 0x16, 0xa6,
-	0x74, 0xc6, 0xaf, 0x74, 0xea, 0xde, 0xd6, 0x23, 0xc4, 0x29, 0xf2, 0x16, 0x58, 0xad, 0xcc, 0xbd,
-	0x27, 0xcf, 0x26, 0xa5, 0xa7, 0xcf, 0x26, 0xa5, 0xbf, 0x3e, 0x9b, 0x94, 0x1e, 0x3f, 0x9f, 0x3c,
-	0xf4, 0xf4, 0xf9, 0xe4, 0xa1, 0x3f, 0x3e, 0x9f, 0x3c, 0xf4, 0xde, 0xb2, 0xef, 0x7f, 0x68, 0x45,
-	0xf8, 0xb9, 0xa2, 0xb2, 0x6d, 0x79, 0x6b, 0xed, 0x2d, 0xbc, 0x99, 0xda, 0xaf, 0x5b, 0x31, 0x57,
-	0x54, 0x89, 0x4e, 0xf9, 0x5f, 0xeb, 0xf2, 0xbf, 0xb2, 0xe8, 0x63, 0xff, 0x2c, 0xfe, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x4d, 0xde, 0x96, 0x83, 0xfc, 0x2c, 0x00, 0x00,
+func (m *TakerFeeShareAgreementFromDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeShareAgreementFromDenomResponse) ProtoMessage()    {}
+func (*TakerFeeShareAgreementFromDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{44}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
-	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
-	// primitive types instead of repeated structs. Each index in the
-	// routes_pool_id field corresponds to the respective routes_token_out_denom
-	// value, thus they are required to have the same length and are grouped
-	// together as pairs.
-	// example usage:
-	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
-	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
-	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
-	EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
-	// ListPoolsByDenom return all pools by denom
-	ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
-	// TotalPoolLiquidity returns the total liquidity of the specified pool.
-	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
-	// TotalLiquidity returns the total liquidity across all pools.
-	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
-	// TotalVolumeForPool returns the total volume of the specified pool.
-	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
-	// TradingPairTakerFee returns the taker fee for a given set of denoms
-	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
-	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
-	// impact, if a trade cannot be estimated a 0 input and 0 output would be
-	// returned.
-	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
-	// AllTakerFeeShareAgreements returns all taker fee share agreements.
-	// A taker fee share agreement includes the denom of the denom getting the
-	// taker fees, the percent of the taker fees that the denom gets when it is
-	// in the route being traded against, and the address that the taker fees are
-	// sent to at epoch.
-	AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error)
-	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
-	// given denom. A taker fee share agreement includes the denom of the denom
-	// getting the taker fees, the percent of the taker fees that the denom gets
-	// when it is in the route being traded against, and the address that the
-	// taker fees are sent to at epoch.
-	TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error)
-	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
-	// the given taker fee denom (the collected fees) for the given fee share
-	// denom (the denom with the taker fee share agreement)
-	TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error)
-	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
-	// taker fee share accumulator includes the denom of the denom getting the
-	// taker fees, and an accumulated value of coins that the denom has accrued
-	// since the last time it was distributed in the epoch prior.
-	AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error)
-	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
-	// from the given denom. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error)
-	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
-	// from the given pool id. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
-	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
-	// registered alloyed pool contains the pool's contract address, along with
-	// the current distribution composition of taker fee share denoms within the
-	// alloyed pool.
-	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareAgreementFromDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TakerFeeShareAgreementFromDenomResponse proto.InternalMessageInfo
+
+func (m *TakerFeeShareAgreementFromDenomResponse) GetTakerFeeShareAgreement() types.TakerFeeShareAgreement {
+	if m != nil {
+		return m.TakerFeeShareAgreement
 	}
-	return out, nil
+	return types.TakerFeeShareAgreement{}
 }
 
-func (c *queryClient) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
-	out := new(EstimateSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TakerFeeShareDenomsToAccruedValueRequest struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TakerFeeDenom string `protobuf:"bytes,2,opt,name=takerFeeDenom,proto3" json:"takerFeeDenom,omitempty"`
 }
 
-func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Reset() {
+	*m = TakerFeeShareDenomsToAccruedValueRequest{}
 }
-
-func (c *queryClient) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeShareDenomsToAccruedValueRequest) ProtoMessage()    {}
+func (*TakerFeeShareDenomsToAccruedValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{45}
 }
-
-func (c *queryClient) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
-	out := new(EstimateSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
-	out := new(NumPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
-	out := new(PoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.Merge(m, src)
 }
-
-func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
-	out := new(AllPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error) {
-	out := new(ListPoolsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
-	out := new(SpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TakerFeeShareDenomsToAccruedValueRequest proto.InternalMessageInfo
+
+func (m *TakerFeeShareDenomsToAccruedValueRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
-	out := new(TotalPoolLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TakerFeeShareDenomsToAccruedValueRequest) GetTakerFeeDenom() string {
+	if m != nil {
+		return m.TakerFeeDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error) {
-	out := new(TotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TakerFeeShareDenomsToAccruedValueResponse struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
 }
 
-func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error) {
-	out := new(TotalVolumeForPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Reset() {
+	*m = TakerFeeShareDenomsToAccruedValueResponse{}
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TakerFeeShareDenomsToAccruedValueResponse) ProtoMessage() {}
+func (*TakerFeeShareDenomsToAccruedValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{46}
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.Merge(m, src)
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
-	out := new(TradingPairTakerFeeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_TakerFeeShareDenomsToAccruedValueResponse proto.InternalMessageInfo
+
+type AllTakerFeeShareAccumulatorsRequest struct {
 }
 
-func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
-	out := new(EstimateTradeBasedOnPriceImpactResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AllTakerFeeShareAccumulatorsRequest) Reset()         { *m = AllTakerFeeShareAccumulatorsRequest{} }
+func (m *AllTakerFeeShareAccumulatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllTakerFeeShareAccumulatorsRequest) ProtoMessage()    {}
+func (*AllTakerFeeShareAccumulatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{47}
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.Merge(m, src)
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllTakerFeeShareAccumulatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error) {
-	out := new(AllTakerFeeShareAgreementsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_AllTakerFeeShareAccumulatorsRequest proto.InternalMessageInfo
+
+type AllTakerFeeShareAccumulatorsResponse struct {
+	TakerFeeSkimAccumulators []types.TakerFeeSkimAccumulator `protobuf:"bytes,1,rep,name=taker_fee_skim_accumulators,json=takerFeeSkimAccumulators,proto3" json:"taker_fee_skim_accumulators"`
 }
 
-func (c *queryClient) TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error) {
-	out := new(TakerFeeShareAgreementFromDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AllTakerFeeShareAccumulatorsResponse) Reset()         { *m = AllTakerFeeShareAccumulatorsResponse{} }
+func (m *AllTakerFeeShareAccumulatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllTakerFeeShareAccumulatorsResponse) ProtoMessage()    {}
+func (*AllTakerFeeShareAccumulatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{48}
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.Merge(m, src)
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
-	out := new(TakerFeeShareDenomsToAccruedValueResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllTakerFeeShareAccumulatorsResponse proto.InternalMessageInfo
+
+func (m *AllTakerFeeShareAccumulatorsResponse) GetTakerFeeSkimAccumulators() []types.TakerFeeSkimAccumulator {
+	if m != nil {
+		return m.TakerFeeSkimAccumulators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error) {
-	out := new(AllTakerFeeShareAccumulatorsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RegisteredAlloyedPoolFromDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *queryClient) RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error) {
-	out := new(RegisteredAlloyedPoolFromDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RegisteredAlloyedPoolFromDenomRequest) Reset()         { *m = RegisteredAlloyedPoolFromDenomRequest{} }
+func (m *RegisteredAlloyedPoolFromDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAlloyedPoolFromDenomRequest) ProtoMessage()    {}
+func (*RegisteredAlloyedPoolFromDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{49}
 }
-
-func (c *queryClient) RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
-	out := new(RegisteredAlloyedPoolFromPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.Merge(m, src)
+}
+func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error) {
-	out := new(AllRegisteredAlloyedPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RegisteredAlloyedPoolFromDenomRequest proto.InternalMessageInfo
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// Estimates swap amount out given in.
-	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
-	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
-	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
-	// primitive types instead of repeated structs. Each index in the
-	// routes_pool_id field corresponds to the respective routes_token_out_denom
-	// value, thus they are required to have the same length and are grouped
-	// together as pairs.
-	// example usage:
-	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
-	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
-	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
-	EstimateSwapExactAmountInWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error)
-	EstimateSinglePoolSwapExactAmountIn(context.Context, *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
-	// Estimates swap amount in given out.
-	EstimateSwapExactAmountOutWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error)
-	EstimateSinglePoolSwapExactAmountOut(context.Context, *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
-	// Returns the total number of pools existing in Osmosis.
-	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
-	// Pool returns the Pool specified by the pool id
-	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
-	// AllPools returns all pools on the Osmosis chain sorted by IDs.
-	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
-	// ListPoolsByDenom return all pools by denom
-	ListPoolsByDenom(context.Context, *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error)
-	// SpotPrice defines a gRPC query handler that returns the spot price given
-	// a base denomination and a quote denomination.
-	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
-	// TotalPoolLiquidity returns the total liquidity of the specified pool.
-	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
-	// TotalLiquidity returns the total liquidity across all pools.
-	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
-	// TotalVolumeForPool returns the total volume of the specified pool.
-	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
-	// TradingPairTakerFee returns the taker fee for a given set of denoms
-	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
-	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
-	// impact, if a trade cannot be estimated a 0 input and 0 output would be
-	// returned.
-	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
-	// AllTakerFeeShareAgreements returns all taker fee share agreements.
-	// A taker fee share agreement includes the denom of the denom getting the
-	// taker fees, the percent of the taker fees that the denom gets when it is
-	// in the route being traded against, and the address that the taker fees are
-	// sent to at epoch.
-	AllTakerFeeShareAgreements(context.Context, *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error)
-	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
-	// given denom. A taker fee share agreement includes the denom of the denom
-	// getting the taker fees, the percent of the taker fees that the denom gets
-	// when it is in the route being traded against, and the address that the
-	// taker fees are sent to at epoch.
-	TakerFeeShareAgreementFromDenom(context.Context, *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error)
-	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
-	// the given taker fee denom (the collected fees) for the given fee share
-	// denom (the denom with the taker fee share agreement)
-	TakerFeeShareDenomsToAccruedValue(context.Context, *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error)
-	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
-	// taker fee share accumulator includes the denom of the denom getting the
-	// taker fees, and an accumulated value of coins that the denom has accrued
-	// since the last time it was distributed in the epoch prior.
-	AllTakerFeeShareAccumulators(context.Context, *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error)
-	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
-	// from the given denom. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromDenom(context.Context, *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error)
-	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
-	// from the given pool id. The registered alloyed pool contains the pool's
-	// contract address, along with the current distribution composition of taker
-	// fee share denoms within the alloyed pool.
-	RegisteredAlloyedPoolFromPoolId(context.Context, *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
-	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
-	// registered alloyed pool contains the pool's contract address, along with
-	// the current distribution composition of taker fee share denoms within the
-	// alloyed pool.
-	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+type RegisteredAlloyedPoolFromDenomResponse struct {
+	ContractState types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,opt,name=contract_state,json=contractState,proto3" json:"contract_state"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *RegisteredAlloyedPoolFromDenomResponse) Reset() {
+	*m = RegisteredAlloyedPoolFromDenomResponse{}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAlloyedPoolFromDenomResponse) ProtoMessage()    {}
+func (*RegisteredAlloyedPoolFromDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{50}
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInWithPrimitiveTypes not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, req *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountIn not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOutWithPrimitiveTypes not implemented")
+func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, req *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountOut not implemented")
+
+var xxx_messageInfo_RegisteredAlloyedPoolFromDenomResponse proto.InternalMessageInfo
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) GetContractState() types.AlloyContractTakerFeeShareState {
+	if m != nil {
+		return m.ContractState
+	}
+	return types.AlloyContractTakerFeeShareState{}
 }
-func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+
+type RegisteredAlloyedPoolFromPoolIdRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Reset() {
+	*m = RegisteredAlloyedPoolFromPoolIdRequest{}
 }
-func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAlloyedPoolFromPoolIdRequest) ProtoMessage()    {}
+func (*RegisteredAlloyedPoolFromPoolIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{51}
 }
-func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalLiquidityRequest) (*TotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
+
+var xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdRequest proto.InternalMessageInfo
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
+
+type RegisteredAlloyedPoolFromPoolIdResponse struct {
+	ContractState types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,opt,name=contract_state,json=contractState,proto3" json:"contract_state"`
 }
-func (*UnimplementedQueryServer) AllTakerFeeShareAgreements(ctx context.Context, req *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAgreements not implemented")
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Reset() {
+	*m = RegisteredAlloyedPoolFromPoolIdResponse{}
 }
-func (*UnimplementedQueryServer) TakerFeeShareAgreementFromDenom(ctx context.Context, req *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareAgreementFromDenom not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAlloyedPoolFromPoolIdResponse) ProtoMessage()    {}
+func (*RegisteredAlloyedPoolFromPoolIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{52}
 }
-func (*UnimplementedQueryServer) TakerFeeShareDenomsToAccruedValue(ctx context.Context, req *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareDenomsToAccruedValue not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllTakerFeeShareAccumulators(ctx context.Context, req *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAccumulators not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromDenom(ctx context.Context, req *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromDenom not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Context, req *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromPoolId not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_RegisteredAlloyedPoolFromPoolIdResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) GetContractState() types.AlloyContractTakerFeeShareState {
+	if m != nil {
+		return m.ContractState
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.AlloyContractTakerFeeShareState{}
 }
 
-func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AllRegisteredAlloyedPoolsRequest struct {
 }
 
-func _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountInWithPrimitiveTypesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountInWithPrimitiveTypesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AllRegisteredAlloyedPoolsRequest) Reset()         { *m = AllRegisteredAlloyedPoolsRequest{} }
+func (m *AllRegisteredAlloyedPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllRegisteredAlloyedPoolsRequest) ProtoMessage()    {}
+func (*AllRegisteredAlloyedPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{53}
 }
-
-func _Query_EstimateSinglePoolSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSinglePoolSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, req.(*EstimateSinglePoolSwapExactAmountInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AllRegisteredAlloyedPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
+func (m *AllRegisteredAlloyedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSwapExactAmountOutWithPrimitiveTypesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountOutWithPrimitiveTypesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AllRegisteredAlloyedPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.Merge(m, src)
 }
-
-func _Query_EstimateSinglePoolSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSinglePoolSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, req.(*EstimateSinglePoolSwapExactAmountOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AllRegisteredAlloyedPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRegisteredAlloyedPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRegisteredAlloyedPoolsRequest.DiscardUnknown(m)
 }
 
-func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_AllRegisteredAlloyedPoolsRequest proto.InternalMessageInfo
+
+type AllRegisteredAlloyedPoolsResponse struct {
+	ContractStates []types.AlloyContractTakerFeeShareState `protobuf:"bytes,1,rep,name=contract_states,json=contractStates,proto3" json:"contract_states"`
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
+func (m *AllRegisteredAlloyedPoolsResponse) Reset()         { *m = AllRegisteredAlloyedPoolsResponse{} }
+func (m *AllRegisteredAlloyedPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllRegisteredAlloyedPoolsResponse) ProtoMessage()    {}
+func (*AllRegisteredAlloyedPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{54}
+}
+func (m *AllRegisteredAlloyedPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllRegisteredAlloyedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AllRegisteredAlloyedPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.Merge(m, src)
+}
+func (m *AllRegisteredAlloyedPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRegisteredAlloyedPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRegisteredAlloyedPoolsResponse.DiscardUnknown(m)
 }
 
-func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
+var xxx_messageInfo_AllRegisteredAlloyedPoolsResponse proto.InternalMessageInfo
+
+func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyContractTakerFeeShareState {
+	if m != nil {
+		return m.ContractStates
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPoolsByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*ListPoolsByDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
+	proto.RegisterType((*EstimateSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
+	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
+	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
+	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutResponse")
+	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.NumPoolsRequest")
+	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.NumPoolsResponse")
+	proto.RegisterType((*PoolRequest)(nil), "osmosis.poolmanager.v1beta1.PoolRequest")
+	proto.RegisterType((*PoolResponse)(nil), "osmosis.poolmanager.v1beta1.PoolResponse")
+	proto.RegisterType((*AllPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllPoolsRequest")
+	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
+	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
+	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
+	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
+	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
+	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
+	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
+	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
+	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
+	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
+	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
+	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
+	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
+	proto.RegisterType((*ExplainTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.ExplainTakerFeeRequest")
+	proto.RegisterType((*ExplainTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.ExplainTakerFeeResponse")
+	proto.RegisterType((*TakerFeeHop)(nil), "osmosis.poolmanager.v1beta1.TakerFeeHop")
+	proto.RegisterType((*AccountVolumeRequest)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeRequest")
+	proto.RegisterType((*AccountVolumeResponse)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeResponse")
+	proto.RegisterType((*AccountVolumeConfigRequest)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeConfigRequest")
+	proto.RegisterType((*AccountVolumeConfigResponse)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeConfigResponse")
+	proto.RegisterType((*TakerFeeDiscountTiersRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountTiersRequest")
+	proto.RegisterType((*TakerFeeDiscountTiersResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountTiersResponse")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
+	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*OptimalRouteRequest)(nil), "osmosis.poolmanager.v1beta1.OptimalRouteRequest")
+	proto.RegisterType((*OptimalRouteResponse)(nil), "osmosis.poolmanager.v1beta1.OptimalRouteResponse")
+	proto.RegisterType((*AllTakerFeeShareAgreementsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsRequest")
+	proto.RegisterType((*AllTakerFeeShareAgreementsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAgreementsResponse")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomRequest")
+	proto.RegisterType((*TakerFeeShareAgreementFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareAgreementFromDenomResponse")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueRequest")
+	proto.RegisterType((*TakerFeeShareDenomsToAccruedValueResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeShareDenomsToAccruedValueResponse")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsRequest)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsRequest")
+	proto.RegisterType((*AllTakerFeeShareAccumulatorsResponse)(nil), "osmosis.poolmanager.v1beta1.AllTakerFeeShareAccumulatorsResponse")
+	proto.RegisterType((*RegisteredAlloyedPoolFromDenomRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomRequest")
+	proto.RegisterType((*RegisteredAlloyedPoolFromDenomResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromDenomResponse")
+	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdRequest)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdRequest")
+	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
+	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
+	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
 }
 
-func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpotPriceRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/query.proto", fileDescriptor_6256a4106f701b7d)
+}
+
+var fileDescriptor_6256a4106f701b7d = []byte{
+	// 3325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0x6c, 0x1c, 0xc7, 0x3e, 0xfe, 0xcd, 0x8d, 0xed, 0x6c, 0x26, 0xa9, 0xd7, 0xb9, 0xf9,
+	0x73, 0x7e, 0xbc, 0x1b, 0x3b, 0x49, 0x93, 0xa6, 0x75, 0xd2, 0x5d, 0xdb, 0x69, 0x4c, 0x53, 0x92,
+	0x8c, 0x4d, 0x0a, 0x6d, 0xd3, 0xd1, 0x64, 0xf7, 0xc6, 0x19, 0x65, 0x77, 0x66, 0x33, 0x73, 0xd7,
+	0xb1, 0xa9, 0x82, 0x00, 0x09, 0x95, 0x27, 0x54, 0x28, 0x52, 0x91, 0x40, 0xaa, 0xfa, 0x80, 0x90,
+	0xe0, 0x01, 0x21, 0x15, 0x24, 0x1e, 0x80, 0x17, 0x1e, 0x2a, 0xa4, 0xa2, 0x48, 0x7d, 0x41, 0x48,
+	0x2c, 0x28, 0xe1, 0x01, 0x01, 0x12, 0xd2, 0x3e, 0xf2, 0x02, 0x9a, 0x7b, 0xef, 0xcc, 0xce, 0xac,
+	0x77, 0x67, 0x67, 0x76, 0xd3, 0xaa, 0x4f, 0x99, 0xbd, 0xf7, 0x9c, 0x73, 0xbf, 0xef, 0xdc, 0x73,
+	0xee, 0xdf, 0x71, 0xe0, 0xa8, 0x69, 0x97, 0x4c, 0x5b, 0xb7, 0x33, 0x65, 0xd3, 0x2c, 0x96, 0x34,
+	0x43, 0x5b, 0x23, 0x56, 0x66, 0x7d, 0xf6, 0x36, 0xa1, 0xda, 0x6c, 0xe6, 0x7e, 0x85, 0x58, 0x9b,
+	0xe9, 0xb2, 0x65, 0x52, 0x13, 0xed, 0x13, 0x82, 0x69, 0x9f, 0x60, 0x5a, 0x08, 0xca, 0x63, 0x6b,
+	0xe6, 0x9a, 0xc9, 0xe4, 0x32, 0xce, 0x17, 0x57, 0x91, 0x8f, 0x85, 0xd9, 0x5e, 0x23, 0x06, 0x61,
+	0xe6, 0x98, 0xe8, 0xa1, 0x30, 0x51, 0xba, 0x21, 0xa4, 0x4e, 0x86, 0x49, 0xd9, 0x0f, 0xb4, 0xb2,
+	0x6a, 0x99, 0x15, 0x4a, 0x84, 0xf4, 0x6c, 0xa8, 0x4d, 0xed, 0x1e, 0xb1, 0xd4, 0x3b, 0x84, 0xa8,
+	0xf6, 0x5d, 0xcd, 0x72, 0x55, 0x26, 0xf3, 0x4c, 0x27, 0x73, 0x5b, 0xb3, 0x89, 0x27, 0x9a, 0x37,
+	0x75, 0x43, 0xf4, 0x1f, 0xf7, 0xf7, 0x33, 0xef, 0x78, 0x52, 0x65, 0x6d, 0x4d, 0x37, 0x34, 0xaa,
+	0x9b, 0xae, 0xec, 0xfe, 0x35, 0xd3, 0x5c, 0x2b, 0x92, 0x8c, 0x56, 0xd6, 0x33, 0x9a, 0x61, 0x98,
+	0x94, 0x75, 0xba, 0x84, 0xf7, 0x8a, 0x5e, 0xf6, 0xeb, 0x76, 0xe5, 0x4e, 0x46, 0x33, 0x36, 0xdd,
+	0x2e, 0x3e, 0x88, 0xca, 0xfd, 0xc9, 0x7f, 0x88, 0xae, 0x54, 0xa3, 0x16, 0xd5, 0x4b, 0xc4, 0xa6,
+	0x5a, 0xa9, 0xcc, 0x05, 0xf0, 0x08, 0x0c, 0x5d, 0xd7, 0x2c, 0xad, 0x64, 0x2b, 0xe4, 0x7e, 0x85,
+	0xd8, 0x14, 0xaf, 0xc0, 0xb0, 0xdb, 0x60, 0x97, 0x4d, 0xc3, 0x26, 0x28, 0x0b, 0xbd, 0x65, 0xd6,
+	0x92, 0x94, 0xa6, 0xa4, 0xe9, 0x81, 0xb9, 0x83, 0xe9, 0x90, 0x99, 0x4d, 0x73, 0xe5, 0x5c, 0xcf,
+	0x47, 0xd5, 0xd4, 0x36, 0x45, 0x28, 0xe2, 0x5f, 0x24, 0x60, 0x6a, 0xc9, 0xa6, 0x7a, 0x49, 0xa3,
+	0x64, 0xe5, 0x81, 0x56, 0x5e, 0xda, 0xd0, 0xf2, 0x34, 0x5b, 0x32, 0x2b, 0x06, 0x5d, 0x36, 0xc4,
+	0xc8, 0x68, 0x1e, 0x7a, 0x6d, 0x62, 0x14, 0x88, 0xc5, 0xc6, 0xe9, 0xcf, 0x1d, 0xae, 0x55, 0x53,
+	0xa9, 0x4d, 0xad, 0x54, 0xbc, 0x80, 0x79, 0x3b, 0x3e, 0x59, 0x20, 0x65, 0x8b, 0xe4, 0x35, 0x4a,
+	0x0a, 0x17, 0x30, 0xb5, 0x2a, 0x04, 0x27, 0x25, 0x45, 0x28, 0xa1, 0x4b, 0xb0, 0xd3, 0xc1, 0xa3,
+	0xea, 0x85, 0x64, 0x62, 0x4a, 0x9a, 0xee, 0xc9, 0x1d, 0xa9, 0x55, 0x53, 0x53, 0x5c, 0x5f, 0x74,
+	0xb4, 0x30, 0xe0, 0xf4, 0x2e, 0x17, 0x50, 0x1a, 0xfa, 0xa8, 0x79, 0x8f, 0x18, 0xaa, 0x6e, 0x24,
+	0xb7, 0x33, 0x04, 0xbb, 0x6b, 0xd5, 0xd4, 0x08, 0xb7, 0xe0, 0xf6, 0x60, 0x65, 0x27, 0xfb, 0x5c,
+	0x36, 0xd0, 0x2d, 0xe8, 0x65, 0xd1, 0x63, 0x27, 0x7b, 0xa6, 0xb6, 0x4f, 0x0f, 0xcc, 0xa5, 0x43,
+	0xfd, 0xe2, 0xd0, 0xf6, 0x18, 0x3b, 0x6a, 0xb9, 0x71, 0xc7, 0x45, 0xb5, 0x6a, 0x6a, 0x88, 0x8f,
+	0xc0, 0x6d, 0x61, 0x45, 0x18, 0xc5, 0xbf, 0x4d, 0xc0, 0x5c, 0x4b, 0x9f, 0xbd, 0xaa, 0xd3, 0xbb,
+	0xd7, 0x2d, 0xbd, 0xa4, 0x53, 0x7d, 0x9d, 0xac, 0x6e, 0x96, 0x89, 0x3b, 0x7f, 0x7e, 0x37, 0x48,
+	0x5d, 0xbb, 0x21, 0x11, 0xc1, 0x0d, 0x97, 0x60, 0x98, 0x23, 0x56, 0xdd, 0x71, 0xb7, 0x4f, 0x6d,
+	0x9f, 0xee, 0xc9, 0xed, 0xad, 0x55, 0x53, 0xe3, 0x7e, 0x6a, 0x6e, 0x3f, 0x56, 0x06, 0x79, 0xc3,
+	0x75, 0x3e, 0xe0, 0x4d, 0x98, 0x10, 0x02, 0xdc, 0xba, 0x59, 0xa1, 0x6a, 0x81, 0x18, 0x66, 0x89,
+	0xf9, 0xb5, 0x3f, 0x77, 0xa0, 0x56, 0x4d, 0x3d, 0x13, 0x30, 0xd4, 0x20, 0x87, 0x95, 0xdd, 0xbc,
+	0x63, 0xd5, 0x69, 0xbf, 0x56, 0xa1, 0x8b, 0xac, 0xf5, 0x63, 0x09, 0x8e, 0x7b, 0x0e, 0xd4, 0x8d,
+	0xb5, 0x22, 0x71, 0x06, 0x6c, 0x19, 0x7e, 0x27, 0x1a, 0x1d, 0x87, 0x6a, 0xd5, 0xd4, 0x70, 0xd0,
+	0x71, 0x1d, 0x3b, 0x29, 0x07, 0x23, 0x8d, 0xe4, 0x78, 0x88, 0xc9, 0xb5, 0x6a, 0x6a, 0xc2, 0xaf,
+	0xe6, 0x63, 0x35, 0x44, 0x03, 0x7c, 0xde, 0x96, 0xe0, 0x40, 0x48, 0x12, 0x89, 0x6c, 0xbd, 0x0d,
+	0xa3, 0x75, 0x43, 0x1a, 0xeb, 0x15, 0xf9, 0x74, 0xde, 0x89, 0xb7, 0x3f, 0x57, 0x53, 0xe3, 0x7c,
+	0x85, 0xb0, 0x0b, 0xf7, 0xd2, 0xba, 0x99, 0x29, 0x69, 0xf4, 0x6e, 0x7a, 0xd9, 0xa0, 0xb5, 0x6a,
+	0x6a, 0x4f, 0x23, 0x0e, 0xae, 0x8e, 0x95, 0x61, 0x17, 0x08, 0x1f, 0x0d, 0xff, 0x2a, 0xd1, 0x12,
+	0xc9, 0xb5, 0x0a, 0xfd, 0xbc, 0xe4, 0xf3, 0x9b, 0x5e, 0x7e, 0x6e, 0x67, 0xf9, 0x99, 0x89, 0x98,
+	0x9f, 0x0e, 0x85, 0x08, 0x09, 0x8a, 0x66, 0xa1, 0xdf, 0x73, 0x55, 0xb2, 0x87, 0x51, 0x1c, 0xab,
+	0x55, 0x53, 0xa3, 0x0d, 0x5e, 0xc4, 0x4a, 0x9f, 0xeb, 0x3e, 0xfc, 0xbb, 0x04, 0x9c, 0x6e, 0xed,
+	0xb8, 0x4f, 0x31, 0xa9, 0xb7, 0x26, 0x69, 0x22, 0x5e, 0x92, 0xae, 0xc0, 0x78, 0x20, 0xf9, 0x74,
+	0xc3, 0x0b, 0x63, 0x27, 0x47, 0xa7, 0x6a, 0xd5, 0xd4, 0xfe, 0x26, 0x39, 0xea, 0x8a, 0x61, 0x05,
+	0xf9, 0x52, 0x74, 0xd9, 0x60, 0x11, 0xdd, 0x89, 0x07, 0xff, 0x28, 0xc1, 0x89, 0xb6, 0x49, 0xed,
+	0x0b, 0xc2, 0x58, 0x59, 0x7d, 0x09, 0x86, 0x1b, 0xd8, 0xf1, 0xdc, 0xf6, 0x79, 0xa9, 0x91, 0xd6,
+	0x20, 0x6d, 0x49, 0x68, 0x7b, 0x24, 0x42, 0xdf, 0x92, 0x00, 0x87, 0xe5, 0x92, 0x48, 0x6b, 0xd5,
+	0x5d, 0x40, 0x74, 0x23, 0x98, 0xd5, 0xe7, 0xda, 0x65, 0xf5, 0x44, 0x03, 0x70, 0x37, 0xa9, 0x87,
+	0x04, 0x72, 0x91, 0xd3, 0xbb, 0x60, 0xe4, 0x8b, 0x95, 0x92, 0xe3, 0x4c, 0xef, 0x28, 0xb0, 0x04,
+	0xa3, 0xf5, 0x26, 0x81, 0x63, 0x16, 0xfa, 0x8d, 0x4a, 0x89, 0x45, 0x89, 0x2d, 0x3c, 0xea, 0x63,
+	0xe8, 0x75, 0x61, 0xa5, 0xcf, 0x10, 0xaa, 0xf8, 0x02, 0x0c, 0x38, 0x1f, 0x9d, 0xcc, 0x08, 0x5e,
+	0x80, 0x41, 0xae, 0x2b, 0x86, 0x3f, 0x0d, 0x3d, 0x4e, 0x8f, 0x38, 0x89, 0x8c, 0xa5, 0xf9, 0xf1,
+	0x26, 0xed, 0x1e, 0x6f, 0xd2, 0x59, 0x63, 0x33, 0xd7, 0xff, 0x87, 0x0f, 0x67, 0x76, 0xb0, 0xb0,
+	0x55, 0x98, 0xb0, 0x43, 0x2d, 0x5b, 0x2c, 0x06, 0xa8, 0x2d, 0xc3, 0x68, 0xbd, 0x49, 0xd8, 0x3e,
+	0x0b, 0x3b, 0x5c, 0x5a, 0xdb, 0xa3, 0x18, 0xe7, 0xd2, 0x38, 0x0b, 0x7b, 0xae, 0xea, 0x36, 0x65,
+	0xb6, 0x72, 0x9b, 0x2c, 0x0e, 0x5c, 0xaa, 0x47, 0x60, 0x07, 0x0f, 0x23, 0x3e, 0x55, 0xa3, 0xb5,
+	0x6a, 0x6a, 0x90, 0x13, 0x15, 0xd1, 0xc3, 0xbb, 0xf1, 0x0d, 0x48, 0x6e, 0x35, 0xd1, 0x1d, 0xaa,
+	0x47, 0x12, 0x8c, 0xae, 0x94, 0x4d, 0x7a, 0xdd, 0xd2, 0xf3, 0xa4, 0xa3, 0x64, 0x58, 0x82, 0x51,
+	0xe7, 0xd4, 0xaa, 0x6a, 0xb6, 0x4d, 0x68, 0x20, 0x1d, 0xf6, 0xd5, 0xf7, 0x8a, 0x46, 0x09, 0xac,
+	0x0c, 0x3b, 0x4d, 0x59, 0xa7, 0x85, 0xa7, 0xc4, 0x15, 0xd8, 0x75, 0xbf, 0x62, 0xd2, 0xa0, 0x1d,
+	0x9e, 0x1a, 0xfb, 0x6b, 0xd5, 0x54, 0x92, 0xdb, 0xd9, 0x22, 0x82, 0x95, 0x11, 0xd6, 0x56, 0xb7,
+	0x84, 0x97, 0x61, 0x97, 0x8f, 0x91, 0x70, 0xcf, 0x19, 0x00, 0xbb, 0x6c, 0x52, 0xb5, 0xec, 0xb4,
+	0x0a, 0x3f, 0x8f, 0xd7, 0xaa, 0xa9, 0x5d, 0xdc, 0x6e, 0xbd, 0x0f, 0x2b, 0xfd, 0xb6, 0xab, 0x8d,
+	0xaf, 0xc0, 0xde, 0x55, 0x93, 0x6a, 0x2c, 0x00, 0xae, 0xea, 0xf7, 0x2b, 0x7a, 0x41, 0xa7, 0x9b,
+	0x1d, 0x05, 0xe8, 0x0f, 0x25, 0x90, 0x9b, 0x99, 0x12, 0xf0, 0x1e, 0x42, 0x7f, 0xd1, 0x6d, 0x14,
+	0x33, 0xb8, 0x37, 0x2d, 0x4e, 0xe8, 0x8e, 0xa3, 0xbc, 0xed, 0x67, 0xc1, 0xd4, 0x8d, 0xdc, 0xa2,
+	0xd8, 0x70, 0x44, 0x36, 0x79, 0x9a, 0xf8, 0xa7, 0x7f, 0x4d, 0x4d, 0xaf, 0xe9, 0xf4, 0x6e, 0xe5,
+	0x76, 0x3a, 0x6f, 0x96, 0xc4, 0x11, 0x5f, 0xfc, 0x33, 0x63, 0x17, 0xee, 0x65, 0xa8, 0xb3, 0x5b,
+	0x30, 0x23, 0xb6, 0x52, 0x1f, 0x11, 0xef, 0x81, 0x71, 0x06, 0xae, 0x91, 0x23, 0x7e, 0x4f, 0x82,
+	0x89, 0xc6, 0x9e, 0xcf, 0x07, 0x64, 0x77, 0x6a, 0x6e, 0x9a, 0xc5, 0x4a, 0x89, 0x5c, 0x36, 0xad,
+	0x8e, 0xd7, 0x8e, 0xef, 0xb9, 0x53, 0xd3, 0x60, 0x4a, 0xf0, 0xa4, 0xd0, 0xbb, 0xce, 0x3a, 0xda,
+	0x93, 0xcc, 0x06, 0x0f, 0x02, 0x5c, 0x2d, 0x1e, 0x43, 0x31, 0x16, 0x5e, 0x07, 0x79, 0xd5, 0xd2,
+	0x0a, 0xba, 0xb1, 0x76, 0x5d, 0xd3, 0xad, 0x55, 0xe7, 0x52, 0x79, 0x99, 0xf8, 0x13, 0x94, 0x45,
+	0xbf, 0x7a, 0x4a, 0x84, 0xb2, 0x8f, 0x9f, 0xe8, 0xc0, 0x4a, 0x2f, 0xfb, 0x3a, 0x55, 0x17, 0x9e,
+	0x4d, 0x26, 0x9a, 0x0b, 0xcf, 0xba, 0xc2, 0xb3, 0x58, 0x85, 0x7d, 0x4d, 0xc7, 0x15, 0xce, 0x78,
+	0x11, 0xfa, 0xbd, 0x0b, 0xae, 0x18, 0xfa, 0xa0, 0xd8, 0x58, 0xf6, 0x6d, 0xdd, 0x58, 0xae, 0x92,
+	0x35, 0x2d, 0xbf, 0xb9, 0x48, 0xf2, 0x4a, 0x1f, 0x15, 0x96, 0xf0, 0x13, 0x09, 0x26, 0x96, 0x36,
+	0xca, 0x45, 0x4d, 0x37, 0x1a, 0x59, 0x6d, 0xdd, 0x56, 0xa5, 0x78, 0xdb, 0x6a, 0xfd, 0xa6, 0x95,
+	0xf8, 0x14, 0x6e, 0x5a, 0xe8, 0x98, 0x77, 0x50, 0xe5, 0xeb, 0xd2, 0xae, 0xba, 0x28, 0x6f, 0xc7,
+	0xee, 0xa1, 0x14, 0x7f, 0x22, 0xc1, 0x9e, 0x2d, 0x2c, 0x85, 0x0f, 0x6f, 0x40, 0xcf, 0x5d, 0xb3,
+	0xec, 0x2e, 0xd4, 0xd3, 0xa1, 0x18, 0x5d, 0xe5, 0x2b, 0x66, 0x39, 0xb7, 0x5b, 0xa0, 0x1b, 0xe0,
+	0x43, 0x3a, 0x36, 0xb0, 0xc2, 0x4c, 0x21, 0xe2, 0xec, 0xfa, 0x54, 0x2b, 0xaa, 0xf5, 0xc9, 0xe1,
+	0x53, 0x3d, 0x1f, 0x61, 0x72, 0xfc, 0x7b, 0x7f, 0xc0, 0x06, 0xdb, 0xfb, 0xa9, 0x56, 0x74, 0x41,
+	0xe0, 0xaf, 0xf7, 0xc0, 0x80, 0x0f, 0xd1, 0x67, 0x7c, 0x68, 0x7a, 0x0a, 0x77, 0x23, 0xb4, 0xea,
+	0x8f, 0xdf, 0x9e, 0xc0, 0xc1, 0xa8, 0x8d, 0x8b, 0xdc, 0xb3, 0x59, 0xdd, 0x39, 0x5e, 0x4c, 0xa3,
+	0x9b, 0xd0, 0x6b, 0x9b, 0x15, 0x2b, 0x4f, 0x92, 0x3b, 0xa6, 0xa4, 0xe9, 0xe1, 0xb9, 0x13, 0x91,
+	0xe6, 0x74, 0x85, 0xa9, 0x04, 0xa2, 0x88, 0xb5, 0x38, 0x51, 0xc4, 0x3e, 0xd0, 0x3c, 0x0c, 0x15,
+	0x74, 0x3b, 0xef, 0x84, 0xa8, 0x4a, 0x75, 0x62, 0x25, 0x7b, 0x19, 0xe2, 0x64, 0xad, 0x9a, 0x1a,
+	0x13, 0xf9, 0xeb, 0xef, 0xc6, 0xca, 0xa0, 0xfb, 0x7b, 0x55, 0x27, 0x16, 0x52, 0xa0, 0xcf, 0xfd,
+	0x9d, 0xdc, 0xc9, 0x34, 0x9f, 0x8d, 0xc6, 0x75, 0x24, 0x68, 0x1c, 0x2b, 0x9e, 0x1d, 0xbc, 0x08,
+	0x63, 0xd9, 0x3c, 0xfb, 0xe4, 0xab, 0xa5, 0x9b, 0xbb, 0x27, 0x61, 0xa7, 0x56, 0x28, 0x58, 0xc4,
+	0xb6, 0xb7, 0xae, 0x48, 0xa2, 0x03, 0x2b, 0xae, 0x08, 0x7e, 0x3b, 0x01, 0xe3, 0x0d, 0x66, 0x44,
+	0x72, 0x9c, 0x83, 0x01, 0xbe, 0xc7, 0xfb, 0x17, 0x80, 0x89, 0x5a, 0x35, 0x85, 0xfc, 0x07, 0x00,
+	0x31, 0xb9, 0xc0, 0x7e, 0xf1, 0x99, 0x7d, 0x03, 0x76, 0x3e, 0xd0, 0x8d, 0x82, 0xf9, 0xc0, 0x4d,
+	0xfe, 0xe3, 0xa1, 0x93, 0x10, 0x18, 0x3d, 0x37, 0x21, 0x52, 0x4b, 0x00, 0x16, 0x86, 0xb0, 0xe2,
+	0x9a, 0x44, 0xaf, 0xc2, 0x20, 0x4f, 0x0e, 0xb1, 0x15, 0xf0, 0xc0, 0x3b, 0xd3, 0xee, 0x4c, 0xbd,
+	0xdb, 0x9f, 0x57, 0x62, 0x65, 0x57, 0x06, 0x68, 0x7d, 0xb3, 0xc1, 0xfb, 0x41, 0x0e, 0x40, 0x59,
+	0x30, 0x8d, 0x3b, 0xfa, 0x9a, 0xbb, 0xfd, 0x7e, 0x0d, 0xf6, 0x35, 0xed, 0xf5, 0x0e, 0xfb, 0xbd,
+	0x79, 0xd6, 0x22, 0xce, 0xb9, 0xa7, 0xa2, 0x53, 0xe6, 0x96, 0x1a, 0x57, 0x3c, 0x6e, 0x0d, 0x2b,
+	0xc2, 0x2c, 0x9e, 0x84, 0xfd, 0x6e, 0xb4, 0x2e, 0xfa, 0x22, 0xcb, 0xae, 0xe3, 0x7b, 0xa6, 0x45,
+	0xbf, 0x40, 0x78, 0x0b, 0x76, 0x38, 0x91, 0xe9, 0x2e, 0x76, 0xb3, 0x91, 0x12, 0xc3, 0x6f, 0x2a,
+	0x37, 0x26, 0x10, 0x8a, 0x03, 0x31, 0xb3, 0x86, 0x15, 0x6e, 0xd5, 0x79, 0xfb, 0x3a, 0xe2, 0x5e,
+	0x8a, 0x9c, 0x6d, 0x8b, 0xe4, 0x34, 0x9b, 0x14, 0xae, 0x19, 0xec, 0xf4, 0xb6, 0x5c, 0x2a, 0x6b,
+	0x79, 0xef, 0x82, 0xf7, 0x02, 0xf4, 0xdf, 0xb1, 0xcc, 0x92, 0xea, 0x3c, 0xba, 0x0a, 0x77, 0x85,
+	0xec, 0xe4, 0xfc, 0x59, 0xb2, 0xcf, 0xd1, 0x70, 0x7e, 0x23, 0x0c, 0x43, 0xd4, 0x64, 0xba, 0xfe,
+	0xb5, 0xcb, 0x99, 0x4a, 0xa7, 0x9b, 0x47, 0xe0, 0x9e, 0xfa, 0x6a, 0xe8, 0x84, 0x47, 0x8f, 0xb7,
+	0xf2, 0xbd, 0x02, 0xa3, 0x25, 0x6d, 0x83, 0x1f, 0x2f, 0x55, 0x9d, 0xa1, 0x4a, 0xf6, 0x44, 0xdf,
+	0x3b, 0x87, 0x4b, 0xda, 0x86, 0x8f, 0x10, 0xfa, 0x02, 0x0c, 0x93, 0x0d, 0x4a, 0x2c, 0x43, 0x2b,
+	0x8a, 0xe3, 0xec, 0x8e, 0xe8, 0xc6, 0x86, 0x5c, 0x55, 0x7e, 0xc0, 0xfd, 0x99, 0x04, 0x47, 0xdb,
+	0x3a, 0x50, 0xcc, 0xe5, 0x45, 0x00, 0xdd, 0x28, 0x57, 0x68, 0x2c, 0x17, 0xf6, 0x33, 0x15, 0xe6,
+	0xc3, 0x17, 0x61, 0xc0, 0xac, 0x50, 0xcf, 0x40, 0x22, 0x9a, 0x01, 0xe0, 0x3a, 0x4e, 0x0b, 0xfe,
+	0x8f, 0x04, 0xbb, 0xaf, 0x95, 0x1d, 0xb4, 0x45, 0xb6, 0x61, 0xbb, 0x73, 0xeb, 0x7f, 0x65, 0x93,
+	0x3a, 0x7b, 0x65, 0x4b, 0xc4, 0xdd, 0x49, 0xd2, 0xd0, 0xe7, 0x4c, 0x2a, 0xdb, 0xc9, 0xd9, 0x74,
+	0xfb, 0xc7, 0x74, 0x7b, 0xb0, 0xb2, 0xb3, 0xa4, 0x6d, 0x5c, 0x71, 0xb6, 0xe8, 0x33, 0x00, 0x4e,
+	0xab, 0x5d, 0x2e, 0xea, 0xd4, 0x66, 0xd3, 0xdf, 0xe3, 0xbf, 0x80, 0xd4, 0xfb, 0xb0, 0xd2, 0x5f,
+	0xd2, 0x36, 0x56, 0xf8, 0xf7, 0x6f, 0x12, 0x30, 0x16, 0x64, 0xec, 0x3d, 0xdf, 0xb9, 0x47, 0x1d,
+	0x9e, 0x59, 0xa7, 0x23, 0x1f, 0x75, 0x98, 0xe5, 0x48, 0xe7, 0x9d, 0x66, 0x4f, 0x84, 0x89, 0xa7,
+	0xfb, 0x44, 0x88, 0x6e, 0xc1, 0x60, 0x20, 0x2f, 0xf8, 0xc2, 0x7a, 0x21, 0xda, 0x3e, 0x25, 0x96,
+	0x57, 0xbf, 0x01, 0xac, 0x0c, 0x94, 0xeb, 0xb1, 0x8b, 0x0f, 0xc2, 0x81, 0x6c, 0xd1, 0x3b, 0xc0,
+	0xac, 0x38, 0x15, 0x99, 0xec, 0x9a, 0x45, 0x48, 0x89, 0x18, 0xd4, 0x5b, 0xc5, 0x7e, 0x24, 0x01,
+	0x0e, 0x93, 0x12, 0x2e, 0x5f, 0x07, 0xb9, 0xa1, 0xb8, 0xa3, 0x6a, 0x9e, 0x54, 0xa4, 0x69, 0x68,
+	0x3e, 0x82, 0x08, 0xf4, 0x3d, 0xb4, 0xf9, 0xf8, 0xf8, 0x22, 0x1c, 0x69, 0xae, 0x78, 0xd9, 0x32,
+	0x4b, 0x81, 0x77, 0x84, 0xb1, 0xc0, 0x3b, 0x82, 0xfb, 0x6a, 0xf0, 0xbe, 0x04, 0x47, 0xdb, 0x1a,
+	0xf0, 0x2e, 0x3b, 0x7b, 0x5b, 0x72, 0x14, 0x29, 0xdf, 0x05, 0xc5, 0x89, 0xe6, 0x14, 0xf1, 0x1d,
+	0x98, 0x0e, 0xe8, 0x31, 0x4c, 0xf6, 0xaa, 0x99, 0xcd, 0xe7, 0xad, 0x0a, 0x29, 0xdc, 0xd4, 0x8a,
+	0x15, 0x12, 0xca, 0x11, 0x1d, 0x82, 0x21, 0xd7, 0xf6, 0xa2, 0x6f, 0x7d, 0x0e, 0x36, 0x62, 0x1b,
+	0x8e, 0x45, 0x18, 0x47, 0xb8, 0xe2, 0x32, 0xf4, 0x06, 0x1e, 0xd0, 0xd2, 0xed, 0x62, 0x5e, 0x64,
+	0x91, 0x1b, 0xe9, 0x42, 0x1b, 0x1f, 0x86, 0x83, 0x5b, 0x82, 0x2b, 0x9f, 0xaf, 0x94, 0x2a, 0x45,
+	0x8d, 0x9a, 0xf5, 0xad, 0xf4, 0x03, 0x09, 0x0e, 0x85, 0xcb, 0x09, 0x5c, 0x9b, 0xb0, 0xcf, 0x37,
+	0x45, 0xf7, 0xf4, 0x92, 0xaa, 0xf9, 0xc4, 0x44, 0x1c, 0x9e, 0x89, 0x36, 0x49, 0xf7, 0xf4, 0x92,
+	0x6f, 0x0c, 0x31, 0x4b, 0x49, 0xda, 0xbc, 0xdb, 0xc6, 0xf3, 0x70, 0x58, 0x21, 0x6b, 0xba, 0x4d,
+	0x89, 0x45, 0x0a, 0xd9, 0x62, 0xd1, 0xdc, 0x24, 0x05, 0xe7, 0xae, 0x1c, 0x31, 0x10, 0xdf, 0x95,
+	0xe0, 0x48, 0x3b, 0x7d, 0x41, 0x52, 0x87, 0xe1, 0xbc, 0x69, 0x50, 0x4b, 0xcb, 0x53, 0xd5, 0xa6,
+	0x1a, 0x25, 0x22, 0xf8, 0x5e, 0x08, 0x3f, 0xe1, 0x38, 0x26, 0x17, 0x84, 0x5e, 0xc0, 0x93, 0x2b,
+	0x8e, 0x0d, 0xc1, 0x6f, 0xc8, 0xb5, 0xcc, 0x1a, 0x71, 0x36, 0x04, 0x14, 0x7f, 0xd4, 0x76, 0x59,
+	0xed, 0x69, 0xb8, 0xee, 0x78, 0x2f, 0x08, 0xdf, 0x97, 0xe0, 0x68, 0x5b, 0x1b, 0x9f, 0x3d, 0x33,
+	0x0c, 0x53, 0xd9, 0x62, 0xb1, 0x29, 0x30, 0x2f, 0xec, 0xde, 0x91, 0xe0, 0x40, 0x88, 0x90, 0x00,
+	0x7d, 0x0f, 0x46, 0x82, 0xa0, 0xdd, 0x38, 0x7b, 0x1a, 0xa8, 0x87, 0x03, 0xa8, 0xed, 0xb9, 0xb7,
+	0x67, 0x61, 0xc7, 0x0d, 0xa7, 0x04, 0x8e, 0xbe, 0x23, 0x41, 0x2f, 0xaf, 0x13, 0xa3, 0xe3, 0x11,
+	0x8a, 0xc9, 0x82, 0x93, 0x7c, 0x22, 0x92, 0x2c, 0xa7, 0x86, 0x4f, 0x7c, 0xf3, 0x93, 0xbf, 0xbf,
+	0x9b, 0x38, 0x8c, 0x0e, 0x66, 0xc2, 0xaa, 0xfa, 0x02, 0xc5, 0x3f, 0x24, 0xd8, 0xdb, 0xb2, 0xb4,
+	0x86, 0xe6, 0x43, 0xc7, 0x6d, 0x57, 0xd7, 0x96, 0x2f, 0x76, 0xaa, 0x2e, 0x98, 0x5c, 0x65, 0x4c,
+	0x2e, 0xa3, 0xc5, 0x50, 0x26, 0x6f, 0x89, 0x10, 0x7e, 0x98, 0x21, 0xc2, 0x22, 0xff, 0x03, 0x07,
+	0xe2, 0xd8, 0x14, 0xdb, 0xb4, 0xaa, 0x1b, 0xe8, 0x83, 0x04, 0x9c, 0x68, 0x39, 0xe6, 0xd6, 0x0a,
+	0x14, 0xba, 0xd6, 0x19, 0xfa, 0x96, 0xb5, 0xac, 0xae, 0xdd, 0xa1, 0x31, 0x77, 0xbc, 0x8e, 0xbe,
+	0xf2, 0x34, 0xdc, 0xa1, 0x3e, 0xd0, 0xe9, 0x5d, 0xb5, 0xec, 0x02, 0x55, 0xd9, 0x93, 0x1d, 0xfa,
+	0x76, 0x02, 0x0e, 0x46, 0xa8, 0x1c, 0xa3, 0x97, 0xa2, 0x51, 0x69, 0x5b, 0x7b, 0xee, 0xda, 0x27,
+	0x5f, 0x66, 0x3e, 0x51, 0xd0, 0xf5, 0xd8, 0x3e, 0x61, 0xd8, 0x78, 0xd1, 0xaf, 0x69, 0xb8, 0xfc,
+	0x5b, 0x02, 0xb9, 0x75, 0x79, 0x0a, 0x75, 0x04, 0xbc, 0x5e, 0x9e, 0x93, 0x2f, 0x75, 0xac, 0x2f,
+	0x98, 0xbf, 0xc2, 0x98, 0xbf, 0x84, 0x96, 0xba, 0x8f, 0x06, 0xb3, 0x42, 0xd1, 0x8f, 0x13, 0x70,
+	0x32, 0x4e, 0x81, 0x16, 0x5d, 0xef, 0x90, 0x40, 0xeb, 0xfc, 0xe8, 0xda, 0x25, 0xb7, 0x99, 0x4b,
+	0xde, 0x40, 0xaf, 0x3d, 0x15, 0x97, 0x34, 0xcf, 0x90, 0x77, 0x12, 0x70, 0x28, 0x4a, 0x19, 0x16,
+	0x5d, 0xe9, 0x2e, 0x45, 0x9e, 0x66, 0xa8, 0xdc, 0x62, 0x7e, 0x79, 0x15, 0x7d, 0x29, 0xa6, 0x5f,
+	0x1c, 0x2f, 0xb4, 0x49, 0x14, 0x27, 0x74, 0xde, 0x93, 0xa0, 0xcf, 0x2d, 0x97, 0xa2, 0x93, 0xa1,
+	0x60, 0x1b, 0x0a, 0xad, 0xf2, 0x4c, 0x44, 0x69, 0x41, 0x24, 0xcd, 0x88, 0x4c, 0xa3, 0x23, 0xa1,
+	0x44, 0xbc, 0x5a, 0x2c, 0xfa, 0xae, 0x04, 0x3d, 0x8e, 0x05, 0x14, 0xfe, 0x26, 0xed, 0x2b, 0xb4,
+	0xc8, 0xc7, 0x22, 0x48, 0x0a, 0x34, 0x67, 0x18, 0x9a, 0x34, 0x3a, 0x19, 0x8a, 0x86, 0x21, 0xa9,
+	0x3b, 0x97, 0x79, 0xcb, 0xad, 0xc0, 0xb6, 0xf1, 0x56, 0x43, 0xed, 0x56, 0x9e, 0x89, 0x28, 0x1d,
+	0xcb, 0x5b, 0x5a, 0xb1, 0x38, 0xc3, 0xbd, 0xf5, 0x6b, 0x09, 0x46, 0x1b, 0xab, 0xb1, 0x28, 0xfc,
+	0xdc, 0xdd, 0xa2, 0xfe, 0x2b, 0x9f, 0x8d, 0xa9, 0x25, 0x10, 0x9f, 0x67, 0x88, 0xe7, 0xd0, 0xa9,
+	0x50, 0xc4, 0x45, 0xdd, 0xa6, 0x1c, 0xf2, 0xcc, 0xed, 0xcd, 0x19, 0x7e, 0x5d, 0x7a, 0x5f, 0x82,
+	0x7e, 0xaf, 0x46, 0x8a, 0xc2, 0x1d, 0xd5, 0x58, 0x1d, 0x96, 0xd3, 0x51, 0xc5, 0x05, 0xcc, 0xd3,
+	0x0c, 0xe6, 0x0c, 0x3a, 0xd1, 0x14, 0x66, 0xc3, 0x84, 0x67, 0xd8, 0xed, 0xdd, 0x46, 0x8f, 0x24,
+	0x40, 0x5b, 0xeb, 0xa5, 0xe8, 0xd9, 0xf0, 0x7b, 0x4d, 0xab, 0x5a, 0xad, 0x7c, 0x2e, 0xb6, 0x9e,
+	0x00, 0xbf, 0xcc, 0xc0, 0x2f, 0xa0, 0x6c, 0x9c, 0xa8, 0xcd, 0xf0, 0x07, 0x5f, 0xf6, 0xd3, 0xab,
+	0x58, 0xa2, 0x9f, 0x4b, 0x30, 0x1c, 0xac, 0xa5, 0xa2, 0xb9, 0xf6, 0xb0, 0xb6, 0x50, 0x39, 0x1d,
+	0x4b, 0x27, 0x56, 0xf2, 0x71, 0xd8, 0x75, 0xc4, 0x1f, 0xb9, 0x93, 0x10, 0xa8, 0x8c, 0x46, 0x99,
+	0x84, 0x66, 0x55, 0x59, 0xf9, 0x5c, 0x6c, 0x3d, 0x81, 0x3e, 0xcb, 0xd0, 0x3f, 0x8f, 0x9e, 0xeb,
+	0x60, 0x12, 0xf8, 0xab, 0x3b, 0xfa, 0xbd, 0x04, 0xbb, 0x9b, 0x14, 0x36, 0x51, 0x1b, 0x4c, 0x2d,
+	0x4b, 0xb0, 0xf2, 0xf9, 0xf8, 0x8a, 0x82, 0xcd, 0x05, 0xc6, 0xe6, 0x0c, 0x9a, 0x0b, 0x9f, 0x0b,
+	0x6e, 0x41, 0x2d, 0x6b, 0xba, 0xc5, 0x4b, 0x72, 0x77, 0x08, 0x41, 0x1f, 0x4a, 0x30, 0xd2, 0x50,
+	0x57, 0x44, 0xe1, 0x01, 0xd1, 0xbc, 0xd6, 0x2a, 0x9f, 0x89, 0xa7, 0x24, 0xa0, 0x3f, 0xcb, 0xa0,
+	0x9f, 0x42, 0xe9, 0x50, 0xe8, 0x84, 0x6b, 0xd7, 0x0b, 0x89, 0xe8, 0x63, 0x09, 0xc6, 0x9b, 0x16,
+	0x0a, 0xd0, 0x73, 0xb1, 0x2b, 0x02, 0xde, 0xfa, 0x7e, 0xa1, 0x13, 0x55, 0x41, 0x64, 0x9e, 0x11,
+	0x39, 0x87, 0xce, 0x66, 0xa2, 0xfd, 0x2d, 0x77, 0xa0, 0xce, 0x66, 0xa3, 0x5f, 0x4a, 0x30, 0x14,
+	0x28, 0xa7, 0xa0, 0xd9, 0xe8, 0xa5, 0x17, 0x17, 0xff, 0x5c, 0x1c, 0x95, 0x58, 0xb8, 0x35, 0xae,
+	0x2b, 0x62, 0x3f, 0xf3, 0x96, 0x28, 0xbb, 0x3d, 0x64, 0x59, 0xd0, 0xa4, 0x0c, 0xd4, 0x26, 0x0b,
+	0x5a, 0x17, 0xa8, 0xe4, 0xf3, 0xf1, 0x15, 0x63, 0x65, 0x41, 0x90, 0x89, 0xca, 0xcb, 0x52, 0xe8,
+	0x5f, 0x12, 0xa4, 0xda, 0x54, 0x2d, 0xd0, 0x42, 0xa4, 0x63, 0x60, 0x78, 0xd1, 0x48, 0x5e, 0xec,
+	0xce, 0x48, 0xac, 0x49, 0x6b, 0x72, 0xa0, 0x74, 0xd6, 0x00, 0x82, 0x7e, 0x22, 0xc1, 0xa0, 0xbf,
+	0x06, 0x80, 0xc2, 0xcb, 0x7c, 0x4d, 0x0a, 0x24, 0xf2, 0x6c, 0x0c, 0x0d, 0x01, 0x7a, 0x8e, 0x81,
+	0x3e, 0x89, 0x8e, 0x87, 0x82, 0x36, 0xb9, 0x2a, 0xff, 0xef, 0x11, 0xe8, 0xb1, 0x04, 0x72, 0xeb,
+	0x87, 0xf4, 0x36, 0x97, 0xc0, 0xb6, 0xef, 0xf4, 0xf2, 0xa5, 0x8e, 0xf5, 0x05, 0xa7, 0x05, 0xc6,
+	0x69, 0x1e, 0x3d, 0xdf, 0xee, 0x88, 0xa7, 0xb6, 0x7e, 0xe8, 0x47, 0xff, 0x93, 0x20, 0xd5, 0xe6,
+	0x39, 0xbd, 0x4d, 0xf0, 0x45, 0x7b, 0xcd, 0x97, 0x17, 0xbb, 0x33, 0x22, 0x38, 0xdf, 0x60, 0x9c,
+	0x5f, 0x46, 0xcb, 0xe1, 0xc1, 0xc7, 0xce, 0x85, 0x0f, 0x33, 0x2d, 0x79, 0xab, 0xac, 0x78, 0xca,
+	0xa4, 0xd0, 0x0f, 0x12, 0x70, 0xa0, 0xed, 0x3b, 0x3a, 0x5a, 0x8a, 0x0e, 0x3f, 0xe4, 0xbd, 0x5f,
+	0xbe, 0xdc, 0xad, 0x19, 0xe1, 0x87, 0x02, 0xf3, 0xc3, 0x9b, 0xe8, 0x8d, 0x70, 0x3f, 0x04, 0x0a,
+	0x06, 0x0f, 0x5b, 0xfa, 0x85, 0x35, 0xdb, 0x2a, 0x35, 0x55, 0x8d, 0x0f, 0xa6, 0xae, 0x33, 0xd2,
+	0xff, 0x94, 0x60, 0x7f, 0xd8, 0x2b, 0x3e, 0x7a, 0x31, 0x5e, 0x0c, 0x6f, 0x2d, 0x14, 0xc8, 0xd9,
+	0x2e, 0x2c, 0x08, 0x5f, 0x2c, 0x31, 0x5f, 0x5c, 0x42, 0xf3, 0xf1, 0xf3, 0xc0, 0xcf, 0xe5, 0xbf,
+	0x12, 0x4c, 0x86, 0xbf, 0xe7, 0xa3, 0x5c, 0x28, 0xd8, 0x48, 0xc5, 0x04, 0x79, 0xa1, 0x2b, 0x1b,
+	0x82, 0xf2, 0x35, 0x46, 0x79, 0x19, 0xbd, 0x14, 0x29, 0x0d, 0x2c, 0xcf, 0xa8, 0xaa, 0x71, 0xab,
+	0xfc, 0x30, 0xef, 0x4b, 0x82, 0x6f, 0x24, 0x20, 0xd5, 0xe6, 0xcd, 0x1f, 0x75, 0x88, 0x3c, 0x50,
+	0x75, 0x90, 0x17, 0xbb, 0x33, 0x22, 0xf8, 0xaf, 0x30, 0xfe, 0xaf, 0xa0, 0x97, 0x23, 0xee, 0x41,
+	0xa1, 0x1e, 0x10, 0x52, 0xe8, 0x2f, 0x12, 0xec, 0x6d, 0x59, 0x3c, 0x68, 0xf3, 0x1c, 0xde, 0xae,
+	0x32, 0x21, 0x5f, 0xec, 0x54, 0x3d, 0xd6, 0xa5, 0xc1, 0x09, 0xf2, 0x16, 0x5c, 0xed, 0xdc, 0xeb,
+	0x1f, 0x3d, 0x9e, 0x94, 0x1e, 0x3d, 0x9e, 0x94, 0xfe, 0xf6, 0x78, 0x52, 0x7a, 0xe7, 0xc9, 0xe4,
+	0xb6, 0x47, 0x4f, 0x26, 0xb7, 0xfd, 0xe9, 0xc9, 0xe4, 0xb6, 0xd7, 0xb2, 0xbe, 0x3f, 0xe8, 0x2c,
+	0x69, 0x9a, 0xb1, 0x39, 0xb3, 0xb1, 0xf9, 0x55, 0xf1, 0x55, 0x20, 0x1b, 0x99, 0xf5, 0xb3, 0x99,
+	0x8d, 0xc0, 0x78, 0xf9, 0xa2, 0x4e, 0x0c, 0xca, 0xff, 0x6b, 0x1f, 0xff, 0x93, 0xec, 0x5e, 0xf6,
+	0xcf, 0xe9, 0xff, 0x0f, 0x00, 0xe4, 0x26, 0x8d, 0xc9, 0x29, 0x39, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
+	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
+	// primitive types instead of repeated structs. Each index in the
+	// routes_pool_id field corresponds to the respective routes_token_out_denom
+	// value, thus they are required to have the same length and are grouped
+	// together as pairs.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
+	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
+	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
+	EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
+	// ListPoolsByDenom return all pools by denom
+	ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
+	// TotalPoolLiquidity returns the total liquidity of the specified pool.
+	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
+	// TotalLiquidity returns the total liquidity across all pools.
+	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
+	// TotalVolumeForPool returns the total volume of the specified pool.
+	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
+	// TradingPairTakerFee returns the taker fee for a given set of denoms
+	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
+	// ExplainTakerFee returns, hop by hop, the taker fee a sender is charged on a
+	// route and where it comes from.
+	ExplainTakerFee(ctx context.Context, in *ExplainTakerFeeRequest, opts ...grpc.CallOption) (*ExplainTakerFeeResponse, error)
+	// TakerFeeDiscountTiers returns all the taker fee discount tiers.
+	TakerFeeDiscountTiers(ctx context.Context, in *TakerFeeDiscountTiersRequest, opts ...grpc.CallOption) (*TakerFeeDiscountTiersResponse, error)
+	// AccountVolume returns the volume an account swapped during the retained
+	// windows.
+	AccountVolume(ctx context.Context, in *AccountVolumeRequest, opts ...grpc.CallOption) (*AccountVolumeResponse, error)
+	// AccountVolumeConfig returns the configuration of account volume tracking.
+	AccountVolumeConfig(ctx context.Context, in *AccountVolumeConfigRequest, opts ...grpc.CallOption) (*AccountVolumeConfigResponse, error)
+	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
+	// impact, if a trade cannot be estimated a 0 input and 0 output would be
+	// returned.
+	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// OptimalRoute searches the pool graph for the split route returning the
+	// most token_out_denom for token_in, with at most max_hops pools per route
+	// and max_splits routes. The routes can be used as is in
+	// MsgSplitRouteSwapExactAmountIn.
+	OptimalRoute(ctx context.Context, in *OptimalRouteRequest, opts ...grpc.CallOption) (*OptimalRouteResponse, error)
+	// AllTakerFeeShareAgreements returns all taker fee share agreements.
+	// A taker fee share agreement includes the denom of the denom getting the
+	// taker fees, the percent of the taker fees that the denom gets when it is
+	// in the route being traded against, and the address that the taker fees are
+	// sent to at epoch.
+	AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error)
+	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
+	// given denom. A taker fee share agreement includes the denom of the denom
+	// getting the taker fees, the percent of the taker fees that the denom gets
+	// when it is in the route being traded against, and the address that the
+	// taker fees are sent to at epoch.
+	TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error)
+	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
+	// the given taker fee denom (the collected fees) for the given fee share
+	// denom (the denom with the taker fee share agreement)
+	TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error)
+	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
+	// taker fee share accumulator includes the denom of the denom getting the
+	// taker fees, and an accumulated value of coins that the denom has accrued
+	// since the last time it was distributed in the epoch prior.
+	AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error)
+	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
+	// from the given denom. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error)
+	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
+	// from the given pool id. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
+	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
+	// registered alloyed pool contains the pool's contract address, along with
+	// the current distribution composition of taker fee share denoms within the
+	// alloyed pool.
+	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalPoolLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountIn(ctx context.Context, in *EstimateSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*TotalPoolLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalLiquidityRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidity(ctx, req.(*TotalLiquidityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalVolumeForPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
+	out := new(EstimateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalVolumeForPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalVolumeForPool(ctx, req.(*TotalVolumeForPoolRequest))
+	return out, nil
+}
+
+func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TradingPairTakerFeeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountOutWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TradingPairTakerFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TradingPairTakerFee(ctx, req.(*TradingPairTakerFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateTradeBasedOnPriceImpactRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, in *EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error) {
+	out := new(EstimateSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, req.(*EstimateTradeBasedOnPriceImpactRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllTakerFeeShareAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTakerFeeShareAgreementsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error) {
+	out := new(NumPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NumPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, req.(*AllTakerFeeShareAgreementsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TakerFeeShareAgreementFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TakerFeeShareAgreementFromDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Pool(ctx context.Context, in *PoolRequest, opts ...grpc.CallOption) (*PoolResponse, error) {
+	out := new(PoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, req.(*TakerFeeShareAgreementFromDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TakerFeeShareDenomsToAccruedValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TakerFeeShareDenomsToAccruedValueRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error) {
+	out := new(AllPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllPools", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error) {
+	out := new(ListPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue",
+	return out, nil
+}
+
+func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
+	out := new(SpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, req.(*TakerFeeShareDenomsToAccruedValueRequest))
+	return out, nil
+}
+
+func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
+	out := new(TotalPoolLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllTakerFeeShareAccumulators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTakerFeeShareAccumulatorsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error) {
+	out := new(TotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error) {
+	out := new(TotalVolumeForPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators",
+	return out, nil
+}
+
+func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
+	out := new(TradingPairTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, req.(*AllTakerFeeShareAccumulatorsRequest))
+	return out, nil
+}
+
+func (c *queryClient) ExplainTakerFee(ctx context.Context, in *ExplainTakerFeeRequest, opts ...grpc.CallOption) (*ExplainTakerFeeResponse, error) {
+	out := new(ExplainTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ExplainTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RegisteredAlloyedPoolFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisteredAlloyedPoolFromDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TakerFeeDiscountTiers(ctx context.Context, in *TakerFeeDiscountTiersRequest, opts ...grpc.CallOption) (*TakerFeeDiscountTiersResponse, error) {
+	out := new(TakerFeeDiscountTiersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeDiscountTiers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) AccountVolume(ctx context.Context, in *AccountVolumeRequest, opts ...grpc.CallOption) (*AccountVolumeResponse, error) {
+	out := new(AccountVolumeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AccountVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom",
+	return out, nil
+}
+
+func (c *queryClient) AccountVolumeConfig(ctx context.Context, in *AccountVolumeConfigRequest, opts ...grpc.CallOption) (*AccountVolumeConfigResponse, error) {
+	out := new(AccountVolumeConfigResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AccountVolumeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, req.(*RegisteredAlloyedPoolFromDenomRequest))
+	return out, nil
+}
+
+func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error) {
+	out := new(EstimateTradeBasedOnPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RegisteredAlloyedPoolFromPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisteredAlloyedPoolFromPoolIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OptimalRoute(ctx context.Context, in *OptimalRouteRequest, opts ...grpc.CallOption) (*OptimalRouteResponse, error) {
+	out := new(OptimalRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/OptimalRoute", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) AllTakerFeeShareAgreements(ctx context.Context, in *AllTakerFeeShareAgreementsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAgreementsResponse, error) {
+	out := new(AllTakerFeeShareAgreementsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId",
+	return out, nil
+}
+
+func (c *queryClient) TakerFeeShareAgreementFromDenom(ctx context.Context, in *TakerFeeShareAgreementFromDenomRequest, opts ...grpc.CallOption) (*TakerFeeShareAgreementFromDenomResponse, error) {
+	out := new(TakerFeeShareAgreementFromDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, req.(*RegisteredAlloyedPoolFromPoolIdRequest))
+	return out, nil
+}
+
+func (c *queryClient) TakerFeeShareDenomsToAccruedValue(ctx context.Context, in *TakerFeeShareDenomsToAccruedValueRequest, opts ...grpc.CallOption) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
+	out := new(TakerFeeShareDenomsToAccruedValueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllRegisteredAlloyedPoolsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllTakerFeeShareAccumulators(ctx context.Context, in *AllTakerFeeShareAccumulatorsRequest, opts ...grpc.CallOption) (*AllTakerFeeShareAccumulatorsResponse, error) {
+	out := new(AllTakerFeeShareAccumulatorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) RegisteredAlloyedPoolFromDenom(ctx context.Context, in *RegisteredAlloyedPoolFromDenomRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromDenomResponse, error) {
+	out := new(RegisteredAlloyedPoolFromDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools",
+	return out, nil
+}
+
+func (c *queryClient) RegisteredAlloyedPoolFromPoolId(ctx context.Context, in *RegisteredAlloyedPoolFromPoolIdRequest, opts ...grpc.CallOption) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
+	out := new(RegisteredAlloyedPoolFromPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, req.(*AllRegisteredAlloyedPoolsRequest))
+	return out, nil
+}
+
+func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error) {
+	out := new(AllRegisteredAlloyedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.poolmanager.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountIn",
-			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountInWithPrimitiveTypes",
-			Handler:    _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler,
-		},
-		{
-			MethodName: "EstimateSinglePoolSwapExactAmountIn",
-			Handler:    _Query_EstimateSinglePoolSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOut",
-			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "EstimateSwapExactAmountOutWithPrimitiveTypes",
-			Handler:    _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler,
-		},
-		{
-			MethodName: "EstimateSinglePoolSwapExactAmountOut",
-			Handler:    _Query_EstimateSinglePoolSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "NumPools",
-			Handler:    _Query_NumPools_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "AllPools",
-			Handler:    _Query_AllPools_Handler,
-		},
-		{
-			MethodName: "ListPoolsByDenom",
-			Handler:    _Query_ListPoolsByDenom_Handler,
-		},
-		{
-			MethodName: "SpotPrice",
-			Handler:    _Query_SpotPrice_Handler,
-		},
-		{
-			MethodName: "TotalPoolLiquidity",
-			Handler:    _Query_TotalPoolLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalLiquidity",
-			Handler:    _Query_TotalLiquidity_Handler,
-		},
-		{
-			MethodName: "TotalVolumeForPool",
-			Handler:    _Query_TotalVolumeForPool_Handler,
-		},
-		{
-			MethodName: "TradingPairTakerFee",
-			Handler:    _Query_TradingPairTakerFee_Handler,
-		},
-		{
-			MethodName: "EstimateTradeBasedOnPriceImpact",
-			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
-		},
-		{
-			MethodName: "AllTakerFeeShareAgreements",
-			Handler:    _Query_AllTakerFeeShareAgreements_Handler,
-		},
-		{
-			MethodName: "TakerFeeShareAgreementFromDenom",
-			Handler:    _Query_TakerFeeShareAgreementFromDenom_Handler,
-		},
-		{
-			MethodName: "TakerFeeShareDenomsToAccruedValue",
-			Handler:    _Query_TakerFeeShareDenomsToAccruedValue_Handler,
-		},
-		{
-			MethodName: "AllTakerFeeShareAccumulators",
-			Handler:    _Query_AllTakerFeeShareAccumulators_Handler,
-		},
-		{
-			MethodName: "RegisteredAlloyedPoolFromDenom",
-			Handler:    _Query_RegisteredAlloyedPoolFromDenom_Handler,
-		},
-		{
-			MethodName: "RegisteredAlloyedPoolFromPoolId",
-			Handler:    _Query_RegisteredAlloyedPoolFromPoolId_Handler,
-		},
-		{
-			MethodName: "AllRegisteredAlloyedPools",
-			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Estimates swap amount out given in.
+	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSwapExactAmountInWithPrimitiveTypes is an alternative query for
+	// EstimateSwapExactAmountIn. Supports query via GRPC-Gateway by using
+	// primitive types instead of repeated structs. Each index in the
+	// routes_pool_id field corresponds to the respective routes_token_out_denom
+	// value, thus they are required to have the same length and are grouped
+	// together as pairs.
+	// example usage:
+	// http://0.0.0.0:1317/osmosis/poolmanager/v1beta1/1/estimate/
+	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
+	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
+	EstimateSwapExactAmountInWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error)
+	EstimateSinglePoolSwapExactAmountIn(context.Context, *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
+	// Estimates swap amount in given out.
+	EstimateSwapExactAmountOutWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error)
+	EstimateSinglePoolSwapExactAmountOut(context.Context, *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
+	// Returns the total number of pools existing in Osmosis.
+	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
+	// Pool returns the Pool specified by the pool id
+	Pool(context.Context, *PoolRequest) (*PoolResponse, error)
+	// AllPools returns all pools on the Osmosis chain sorted by IDs.
+	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
+	// ListPoolsByDenom return all pools by denom
+	ListPoolsByDenom(context.Context, *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error)
+	// SpotPrice defines a gRPC query handler that returns the spot price given
+	// a base denomination and a quote denomination.
+	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
+	// TotalPoolLiquidity returns the total liquidity of the specified pool.
+	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
+	// TotalLiquidity returns the total liquidity across all pools.
+	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
+	// TotalVolumeForPool returns the total volume of the specified pool.
+	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
+	// TradingPairTakerFee returns the taker fee for a given set of denoms
+	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
+	// ExplainTakerFee returns, hop by hop, the taker fee a sender is charged on a
+	// route and where it comes from.
+	ExplainTakerFee(context.Context, *ExplainTakerFeeRequest) (*ExplainTakerFeeResponse, error)
+	// TakerFeeDiscountTiers returns all the taker fee discount tiers.
+	TakerFeeDiscountTiers(context.Context, *TakerFeeDiscountTiersRequest) (*TakerFeeDiscountTiersResponse, error)
+	// AccountVolume returns the volume an account swapped during the retained
+	// windows.
+	AccountVolume(context.Context, *AccountVolumeRequest) (*AccountVolumeResponse, error)
+	// AccountVolumeConfig returns the configuration of account volume tracking.
+	AccountVolumeConfig(context.Context, *AccountVolumeConfigRequest) (*AccountVolumeConfigResponse, error)
+	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
+	// impact, if a trade cannot be estimated a 0 input and 0 output would be
+	// returned.
+	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// OptimalRoute searches the pool graph for the split route returning the
+	// most token_out_denom for token_in, with at most max_hops pools per route
+	// and max_splits routes. The routes can be used as is in
+	// MsgSplitRouteSwapExactAmountIn.
+	OptimalRoute(context.Context, *OptimalRouteRequest) (*OptimalRouteResponse, error)
+	// AllTakerFeeShareAgreements returns all taker fee share agreements.
+	// A taker fee share agreement includes the denom of the denom getting the
+	// taker fees, the percent of the taker fees that the denom gets when it is
+	// in the route being traded against, and the address that the taker fees are
+	// sent to at epoch.
+	AllTakerFeeShareAgreements(context.Context, *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error)
+	// TakerFeeShareAgreementFromDenom returns the taker fee share agreement for a
+	// given denom. A taker fee share agreement includes the denom of the denom
+	// getting the taker fees, the percent of the taker fees that the denom gets
+	// when it is in the route being traded against, and the address that the
+	// taker fees are sent to at epoch.
+	TakerFeeShareAgreementFromDenom(context.Context, *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error)
+	// TakerFeeShareDenomsToAccruedValue returns the accrued value (as an Int) of
+	// the given taker fee denom (the collected fees) for the given fee share
+	// denom (the denom with the taker fee share agreement)
+	TakerFeeShareDenomsToAccruedValue(context.Context, *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error)
+	// AllTakerFeeShareAccumulators returns all taker fee share accumulators. A
+	// taker fee share accumulator includes the denom of the denom getting the
+	// taker fees, and an accumulated value of coins that the denom has accrued
+	// since the last time it was distributed in the epoch prior.
+	AllTakerFeeShareAccumulators(context.Context, *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error)
+	// RegisteredAlloyedPoolFromDenom returns the registered alloyed pool state
+	// from the given denom. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromDenom(context.Context, *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error)
+	// RegisteredAlloyedPoolFromPoolId returns the registered alloyed pool state
+	// from the given pool id. The registered alloyed pool contains the pool's
+	// contract address, along with the current distribution composition of taker
+	// fee share denoms within the alloyed pool.
+	RegisteredAlloyedPoolFromPoolId(context.Context, *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error)
+	// AllRegisteredAlloyedPools returns all registered alloyed pools. The
+	// registered alloyed pool contains the pool's contract address, along with
+	// the current distribution composition of taker fee share denoms within the
+	// alloyed pool.
+	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context, req *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountIn not implemented")
 }
-
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInWithPrimitiveTypes not implemented")
 }
-
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, req *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountIn not implemented")
 }
-
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
-
-func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EstimateSwapExactAmountOutWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOutWithPrimitiveTypes not implemented")
 }
-
-func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountOut(ctx context.Context, req *EstimateSinglePoolSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *PoolRequest) (*PoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequest) (*AllPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPools not implemented")
+}
+func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalLiquidityRequest) (*TotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
+}
+func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
+}
+func (*UnimplementedQueryServer) ExplainTakerFee(ctx context.Context, req *ExplainTakerFeeRequest) (*ExplainTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainTakerFee not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeDiscountTiers(ctx context.Context, req *TakerFeeDiscountTiersRequest) (*TakerFeeDiscountTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeDiscountTiers not implemented")
+}
+func (*UnimplementedQueryServer) AccountVolume(ctx context.Context, req *AccountVolumeRequest) (*AccountVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountVolume not implemented")
+}
+func (*UnimplementedQueryServer) AccountVolumeConfig(ctx context.Context, req *AccountVolumeConfigRequest) (*AccountVolumeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountVolumeConfig not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
+}
+func (*UnimplementedQueryServer) OptimalRoute(ctx context.Context, req *OptimalRouteRequest) (*OptimalRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimalRoute not implemented")
+}
+func (*UnimplementedQueryServer) AllTakerFeeShareAgreements(ctx context.Context, req *AllTakerFeeShareAgreementsRequest) (*AllTakerFeeShareAgreementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAgreements not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeShareAgreementFromDenom(ctx context.Context, req *TakerFeeShareAgreementFromDenomRequest) (*TakerFeeShareAgreementFromDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareAgreementFromDenom not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeShareDenomsToAccruedValue(ctx context.Context, req *TakerFeeShareDenomsToAccruedValueRequest) (*TakerFeeShareDenomsToAccruedValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeShareDenomsToAccruedValue not implemented")
+}
+func (*UnimplementedQueryServer) AllTakerFeeShareAccumulators(ctx context.Context, req *AllTakerFeeShareAccumulatorsRequest) (*AllTakerFeeShareAccumulatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTakerFeeShareAccumulators not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromDenom(ctx context.Context, req *RegisteredAlloyedPoolFromDenomRequest) (*RegisteredAlloyedPoolFromDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromDenom not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Context, req *RegisteredAlloyedPoolFromPoolIdRequest) (*RegisteredAlloyedPoolFromPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredAlloyedPoolFromPoolId not implemented")
+}
+func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
 }
 
-func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Params",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountIn(ctx, req.(*EstimateSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RoutesTokenOutDenom) > 0 {
-		for iNdEx := len(m.RoutesTokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RoutesTokenOutDenom[iNdEx])
-			copy(dAtA[i:], m.RoutesTokenOutDenom[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenOutDenom[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountInWithPrimitiveTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RoutesPoolId) > 0 {
-		dAtA3 := make([]byte, len(m.RoutesPoolId)*10)
-		var j2 int
-		for _, num := range m.RoutesPoolId {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountInWithPrimitiveTypes",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountInWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountInWithPrimitiveTypesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSinglePoolSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSinglePoolSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountIn(ctx, req.(*EstimateSinglePoolSwapExactAmountInRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOut(ctx, req.(*EstimateSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSwapExactAmountOutWithPrimitiveTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOutWithPrimitiveTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactAmountOutWithPrimitiveTypes(ctx, req.(*EstimateSwapExactAmountOutWithPrimitiveTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSinglePoolSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSinglePoolSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSinglePoolSwapExactAmountOut(ctx, req.(*EstimateSinglePoolSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).NumPools(ctx, in)
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NumPools",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumPools(ctx, req.(*NumPoolsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*PoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+func _Query_AllPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RoutesTokenInDenom) > 0 {
-		for iNdEx := len(m.RoutesTokenInDenom) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RoutesTokenInDenom[iNdEx])
-			copy(dAtA[i:], m.RoutesTokenInDenom[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenInDenom[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPools(ctx, in)
 	}
-	if len(m.RoutesPoolId) > 0 {
-		dAtA5 := make([]byte, len(m.RoutesPoolId)*10)
-		var j4 int
-		for _, num := range m.RoutesPoolId {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintQuery(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllPools",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPools(ctx, req.(*AllPoolsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsByDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
 	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ListPoolsByDenom",
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*ListPoolsByDenomRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpotPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPrice(ctx, req.(*SpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalPoolLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPoolLiquidity(ctx, req.(*TotalPoolLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidity(ctx, req.(*TotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalVolumeForPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVolumeForPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TotalVolumeForPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVolumeForPool(ctx, req.(*TotalVolumeForPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradingPairTakerFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradingPairTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradingPairTakerFee(ctx, req.(*TradingPairTakerFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
-		i--
-		dAtA[i] = 0x8
+func _Query_ExplainTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainTakerFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ExplainTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainTakerFee(ctx, req.(*ExplainTakerFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TakerFeeDiscountTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeDiscountTiersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeDiscountTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeDiscountTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeDiscountTiers(ctx, req.(*TakerFeeDiscountTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AccountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AccountVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountVolume(ctx, req.(*AccountVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func _Query_AccountVolumeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountVolumeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AccountVolumeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AccountVolumeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountVolumeConfig(ctx, req.(*AccountVolumeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateTradeBasedOnPriceImpactRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTradeBasedOnPriceImpact(ctx, req.(*EstimateTradeBasedOnPriceImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OptimalRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimalRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OptimalRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/OptimalRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptimalRoute(ctx, req.(*OptimalRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_AllTakerFeeShareAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTakerFeeShareAgreementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAgreements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTakerFeeShareAgreements(ctx, req.(*AllTakerFeeShareAgreementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TakerFeeShareAgreementFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeShareAgreementFromDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareAgreementFromDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeShareAgreementFromDenom(ctx, req.(*TakerFeeShareAgreementFromDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TakerFeeShareDenomsToAccruedValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeShareDenomsToAccruedValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeShareDenomsToAccruedValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeShareDenomsToAccruedValue(ctx, req.(*TakerFeeShareDenomsToAccruedValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_AllTakerFeeShareAccumulators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTakerFeeShareAccumulatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllTakerFeeShareAccumulators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTakerFeeShareAccumulators(ctx, req.(*AllTakerFeeShareAccumulatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
+func _Query_RegisteredAlloyedPoolFromDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredAlloyedPoolFromDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromDenom(ctx, req.(*RegisteredAlloyedPoolFromDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredAlloyedPoolFromPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisteredAlloyedPoolFromPoolIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RegisteredAlloyedPoolFromPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredAlloyedPoolFromPoolId(ctx, req.(*RegisteredAlloyedPoolFromPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRegisteredAlloyedPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/AllRegisteredAlloyedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRegisteredAlloyedPools(ctx, req.(*AllRegisteredAlloyedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.poolmanager.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountIn",
+			Handler:    _Query_EstimateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountInWithPrimitiveTypes",
+			Handler:    _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler,
+		},
+		{
+			MethodName: "EstimateSinglePoolSwapExactAmountIn",
+			Handler:    _Query_EstimateSinglePoolSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOut",
+			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactAmountOutWithPrimitiveTypes",
+			Handler:    _Query_EstimateSwapExactAmountOutWithPrimitiveTypes_Handler,
+		},
+		{
+			MethodName: "EstimateSinglePoolSwapExactAmountOut",
+			Handler:    _Query_EstimateSinglePoolSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "NumPools",
+			Handler:    _Query_NumPools_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "AllPools",
+			Handler:    _Query_AllPools_Handler,
+		},
+		{
+			MethodName: "ListPoolsByDenom",
+			Handler:    _Query_ListPoolsByDenom_Handler,
+		},
+		{
+			MethodName: "SpotPrice",
+			Handler:    _Query_SpotPrice_Handler,
+		},
+		{
+			MethodName: "TotalPoolLiquidity",
+			Handler:    _Query_TotalPoolLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalLiquidity",
+			Handler:    _Query_TotalLiquidity_Handler,
+		},
+		{
+			MethodName: "TotalVolumeForPool",
+			Handler:    _Query_TotalVolumeForPool_Handler,
+		},
+		{
+			MethodName: "TradingPairTakerFee",
+			Handler:    _Query_TradingPairTakerFee_Handler,
+		},
+		{
+			MethodName: "ExplainTakerFee",
+			Handler:    _Query_ExplainTakerFee_Handler,
+		},
+		{
+			MethodName: "TakerFeeDiscountTiers",
+			Handler:    _Query_TakerFeeDiscountTiers_Handler,
+		},
+		{
+			MethodName: "AccountVolume",
+			Handler:    _Query_AccountVolume_Handler,
+		},
+		{
+			MethodName: "AccountVolumeConfig",
+			Handler:    _Query_AccountVolumeConfig_Handler,
+		},
+		{
+			MethodName: "EstimateTradeBasedOnPriceImpact",
+			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
+		},
+		{
+			MethodName: "OptimalRoute",
+			Handler:    _Query_OptimalRoute_Handler,
+		},
+		{
+			MethodName: "AllTakerFeeShareAgreements",
+			Handler:    _Query_AllTakerFeeShareAgreements_Handler,
+		},
+		{
+			MethodName: "TakerFeeShareAgreementFromDenom",
+			Handler:    _Query_TakerFeeShareAgreementFromDenom_Handler,
+		},
+		{
+			MethodName: "TakerFeeShareDenomsToAccruedValue",
+			Handler:    _Query_TakerFeeShareDenomsToAccruedValue_Handler,
+		},
+		{
+			MethodName: "AllTakerFeeShareAccumulators",
+			Handler:    _Query_AllTakerFeeShareAccumulators_Handler,
+		},
+		{
+			MethodName: "RegisteredAlloyedPoolFromDenom",
+			Handler:    _Query_RegisteredAlloyedPoolFromDenom_Handler,
+		},
+		{
+			MethodName: "RegisteredAlloyedPoolFromPoolId",
+			Handler:    _Query_RegisteredAlloyedPoolFromPoolId_Handler,
+		},
+		{
+			MethodName: "AllRegisteredAlloyedPools",
+			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3986,34 +4238,20 @@ func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4023,27 +4261,30 @@ func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4053,20 +4294,20 @@ func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4074,13 +4315,32 @@ func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4090,27 +4350,47 @@ func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuoteAssetDenom) > 0 {
-		i -= len(m.QuoteAssetDenom)
-		copy(dAtA[i:], m.QuoteAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for iNdEx := len(m.RoutesTokenOutDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutesTokenOutDenom[iNdEx])
+			copy(dAtA[i:], m.RoutesTokenOutDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenOutDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RoutesPoolId) > 0 {
+		dAtA3 := make([]byte, len(m.RoutesPoolId)*10)
+		var j2 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BaseAssetDenom) > 0 {
-		i -= len(m.BaseAssetDenom)
-		copy(dAtA[i:], m.BaseAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4122,7 +4402,7 @@ func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4132,27 +4412,39 @@ func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4162,25 +4454,30 @@ func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4190,20 +4487,27 @@ func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4211,13 +4515,25 @@ func (m *TotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4227,20 +4543,59 @@ func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RoutesTokenInDenom) > 0 {
+		for iNdEx := len(m.RoutesTokenInDenom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoutesTokenInDenom[iNdEx])
+			copy(dAtA[i:], m.RoutesTokenInDenom[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RoutesTokenInDenom[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoutesPoolId) > 0 {
+		dAtA5 := make([]byte, len(m.RoutesPoolId)*10)
+		var j4 int
+		for _, num := range m.RoutesPoolId {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4250,34 +4605,39 @@ func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4287,25 +4647,30 @@ func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalVolumeForPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalVolumeForPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4315,34 +4680,20 @@ func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalVolumeForPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Volume) > 0 {
-		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4352,67 +4703,25 @@ func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom_1) > 0 {
-		i -= len(m.Denom_1)
-		copy(dAtA[i:], m.Denom_1)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom_0) > 0 {
-		i -= len(m.Denom_0)
-		copy(dAtA[i:], m.Denom_0)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+	if m.NumPools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPools))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4422,62 +4731,25 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ExternalPrice.Size()
-		i -= size
-		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPriceImpact.Size()
-		i -= size
-		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ToCoinDenom) > 0 {
-		i -= len(m.ToCoinDenom)
-		copy(dAtA[i:], m.ToCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4487,40 +4759,32 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4530,12 +4794,12 @@ func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4543,7 +4807,7 @@ func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4553,20 +4817,20 @@ func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeShareAgreements) > 0 {
-		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TakerFeeShareAgreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4580,7 +4844,7 @@ func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4590,12 +4854,12 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4610,7 +4874,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4620,30 +4884,34 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TakerFeeShareAgreement.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err error) {
+func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4653,34 +4921,39 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeDenom) > 0 {
-		i -= len(m.TakerFeeDenom)
-		copy(dAtA[i:], m.TakerFeeDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TakerFeeDenom)))
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err error) {
+func (m *SpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4690,30 +4963,27 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4723,20 +4993,25 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error) {
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4746,20 +5021,20 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeSkimAccumulators) > 0 {
-		for iNdEx := len(m.TakerFeeSkimAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TakerFeeSkimAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4773,7 +5048,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4783,27 +5058,20 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *TotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4813,30 +5081,34 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4846,12 +5118,12 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4864,7 +5136,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *TotalVolumeForPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4874,22 +5146,96 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -4897,7 +5243,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ExplainTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4907,20 +5253,48 @@ func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExplainTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllRegisteredAlloyedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExplainTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExplainTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4930,20 +5304,30 @@ func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExplainTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExplainTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractStates) > 0 {
-		for iNdEx := len(m.ContractStates) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.TotalTakerFee.Size()
+		i -= size
+		if _, err := m.TotalTakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ContractStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4957,659 +5341,3211 @@ func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *TakerFeeHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DiscountTier) > 0 {
+		i -= len(m.DiscountTier)
+		copy(dAtA[i:], m.DiscountTier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DiscountTier)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RoutesPoolId) > 0 {
-		l = 0
-		for _, e := range m.RoutesPoolId {
-			l += sovQuery(uint64(e))
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.RoutesTokenOutDenom) > 0 {
-		for _, s := range m.RoutesTokenOutDenom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccountVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.RoutesPoolId) > 0 {
-		l = 0
-		for _, e := range m.RoutesPoolId {
-			l += sovQuery(uint64(e))
+	{
+		size := m.TotalVolume.Size()
+		i -= size
+		if _, err := m.TotalVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.RoutesTokenInDenom) > 0 {
-		for _, s := range m.RoutesTokenInDenom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AccountVolumeConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *AccountVolumeConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccountVolumeConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+func (m *AccountVolumeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccountVolumeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *TakerFeeDiscountTiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeDiscountTiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeDiscountTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListPoolsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListPoolsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.ExternalPrice.Size()
+		i -= size
+		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *SpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x22
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ToCoinDenom) > 0 {
+		i -= len(m.ToCoinDenom)
+		copy(dAtA[i:], m.ToCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToCoinDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.FromCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EstimateTradeBasedOnPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalPoolLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalPoolLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.OutputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.InputCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OptimalRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *OptimalRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalVolumeForPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OptimalRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *TotalVolumeForPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.Volume) > 0 {
-		for _, e := range m.Volume {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom_0)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom_1)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OptimalRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TakerFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *OptimalRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateTradeBasedOnPriceImpactRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OptimalRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FromCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ToCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.MaxPriceImpact.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ExternalPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllTakerFeeShareAgreementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InputCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.OutputCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAgreementsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllTakerFeeShareAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllTakerFeeShareAgreementsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllTakerFeeShareAgreementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.TakerFeeShareAgreements) > 0 {
-		for _, e := range m.TakerFeeShareAgreements {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.TakerFeeShareAgreements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeShareAgreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareAgreementFromDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareAgreementFromDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareAgreementFromDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TakerFeeShareAgreement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeShareAgreementFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareAgreementFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TakerFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TakerFeeShareAgreement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllTakerFeeShareAccumulatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllTakerFeeShareAccumulatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TakerFeeShareDenomsToAccruedValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeeSkimAccumulators) > 0 {
-		for _, e := range m.TakerFeeSkimAccumulators {
+	if len(m.TakerFeeDenom) > 0 {
+		i -= len(m.TakerFeeDenom)
+		copy(dAtA[i:], m.TakerFeeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TakerFeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakerFeeSkimAccumulators) > 0 {
+		for iNdEx := len(m.TakerFeeSkimAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeSkimAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRegisteredAlloyedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRegisteredAlloyedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRegisteredAlloyedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRegisteredAlloyedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRegisteredAlloyedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractStates) > 0 {
+		for iNdEx := len(m.ContractStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for _, s := range m.RoutesTokenOutDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenInDenom) > 0 {
+		for _, s := range m.RoutesTokenInDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *PoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPoolsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalVolumeForPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TotalVolumeForPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradingPairTakerFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom_1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradingPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ExplainTakerFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExplainTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalTakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	l = len(m.DiscountTier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AccountVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AccountVolumeConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AccountVolumeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeDiscountTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TakerFeeDiscountTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateTradeBasedOnPriceImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ToCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExternalPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InputCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutputCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OptimalRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *OptimalRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllTakerFeeShareAgreementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllTakerFeeShareAgreementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeeShareAgreements) > 0 {
+		for _, e := range m.TakerFeeShareAgreements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeShareAgreementFromDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeShareAgreementFromDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFeeShareAgreement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TakerFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllTakerFeeShareAccumulatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllTakerFeeShareAccumulatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeeSkimAccumulators) > 0 {
+		for _, e := range m.TakerFeeSkimAccumulators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllRegisteredAlloyedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractStates) > 0 {
+		for _, e := range m.ContractStates {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RegisteredAlloyedPoolFromDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *RegisteredAlloyedPoolFromDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.ContractState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.ContractState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *AllRegisteredAlloyedPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractStates) > 0 {
-		for _, e := range m.ContractStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,12 +8568,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5659,7 +8614,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5682,15 +8637,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5717,7 +8672,10 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pool == nil {
+				m.Pool = &types1.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5742,7 +8700,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5765,17 +8723,67 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5785,46 +8793,79 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5852,11 +8893,61 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5883,8 +8974,8 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5909,7 +9000,7 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5932,10 +9023,10 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5959,7 +9050,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5987,87 +9078,11 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,7 +9110,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6118,7 +9133,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6141,17 +9156,17 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6161,16 +9176,79 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var stringLen uint64
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6180,29 +9258,66 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6212,23 +9327,25 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6251,7 +9368,7 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6274,46 +9391,12 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6335,7 +9418,7 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,66 +9441,15 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6444,43 +9476,11 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6502,7 +9502,7 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6525,10 +9525,10 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6550,119 +9550,61 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6672,23 +9614,25 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6711,7 +9655,7 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,34 +9678,15 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6789,11 +9714,11 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6821,7 +9746,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6844,7 +9769,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6867,15 +9792,15 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6903,7 +9828,7 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6928,7 +9853,7 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *ExplainTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6951,67 +9876,49 @@ func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainTakerFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.NumPools = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7021,66 +9928,31 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7090,11 +9962,24 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7116,7 +10001,7 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+func (m *ExplainTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7139,15 +10024,15 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7174,63 +10059,45 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types1.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hops = append(m.Hops, TakerFeeHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTakerFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7252,7 +10119,7 @@ func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,17 +10142,36 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7295,79 +10181,144 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= types.TakerFeeSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountTier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscountTier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7395,7 +10346,9 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7418,7 +10371,7 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7441,17 +10394,17 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7461,25 +10414,23 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7502,7 +10453,7 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7525,34 +10476,15 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7580,13 +10512,13 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7596,77 +10528,29 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Windows = append(m.Windows, types.AccountVolume{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7694,7 +10578,9 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7717,7 +10603,7 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7740,31 +10626,12 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7786,7 +10653,7 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7809,15 +10676,15 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7844,8 +10711,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7870,7 +10736,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountTiersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7893,10 +10759,10 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDiscountTiersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDiscountTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7920,7 +10786,7 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountTiersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7943,15 +10809,15 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDiscountTiersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDiscountTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7978,8 +10844,8 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, types.TakerFeeDiscountTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8004,7 +10870,7 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8027,17 +10893,17 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8047,66 +10913,30 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8116,79 +10946,46 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Volume = append(m.Volume, types2.Coin{})
-			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8216,11 +11013,13 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8248,7 +11047,9 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8271,7 +11072,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8294,17 +11095,17 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8314,23 +11115,55 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8355,7 +11188,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *OptimalRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8378,17 +11211,17 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptimalRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptimalRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8398,28 +11231,27 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8447,13 +11279,13 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
 			}
-			m.PoolId = 0
+			m.MaxHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8463,50 +11295,16 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.MaxHops |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
 			}
-			var stringLen uint64
+			m.MaxSplits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8516,26 +11314,11 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSplits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8557,7 +11340,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *OptimalRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,15 +11363,15 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptimalRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptimalRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8615,15 +11398,16 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, types.SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8633,22 +11417,57 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,6 +12,14 @@ import (
 
 var IntMaxValue = intMaxValue
 
+// SetRouteSearchSteps sets the bound on the pool hops tried by OptimalRoute and returns a function
+// restoring the previous one.
+func SetRouteSearchSteps(steps int) (restore func()) {
+	previous := routeSearchSteps
+	routeSearchSteps = steps
+	return func() { routeSearchSteps = previous }
+}
+
 func (k Keeper) GetNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
 	return k.getNextPoolIdAndIncrement(ctx)
 }
@@ -54,8 +62,8 @@ func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	k.trackVolume(ctx, poolId, volumeGenerated)
 }
 
-func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
-	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn)
+func (k Keeper) ChargeTakerFee(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
+	return k.chargeTakerFee(ctx, poolId, tokenIn, tokenOutDenom, sender, exactIn)
 }
 
 func (k Keeper) QueryAndCheckAlloyedDenom(ctx sdk.Context, contractAddr sdk.AccAddress) (string, error) {
@@ -28,6 +28,7 @@ type Keeper struct {
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
 	wasmKeeper           types.WasmKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -58,15 +59,24 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, gam
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	routesMap := map[types.PoolType]types.PoolModuleI{
-		types.Balancer:     gammKeeper,
-		types.Stableswap:   gammKeeper,
-		types.Concentrated: concentratedKeeper,
-		types.CosmWasm:     cosmwasmpoolKeeper,
-	}
+	routesMap := map[types.PoolType]types.PoolModuleI{}
+	routesList := []types.PoolModuleI{}
 
-	routesList := []types.PoolModuleI{
-		gammKeeper, concentratedKeeper, cosmwasmpoolKeeper,
+	// Pool modules that are not wired into the app are left out of the routes,
+	// so that pools of their type fail with an undefined route error instead of
+	// dereferencing a nil module.
+	if gammKeeper != nil {
+		routesMap[types.Balancer] = gammKeeper
+		routesMap[types.Stableswap] = gammKeeper
+		routesList = append(routesList, gammKeeper)
+	}
+	if concentratedKeeper != nil {
+		routesMap[types.Concentrated] = concentratedKeeper
+		routesList = append(routesList, concentratedKeeper)
+	}
+	if cosmwasmpoolKeeper != nil {
+		routesMap[types.CosmWasm] = cosmwasmpoolKeeper
+		routesList = append(routesList, cosmwasmpoolKeeper)
 	}
 
 	cachedPoolModules := &sync.Map{}
@@ -113,6 +123,13 @@ func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
 
+// GetBaseDenom returns the base denom pool volume is tracked in.
+func (k Keeper) GetBaseDenom(ctx sdk.Context) string {
+	var baseDenom string
+	k.paramSpace.Get(ctx, types.KeyBaseDenom, &baseDenom)
+	return baseDenom
+}
+
 // InitGenesis initializes the poolmanager module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
@@ -147,7 +164,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 	// Set the denom pair taker fees KVStore.
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
-		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
+		k.ApplyDenomPairTakerFee(ctx, denomPairTakerFee)
+	}
+
+	// Set the pool taker fees KVStore.
+	for _, poolTakerFee := range genState.PoolTakerFeeStore {
+		k.SetPoolTakerFee(ctx, poolTakerFee.PoolId, poolTakerFee.TakerFee)
+	}
+
+	// Set the account volume tracking KVStore.
+	if !genState.AccountVolumeConfig.IsUnset() {
+		k.SetAccountVolumeConfig(ctx, genState.AccountVolumeConfig)
+	}
+	for _, accountVolume := range genState.AccountVolumes {
+		if err := k.SetAccountVolume(ctx, accountVolume); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the taker fee discount tiers KVStore.
+	for _, tier := range genState.TakerFeeDiscountTiers {
+		if err := k.SetTakerFeeDiscountTier(ctx, tier); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -174,6 +213,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolTakerFees, err := k.GetAllPoolTakerFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	takerFeeDiscountTiers, err := k.GetAllTakerFeeDiscountTiers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	accountVolumes, err := k.GetAllAccountVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -187,6 +241,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolTakerFeeStore:      poolTakerFees,
+		TakerFeeDiscountTiers:  takerFeeDiscountTiers,
+		AccountVolumeConfig:    k.GetAccountVolumeConfig(ctx),
+		AccountVolumes:         accountVolumes,
 	}
 }
 
@@ -226,6 +284,11 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // BeginBlock sets the poolmanager caches if they are empty
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
 	// Here, the only time in which these caches are empty is during the start up of the node.
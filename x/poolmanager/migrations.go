@@ -0,0 +1,68 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// The migration sets the base denom params added in the consensus version 2 and converts the stored
+// pool volume to the base denom. Volume in other denoms is converted at the TWAP of their base
+// denom price pool, and dropped if they have none or it has no TWAP, since it was tracked in a
+// denom assumed to be the base denom of the chain.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	ctx.Logger().Info("Migrating poolmanager base denom...")
+
+	if !k.paramSpace.Has(ctx, types.KeyBaseDenom) {
+		k.SetParam(ctx, types.KeyBaseDenom, types.DefaultParams().BaseDenom)
+	}
+	if !k.paramSpace.Has(ctx, types.KeyBaseDenomPricePools) {
+		k.SetParam(ctx, types.KeyBaseDenomPricePools, []types.DenomPricePool{})
+	}
+	if !k.paramSpace.Has(ctx, types.KeyBaseDenomTwapDuration) {
+		k.SetParam(ctx, types.KeyBaseDenomTwapDuration, types.DefaultBaseDenomTwapDuration)
+	}
+	params := k.GetParams(ctx)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		totalVolume := k.GetTotalVolumeForPool(ctx, pool.GetId())
+		if totalVolume.IsZero() {
+			continue
+		}
+
+		volumeInBaseDenom := osmomath.ZeroInt()
+		for _, volume := range totalVolume {
+			value, ok := k.valueInBaseDenom(ctx, params, volume)
+			if !ok {
+				ctx.Logger().Info("Dropping pool volume that cannot be priced in the base denom", "pool_id", pool.GetId(), "volume", volume.String())
+				continue
+			}
+			volumeInBaseDenom = volumeInBaseDenom.Add(value)
+		}
+		k.SetVolume(ctx, pool.GetId(), sdk.NewCoins(sdk.NewCoin(params.BaseDenom, volumeInBaseDenom)))
+	}
+
+	ctx.Logger().Info("Finished migrating poolmanager base denom...")
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package poolmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+func TestBaseDenomVolume(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+
+	atomNtrn := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 2_000_000))
+	usdcAtom := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("uatom", 1_000_000))
+
+	params := k.GetParams(ctx)
+	params.BaseDenom = "untrn"
+	params.BaseDenomPricePools = []types.DenomPricePool{{Denom: "uatom", PoolId: atomNtrn}}
+	params.BaseDenomTwapDuration = time.Hour
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+	require.Equal(t, "untrn", k.GetBaseDenom(ctx))
+
+	params.BaseDenomTwapDuration = 0
+	require.Error(t, params.Validate())
+
+	fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000), sdk.NewInt64Coin("uusdc", 1_000)))
+
+	t.Run("swaps are tracked in the base denom", func(t *testing.T) {
+		// the price pool has no TWAP over the last hour yet, the uatom in is not valued
+		_, _, err := k.SwapExactAmountIn(ctx, sender, atomNtrn, sdk.NewInt64Coin("uatom", 1_000), "untrn", math.OneInt())
+		require.NoError(t, err)
+		require.True(t, k.GetTotalVolumeForPool(ctx, atomNtrn).IsZero())
+
+		// the swap moved the spot price, the volume is still priced at the TWAP
+		later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		_, _, err = k.SwapExactAmountIn(later, sender, atomNtrn, sdk.NewInt64Coin("uatom", 1_000), "untrn", math.OneInt())
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(2_000), k.GetBaseDenomVolumeForPool(ctx, atomNtrn))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("untrn", 2_000)), k.GetTotalVolumeForPool(ctx, atomNtrn))
+
+		// uusdc has no base denom price pool, so its volume is not tracked
+		_, _, err = k.SwapExactAmountIn(later, sender, usdcAtom, sdk.NewInt64Coin("uusdc", 1_000), "uatom", math.OneInt())
+		require.NoError(t, err)
+		require.True(t, k.GetTotalVolumeForPool(ctx, usdcAtom).IsZero())
+	})
+
+	t.Run("migration converts stored volume to the base denom", func(t *testing.T) {
+		k.SetVolume(ctx, atomNtrn, sdk.NewCoins(sdk.NewInt64Coin("untrn", 500), sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 7_000)))
+		k.SetVolume(ctx, usdcAtom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 7_000)))
+
+		require.NoError(t, poolmanager.NewMigrator(k).Migrate1to2(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+
+		// uatom is converted at its TWAP, uosmo cannot be priced and is dropped
+		require.Equal(t, math.NewInt(2_500), k.GetBaseDenomVolumeForPool(ctx, atomNtrn))
+		require.Len(t, k.GetTotalVolumeForPool(ctx, atomNtrn), 1)
+		require.True(t, k.GetTotalVolumeForPool(ctx, usdcAtom).IsZero())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
@@ -29,7 +30,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.SwapProtection())
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +49,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.SwapProtection())
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +67,7 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount, msg.SwapProtection())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount, msg.SwapProtection())
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +99,7 @@ func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.M
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	for _, denomPair := range msg.DenomPairTakerFee {
-		err := server.keeper.SenderValidationSetDenomPairTakerFee(ctx, msg.Sender, denomPair.TokenInDenom, denomPair.TokenOutDenom, denomPair.TakerFee)
+		err := server.keeper.SenderValidationSetDenomPairTakerFee(ctx, msg.Sender, denomPair)
 		if err != nil {
 			return nil, err
 		}
@@ -170,3 +171,96 @@ func (server msgServer) SetRegisteredAlloyedPool(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRegisteredAlloyedPoolResponse{}, nil
 }
+
+func (server msgServer) SetPoolTakerFee(goCtx context.Context, msg *types.MsgSetPoolTakerFee) (*types.MsgSetPoolTakerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkGovSender(msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, poolTakerFee := range msg.PoolTakerFees {
+		if poolTakerFee.Unset {
+			server.keeper.DeletePoolTakerFee(ctx, poolTakerFee.PoolId)
+		} else {
+			if _, err := server.keeper.GetPoolModule(ctx, poolTakerFee.PoolId); err != nil {
+				return nil, err
+			}
+			server.keeper.SetPoolTakerFee(ctx, poolTakerFee.PoolId, poolTakerFee.TakerFee)
+		}
+
+		// Emit event
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeMsgSetPoolTakerFee,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolTakerFee.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyTakerFee, takerFeeAttribute(poolTakerFee.TakerFee, poolTakerFee.Unset)),
+			),
+		})
+	}
+
+	return &types.MsgSetPoolTakerFeeResponse{}, nil
+}
+
+func (server msgServer) SetTakerFeeDiscountTiers(goCtx context.Context, msg *types.MsgSetTakerFeeDiscountTiers) (*types.MsgSetTakerFeeDiscountTiersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkGovSender(msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, tier := range msg.Tiers {
+		if err := server.keeper.ApplyTakerFeeDiscountTier(ctx, tier); err != nil {
+			return nil, err
+		}
+
+		discount := tier.Discount.String()
+		if tier.Unset {
+			discount = types.AttributeValueTakerFeeUnset
+		}
+
+		// Emit event
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeMsgSetTakerFeeDiscountTiers,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyDiscountTier, tier.Name),
+				sdk.NewAttribute(types.AttributeKeyDiscount, discount),
+			),
+		})
+	}
+
+	return &types.MsgSetTakerFeeDiscountTiersResponse{}, nil
+}
+
+func (server msgServer) SetAccountVolumeConfig(goCtx context.Context, msg *types.MsgSetAccountVolumeConfig) (*types.MsgSetAccountVolumeConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkGovSender(msg.Sender); err != nil {
+		return nil, err
+	}
+
+	server.keeper.SetAccountVolumeConfig(ctx, msg.Config)
+
+	// Emit event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetAccountVolumeConfig,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQuoteDenom, msg.Config.QuoteDenom),
+			sdk.NewAttribute(types.AttributeKeyWindowDuration, msg.Config.WindowDuration.String()),
+		),
+	})
+
+	return &types.MsgSetAccountVolumeConfigResponse{}, nil
+}
+
+// checkGovSender returns an error unless sender is the gov module account. The account may not be
+// created yet, so its address is derived instead of looked up.
+func checkGovSender(sender string) error {
+	if sender != authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		return types.ErrUnauthorizedGov
+	}
+	return nil
+}
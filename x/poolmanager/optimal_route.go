@@ -0,0 +1,289 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+const (
+	// MaxOptimalRouteHops bounds the number of pools in a route searched by OptimalRoute.
+	MaxOptimalRouteHops = 4
+	// MaxOptimalRouteSplits bounds the number of routes OptimalRoute splits the input across.
+	MaxOptimalRouteSplits = 5
+
+	// maxRouteCandidates bounds the number of routes the graph search collects, so that the
+	// number of route estimates does not grow with the number of pools.
+	maxRouteCandidates = 64
+	// routeSplitSteps is the number of equal chunks the input is split in when allocating it
+	// across several routes.
+	routeSplitSteps = 10
+)
+
+// routeSearchSteps bounds the number of pool hops the graph search tries, so that the search stays
+// bounded on denoms shared by many pools.
+var routeSearchSteps = 1024
+
+// routeCandidate is a route found by the graph search along with its output for the whole input.
+type routeCandidate struct {
+	pools  []types.SwapAmountInRoute
+	out    osmomath.Int
+	search int
+}
+
+// OptimalRoute searches the pool graph for the split route returning the most tokenOutDenom for
+// tokenIn. Routes have at most maxHops pools and the input is split across at most maxSplits routes
+// sharing no pool, so that each route estimate holds when the routes are swapped one after the
+// other by SplitRouteExactAmountIn. It returns the routes, the expected output net of spread and
+// taker fees and the price impact against the spot prices of the routes.
+func (k Keeper) OptimalRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops, maxSplits uint64,
+) ([]types.SwapAmountInSplitRoute, osmomath.Int, osmomath.Dec, error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, osmomath.Int{}, osmomath.Dec{}, fmt.Errorf("token in must be a positive coin, got %s", tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, osmomath.Int{}, osmomath.Dec{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, osmomath.Dec{}, fmt.Errorf("token in and token out denoms are both %s", tokenOutDenom)
+	}
+	if maxHops == 0 || maxHops > MaxOptimalRouteHops {
+		return nil, osmomath.Int{}, osmomath.Dec{}, fmt.Errorf("max hops must be in [1, %d], got %d", MaxOptimalRouteHops, maxHops)
+	}
+	if maxSplits == 0 || maxSplits > MaxOptimalRouteSplits {
+		return nil, osmomath.Int{}, osmomath.Dec{}, fmt.Errorf("max splits must be in [1, %d], got %d", MaxOptimalRouteSplits, maxSplits)
+	}
+
+	// estimates only read the pools, the cache context makes sure nothing leaks out of the query
+	ctx, _ = ctx.CacheContext()
+
+	candidates, err := k.findRouteCandidates(ctx, tokenIn, tokenOutDenom, int(maxHops))
+	if err != nil {
+		return nil, osmomath.Int{}, osmomath.Dec{}, err
+	}
+	if len(candidates) == 0 {
+		return nil, osmomath.Int{}, osmomath.Dec{}, fmt.Errorf("%w from %s to %s within %d hops", types.ErrNoRouteFound, tokenIn.Denom, tokenOutDenom, maxHops)
+	}
+
+	best := candidates[0]
+	routes := []types.SwapAmountInSplitRoute{{Pools: best.pools, TokenInAmount: tokenIn.Amount}}
+	tokenOutAmount := best.out
+
+	if split := disjointRoutes(candidates, int(maxSplits)); len(split) > 1 {
+		splitRoutes, splitOut := k.allocateSplit(ctx, split, tokenIn)
+		if splitOut.GT(tokenOutAmount) {
+			routes, tokenOutAmount = splitRoutes, splitOut
+		}
+	}
+
+	priceImpact, err := k.routesPriceImpact(ctx, routes, tokenIn.Denom, tokenOutAmount)
+	if err != nil {
+		return nil, osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	return routes, tokenOutAmount, priceImpact, nil
+}
+
+// routeGraph maps every denom to the ids of the pools trading it, in pool id order, and every pool
+// id to its denoms. It is built once per search from the pools of every pool module. Pools whose
+// denoms cannot be read are left out.
+type routeGraph struct {
+	poolsByDenom map[string][]uint64
+	poolDenoms   map[uint64][]string
+}
+
+func (k Keeper) buildRouteGraph(ctx sdk.Context) (routeGraph, error) {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return routeGraph{}, err
+	}
+	graph := routeGraph{
+		poolsByDenom: make(map[string][]uint64),
+		poolDenoms:   make(map[uint64][]string, len(pools)),
+	}
+	for _, pool := range pools {
+		denoms, err := k.RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil {
+			continue
+		}
+		graph.poolDenoms[pool.GetId()] = denoms
+		for _, denom := range denoms {
+			graph.poolsByDenom[denom] = append(graph.poolsByDenom[denom], pool.GetId())
+		}
+	}
+	return graph, nil
+}
+
+// findRouteCandidates walks the pool graph depth first from the token in denom, collecting the
+// routes to tokenOutDenom that visit a denom at most once, sorted by their output for the whole
+// input. Routes the pools cannot estimate (e.g. not enough liquidity) are left out. The walk tries
+// at most routeSearchSteps pool hops, the lower pool ids first.
+func (k Keeper) findRouteCandidates(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops int) ([]routeCandidate, error) {
+	graph, err := k.buildRouteGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		candidates []routeCandidate
+		path       []types.SwapAmountInRoute
+		visited    = map[string]bool{tokenIn.Denom: true}
+		steps      int
+	)
+
+	done := func() bool {
+		return len(candidates) >= maxRouteCandidates || steps >= routeSearchSteps
+	}
+
+	var walk func(denom string)
+	walk = func(denom string) {
+		if len(path) >= maxHops {
+			return
+		}
+
+		for _, poolId := range graph.poolsByDenom[denom] {
+			for _, next := range graph.poolDenoms[poolId] {
+				if visited[next] {
+					continue
+				}
+				if done() {
+					return
+				}
+				steps++
+
+				path = append(path, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: next})
+				if next == tokenOutDenom {
+					route := append([]types.SwapAmountInRoute(nil), path...)
+					if out, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn); err == nil {
+						candidates = append(candidates, routeCandidate{pools: route, out: out, search: len(candidates)})
+					}
+				} else {
+					visited[next] = true
+					walk(next)
+					visited[next] = false
+				}
+				path = path[:len(path)-1]
+			}
+		}
+	}
+	walk(tokenIn.Denom)
+
+	// ties are broken by search order, which follows the pool ids, to stay deterministic
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].out.Equal(candidates[j].out) {
+			return candidates[i].out.GT(candidates[j].out)
+		}
+		return candidates[i].search < candidates[j].search
+	})
+	return candidates, nil
+}
+
+// disjointRoutes picks, best first, up to maxSplits candidates that share no pool.
+func disjointRoutes(candidates []routeCandidate, maxSplits int) []routeCandidate {
+	usedPools := make(map[uint64]bool)
+	var picked []routeCandidate
+	for _, candidate := range candidates {
+		if len(picked) >= maxSplits {
+			break
+		}
+		disjoint := true
+		for _, hop := range candidate.pools {
+			if usedPools[hop.PoolId] {
+				disjoint = false
+				break
+			}
+		}
+		if !disjoint {
+			continue
+		}
+		for _, hop := range candidate.pools {
+			usedPools[hop.PoolId] = true
+		}
+		picked = append(picked, candidate)
+	}
+	return picked
+}
+
+// allocateSplit splits tokenIn in routeSplitSteps chunks and gives each chunk to the route with the
+// largest marginal output for it. Routes left without input are dropped.
+func (k Keeper) allocateSplit(ctx sdk.Context, split []routeCandidate, tokenIn sdk.Coin) ([]types.SwapAmountInSplitRoute, osmomath.Int) {
+	allocated := make([]osmomath.Int, len(split))
+	outs := make([]osmomath.Int, len(split))
+	for i := range split {
+		allocated[i] = osmomath.ZeroInt()
+		outs[i] = osmomath.ZeroInt()
+	}
+
+	step := tokenIn.Amount.QuoRaw(routeSplitSteps)
+	remaining := tokenIn.Amount
+	for remaining.IsPositive() {
+		chunk := step
+		// the first chunk takes the rounding remainder
+		if chunk.IsZero() || remaining.Equal(tokenIn.Amount) {
+			chunk = remaining.Sub(step.MulRaw(routeSplitSteps - 1))
+			if !chunk.IsPositive() || chunk.GT(remaining) {
+				chunk = remaining
+			}
+		}
+
+		bestIdx, bestOut, bestGain := -1, osmomath.Int{}, osmomath.Int{}
+		for i, candidate := range split {
+			out, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, candidate.pools, sdk.NewCoin(tokenIn.Denom, allocated[i].Add(chunk)))
+			if err != nil {
+				continue
+			}
+			if gain := out.Sub(outs[i]); bestIdx < 0 || gain.GT(bestGain) {
+				bestIdx, bestOut, bestGain = i, out, gain
+			}
+		}
+		if bestIdx < 0 {
+			// no route takes the chunk, the split cannot beat the single route
+			return nil, osmomath.ZeroInt()
+		}
+		allocated[bestIdx] = allocated[bestIdx].Add(chunk)
+		outs[bestIdx] = bestOut
+		remaining = remaining.Sub(chunk)
+	}
+
+	routes := make([]types.SwapAmountInSplitRoute, 0, len(split))
+	total := osmomath.ZeroInt()
+	for i, candidate := range split {
+		if allocated[i].IsZero() {
+			continue
+		}
+		routes = append(routes, types.SwapAmountInSplitRoute{Pools: candidate.pools, TokenInAmount: allocated[i]})
+		total = total.Add(outs[i])
+	}
+	return routes, total
+}
+
+// routesPriceImpact returns the relative shortfall of tokenOutAmount against the output of the
+// routes at the spot prices of their pools.
+func (k Keeper) routesPriceImpact(ctx sdk.Context, routes []types.SwapAmountInSplitRoute, tokenInDenom string, tokenOutAmount osmomath.Int) (osmomath.Dec, error) {
+	spotOut := osmomath.ZeroBigDec()
+	for _, route := range routes {
+		out := osmomath.BigDecFromSDKInt(route.TokenInAmount)
+		denomIn := tokenInDenom
+		for _, hop := range route.Pools {
+			price, err := k.RouteCalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, denomIn)
+			if err != nil {
+				return osmomath.Dec{}, err
+			}
+			out = out.Mul(price)
+			denomIn = hop.TokenOutDenom
+		}
+		spotOut = spotOut.Add(out)
+	}
+	if spotOut.IsZero() {
+		return osmomath.ZeroDec(), nil
+	}
+	return osmomath.OneBigDec().Sub(osmomath.BigDecFromSDKInt(tokenOutAmount).Quo(spotOut)).Dec(), nil
+}
@@ -0,0 +1,99 @@
+package poolmanager_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+func TestOptimalRoute(t *testing.T) {
+	neutronApp, ctx, creator := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	createPool := func(a, b sdk.Coin) uint64 {
+		return createBalancerPool(t, neutronApp, ctx, creator, a, b)
+	}
+
+	// a shallow direct pool and a deep two hop path through uusdc
+	direct := createPool(sdk.NewInt64Coin("uatom", 100_000), sdk.NewInt64Coin("untrn", 100_000))
+	atomUsdc := createPool(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uusdc", 1_000_000))
+	usdcNtrn := createPool(sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("untrn", 1_000_000))
+
+	tokenIn := sdk.NewInt64Coin("uatom", 50_000)
+
+	t.Run("single hop", func(t *testing.T) {
+		routes, out, _, err := k.OptimalRoute(ctx, tokenIn, "untrn", 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, []types.SwapAmountInSplitRoute{{
+			Pools:         []types.SwapAmountInRoute{{PoolId: direct, TokenOutDenom: "untrn"}},
+			TokenInAmount: tokenIn.Amount,
+		}}, routes)
+		expected, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, routes[0].Pools, tokenIn)
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("two hops beat the shallow pool", func(t *testing.T) {
+		routes, _, _, err := k.OptimalRoute(ctx, tokenIn, "untrn", 2, 1)
+		require.NoError(t, err)
+		require.Equal(t, []types.SwapAmountInRoute{
+			{PoolId: atomUsdc, TokenOutDenom: "uusdc"},
+			{PoolId: usdcNtrn, TokenOutDenom: "untrn"},
+		}, routes[0].Pools)
+	})
+
+	t.Run("split beats every single route", func(t *testing.T) {
+		_, singleOut, _, err := k.OptimalRoute(ctx, tokenIn, "untrn", 2, 1)
+		require.NoError(t, err)
+
+		routes, out, priceImpact, err := k.OptimalRoute(ctx, tokenIn, "untrn", 2, 2)
+		require.NoError(t, err)
+		require.Len(t, routes, 2)
+		require.True(t, out.GT(singleOut), "split output %s not above single route output %s", out, singleOut)
+		require.True(t, priceImpact.IsPositive())
+		require.True(t, priceImpact.LT(math.LegacyOneDec()))
+		require.NoError(t, types.ValidateSwapAmountInSplitRoute(routes))
+
+		total := math.ZeroInt()
+		for _, route := range routes {
+			total = total.Add(route.TokenInAmount)
+		}
+		require.Equal(t, tokenIn.Amount, total)
+
+		// the split is swappable as returned and yields the expected output
+		fundAccount(t, neutronApp, ctx, creator, sdk.NewCoins(tokenIn))
+		swapped, err := k.SplitRouteExactAmountIn(ctx, creator, routes, tokenIn.Denom, out, types.SwapProtection{})
+		require.NoError(t, err)
+		require.Equal(t, out, swapped)
+	})
+
+	t.Run("search stops after its step bound", func(t *testing.T) {
+		// the first hop tried is the direct pool, the two hop path is never reached
+		defer poolmanager.SetRouteSearchSteps(1)()
+		routes, _, _, err := k.OptimalRoute(ctx, tokenIn, "untrn", 2, 2)
+		require.NoError(t, err)
+		require.Equal(t, []types.SwapAmountInSplitRoute{{
+			Pools:         []types.SwapAmountInRoute{{PoolId: direct, TokenOutDenom: "untrn"}},
+			TokenInAmount: tokenIn.Amount,
+		}}, routes)
+
+		poolmanager.SetRouteSearchSteps(0)
+		_, _, _, err = k.OptimalRoute(ctx, tokenIn, "untrn", 2, 2)
+		require.ErrorIs(t, err, types.ErrNoRouteFound)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, _, _, err := k.OptimalRoute(ctx, tokenIn, "uatom", 2, 1)
+		require.Error(t, err)
+		_, _, _, err = k.OptimalRoute(ctx, tokenIn, "untrn", 0, 1)
+		require.Error(t, err)
+		_, _, _, err = k.OptimalRoute(ctx, tokenIn, "untrn", 2, 6)
+		require.Error(t, err)
+		_, _, _, err = k.OptimalRoute(ctx, tokenIn, "uosmo", 3, 1)
+		require.ErrorIs(t, err, types.ErrNoRouteFound)
+	})
+}
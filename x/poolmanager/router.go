@@ -48,20 +48,25 @@ func (k *Keeper) GetPoolModuleAndPool(ctx sdk.Context, poolId uint64) (swapModul
 // corresponding to poolID's pool type. It takes in the input denom and amount for
 // the initial swap against the first pool and chains the output as the input for the
 // next routed pool until the last pool is reached.
-// Transaction succeeds if final amount out is greater than tokenOutMinAmount defined
-// and no errors are encountered along the way.
+// Transaction succeeds if final amount out is greater than tokenOutMinAmount defined,
+// every hop stays within the bounds of protection and no errors are encountered along the way.
 func (k Keeper) RouteExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
+	protection types.SwapProtection,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
 		return osmomath.Int{}, err
 	}
 
+	if err := protection.CheckDeadline(ctx.BlockTime()); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	totalTakerFeesCharged := sdk.Coins{}
 	denomsInvolvedInRoute := []string{tokenIn.Denom}
 
@@ -74,14 +79,36 @@ func (k Keeper) RouteExactAmountIn(
 			_outMinAmount = tokenOutMinAmount
 		}
 
+		var spotPriceBefore osmomath.BigDec
+		if protection.ChecksSpotPrice() {
+			spotPriceBefore, err = k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, routeStep.TokenOutDenom, tokenIn.Denom)
+			if err != nil {
+				return osmomath.Int{}, err
+			}
+		}
+
 		var takerFeeCharged sdk.Coin
 		tokenOutAmount, takerFeeCharged, err = k.SwapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
 
+		if protection.ChecksSpotPrice() {
+			// the taker fee is charged before the pool, only the remainder is priced by it
+			poolTokenIn := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(takerFeeCharged.Amount))
+			if err := k.checkHopSwapProtection(ctx, protection, routeStep.PoolId, spotPriceBefore, poolTokenIn, sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)); err != nil {
+				return osmomath.Int{}, err
+			}
+		}
+
+		// Only the hops that paid a taker fee count towards the volume discount tiers
+		hopTokenOut := sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
+		if takerFeeCharged.IsPositive() {
+			k.trackAccountVolume(ctx, sender, tokenIn, hopTokenOut)
+		}
+
 		// Chain output of current pool as the input for the next routed pool
-		tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
+		tokenIn = hopTokenOut
 
 		// Track taker fees charged
 		totalTakerFeesCharged = totalTakerFeesCharged.Add(takerFeeCharged)
@@ -107,7 +134,7 @@ func (k Keeper) RouteExactAmountIn(
 // The route must end with the same token out and begin with the same token in.
 //
 // It performs the price impact protection check on the combination of tokens out from all multihop paths. The given tokenOutMinAmount
-// is used for comparison.
+// is used for comparison. The bounds of protection are enforced on every multihop path.
 //
 // Returns error if:
 //   - route are empty
@@ -122,6 +149,7 @@ func (k Keeper) SplitRouteExactAmountIn(
 	routes []types.SwapAmountInSplitRoute,
 	tokenInDenom string,
 	tokenOutMinAmount osmomath.Int,
+	protection types.SwapProtection,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
 		return osmomath.Int{}, err
@@ -141,7 +169,8 @@ func (k Keeper) SplitRouteExactAmountIn(
 			sender,
 			types.SwapAmountInRoutes(multihopRoute.Pools),
 			sdk.NewCoin(tokenInDenom, multihopRoute.TokenInAmount),
-			multihopStartTokenOutMinAmount)
+			multihopStartTokenOutMinAmount,
+			protection)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -192,7 +221,7 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, sdk.Coin{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
-	tokenInAfterSubTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	tokenInAfterSubTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, poolId, tokenIn, tokenOutDenom, sender, true)
 	if err != nil {
 		return osmomath.Int{}, sdk.Coin{}, err
 	}
@@ -204,7 +233,7 @@ func (k Keeper) SwapExactAmountIn(
 	}
 
 	// Track volume for volume-splitting incentives
-	// k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackVolume(ctx, pool.GetId(), tokenIn)
 
 	return tokenOutAmount, takerFeeCharged, nil
 }
@@ -293,7 +322,7 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		actualTokenIn := tokenIn
 		// apply taker fee if applicable
 		if applyTakerFee {
-			takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom)
+			takerFee, _, err := k.GetEffectiveTakerFee(ctx, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
 			if err != nil {
 				return osmomath.Int{}, err
 			}
@@ -324,12 +353,13 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 // for a given input amount when swapping tokens, taking into account the current price of the
 // tokens in the pool and any slippage.
 // Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined
-// tokenInMaxAmount defined.
+// tokenInMaxAmount defined and every hop stays within the bounds of protection.
 func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	sender sdk.AccAddress,
 	route []types.SwapAmountOutRoute,
 	tokenInMaxAmount osmomath.Int,
 	tokenOut sdk.Coin,
+	protection types.SwapProtection,
 ) (tokenInAmount osmomath.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
@@ -337,6 +367,10 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		return osmomath.Int{}, err
 	}
 
+	if err := protection.CheckDeadline(ctx.BlockTime()); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			tokenInAmount = osmomath.Int{}
@@ -391,21 +425,39 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			spreadFactor = routeSpreadFactor.Mul((spreadFactor.Quo(sumOfSpreadFactors)))
 		}
 
+		var spotPriceBefore osmomath.BigDec
+		if protection.ChecksSpotPrice() {
+			spotPriceBefore, err = k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, _tokenOut.Denom, routeStep.TokenInDenom)
+			if err != nil {
+				return osmomath.Int{}, err
+			}
+		}
+
 		curTokenInAmount, swapErr := swapModule.SwapExactAmountOut(ctx, sender, pool, routeStep.TokenInDenom, insExpected[i], _tokenOut, spreadFactor)
 		if swapErr != nil {
 			return osmomath.Int{}, swapErr
 		}
 
+		if protection.ChecksSpotPrice() {
+			if err := k.checkHopSwapProtection(ctx, protection, routeStep.PoolId, spotPriceBefore, sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount), _tokenOut); err != nil {
+				return osmomath.Int{}, err
+			}
+		}
+
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+		tokenInAfterAddTakerFee, takerFeeCharged, err := k.chargeTakerFee(ctx, routeStep.PoolId, tokenIn, _tokenOut.Denom, sender, false)
 		ctx.Logger().Info("in here with token fee: ", "tokenIn", tokenIn, "tokenInAfterTakerFee", tokenInAfterAddTakerFee)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
 
 		// Track volume for volume-splitting incentives
-		//TODO: check if custom logic needed 
-		//k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+
+		// Only the hops that paid a taker fee count towards the volume discount tiers
+		if takerFeeCharged.IsPositive() {
+			k.trackAccountVolume(ctx, sender, tokenInAfterAddTakerFee, _tokenOut)
+		}
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
@@ -439,7 +491,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 // The route must end with the same token out and begin with the same token in.
 //
 // It performs the price impact protection check on the combination of tokens in from all multihop paths. The given tokenInMaxAmount
-// is used for comparison.
+// is used for comparison. The bounds of protection are enforced on every multihop path.
 //
 // Returns error if:
 //   - route are empty
@@ -454,6 +506,7 @@ func (k Keeper) SplitRouteExactAmountOut(
 	route []types.SwapAmountOutSplitRoute,
 	tokenOutDenom string,
 	tokenInMaxAmount osmomath.Int,
+	protection types.SwapProtection,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountOutSplitRoute(route); err != nil {
 		return osmomath.Int{}, err
@@ -474,7 +527,8 @@ func (k Keeper) SplitRouteExactAmountOut(
 			sender,
 			types.SwapAmountOutRoutes(multihopRoute.Pools),
 			multihopStartTokenInMaxAmount,
-			sdk.NewCoin(tokenOutDenom, multihopRoute.TokenOutAmount))
+			sdk.NewCoin(tokenOutDenom, multihopRoute.TokenOutAmount),
+			protection)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -502,6 +556,42 @@ func (k Keeper) SplitRouteExactAmountOut(
 	return totalInAmount, nil
 }
 
+// checkHopSwapProtection enforces the price impact and spot price drift bounds of protection on a hop
+// that swapped tokenIn for tokenOut in the pool. spotPriceBefore is the spot price of the pool before
+// the swap, in tokenOut per tokenIn.
+func (k Keeper) checkHopSwapProtection(
+	ctx sdk.Context,
+	protection types.SwapProtection,
+	poolId uint64,
+	spotPriceBefore osmomath.BigDec,
+	tokenIn, tokenOut sdk.Coin,
+) error {
+	if !spotPriceBefore.IsPositive() || !tokenIn.Amount.IsPositive() {
+		return fmt.Errorf("pool %d has no spot price for %s in %s", poolId, tokenIn.Denom, tokenOut.Denom)
+	}
+
+	if protection.MaxPriceImpact != nil {
+		executionPrice := osmomath.BigDecFromSDKInt(tokenOut.Amount).Quo(osmomath.BigDecFromSDKInt(tokenIn.Amount))
+		priceImpact := osmomath.OneBigDec().Sub(executionPrice.Quo(spotPriceBefore)).Dec()
+		if priceImpact.GT(*protection.MaxPriceImpact) {
+			return types.MaxPriceImpactExceededError{PoolId: poolId, PriceImpact: priceImpact, MaxPriceImpact: *protection.MaxPriceImpact}
+		}
+	}
+
+	if protection.MaxSpotPriceDrift != nil {
+		spotPriceAfter, err := k.RouteCalculateSpotPrice(ctx, poolId, tokenOut.Denom, tokenIn.Denom)
+		if err != nil {
+			return err
+		}
+		spotPriceDrift := spotPriceAfter.Sub(spotPriceBefore).Abs().Quo(spotPriceBefore).Dec()
+		if spotPriceDrift.GT(*protection.MaxSpotPriceDrift) {
+			return types.MaxSpotPriceDriftExceededError{PoolId: poolId, SpotPriceDrift: spotPriceDrift, MaxSpotPriceDrift: *protection.MaxSpotPriceDrift}
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) RouteGetPoolDenoms(
 	ctx sdk.Context,
 	poolId uint64,
@@ -657,7 +747,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, _, err := k.GetEffectiveTakerFee(ctx, routeStep.PoolId, routeStep.TokenInDenom, tokenOut.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -693,73 +783,73 @@ func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins
 
 // TotalLiquidity gets the total liquidity across all pools.
 func (k Keeper) TotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
-	totalGammLiquidity, err := k.gammKeeper.GetTotalLiquidity(ctx)
-	if err != nil {
-		return nil, err
-	}
-	totalConcentratedLiquidity, err := k.concentratedKeeper.GetTotalLiquidity(ctx)
-	if err != nil {
-		return nil, err
-	}
-	totalCosmwasmLiquidity, err := k.cosmwasmpoolKeeper.GetTotalLiquidity(ctx)
-	if err != nil {
-		return nil, err
+	totalLiquidity := sdk.Coins{}
+	for _, poolModule := range k.poolModules {
+		moduleLiquidity, err := poolModule.GetTotalLiquidity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		totalLiquidity = totalLiquidity.Add(moduleLiquidity...)
 	}
-	totalLiquidity := totalGammLiquidity.Add(totalConcentratedLiquidity...).Add(totalCosmwasmLiquidity...)
 	return totalLiquidity, nil
 }
 
-// nolint: unused
-// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID.
-// Fails quietly if an OSMO paired pool cannot be found, although this should only happen in rare scenarios where OSMO is
-// removed as a base denom from the protorev module (which this function relies on).
+// trackVolume converts the input token into base denom units and adds it to the global tracked volume for the given pool ID.
+// Fails quietly if the input token cannot be priced in the base denom, as volume tracking should never fail a swap.
 //
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
 func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
-	// If the denom is already denominated in uosmo, we can just use it directly
-	OSMO, err := k.stakingKeeper.BondDenom(ctx)
-	if err != nil {
-		panic(err)
-	}
-	if volumeGenerated.Denom == OSMO {
-		k.addVolume(ctx, poolId, volumeGenerated)
+	params := k.GetParams(ctx)
+	volumeInBaseDenom, ok := k.valueInBaseDenom(ctx, params, volumeGenerated)
+	if !ok {
 		return
 	}
 
-	// Get the most liquid OSMO-paired pool with `volumeGenerated`'s denom using `GetPoolForDenomPair`
-	osmoPairedPoolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, OSMO, volumeGenerated.Denom)
+	// Add this new volume to the global tracked volume for the pool ID
+	k.addVolume(ctx, poolId, sdk.NewCoin(params.BaseDenom, volumeInBaseDenom))
+}
 
-	// If no pool is found, fail quietly.
-	//
-	// This is a rare scenario that should only happen if OSMO-paired pools are all removed from the protorev module.
-	// Since this removal scenario is all-or-nothing, this is functionally equiavalent to freezing the tracked volume amounts
-	// where they were prior to the disabling, which seems an appropriate response.
-	//
-	// This branch would also get triggered in the case where there is a token that has no OSMO-paired pool on the entire chain.
-	// We simply do not track volume in these cases. Importantly, volume splitting gauge logic should prevent a gauge from being
-	// created for such a pool that includes such a token, although it is okay to no-op in these cases regardless.
-	if err != nil {
-		return
+// valueInBaseDenom returns the value of coin in the base denom, priced with the TWAP of its base denom price pool,
+// or of the base denom paired pool registered in protorev if it has none. Pool volume feeds the incentives, so it is
+// never priced at the spot price, which a swap can move: without a twap keeper or a TWAP for the pool, only the base
+// denom is valued.
+func (k Keeper) valueInBaseDenom(ctx sdk.Context, params types.Params, coin sdk.Coin) (osmomath.Int, bool) {
+	// If the denom is already the base denom, we can just use it directly
+	if coin.Denom == params.BaseDenom {
+		return coin.Amount, true
 	}
 
-	// Since we want to ultimately multiply the volume by this spot price, we want to quote OSMO in terms of the input token.
-	// This is so that once we multiply the volume by the spot price, we get the volume in units of OSMO.
-	osmoPerInputToken, err := k.RouteCalculateSpotPrice(ctx, osmoPairedPoolId, OSMO, volumeGenerated.Denom)
+	poolId, ok := params.BaseDenomPricePool(coin.Denom)
+	if !ok {
+		// This branch gets triggered in the case where there is a token that has no base denom paired pool known to the
+		// chain. We simply do not track volume in these cases. Importantly, volume splitting gauge logic should prevent a
+		// gauge from being created for such a pool, although it is okay to no-op in these cases regardless.
+		if k.protorevKeeper == nil {
+			return osmomath.Int{}, false
+		}
+		var err error
+		poolId, err = k.protorevKeeper.GetPoolForDenomPair(ctx, params.BaseDenom, coin.Denom)
+		if err != nil {
+			return osmomath.Int{}, false
+		}
+	}
+	if k.twapKeeper == nil {
+		return osmomath.Int{}, false
+	}
 
-	// We expect that if a pool is found, there should always be an available spot price as well.
-	// That being said, if there is an error finding the spot price, we fail quietly and leave tracked volume unchanged.
-	// This is because we do not want to escalate an issue with finding spot price to locking all swaps involving the given asset.
+	// The TWAP quotes the base denom in terms of the input token, so that once we multiply the volume by it, we get
+	// the volume in units of the base denom.
+	//
+	// If there is no TWAP, we fail quietly and leave tracked volume unchanged. This is because we do not want to
+	// escalate an issue with pricing to locking all swaps involving the given asset.
+	baseDenomPerInputToken, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, coin.Denom, params.BaseDenom, ctx.BlockTime().Add(-params.BaseDenomTwapDuration))
 	if err != nil {
-		return
+		return osmomath.Int{}, false
 	}
 
-	// Multiply `volumeGenerated.Amount.ToDec()` by this spot price.
 	// While rounding does not particularly matter here, we round down to ensure that we do not overcount volume.
-	volumeInOsmo := osmomath.BigDecFromSDKInt(volumeGenerated.Amount).Mul(osmoPerInputToken).Dec().TruncateInt()
-
-	// Add this new volume to the global tracked volume for the pool ID
-	k.addVolume(ctx, poolId, sdk.NewCoin(OSMO, volumeInOsmo))
+	return osmomath.BigDecFromSDKInt(coin.Amount).Mul(osmomath.BigDecFromDec(baseDenomPerInputToken)).Dec().TruncateInt(), true
 }
 
 // addVolume adds the given volume to the global tracked volume for the given pool ID.
@@ -802,14 +892,9 @@ func (k Keeper) GetTotalVolumeForPool(ctx sdk.Context, poolId uint64) sdk.Coins
 	return currentTotalVolume
 }
 
-// GetOsmoVolumeForPool gets the total OSMO-denominated historical volume for a given pool ID.
-func (k Keeper) GetOsmoVolumeForPool(ctx sdk.Context, poolId uint64) osmomath.Int {
-	totalVolume := k.GetTotalVolumeForPool(ctx, poolId)
-	OSMO, err := k.stakingKeeper.BondDenom(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return totalVolume.AmountOf(OSMO)
+// GetBaseDenomVolumeForPool gets the total base denom denominated historical volume for a given pool ID.
+func (k Keeper) GetBaseDenomVolumeForPool(ctx sdk.Context, poolId uint64) osmomath.Int {
+	return k.GetTotalVolumeForPool(ctx, poolId).AmountOf(k.GetBaseDenom(ctx))
 }
 
 // EstimateTradeBasedOnPriceImpactBalancerPool estimates a trade based on price impact for a balancer pool type.
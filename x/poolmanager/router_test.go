@@ -0,0 +1,175 @@
+package poolmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	icssimapp "github.com/cosmos/interchain-security/v5/testutil/ibc_testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/app"
+	"github.com/maany-xyz/maany-dex/v5/app/config"
+	"github.com/maany-xyz/maany-dex/v5/testutil"
+	clmodel "github.com/maany-xyz/maany-dex/v5/x/concentrated-liquidity/model"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	gammtypes "github.com/maany-xyz/maany-dex/v5/x/gamm/types"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// setupPoolmanagerTest returns an app without pool creation fee and a funded-on-demand sender.
+func setupPoolmanagerTest(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	chainID := ibctesting.GetChainID(1)
+
+	ibctesting.DefaultTestingAppInit = icssimapp.ProviderAppIniter
+	coordinator.Chains[chainID] = ibctesting.NewTestChain(t, coordinator, chainID)
+	providerChain := coordinator.GetChain(chainID)
+
+	_ = config.GetDefaultConfig()
+	ibctesting.DefaultTestingAppInit = testutil.SetupTestingApp(cmttypes.TM2PB.ValidatorUpdates(providerChain.Vals))
+	chain := ibctesting.NewTestChain(t, coordinator, "test")
+
+	neutronApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+
+	params := neutronApp.PoolManagerKeeper.GetParams(ctx)
+	params.PoolCreationFee = sdk.NewCoins()
+	neutronApp.PoolManagerKeeper.SetParams(ctx, params)
+
+	return neutronApp, ctx, chain.SenderAccount.GetAddress()
+}
+
+func fundAccount(t *testing.T, neutronApp *app.App, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	require.NoError(t, neutronApp.BankKeeper.MintCoins(ctx, gammtypes.ModuleName, coins))
+	require.NoError(t, neutronApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, gammtypes.ModuleName, addr, coins))
+}
+
+// createBalancerPool creates an equally weighted balancer pool of a and b with a 0.3% spread factor.
+func createBalancerPool(t *testing.T, neutronApp *app.App, ctx sdk.Context, creator sdk.AccAddress, a, b sdk.Coin) uint64 {
+	fundAccount(t, neutronApp, ctx, creator, sdk.NewCoins(a, b))
+	poolID, err := neutronApp.PoolManagerKeeper.CreatePool(ctx, balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+		SwapFee: math.LegacyNewDecWithPrec(3, 3),
+		ExitFee: math.LegacyZeroDec(),
+	}, []balancer.PoolAsset{
+		{Token: a, Weight: math.NewInt(1)},
+		{Token: b, Weight: math.NewInt(1)},
+	}, ""))
+	require.NoError(t, err)
+	return poolID
+}
+
+func TestRouteSwapProtection(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+
+	poolID := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 1_000_000))
+	fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)))
+
+	routeIn := []types.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: "untrn"}}
+	routeOut := []types.SwapAmountOutRoute{{PoolId: poolID, TokenInDenom: "uatom"}}
+	// 10% of the pool moves its spot price by about 20% and executes about 10% below spot
+	tokenIn := sdk.NewInt64Coin("uatom", 100_000)
+	tokenOut := sdk.NewInt64Coin("untrn", 90_000)
+	dec := func(s string) *math.LegacyDec {
+		d := math.LegacyMustNewDecFromStr(s)
+		return &d
+	}
+	past, future := ctx.BlockTime().Add(-time.Second), ctx.BlockTime().Add(time.Minute)
+
+	tests := map[string]struct {
+		protection types.SwapProtection
+		expectErr  error
+	}{
+		"no protection": {},
+		"deadline not reached": {
+			protection: types.SwapProtection{Deadline: &future},
+		},
+		"deadline exceeded": {
+			protection: types.SwapProtection{Deadline: &past},
+			expectErr:  types.SwapDeadlineExceededError{Deadline: past, BlockTime: ctx.BlockTime()},
+		},
+		"price impact within bound": {
+			protection: types.SwapProtection{MaxPriceImpact: dec("0.15")},
+		},
+		"price impact exceeded": {
+			protection: types.SwapProtection{MaxPriceImpact: dec("0.05")},
+			expectErr:  types.MaxPriceImpactExceededError{},
+		},
+		"spot price drift within bound": {
+			protection: types.SwapProtection{MaxSpotPriceDrift: dec("0.25")},
+		},
+		"spot price drift exceeded": {
+			protection: types.SwapProtection{MaxSpotPriceDrift: dec("0.1")},
+			expectErr:  types.MaxSpotPriceDriftExceededError{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, exactIn := range []bool{true, false} {
+				cacheCtx, _ := ctx.CacheContext()
+				var err error
+				if exactIn {
+					_, err = k.RouteExactAmountIn(cacheCtx, sender, routeIn, tokenIn, math.OneInt(), tc.protection)
+				} else {
+					_, err = k.RouteExactAmountOut(cacheCtx, sender, routeOut, tokenIn.Amount.MulRaw(2), tokenOut, tc.protection)
+				}
+
+				switch expected := tc.expectErr.(type) {
+				case nil:
+					require.NoError(t, err)
+				case types.SwapDeadlineExceededError:
+					require.Equal(t, expected, err)
+				default:
+					require.IsType(t, expected, err)
+				}
+			}
+		})
+	}
+
+	// the split routes enforce the protection on every multihop path
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := k.SplitRouteExactAmountIn(cacheCtx, sender, []types.SwapAmountInSplitRoute{
+		{Pools: routeIn, TokenInAmount: tokenIn.Amount},
+	}, "uatom", math.OneInt(), types.SwapProtection{MaxPriceImpact: dec("0.05")})
+	require.IsType(t, types.MaxPriceImpactExceededError{}, err)
+}
+
+func TestRouteThroughConcentratedPool(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+
+	// the default genesis allows permissionless concentrated pool creation
+	require.True(t, neutronApp.ConcentratedLiquidityKeeper.GetParams(ctx).IsPermissionlessPoolCreationEnabled)
+
+	poolID, err := k.CreatePool(ctx, clmodel.NewMsgCreateConcentratedPool(sender, "uatom", config.BaseCoinUnit, 100, math.LegacyMustNewDecFromStr("0.003")))
+	require.NoError(t, err)
+	poolType, err := k.GetPoolType(ctx, poolID)
+	require.NoError(t, err)
+	require.Equal(t, types.Concentrated, poolType)
+
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin(config.BaseCoinUnit, 1_000_000))
+	fundAccount(t, neutronApp, ctx, sender, liquidity)
+	_, err = neutronApp.ConcentratedLiquidityKeeper.CreateFullRangePosition(ctx, poolID, sender, liquidity)
+	require.NoError(t, err)
+
+	tokenIn := sdk.NewInt64Coin("uatom", 10_000)
+	fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(tokenIn))
+	balanceBefore := neutronApp.BankKeeper.GetBalance(ctx, sender, config.BaseCoinUnit)
+
+	route := []types.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: config.BaseCoinUnit}}
+	tokenOut, err := k.RouteExactAmountIn(ctx, sender, route, tokenIn, math.NewInt(9_000), types.SwapProtection{})
+	require.NoError(t, err)
+	require.True(t, tokenOut.GTE(math.NewInt(9_000)))
+	require.Equal(t, balanceBefore.Amount.Add(tokenOut), neutronApp.BankKeeper.GetBalance(ctx, sender, config.BaseCoinUnit).Amount)
+
+	// the output left the pool liquidity, the spread factor was set aside as spread rewards
+	poolLiquidity, err := k.GetTotalPoolLiquidity(ctx, poolID)
+	require.NoError(t, err)
+	require.Equal(t, liquidity.AmountOf(config.BaseCoinUnit).Sub(tokenOut), poolLiquidity.AmountOf(config.BaseCoinUnit))
+	require.Equal(t, liquidity.AmountOf("uatom").Add(math.NewInt(9_970)), poolLiquidity.AmountOf("uatom"))
+}
@@ -4,14 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/maany-xyz/maany-dex/v5/osmomath"
 
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/maany-xyz/maany-dex/v5/osmoutils"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
 	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
 	takerfeetypes "github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
 )
@@ -32,26 +35,278 @@ func (k *Keeper) GetDefaultTakerFee(ctx sdk.Context) osmomath.Dec {
 	return k.defaultTakerFeeVal
 }
 
-// SetDenomPairTakerFee sets the taker fee for the given trading pair.
-// If the taker fee for this denom pair matches the default taker fee, then
-// it is deleted from state.
+// SetDenomPairTakerFee sets the taker fee override for the given trading pair.
+// The override is kept even if it equals the default taker fee, so that it is
+// not affected by later changes of the default.
 func (k Keeper) SetDenomPairTakerFee(ctx sdk.Context, denom0, denom1 string, takerFee osmomath.Dec) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.FormatDenomTradePairKey(denom0, denom1)
-	// if given taker fee is equal to the default taker fee,
-	// delete whatever we have in current state to use default taker fee.
-	// TODO: This logic is actually wrong imo, where it can be valid to set an override over the default.
-	if takerFee.Equal(k.GetDefaultTakerFee(ctx)) {
-		store.Delete(key)
+	osmoutils.MustSetDec(store, types.FormatDenomTradePairKey(denom0, denom1), takerFee)
+}
+
+// DeleteDenomPairTakerFee removes the taker fee override for the given trading pair,
+// which then falls back to the default taker fee.
+func (k Keeper) DeleteDenomPairTakerFee(ctx sdk.Context, denom0, denom1 string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatDenomTradePairKey(denom0, denom1))
+}
+
+// ApplyDenomPairTakerFee sets or, if the record is unset, removes the taker fee override
+// of the record's trading pair.
+func (k Keeper) ApplyDenomPairTakerFee(ctx sdk.Context, record types.DenomPairTakerFee) {
+	if record.Unset {
+		k.DeleteDenomPairTakerFee(ctx, record.TokenInDenom, record.TokenOutDenom)
 		return
-	} else {
-		osmoutils.MustSetDec(store, key, takerFee)
 	}
+	k.SetDenomPairTakerFee(ctx, record.TokenInDenom, record.TokenOutDenom, record.TakerFee)
+}
+
+// SetPoolTakerFee sets the taker fee override for every swap in the given pool.
+// It takes precedence over the denom pair and default taker fees.
+func (k Keeper) SetPoolTakerFee(ctx sdk.Context, poolId uint64, takerFee osmomath.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSetDec(store, types.FormatPoolTakerFeeKey(poolId), takerFee)
+}
+
+// DeletePoolTakerFee removes the taker fee override for the given pool.
+func (k Keeper) DeletePoolTakerFee(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatPoolTakerFeeKey(poolId))
+}
+
+// GetPoolTakerFee returns the taker fee override for the given pool, if any.
+func (k Keeper) GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	takerFee := &sdk.DecProto{}
+	found, err := osmoutils.Get(store, types.FormatPoolTakerFeeKey(poolId), takerFee)
+	if err != nil || !found {
+		return osmomath.Dec{}, false, err
+	}
+	return takerFee.Dec, true, nil
+}
+
+// GetAllPoolTakerFees returns all the taker fee overrides of pools, by pool id.
+func (k Keeper) GetAllPoolTakerFees(ctx sdk.Context) ([]types.PoolTakerFee, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PoolTakerFeePrefix)
+	defer iterator.Close()
+
+	var takerFees []types.PoolTakerFee
+	for ; iterator.Valid(); iterator.Next() {
+		poolId, err := types.ParsePoolTakerFeeKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		takerFee := &sdk.DecProto{}
+		osmoutils.MustGet(store, iterator.Key(), takerFee)
+		takerFees = append(takerFees, types.PoolTakerFee{PoolId: poolId, TakerFee: takerFee.Dec})
+	}
+
+	return takerFees, nil
+}
+
+// GetEffectiveTakerFee returns the taker fee of a swap from tokenInDenom to tokenOutDenom in the
+// given pool and where it comes from: the pool override, else the trading pair override, else the
+// default taker fee.
+func (k Keeper) GetEffectiveTakerFee(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (osmomath.Dec, types.TakerFeeSource, error) {
+	poolTakerFee, found, err := k.GetPoolTakerFee(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, types.TAKER_FEE_SOURCE_DEFAULT, err
+	}
+	if found {
+		return poolTakerFee, types.TAKER_FEE_SOURCE_POOL, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	pairTakerFee := &sdk.DecProto{}
+	found, err = osmoutils.Get(store, types.FormatDenomTradePairKey(tokenInDenom, tokenOutDenom), pairTakerFee)
+	if err != nil {
+		return osmomath.Dec{}, types.TAKER_FEE_SOURCE_DEFAULT, err
+	}
+	if found {
+		return pairTakerFee.Dec, types.TAKER_FEE_SOURCE_DENOM_PAIR, nil
+	}
+
+	return k.GetDefaultTakerFee(ctx), types.TAKER_FEE_SOURCE_DEFAULT, nil
+}
+
+// SetTakerFeeDiscountTier sets the taker fee discount tier, replacing the tier of the same name.
+func (k Keeper) SetTakerFeeDiscountTier(ctx sdk.Context, tier types.TakerFeeDiscountTier) error {
+	if err := k.DeleteTakerFeeDiscountTier(ctx, tier.Name); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	tier.Unset = false
+	osmoutils.MustSet(store, types.FormatTakerFeeDiscountTierKey(tier.Name), &tier)
+	for _, member := range tier.Members {
+		memberAddr, err := sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return err
+		}
+		store.Set(types.FormatTakerFeeDiscountMemberKey(memberAddr, tier.Name), []byte{})
+	}
+	if tier.QualifiesByVolume() {
+		osmoutils.MustSet(store, types.FormatTakerFeeDiscountVolumeKey(*tier.MinVolume, tier.Name), &sdk.DecProto{Dec: tier.Discount})
+	}
+	return nil
+}
+
+// DeleteTakerFeeDiscountTier removes the taker fee discount tier of the given name, if any.
+func (k Keeper) DeleteTakerFeeDiscountTier(ctx sdk.Context, name string) error {
+	tier, found, err := k.GetTakerFeeDiscountTier(ctx, name)
+	if err != nil || !found {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, member := range tier.Members {
+		memberAddr, err := sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return err
+		}
+		store.Delete(types.FormatTakerFeeDiscountMemberKey(memberAddr, name))
+	}
+	if tier.QualifiesByVolume() {
+		store.Delete(types.FormatTakerFeeDiscountVolumeKey(*tier.MinVolume, name))
+	}
+	store.Delete(types.FormatTakerFeeDiscountTierKey(name))
+	return nil
+}
+
+// ApplyTakerFeeDiscountTier sets or, if the record is unset, removes the taker fee discount tier.
+func (k Keeper) ApplyTakerFeeDiscountTier(ctx sdk.Context, tier types.TakerFeeDiscountTier) error {
+	if tier.Unset {
+		return k.DeleteTakerFeeDiscountTier(ctx, tier.Name)
+	}
+	return k.SetTakerFeeDiscountTier(ctx, tier)
 }
 
-// SenderValidationSetDenomPairTakerFee sets the taker fee for the given trading pair iff the sender's address
+// GetTakerFeeDiscountTier returns the taker fee discount tier of the given name, if any.
+func (k Keeper) GetTakerFeeDiscountTier(ctx sdk.Context, name string) (types.TakerFeeDiscountTier, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	tier := types.TakerFeeDiscountTier{}
+	found, err := osmoutils.Get(store, types.FormatTakerFeeDiscountTierKey(name), &tier)
+	if err != nil || !found {
+		return types.TakerFeeDiscountTier{}, false, err
+	}
+	return tier, true, nil
+}
+
+// GetAllTakerFeeDiscountTiers returns all the taker fee discount tiers, by name.
+func (k Keeper) GetAllTakerFeeDiscountTiers(ctx sdk.Context) ([]types.TakerFeeDiscountTier, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.TakerFeeDiscountTierPrefix, func(bz []byte) (types.TakerFeeDiscountTier, error) {
+		tier := types.TakerFeeDiscountTier{}
+		err := proto.Unmarshal(bz, &tier)
+		return tier, err
+	})
+}
+
+// GetTakerFeeDiscount returns the largest discount of the taker fee discount tiers the sender is in,
+// as a member or by its volume during the previous window, along with the name of its tier. A sender
+// in no tier gets no discount and an empty tier name.
+func (k Keeper) GetTakerFeeDiscount(ctx sdk.Context, sender sdk.AccAddress) (osmomath.Dec, string, error) {
+	store := ctx.KVStore(k.storeKey)
+	memberPrefix := types.FormatTakerFeeDiscountMemberPrefix(sender)
+	iterator := storetypes.KVStorePrefixIterator(store, memberPrefix)
+	defer iterator.Close()
+
+	discount, tierName := osmomath.ZeroDec(), ""
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(memberPrefix):])
+		tier, found, err := k.GetTakerFeeDiscountTier(ctx, name)
+		if err != nil {
+			return osmomath.Dec{}, "", err
+		}
+		if found && tier.Discount.GT(discount) {
+			discount, tierName = tier.Discount, tier.Name
+		}
+	}
+
+	// the volume tiers are indexed by min volume, only the ones the volume reaches are read
+	if volume := k.GetPreviousWindowAccountVolume(ctx, sender); volume.IsPositive() {
+		volumeIterator := store.Iterator(types.TakerFeeDiscountVolumePrefix, storetypes.PrefixEndBytes(types.FormatTakerFeeDiscountVolumePrefix(volume)))
+		defer volumeIterator.Close()
+
+		for ; volumeIterator.Valid(); volumeIterator.Next() {
+			name, err := types.ParseTakerFeeDiscountVolumeKey(volumeIterator.Key())
+			if err != nil {
+				return osmomath.Dec{}, "", err
+			}
+			tierDiscount := sdk.DecProto{}
+			if err := proto.Unmarshal(volumeIterator.Value(), &tierDiscount); err != nil {
+				return osmomath.Dec{}, "", err
+			}
+			if tierDiscount.Dec.GT(discount) {
+				discount, tierName = tierDiscount.Dec, name
+			}
+		}
+	}
+	return discount, tierName, nil
+}
+
+// ExplainTakerFee returns, hop by hop, the taker fee a swap of tokenInDenom through the given routes
+// is charged and where each fee comes from, along with the total taker fee of the route, that is
+// the share of the input it loses to taker fees. If sender is set, the taker fees are net of its
+// discount tier, and if it is in the reduced taker fee whitelist, every hop is free of taker fees.
+func (k Keeper) ExplainTakerFee(ctx sdk.Context, tokenInDenom string, routes []types.SwapAmountInRoute, sender string) ([]queryproto.TakerFeeHop, osmomath.Dec, error) {
+	if err := types.SwapAmountInRoutes(routes).Validate(); err != nil {
+		return nil, osmomath.Dec{}, err
+	}
+
+	reducedFeeWhitelist := []string{}
+	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
+	whitelisted := sender != "" && osmoutils.Contains(reducedFeeWhitelist, sender)
+
+	discount, discountTier := osmomath.ZeroDec(), ""
+	if sender != "" && !whitelisted {
+		senderAddr, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			return nil, osmomath.Dec{}, err
+		}
+		if discount, discountTier, err = k.GetTakerFeeDiscount(ctx, senderAddr); err != nil {
+			return nil, osmomath.Dec{}, err
+		}
+	}
+
+	hops := make([]queryproto.TakerFeeHop, 0, len(routes))
+	// the share of the input left after the taker fees of the hops so far
+	remaining := osmomath.OneDec()
+	for _, route := range routes {
+		if _, err := k.GetPoolModule(ctx, route.PoolId); err != nil {
+			return nil, osmomath.Dec{}, err
+		}
+
+		hop := queryproto.TakerFeeHop{
+			PoolId:        route.PoolId,
+			TokenInDenom:  tokenInDenom,
+			TokenOutDenom: route.TokenOutDenom,
+		}
+		if whitelisted {
+			hop.TakerFee, hop.Source, hop.Discount = osmomath.ZeroDec(), types.TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST, osmomath.OneDec()
+		} else {
+			takerFee, source, err := k.GetEffectiveTakerFee(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom)
+			if err != nil {
+				return nil, osmomath.Dec{}, err
+			}
+			hop.TakerFee, hop.Source, hop.Discount = applyTakerFeeDiscount(takerFee, discount), source, discount
+			if discount.IsPositive() {
+				hop.DiscountTier = discountTier
+			}
+		}
+		takerFee := hop.TakerFee
+
+		hops = append(hops, hop)
+		remaining = remaining.Mul(osmomath.OneDec().Sub(takerFee))
+		tokenInDenom = route.TokenOutDenom
+	}
+
+	return hops, osmomath.OneDec().Sub(remaining), nil
+}
+
+// SenderValidationSetDenomPairTakerFee sets or unsets the taker fee for the given trading pair iff the sender's address
 // also exists in the pool manager taker fee admin address list.
-func (k Keeper) SenderValidationSetDenomPairTakerFee(ctx sdk.Context, sender, denom0, denom1 string, takerFee osmomath.Dec) error {
+func (k Keeper) SenderValidationSetDenomPairTakerFee(ctx sdk.Context, sender string, record types.DenomPairTakerFee) error {
 	adminAddresses := k.GetParams(ctx).TakerFeeParams.AdminAddresses
 	isAdmin := false
 	for _, admin := range adminAddresses {
@@ -64,22 +319,51 @@ func (k Keeper) SenderValidationSetDenomPairTakerFee(ctx sdk.Context, sender, de
 		return fmt.Errorf("%s is not in the pool manager taker fee admin address list", sender)
 	}
 
-	k.SetDenomPairTakerFee(ctx, denom0, denom1, takerFee)
+	k.ApplyDenomPairTakerFee(ctx, record)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgSetDenomPairTakerFee,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyDenom0, denom0),
-			sdk.NewAttribute(types.AttributeKeyDenom1, denom1),
-			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom0, record.TokenInDenom),
+			sdk.NewAttribute(types.AttributeKeyDenom1, record.TokenOutDenom),
+			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFeeAttribute(record.TakerFee, record.Unset)),
 		),
 	})
 
 	return nil
 }
 
+// applyTakerFeeDiscount returns the taker fee left after waiving the discount share of it.
+func applyTakerFeeDiscount(takerFee, discount osmomath.Dec) osmomath.Dec {
+	return takerFee.Mul(osmomath.OneDec().Sub(discount))
+}
+
+// emitTakerFeeDiscountEvent emits the discount applied to the taker fee of a swap and the taker
+// fee charged after it.
+func emitTakerFeeDiscountEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, discountTier string, discount, takerFee osmomath.Dec) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTakerFeeDiscount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDiscountTier, discountTier),
+			sdk.NewAttribute(types.AttributeKeyDiscount, discount.String()),
+			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		),
+	})
+}
+
+// takerFeeAttribute returns the taker fee event attribute of a taker fee override record.
+func takerFeeAttribute(takerFee osmomath.Dec, unset bool) string {
+	if unset {
+		return types.AttributeValueTakerFeeUnset
+	}
+	return takerFee.String()
+}
+
 // GetTradingPairTakerFee returns the taker fee for the given trading pair.
 // If the trading pair does not exist, it returns the default taker fee.
 // The order of the trading pair matters.
@@ -126,9 +410,10 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 // chargeTakerFee extracts the taker fee from the given tokenIn and sends it to the appropriate
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
-// In the future, we might charge a lower taker fee as opposed to no fee at all.
+// The taker fee is the effective one of the pool the swap goes through, reduced by the discount of the
+// sender's taker fee discount tier, if any. Applied discounts are emitted as events.
 // TODO: Gas optimize this function, its expensive in both gas and CPU.
-func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
+func (k Keeper) chargeTakerFee(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
 	takerFeeModuleAccountName := takerfeetypes.ModuleName //txfeestypes.TakerFeeCollectorName 
 
 	reducedFeeWhitelist := []string{}
@@ -136,14 +421,26 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 
 	// Determine if eligible to bypass taker fee.
 	if osmoutils.Contains(reducedFeeWhitelist, sender.String()) {
+		emitTakerFeeDiscountEvent(ctx, sender, poolId, types.AttributeValueReducedFeeWhitelist, osmomath.OneDec(), osmomath.ZeroDec())
 		return tokenIn, sdk.Coin{Denom: tokenIn.Denom, Amount: zero}, nil
 	}
 
-	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, tokenOutDenom)
+	takerFee, _, err := k.GetEffectiveTakerFee(ctx, poolId, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	if takerFee.IsPositive() {
+		discount, discountTier, err := k.GetTakerFeeDiscount(ctx, sender)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if discount.IsPositive() {
+			takerFee = applyTakerFeeDiscount(takerFee, discount)
+			emitTakerFeeDiscountEvent(ctx, sender, poolId, discountTier, discount, takerFee)
+		}
+	}
+
 	var tokenInAfterTakerFee sdk.Coin
 	var takerFeeCoin sdk.Coin
 	if exactIn {
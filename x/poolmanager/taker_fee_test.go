@@ -0,0 +1,244 @@
+package poolmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/client/queryproto"
+	"github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+func TestTakerFeeOverrides(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	msgServer := poolmanager.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := k.GetParams(ctx)
+	params.TakerFeeParams.DefaultTakerFee = math.LegacyNewDecWithPrec(1, 3)
+	k.SetParams(ctx, params)
+
+	atomNtrn := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 1_000_000))
+	ntrnUsdc := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("untrn", 1_000_000), sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	t.Run("pair override equal to the default persists", func(t *testing.T) {
+		k.ApplyDenomPairTakerFee(ctx, types.DenomPairTakerFee{TokenInDenom: "uatom", TokenOutDenom: "untrn", TakerFee: math.LegacyNewDecWithPrec(1, 3)})
+
+		params.TakerFeeParams.DefaultTakerFee = math.LegacyNewDecWithPrec(2, 3)
+		k.SetParams(ctx, params)
+
+		takerFee, source, err := k.GetEffectiveTakerFee(ctx, atomNtrn, "uatom", "untrn")
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDecWithPrec(1, 3), takerFee)
+		require.Equal(t, types.TAKER_FEE_SOURCE_DENOM_PAIR, source)
+
+		k.ApplyDenomPairTakerFee(ctx, types.DenomPairTakerFee{TokenInDenom: "uatom", TokenOutDenom: "untrn", Unset: true})
+		takerFee, source, err = k.GetEffectiveTakerFee(ctx, atomNtrn, "uatom", "untrn")
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 3), takerFee)
+		require.Equal(t, types.TAKER_FEE_SOURCE_DEFAULT, source)
+	})
+
+	t.Run("pool override takes precedence and can be zero", func(t *testing.T) {
+		k.SetDenomPairTakerFee(ctx, "uatom", "untrn", math.LegacyNewDecWithPrec(5, 3))
+
+		_, err := msgServer.SetPoolTakerFee(ctx, &types.MsgSetPoolTakerFee{
+			Sender:        sender.String(),
+			PoolTakerFees: []types.PoolTakerFee{{PoolId: atomNtrn, TakerFee: math.LegacyZeroDec()}},
+		})
+		require.ErrorIs(t, err, types.ErrUnauthorizedGov)
+
+		_, err = msgServer.SetPoolTakerFee(ctx, &types.MsgSetPoolTakerFee{
+			Sender:        govAddr,
+			PoolTakerFees: []types.PoolTakerFee{{PoolId: 1000, TakerFee: math.LegacyZeroDec()}},
+		})
+		require.Error(t, err)
+
+		_, err = msgServer.SetPoolTakerFee(ctx, &types.MsgSetPoolTakerFee{
+			Sender:        govAddr,
+			PoolTakerFees: []types.PoolTakerFee{{PoolId: atomNtrn, TakerFee: math.LegacyZeroDec()}},
+		})
+		require.NoError(t, err)
+
+		takerFee, source, err := k.GetEffectiveTakerFee(ctx, atomNtrn, "uatom", "untrn")
+		require.NoError(t, err)
+		require.True(t, takerFee.IsZero())
+		require.Equal(t, types.TAKER_FEE_SOURCE_POOL, source)
+
+		tokenIn := sdk.NewInt64Coin("uatom", 10_000)
+		fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(tokenIn))
+		tokenInAfterTakerFee, takerFeeCoin, err := k.ChargeTakerFee(ctx, atomNtrn, tokenIn, "untrn", sender, true)
+		require.NoError(t, err)
+		require.Equal(t, tokenIn, tokenInAfterTakerFee)
+		require.True(t, takerFeeCoin.IsZero())
+
+		poolTakerFees, err := k.GetAllPoolTakerFees(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []types.PoolTakerFee{{PoolId: atomNtrn, TakerFee: math.LegacyZeroDec()}}, poolTakerFees)
+	})
+
+	t.Run("explain taker fee", func(t *testing.T) {
+		routes := []types.SwapAmountInRoute{
+			{PoolId: atomNtrn, TokenOutDenom: "untrn"},
+			{PoolId: ntrnUsdc, TokenOutDenom: "uusdc"},
+		}
+
+		hops, total, err := k.ExplainTakerFee(ctx, "uatom", routes, "")
+		require.NoError(t, err)
+		require.Equal(t, []queryproto.TakerFeeHop{
+			{PoolId: atomNtrn, TokenInDenom: "uatom", TokenOutDenom: "untrn", TakerFee: math.LegacyZeroDec(), Source: types.TAKER_FEE_SOURCE_POOL, Discount: math.LegacyZeroDec()},
+			{PoolId: ntrnUsdc, TokenInDenom: "untrn", TokenOutDenom: "uusdc", TakerFee: math.LegacyNewDecWithPrec(2, 3), Source: types.TAKER_FEE_SOURCE_DEFAULT, Discount: math.LegacyZeroDec()},
+		}, hops)
+		require.Equal(t, math.LegacyNewDecWithPrec(2, 3), total)
+
+		params.TakerFeeParams.ReducedFeeWhitelist = []string{sender.String()}
+		k.SetParams(ctx, params)
+		hops, total, err = k.ExplainTakerFee(ctx, "uatom", routes, sender.String())
+		require.NoError(t, err)
+		for _, hop := range hops {
+			require.True(t, hop.TakerFee.IsZero())
+			require.Equal(t, types.TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST, hop.Source)
+		}
+		require.True(t, total.IsZero())
+
+		_, err = msgServer.SetPoolTakerFee(ctx, &types.MsgSetPoolTakerFee{
+			Sender:        govAddr,
+			PoolTakerFees: []types.PoolTakerFee{{PoolId: atomNtrn, Unset: true}},
+		})
+		require.NoError(t, err)
+		takerFee, source, err := k.GetEffectiveTakerFee(ctx, atomNtrn, "uatom", "untrn")
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyNewDecWithPrec(5, 3), takerFee)
+		require.Equal(t, types.TAKER_FEE_SOURCE_DENOM_PAIR, source)
+	})
+}
+
+func TestTakerFeeDiscountTiers(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	msgServer := poolmanager.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := sdk.AccAddress([]byte("other_taker_address_"))
+
+	params := k.GetParams(ctx)
+	params.TakerFeeParams.DefaultTakerFee = math.LegacyNewDecWithPrec(1, 2)
+	k.SetParams(ctx, params)
+
+	poolID := createBalancerPool(t, neutronApp, ctx, sender, sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("untrn", 1_000_000))
+
+	setTiers := func(tiers ...types.TakerFeeDiscountTier) {
+		_, err := msgServer.SetTakerFeeDiscountTiers(ctx, &types.MsgSetTakerFeeDiscountTiers{Sender: govAddr, Tiers: tiers})
+		require.NoError(t, err)
+	}
+	chargedTakerFee := func() sdk.Coin {
+		tokenIn := sdk.NewInt64Coin("uatom", 100_000)
+		fundAccount(t, neutronApp, ctx, sender, sdk.NewCoins(tokenIn))
+		_, takerFeeCoin, err := k.ChargeTakerFee(ctx, poolID, tokenIn, "untrn", sender, true)
+		require.NoError(t, err)
+		return takerFeeCoin
+	}
+
+	_, err := msgServer.SetTakerFeeDiscountTiers(ctx, &types.MsgSetTakerFeeDiscountTiers{
+		Sender: sender.String(),
+		Tiers:  []types.TakerFeeDiscountTier{{Name: "market-makers", Discount: math.LegacyNewDecWithPrec(5, 1), Members: []string{sender.String()}}},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedGov)
+
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1_000), chargedTakerFee())
+
+	// the largest discount of the sender's tiers applies
+	setTiers(
+		types.TakerFeeDiscountTier{Name: "market-makers", Discount: math.LegacyNewDecWithPrec(5, 1), Members: []string{sender.String(), other.String()}},
+		types.TakerFeeDiscountTier{Name: "protocols", Discount: math.LegacyNewDecWithPrec(25, 2), Members: []string{sender.String()}},
+	)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Equal(t, sdk.NewInt64Coin("uatom", 500), chargedTakerFee())
+
+	var discountEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.TypeEvtTakerFeeDiscount {
+			discountEvent = &event
+		}
+	}
+	require.NotNil(t, discountEvent)
+	tier, found := discountEvent.GetAttribute(types.AttributeKeyDiscountTier)
+	require.True(t, found)
+	require.Equal(t, "market-makers", tier.Value)
+
+	hops, total, err := k.ExplainTakerFee(ctx, "uatom", []types.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: "untrn"}}, sender.String())
+	require.NoError(t, err)
+	require.Equal(t, "market-makers", hops[0].DiscountTier)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), hops[0].Discount)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 3), total)
+
+	// replacing a tier drops the members left out of it
+	setTiers(types.TakerFeeDiscountTier{Name: "market-makers", Discount: math.LegacyNewDecWithPrec(5, 1), Members: []string{other.String()}})
+	require.Equal(t, sdk.NewInt64Coin("uatom", 750), chargedTakerFee())
+
+	setTiers(types.TakerFeeDiscountTier{Name: "protocols", Unset: true})
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1_000), chargedTakerFee())
+
+	tiers, err := k.GetAllTakerFeeDiscountTiers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.TakerFeeDiscountTier{{Name: "market-makers", Discount: math.LegacyNewDecWithPrec(5, 1), Members: []string{other.String()}}}, tiers)
+}
+
+func TestTakerFeeDiscountVolumeTiers(t *testing.T) {
+	neutronApp, ctx, sender := setupPoolmanagerTest(t)
+	k := neutronApp.PoolManagerKeeper
+	msgServer := poolmanager.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	config := types.AccountVolumeConfig{QuoteDenom: "untrn", WindowDuration: time.Hour, RetainedWindows: 2}
+	_, err := msgServer.SetAccountVolumeConfig(ctx, &types.MsgSetAccountVolumeConfig{Sender: govAddr, Config: config})
+	require.NoError(t, err)
+	previousWindow := config.WindowStart(ctx.BlockTime()).Add(-time.Hour)
+	setVolume := func(volume int64) {
+		require.NoError(t, k.SetAccountVolume(ctx, types.AccountVolume{Address: sender.String(), WindowStart: previousWindow, Volume: math.NewInt(volume)}))
+	}
+	minVolume := func(volume int64) *math.Int {
+		minVolume := math.NewInt(volume)
+		return &minVolume
+	}
+	requireDiscount := func(expectedDiscount math.LegacyDec, expectedTier string) {
+		discount, tier, err := k.GetTakerFeeDiscount(ctx, sender)
+		require.NoError(t, err)
+		require.Equal(t, expectedDiscount, discount)
+		require.Equal(t, expectedTier, tier)
+	}
+
+	// the volume tiers are read in min volume order, not name order
+	_, err = msgServer.SetTakerFeeDiscountTiers(ctx, &types.MsgSetTakerFeeDiscountTiers{Sender: govAddr, Tiers: []types.TakerFeeDiscountTier{
+		{Name: "a-whale", Discount: math.LegacyNewDecWithPrec(5, 1), MinVolume: minVolume(1_000_000)},
+		{Name: "b-bronze", Discount: math.LegacyNewDecWithPrec(1, 1), MinVolume: minVolume(9_000)},
+		{Name: "c-silver", Discount: math.LegacyNewDecWithPrec(3, 1), MinVolume: minVolume(50_000)},
+		{Name: "d-members", Discount: math.LegacyNewDecWithPrec(2, 1), Members: []string{sender.String()}},
+	}})
+	require.NoError(t, err)
+
+	setVolume(8_999)
+	requireDiscount(math.LegacyNewDecWithPrec(2, 1), "d-members")
+	setVolume(50_000)
+	requireDiscount(math.LegacyNewDecWithPrec(3, 1), "c-silver")
+	setVolume(999_999)
+	requireDiscount(math.LegacyNewDecWithPrec(3, 1), "c-silver")
+	setVolume(1_000_000)
+	requireDiscount(math.LegacyNewDecWithPrec(5, 1), "a-whale")
+
+	// replacing or removing a tier moves it out of the min volume index
+	_, err = msgServer.SetTakerFeeDiscountTiers(ctx, &types.MsgSetTakerFeeDiscountTiers{Sender: govAddr, Tiers: []types.TakerFeeDiscountTier{
+		{Name: "a-whale", Discount: math.LegacyNewDecWithPrec(5, 1), MinVolume: minVolume(2_000_000)},
+		{Name: "c-silver", Unset: true},
+	}})
+	require.NoError(t, err)
+	requireDiscount(math.LegacyNewDecWithPrec(2, 1), "d-members")
+	setVolume(2_000_000)
+	requireDiscount(math.LegacyNewDecWithPrec(5, 1), "a-whale")
+}
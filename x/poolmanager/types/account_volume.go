@@ -0,0 +1,107 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxRetainedVolumeWindows bounds the number of volume windows kept per account.
+	MaxRetainedVolumeWindows = 366
+	// MaxVolumeTwapDuration is how far back the twap module keeps records.
+	MaxVolumeTwapDuration = 48 * time.Hour
+)
+
+// DefaultAccountVolumeConfig returns the default account volume config, which does not track volume
+// until a quote denom is set.
+func DefaultAccountVolumeConfig() AccountVolumeConfig {
+	return AccountVolumeConfig{
+		QuoteDenom:      "",
+		WindowDuration:  24 * time.Hour,
+		RetainedWindows: 30,
+		TwapDuration:    time.Hour,
+		PricePools:      []DenomPricePool{},
+	}
+}
+
+// IsUnset returns whether the config is the zero value, as in genesis files predating account
+// volume tracking.
+func (c AccountVolumeConfig) IsUnset() bool {
+	return c.QuoteDenom == "" && c.WindowDuration == 0 && c.RetainedWindows == 0 && c.TwapDuration == 0 && len(c.PricePools) == 0
+}
+
+// Enabled returns whether volume is tracked.
+func (c AccountVolumeConfig) Enabled() bool {
+	return c.QuoteDenom != ""
+}
+
+// WindowStart returns the start of the window blockTime is in. Windows are aligned on the unix epoch.
+func (c AccountVolumeConfig) WindowStart(blockTime time.Time) time.Time {
+	window := int64(c.WindowDuration / time.Second)
+	return time.Unix(blockTime.Unix()/window*window, 0).UTC()
+}
+
+// PricePool returns the pool pricing denom in the quote denom, if any.
+func (c AccountVolumeConfig) PricePool(denom string) (uint64, bool) {
+	return findPricePool(c.PricePools, denom)
+}
+
+// Validate returns an error if the config is invalid.
+func (c AccountVolumeConfig) Validate() error {
+	if c.QuoteDenom != "" {
+		if err := sdk.ValidateDenom(c.QuoteDenom); err != nil {
+			return err
+		}
+	}
+	if c.WindowDuration < time.Second || c.WindowDuration%time.Second != 0 {
+		return fmt.Errorf("window duration must be a positive number of seconds, got %s", c.WindowDuration)
+	}
+	if c.RetainedWindows == 0 || c.RetainedWindows > MaxRetainedVolumeWindows {
+		return fmt.Errorf("retained windows must be in [1, %d], got %d", MaxRetainedVolumeWindows, c.RetainedWindows)
+	}
+	if c.TwapDuration <= 0 || c.TwapDuration > MaxVolumeTwapDuration {
+		return fmt.Errorf("twap duration must be in (0, %s], got %s", MaxVolumeTwapDuration, c.TwapDuration)
+	}
+
+	return validateDenomPricePools(c.PricePools, c.QuoteDenom)
+}
+
+// findPricePool returns the pool pricing denom among pricePools, if any.
+func findPricePool(pricePools []DenomPricePool, denom string) (uint64, bool) {
+	for _, pricePool := range pricePools {
+		if pricePool.Denom == denom {
+			return pricePool.PoolId, true
+		}
+	}
+	return 0, false
+}
+
+// validateDenomPricePools returns an error if pricePools has an invalid or duplicate denom, or
+// a price pool for the denom they price in.
+func validateDenomPricePools(pricePools []DenomPricePool, quoteDenom string) error {
+	seen := make(map[string]bool, len(pricePools))
+	for _, pricePool := range pricePools {
+		if err := sdk.ValidateDenom(pricePool.Denom); err != nil {
+			return err
+		}
+		if pricePool.Denom == quoteDenom {
+			return fmt.Errorf("the quote denom %s needs no price pool", quoteDenom)
+		}
+		if seen[pricePool.Denom] {
+			return fmt.Errorf("duplicate price pool for %s", pricePool.Denom)
+		}
+		seen[pricePool.Denom] = true
+		if pricePool.PoolId == 0 {
+			return errors.New("price pool id cannot be 0")
+		}
+	}
+	return nil
+}
+
+// QualifiesByVolume returns whether the tier also applies to senders by their volume.
+func (tier TakerFeeDiscountTier) QualifiesByVolume() bool {
+	return tier.MinVolume != nil && tier.MinVolume.IsPositive()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/maany-xyz/maany-dex/v5/osmomath"
 )
@@ -24,6 +25,7 @@ var (
 	ErrSetRegisteredAlloyedPool                  = errors.New("error setting registered alloyed pool")
 	ErrInvalidKeyFormat                          = errors.New("invalid key format")
 	ErrTotalAlloyedLiquidityIsZero               = errors.New("totalAlloyedLiquidity is zero")
+	ErrNoRouteFound                              = errors.New("no route found")
 )
 
 type nonPositiveAmountError struct {
@@ -204,3 +206,41 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type SwapDeadlineExceededError struct {
+	Deadline  time.Time
+	BlockTime time.Time
+}
+
+func (e SwapDeadlineExceededError) Error() string {
+	return fmt.Sprintf("swap deadline %s exceeded, block time is %s", e.Deadline.UTC().Format(time.RFC3339), e.BlockTime.UTC().Format(time.RFC3339))
+}
+
+type MaxPriceImpactExceededError struct {
+	PoolId         uint64
+	PriceImpact    osmomath.Dec
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e MaxPriceImpactExceededError) Error() string {
+	return fmt.Sprintf("price impact %s on pool %d exceeds max price impact %s", e.PriceImpact, e.PoolId, e.MaxPriceImpact)
+}
+
+type MaxSpotPriceDriftExceededError struct {
+	PoolId            uint64
+	SpotPriceDrift    osmomath.Dec
+	MaxSpotPriceDrift osmomath.Dec
+}
+
+func (e MaxSpotPriceDriftExceededError) Error() string {
+	return fmt.Sprintf("spot price drift %s on pool %d exceeds max spot price drift %s", e.SpotPriceDrift, e.PoolId, e.MaxSpotPriceDrift)
+}
+
+type InvalidSwapProtectionError struct {
+	Field string
+	Value osmomath.Dec
+}
+
+func (e InvalidSwapProtectionError) Error() string {
+	return fmt.Sprintf("invalid %s: %s, must not be negative", e.Field, e.Value)
+}
@@ -4,6 +4,7 @@ const (
 	AttributeValueCategory               = ModuleName
 	TypeEvtPoolCreated                   = "pool_created"
 	TypeEvtSplitRouteSwapExactIn         = "split_route_swap_exact_in"
+	TypeEvtTakerFeeDiscount              = "taker_fee_discount"
 	AttributeKeyTokensIn                 = "tokens_in"
 	AttributeKeyTokensOut                = "tokens_out"
 	AttributeKeyPoolId                   = "pool_id"
@@ -13,4 +14,14 @@ const (
 	AttributeKeyTakerFeeShareDenom       = "taker_fee_share_denom"
 	AttributeKeyTakerFeeShareSkimPercent = "taker_fee_share_skim_percent"
 	AttributeKeyTakerFeeShareSkimAddress = "taker_fee_share_skim_address"
+	AttributeKeyDiscountTier             = "discount_tier"
+	AttributeKeyDiscount                 = "discount"
+	AttributeKeyQuoteDenom               = "quote_denom"
+	AttributeKeyWindowDuration           = "window_duration"
+
+	// AttributeValueTakerFeeUnset is the taker fee attribute of an event removing a taker fee override.
+	AttributeValueTakerFeeUnset = "unset"
+	// AttributeValueReducedFeeWhitelist is the discount tier attribute of a swap by a sender in the
+	// reduced taker fee whitelist.
+	AttributeValueReducedFeeWhitelist = "reduced_fee_whitelist"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,6 +17,7 @@ func DefaultGenesis() *GenesisState {
 			TakerFeesToCommunityPool:   sdk.NewCoins(),
 			HeightAccountingStartsFrom: 0,
 		},
+		AccountVolumeConfig: DefaultAccountVolumeConfig(),
 	}
 }
 
@@ -28,5 +30,34 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, record := range gs.PoolTakerFeeStore {
+		if record.Unset {
+			return errors.New("pool taker fee store cannot hold unset records")
+		}
+	}
+	if err := validatePoolTakerFees(gs.PoolTakerFeeStore); err != nil {
+		return err
+	}
+	for _, tier := range gs.TakerFeeDiscountTiers {
+		if tier.Unset {
+			return errors.New("taker fee discount tiers cannot hold unset records")
+		}
+	}
+	if err := validateTakerFeeDiscountTiers(gs.TakerFeeDiscountTiers); err != nil {
+		return err
+	}
+	if !gs.AccountVolumeConfig.IsUnset() {
+		if err := gs.AccountVolumeConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, accountVolume := range gs.AccountVolumes {
+		if _, err := sdk.AccAddressFromBech32(accountVolume.Address); err != nil {
+			return err
+		}
+		if accountVolume.Volume.IsNil() || accountVolume.Volume.IsNegative() {
+			return fmt.Errorf("volume of %s cannot be negative", accountVolume.Address)
+		}
+	}
 	return nil
 }
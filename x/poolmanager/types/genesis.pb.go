@@ -12,16 +12,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +32,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TakerFeeSource is where the taker fee charged on a swap hop comes from.
+type TakerFeeSource int32
+
+const (
+	// TAKER_FEE_SOURCE_DEFAULT is the default taker fee of the params.
+	TAKER_FEE_SOURCE_DEFAULT TakerFeeSource = 0
+	// TAKER_FEE_SOURCE_DENOM_PAIR is the override of the traded denom pair.
+	TAKER_FEE_SOURCE_DENOM_PAIR TakerFeeSource = 1
+	// TAKER_FEE_SOURCE_POOL is the override of the pool.
+	TAKER_FEE_SOURCE_POOL TakerFeeSource = 2
+	// TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST means the sender is in the reduced
+	// fee whitelist and pays no taker fee.
+	TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST TakerFeeSource = 3
+)
+
+var TakerFeeSource_name = map[int32]string{
+	0: "TAKER_FEE_SOURCE_DEFAULT",
+	1: "TAKER_FEE_SOURCE_DENOM_PAIR",
+	2: "TAKER_FEE_SOURCE_POOL",
+	3: "TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST",
+}
+
+var TakerFeeSource_value = map[string]int32{
+	"TAKER_FEE_SOURCE_DEFAULT":               0,
+	"TAKER_FEE_SOURCE_DENOM_PAIR":            1,
+	"TAKER_FEE_SOURCE_POOL":                  2,
+	"TAKER_FEE_SOURCE_REDUCED_FEE_WHITELIST": 3,
+}
+
+func (x TakerFeeSource) String() string {
+	return proto.EnumName(TakerFeeSource_name, int32(x))
+}
+
+func (TakerFeeSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{0}
+}
+
 // Params holds parameters for the poolmanager module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
@@ -43,6 +83,17 @@ type Params struct {
 	// orders at prices in terms of token1 (quote asset) that are easy to reason
 	// about.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms"`
+	// base_denom is the chain's base denom. Pool volume is tracked in it and
+	// taker fees collected in it are distributed without being swapped.
+	BaseDenom string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// base_denom_price_pools is the pool used to price each denom in the
+	// base denom when tracking pool volume. Denoms without a price pool are
+	// priced with the pool registered in protorev, if any.
+	BaseDenomPricePools []DenomPricePool `protobuf:"bytes,5,rep,name=base_denom_price_pools,json=baseDenomPricePools,proto3" json:"base_denom_price_pools" yaml:"base_denom_price_pools"`
+	// base_denom_twap_duration is how far back the TWAP pool volume is priced at
+	// goes. Volume is never priced at the spot price: a denom without a TWAP is
+	// not tracked.
+	BaseDenomTwapDuration time.Duration `protobuf:"bytes,6,opt,name=base_denom_twap_duration,json=baseDenomTwapDuration,proto3,stdduration" json:"base_denom_twap_duration" yaml:"base_denom_twap_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +150,27 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseDenomPricePools() []DenomPricePool {
+	if m != nil {
+		return m.BaseDenomPricePools
+	}
+	return nil
+}
+
+func (m *Params) GetBaseDenomTwapDuration() time.Duration {
+	if m != nil {
+		return m.BaseDenomTwapDuration
+	}
+	return 0
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -111,6 +183,14 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// pool_taker_fee_store is the taker fee overrides of pools.
+	PoolTakerFeeStore []PoolTakerFee `protobuf:"bytes,7,rep,name=pool_taker_fee_store,json=poolTakerFeeStore,proto3" json:"pool_taker_fee_store"`
+	// taker_fee_discount_tiers is the taker fee discount tiers.
+	TakerFeeDiscountTiers []TakerFeeDiscountTier `protobuf:"bytes,8,rep,name=taker_fee_discount_tiers,json=takerFeeDiscountTiers,proto3" json:"taker_fee_discount_tiers"`
+	// account_volume_config configures the tracking of account volumes.
+	AccountVolumeConfig AccountVolumeConfig `protobuf:"bytes,9,opt,name=account_volume_config,json=accountVolumeConfig,proto3" json:"account_volume_config"`
+	// account_volumes is the tracked volume of accounts, by window.
+	AccountVolumes []AccountVolume `protobuf:"bytes,10,rep,name=account_volumes,json=accountVolumes,proto3" json:"account_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,24 +268,53 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolTakerFeeStore() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFeeStore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakerFeeDiscountTiers() []TakerFeeDiscountTier {
+	if m != nil {
+		return m.TakerFeeDiscountTiers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountVolumeConfig() AccountVolumeConfig {
+	if m != nil {
+		return m.AccountVolumeConfig
+	}
+	return AccountVolumeConfig{}
+}
+
+func (m *GenesisState) GetAccountVolumes() []AccountVolume {
+	if m != nil {
+		return m.AccountVolumes
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
 	// fall under a custom pool taker fee or stableswap taker fee category.
 	DefaultTakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=default_taker_fee,json=defaultTakerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_taker_fee"`
 	// osmo_taker_fee_distribution defines the distribution of taker fees
-	// generated in OSMO. As of this writing, it has two categories:
+	// generated in the base denom. As of this writing, it has two categories:
 	// - staking_rewards: the percent of the taker fee that gets distributed to
 	//   stakers.
 	// - community_pool: the percent of the taker fee that gets sent to the
 	//   community pool.
 	OsmoTakerFeeDistribution TakerFeeDistributionPercentage `protobuf:"bytes,2,opt,name=osmo_taker_fee_distribution,json=osmoTakerFeeDistribution,proto3" json:"osmo_taker_fee_distribution"`
 	// non_osmo_taker_fee_distribution defines the distribution of taker fees
-	// generated in non-OSMO. As of this writing, it has two categories:
-	// - staking_rewards: the percent of the taker fee that gets swapped to OSMO
-	//   and then distributed to stakers.
+	// generated in other denoms than the base denom. As of this writing, it has
+	// two categories:
+	// - staking_rewards: the percent of the taker fee that gets swapped to the
+	//   base denom and then distributed to stakers.
 	// - community_pool: the percent of the taker fee that gets sent to the
-	//   community pool. Note: If the non-OSMO asset is an authorized_quote_denom,
+	//   community pool. Note: If the asset is an authorized_quote_denom,
 	//   that denom is sent directly to the community pool. Otherwise, it is
 	//   swapped to the community_pool_denom_to_swap_non_whitelisted_assets_to and
 	//   then sent to the community pool as that denom.
@@ -224,8 +333,8 @@ type TakerFeeParams struct {
 	// (i.e. swap without paying the taker fee).
 	// It is intended to be used for integrators who meet qualifying factors
 	// that are approved by governance.
-	// Initially, the taker fee is allowed to be bypassed completely. However
-	// In the future, we will charge a reduced taker fee instead of no fee at all.
+	// The taker fee is bypassed completely. Partial reductions are set with
+	// taker fee discount tiers instead.
 	ReducedFeeWhitelist []string `protobuf:"bytes,6,rep,name=reduced_fee_whitelist,json=reducedFeeWhitelist,proto3" json:"reduced_fee_whitelist,omitempty" yaml:"reduced_fee_whitelist"`
 }
 
@@ -454,6 +563,7 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.poolmanager.v1beta1.TakerFeeSource", TakerFeeSource_name, TakerFeeSource_value)
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
@@ -467,75 +577,95 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8f, 0xb3, 0xe9, 0xfe, 0x95, 0x49, 0xfe, 0x49, 0x3a, 0x90, 0xc6, 0x4d, 0xca, 0x7a, 0xe5,
-	0x56, 0x62, 0x11, 0x8a, 0x97, 0x06, 0xa9, 0x88, 0x97, 0x1e, 0xb2, 0x89, 0x82, 0x40, 0xa5, 0x4d,
-	0x9d, 0x08, 0xa4, 0x72, 0x18, 0xcd, 0xda, 0x4f, 0xbc, 0xd6, 0xda, 0x9e, 0x65, 0x66, 0x9c, 0x17,
-	0xbe, 0x02, 0x17, 0xa4, 0x5e, 0x39, 0x73, 0xe0, 0x86, 0xc4, 0x87, 0xe8, 0xb1, 0x47, 0xc4, 0x61,
-	0x8b, 0x92, 0x33, 0x97, 0xfd, 0x04, 0xc8, 0x33, 0xb3, 0xaf, 0x4d, 0xb6, 0x01, 0x4e, 0xbb, 0x7e,
-	0x9e, 0xe7, 0xf7, 0xf3, 0xef, 0x79, 0x9b, 0x31, 0x7a, 0x8f, 0x89, 0x94, 0x89, 0x58, 0xd4, 0x3b,
-	0x8c, 0x25, 0x29, 0xcd, 0x68, 0x04, 0xbc, 0x7e, 0x7c, 0xbf, 0x09, 0x92, 0xde, 0xaf, 0x47, 0x90,
-	0x81, 0x88, 0x85, 0xd7, 0xe1, 0x4c, 0x32, 0xbc, 0x61, 0x42, 0xbd, 0x91, 0x50, 0xcf, 0x84, 0xae,
-	0xbf, 0x1d, 0xb1, 0x88, 0xa9, 0xb8, 0x7a, 0xf1, 0x4f, 0x43, 0xd6, 0x6f, 0x47, 0x8c, 0x45, 0x09,
-	0xd4, 0xd5, 0x53, 0x33, 0x3f, 0xaa, 0xd3, 0xec, 0xac, 0xef, 0x0a, 0x14, 0x1d, 0xd1, 0x18, 0xfd,
-	0x60, 0x5c, 0x95, 0x49, 0x54, 0x98, 0x73, 0x2a, 0x63, 0x96, 0xf5, 0xfd, 0x3a, 0xba, 0xde, 0xa4,
-	0x02, 0x06, 0x5a, 0x03, 0x16, 0xf7, 0xfd, 0xde, 0xb4, 0x9c, 0x52, 0x16, 0xe6, 0x09, 0x10, 0xce,
-	0x72, 0x09, 0x26, 0xfe, 0xde, 0xb4, 0x78, 0x79, 0xaa, 0xa3, 0xdc, 0xde, 0x2c, 0x2a, 0xef, 0x53,
-	0x4e, 0x53, 0x81, 0x9f, 0x5b, 0xe8, 0x66, 0x11, 0x4b, 0x02, 0x0e, 0x4a, 0x18, 0x39, 0x02, 0xb0,
-	0xad, 0x6a, 0xa9, 0xb6, 0xb0, 0x75, 0xdb, 0x33, 0xb9, 0x14, 0xea, 0xfa, 0xe5, 0xf1, 0x76, 0x58,
-	0x9c, 0x35, 0x1e, 0xbd, 0xe8, 0x3a, 0x33, 0xbd, 0xae, 0x63, 0x9f, 0xd1, 0x34, 0xf9, 0xc4, 0x7d,
-	0x8d, 0xc1, 0xfd, 0xe5, 0x95, 0x53, 0x8b, 0x62, 0xd9, 0xca, 0x9b, 0x5e, 0xc0, 0x52, 0x53, 0x14,
-	0xf3, 0xb3, 0x29, 0xc2, 0x76, 0x5d, 0x9e, 0x75, 0x40, 0x28, 0x32, 0xe1, 0x2f, 0x17, 0xf8, 0x1d,
-	0x03, 0xdf, 0x03, 0xc0, 0xc7, 0x68, 0x45, 0xd2, 0x36, 0xf0, 0x82, 0x8a, 0x74, 0x94, 0x52, 0x7b,
-	0xb6, 0x6a, 0xd5, 0x16, 0xb6, 0xde, 0xf7, 0xa6, 0xb4, 0xce, 0x3b, 0x2c, 0x40, 0x7b, 0x00, 0x3a,
-	0xb9, 0x86, 0x63, 0x54, 0xae, 0x69, 0x95, 0x93, 0x94, 0xae, 0xbf, 0x24, 0xc7, 0x00, 0xf8, 0x19,
-	0x5a, 0xa3, 0xb9, 0x6c, 0x31, 0x1e, 0x7f, 0x0f, 0x21, 0xf9, 0x2e, 0x67, 0x12, 0x48, 0x08, 0x19,
-	0x4b, 0x85, 0x5d, 0xaa, 0x96, 0x6a, 0xf3, 0x0d, 0xb7, 0xd7, 0x75, 0x2a, 0x9a, 0xed, 0x8a, 0x40,
-	0xd7, 0x5f, 0x1d, 0x7a, 0x9e, 0x16, 0x8e, 0x5d, 0x6d, 0x7f, 0x55, 0x42, 0x8b, 0x9f, 0xeb, 0x29,
-	0x3c, 0x90, 0x54, 0x02, 0xae, 0xa2, 0xc5, 0x0c, 0x4e, 0x25, 0x51, 0xc5, 0x8b, 0x43, 0xdb, 0xaa,
-	0x5a, 0xb5, 0x39, 0x1f, 0x15, 0xb6, 0x7d, 0xc6, 0x92, 0x2f, 0x42, 0xbc, 0x8d, 0xca, 0x63, 0xc9,
-	0xdf, 0x9d, 0x9a, 0xbc, 0x49, 0x7a, 0xae, 0x48, 0xda, 0x37, 0x40, 0xfc, 0x04, 0x2d, 0x28, 0x7e,
-	0x35, 0x24, 0x3a, 0x8b, 0x85, 0xad, 0xda, 0x54, 0x9e, 0xaf, 0xd4, 0x58, 0xf9, 0x05, 0xc0, 0x90,
-	0xa1, 0x22, 0x4c, 0x19, 0x04, 0xfe, 0x16, 0xe1, 0x41, 0x1d, 0x05, 0x91, 0x9c, 0x06, 0x6d, 0xe0,
-	0xf6, 0x9c, 0xd2, 0xb7, 0x79, 0xad, 0xe6, 0x88, 0x43, 0x0d, 0xf2, 0x57, 0xe4, 0x84, 0x05, 0x7f,
-	0x89, 0x16, 0x95, 0xda, 0x63, 0x96, 0xe4, 0x29, 0x08, 0xfb, 0x86, 0x92, 0xfb, 0xee, 0xf4, 0xb4,
-	0x19, 0x4b, 0xbe, 0x56, 0xf1, 0xbe, 0x4a, 0x55, 0xff, 0x17, 0xb8, 0x83, 0xd6, 0x55, 0x47, 0x48,
-	0x87, 0xc6, 0x9c, 0x0c, 0x7b, 0x2f, 0x24, 0xe3, 0x60, 0x97, 0x15, 0xb3, 0x37, 0x95, 0x59, 0x35,
-	0x6e, 0x9f, 0xc6, 0xbc, 0xaf, 0xdc, 0x94, 0xe3, 0x56, 0x38, 0xe9, 0x38, 0x28, 0x38, 0xdd, 0x1f,
-	0xca, 0x68, 0x69, 0x7c, 0x02, 0x71, 0x13, 0xdd, 0x0c, 0xe1, 0x88, 0xe6, 0x89, 0x1c, 0x2a, 0x50,
-	0x8d, 0x9e, 0x6f, 0x3c, 0x28, 0xb8, 0xfe, 0xe8, 0x3a, 0x1b, 0x7a, 0x29, 0x44, 0xd8, 0xf6, 0x62,
-	0x56, 0x4f, 0xa9, 0x6c, 0x79, 0x8f, 0x20, 0xa2, 0xc1, 0xd9, 0x2e, 0x04, 0xe7, 0x5d, 0x67, 0x79,
-	0x57, 0xe3, 0xfb, 0xc4, 0xfe, 0x72, 0x38, 0x6e, 0xc0, 0x3f, 0x59, 0x48, 0x9d, 0x67, 0x23, 0x39,
-	0x86, 0xb1, 0x90, 0x3c, 0x6e, 0xe6, 0xc5, 0x3e, 0x99, 0xd9, 0xf9, 0xf4, 0x5a, 0xbd, 0xd9, 0x1d,
-	0x01, 0xee, 0x03, 0x0f, 0x20, 0x93, 0x34, 0x82, 0x46, 0xb5, 0xd0, 0x7a, 0xde, 0x75, 0xec, 0x27,
-	0x22, 0x65, 0x97, 0xc5, 0xfa, 0x36, 0xbb, 0xc2, 0x83, 0x7f, 0xb6, 0x90, 0x93, 0xb1, 0x8c, 0x4c,
-	0x93, 0x58, 0xfa, 0xef, 0x12, 0xef, 0x1a, 0x89, 0x1b, 0x8f, 0x59, 0x76, 0xa5, 0xca, 0x8d, 0xec,
-	0x6a, 0x27, 0xde, 0x41, 0xcb, 0x34, 0x4c, 0xe3, 0x8c, 0xd0, 0x30, 0xe4, 0x20, 0x04, 0x08, 0x7b,
-	0x4e, 0x2d, 0xfd, 0x7a, 0xaf, 0xeb, 0xdc, 0x32, 0x4b, 0x3f, 0x1e, 0xe0, 0xfa, 0x4b, 0xca, 0xb2,
-	0xdd, 0x37, 0xe0, 0x5f, 0x2d, 0xf4, 0x20, 0x60, 0x69, 0x9a, 0x67, 0xb1, 0x3c, 0xd3, 0xab, 0xad,
-	0xa7, 0x50, 0x32, 0x22, 0x4e, 0x68, 0x87, 0x14, 0xa5, 0x38, 0x69, 0xc5, 0x12, 0x92, 0x58, 0x48,
-	0x08, 0x09, 0x15, 0x02, 0xa4, 0x20, 0x92, 0xd9, 0x37, 0xd4, 0x58, 0x6c, 0xf7, 0xba, 0xce, 0x43,
-	0xfd, 0xb2, 0x7f, 0xc7, 0xe3, 0xfa, 0xde, 0x00, 0x58, 0xec, 0x86, 0x9a, 0xe2, 0x43, 0x76, 0x70,
-	0x42, 0x3b, 0x8f, 0x59, 0xf6, 0xcd, 0x10, 0xb2, 0xad, 0x10, 0x87, 0x0c, 0x1f, 0xa2, 0x55, 0x0e,
-	0x61, 0x1e, 0x40, 0xa8, 0x3a, 0x33, 0x60, 0x55, 0x4b, 0x32, 0xdf, 0xa8, 0xf6, 0xba, 0xce, 0x1d,
-	0xad, 0xe8, 0xd2, 0x30, 0xd7, 0x7f, 0xcb, 0xd8, 0xf7, 0x00, 0x06, 0xfc, 0xee, 0x5f, 0x16, 0xaa,
-	0x4c, 0xef, 0x19, 0x3e, 0x42, 0xcb, 0x42, 0xd2, 0x76, 0x9c, 0x45, 0x84, 0xc3, 0x09, 0xe5, 0xa1,
-	0x30, 0xbb, 0xf1, 0xf0, 0x1a, 0xbb, 0x31, 0x6c, 0xca, 0x04, 0x87, 0xeb, 0x2f, 0x19, 0x8b, 0xaf,
-	0x0d, 0x38, 0x40, 0x4b, 0xe3, 0xb5, 0x54, 0x3b, 0x31, 0xdf, 0xf8, 0xec, 0x7a, 0xaf, 0x59, 0xbd,
-	0xac, 0x1d, 0xae, 0xff, 0xff, 0xb1, 0x32, 0xbb, 0xbf, 0xcd, 0xa2, 0x95, 0xc9, 0x23, 0x0e, 0xfb,
-	0x68, 0x75, 0xf4, 0xb4, 0x64, 0x44, 0xa8, 0x47, 0xf1, 0xe6, 0x1b, 0x56, 0x1f, 0x35, 0x78, 0x78,
-	0x44, 0xb2, 0x03, 0x0d, 0xc5, 0x04, 0xdd, 0x19, 0xe7, 0x7c, 0x2d, 0xb7, 0x6b, 0x51, 0xdb, 0x23,
-	0xd4, 0x3b, 0xa3, 0x99, 0xe0, 0x36, 0x7a, 0xa7, 0x05, 0x71, 0xd4, 0x92, 0x84, 0x06, 0x01, 0xcb,
-	0x33, 0x59, 0x14, 0x57, 0x48, 0xca, 0xa5, 0x20, 0x47, 0x9c, 0xa5, 0x6a, 0x5d, 0x4b, 0x8d, 0x5a,
-	0xaf, 0xeb, 0xdc, 0xd3, 0xa5, 0x99, 0x1a, 0xee, 0xfa, 0xeb, 0xda, 0xbf, 0x3d, 0x70, 0x1f, 0x28,
-	0xef, 0x5e, 0xe1, 0x7c, 0x6e, 0x21, 0x34, 0x3c, 0xc2, 0xf1, 0x1a, 0xfa, 0xdf, 0xf8, 0x7d, 0x58,
-	0xee, 0xe8, 0xbb, 0x30, 0x31, 0x17, 0x99, 0xbe, 0x1a, 0xde, 0x9c, 0xe4, 0x07, 0x45, 0x92, 0xff,
-	0xe8, 0x2b, 0x04, 0x0d, 0x6f, 0x8f, 0xc6, 0xd3, 0x17, 0xe7, 0x15, 0xeb, 0xe5, 0x79, 0xc5, 0xfa,
-	0xf3, 0xbc, 0x62, 0xfd, 0x78, 0x51, 0x99, 0x79, 0x79, 0x51, 0x99, 0xf9, 0xfd, 0xa2, 0x32, 0xf3,
-	0xec, 0xa3, 0x11, 0x3e, 0x73, 0x5c, 0x6d, 0x26, 0xb4, 0x29, 0xfa, 0x0f, 0xf5, 0xe3, 0xad, 0x8f,
-	0xeb, 0xa7, 0x63, 0xdf, 0x5f, 0xea, 0x25, 0xcd, 0xb2, 0xfa, 0xf6, 0xfa, 0xf0, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3d, 0x89, 0xbc, 0xc7, 0xa7, 0x0a, 0x00, 0x00,
+	// 1401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbd, 0x73, 0x1b, 0x45,
+	0x14, 0xf7, 0xc5, 0x8e, 0x82, 0xd7, 0xc1, 0x1f, 0x9b, 0x28, 0x39, 0xdb, 0x89, 0x4e, 0x73, 0x09,
+	0xa0, 0x24, 0x93, 0x53, 0x62, 0x48, 0x0a, 0x20, 0x85, 0x64, 0xc9, 0x60, 0x70, 0x62, 0x73, 0x52,
+	0xc8, 0x10, 0x8a, 0x65, 0x7d, 0xb7, 0x96, 0x0f, 0xeb, 0x6e, 0xc5, 0xed, 0x9e, 0x6d, 0xa5, 0xa1,
+	0x84, 0x19, 0x1a, 0x66, 0xd2, 0x50, 0xa4, 0x63, 0x86, 0x82, 0x8e, 0x81, 0x3f, 0x22, 0x65, 0x4a,
+	0x86, 0x42, 0x61, 0x9c, 0x9a, 0x46, 0x25, 0x15, 0x73, 0xbb, 0xab, 0x8f, 0x93, 0x6d, 0x59, 0x40,
+	0x65, 0xdd, 0x7b, 0xef, 0xf7, 0x7b, 0x9f, 0xfb, 0x76, 0x0d, 0xae, 0x51, 0xe6, 0x53, 0xe6, 0xb1,
+	0x7c, 0x83, 0xd2, 0xba, 0x8f, 0x03, 0x5c, 0x23, 0x61, 0x7e, 0xf7, 0xf6, 0x26, 0xe1, 0xf8, 0x76,
+	0xbe, 0x46, 0x02, 0xc2, 0x3c, 0x66, 0x35, 0x42, 0xca, 0x29, 0x5c, 0x54, 0xa6, 0x56, 0x9f, 0xa9,
+	0xa5, 0x4c, 0x17, 0xce, 0xd7, 0x68, 0x8d, 0x0a, 0xbb, 0x7c, 0xfc, 0x4b, 0x42, 0x16, 0xe6, 0x6b,
+	0x94, 0xd6, 0xea, 0x24, 0x2f, 0xbe, 0x36, 0xa3, 0xad, 0x3c, 0x0e, 0x9a, 0x1d, 0x95, 0x23, 0xe8,
+	0x90, 0xc4, 0xc8, 0x0f, 0xa5, 0xca, 0x0c, 0xa2, 0xdc, 0x28, 0xc4, 0xdc, 0xa3, 0x41, 0x47, 0x2f,
+	0xad, 0xf3, 0x9b, 0x98, 0x91, 0x6e, 0xac, 0x0e, 0xf5, 0x3a, 0x7a, 0x6b, 0x58, 0x4e, 0x3e, 0x75,
+	0xa3, 0x3a, 0x41, 0x21, 0x8d, 0x38, 0x51, 0xf6, 0x57, 0x87, 0xd9, 0xf3, 0x7d, 0x69, 0x65, 0xfe,
+	0x7a, 0x1a, 0xa4, 0x36, 0x70, 0x88, 0x7d, 0x06, 0x9f, 0x6a, 0x60, 0x2e, 0xb6, 0x45, 0x4e, 0x48,
+	0x44, 0x60, 0x68, 0x8b, 0x10, 0x5d, 0xcb, 0x8e, 0xe7, 0xa6, 0x96, 0xe6, 0x2d, 0x95, 0x4b, 0x1c,
+	0x5d, 0xa7, 0x3c, 0xd6, 0x32, 0xf5, 0x82, 0xe2, 0xda, 0xf3, 0x96, 0x31, 0xd6, 0x6e, 0x19, 0x7a,
+	0x13, 0xfb, 0xf5, 0x77, 0xcd, 0x43, 0x0c, 0xe6, 0xcf, 0x2f, 0x8d, 0x5c, 0xcd, 0xe3, 0xdb, 0xd1,
+	0xa6, 0xe5, 0x50, 0x5f, 0x15, 0x45, 0xfd, 0xb9, 0xc9, 0xdc, 0x9d, 0x3c, 0x6f, 0x36, 0x08, 0x13,
+	0x64, 0xcc, 0x9e, 0x89, 0xf1, 0xcb, 0x0a, 0xbe, 0x42, 0x08, 0xdc, 0x05, 0xb3, 0x1c, 0xef, 0x90,
+	0x30, 0xa6, 0x42, 0x0d, 0x11, 0xa9, 0x7e, 0x2a, 0xab, 0xe5, 0xa6, 0x96, 0x6e, 0x58, 0x43, 0x5a,
+	0x67, 0x55, 0x63, 0xd0, 0x0a, 0x21, 0x32, 0xb9, 0xa2, 0xa1, 0xa2, 0xbc, 0x28, 0xa3, 0x1c, 0xa4,
+	0x34, 0xed, 0x69, 0x9e, 0x00, 0xc0, 0xc7, 0xe0, 0x22, 0x8e, 0xf8, 0x36, 0x0d, 0xbd, 0x27, 0xc4,
+	0x45, 0x5f, 0x45, 0x94, 0x13, 0xe4, 0x92, 0x80, 0xfa, 0x4c, 0x1f, 0xcf, 0x8e, 0xe7, 0x26, 0x8b,
+	0x66, 0xbb, 0x65, 0x64, 0x24, 0xdb, 0x31, 0x86, 0xa6, 0x9d, 0xee, 0x69, 0x3e, 0x89, 0x15, 0x25,
+	0x21, 0x87, 0xef, 0x00, 0x10, 0xd7, 0x51, 0x9a, 0xe9, 0x13, 0x59, 0x2d, 0x37, 0x59, 0x4c, 0xb7,
+	0x5b, 0xc6, 0x9c, 0xa4, 0xeb, 0xe9, 0x4c, 0x7b, 0x32, 0xfe, 0x10, 0x30, 0xf8, 0x8d, 0x06, 0x2e,
+	0xf4, 0x54, 0xa8, 0x11, 0x7a, 0x0e, 0x41, 0x71, 0xea, 0x4c, 0x3f, 0x9d, 0x1d, 0x3f, 0xb1, 0x20,
+	0x82, 0x64, 0x23, 0x06, 0x6d, 0x50, 0x5a, 0x2f, 0xbe, 0xa1, 0x0a, 0x72, 0x79, 0xd0, 0x67, 0x3f,
+	0xb1, 0x69, 0x9f, 0xeb, 0xfa, 0xef, 0x42, 0x19, 0xfc, 0x1a, 0xe8, 0x7d, 0xf6, 0x7c, 0x0f, 0x37,
+	0x50, 0x67, 0x98, 0xf5, 0x94, 0xe8, 0xcd, 0xbc, 0x25, 0xa7, 0xdd, 0xea, 0x4c, 0xbb, 0x55, 0x52,
+	0x06, 0xc5, 0x1b, 0xca, 0xb1, 0x71, 0xc8, 0x71, 0x82, 0xc8, 0xfc, 0xe1, 0xa5, 0xa1, 0xd9, 0xe9,
+	0xae, 0xfb, 0xea, 0x1e, 0x6e, 0x74, 0x38, 0xcc, 0xbf, 0x53, 0xe0, 0xec, 0x07, 0xf2, 0x18, 0x57,
+	0x38, 0xe6, 0x04, 0x66, 0xc1, 0xd9, 0x80, 0xec, 0x73, 0x11, 0x35, 0xf2, 0x5c, 0x5d, 0xcb, 0x6a,
+	0xb9, 0x09, 0x1b, 0xc4, 0xb2, 0x38, 0xe4, 0x55, 0x17, 0x16, 0x40, 0x2a, 0x31, 0x3d, 0x57, 0x86,
+	0x16, 0x4b, 0x4d, 0xcd, 0x44, 0x1c, 0xab, 0xad, 0x80, 0x70, 0x1d, 0x4c, 0x09, 0x7e, 0x71, 0xca,
+	0xe4, 0x18, 0x4c, 0x2d, 0xe5, 0x86, 0xf2, 0xdc, 0x17, 0xe7, 0xd2, 0x8e, 0x01, 0x8a, 0x0c, 0xc4,
+	0x66, 0x42, 0xc0, 0xe0, 0xe7, 0x00, 0x76, 0x07, 0x91, 0x21, 0x1e, 0x62, 0x67, 0x87, 0x84, 0x62,
+	0x1e, 0xa6, 0x96, 0x6e, 0x8e, 0x34, 0xdd, 0xac, 0x2a, 0x41, 0xf6, 0x2c, 0x1f, 0x90, 0xc0, 0x8f,
+	0xc0, 0x59, 0x11, 0xed, 0x2e, 0xad, 0x47, 0x3e, 0xe9, 0xcc, 0xc8, 0x5b, 0xc3, 0xd3, 0xa6, 0xb4,
+	0xfe, 0xa9, 0xb0, 0xb7, 0xa7, 0x1a, 0xdd, 0xdf, 0x0c, 0x36, 0xc0, 0x82, 0x9a, 0x0d, 0xec, 0x85,
+	0xa8, 0x77, 0x78, 0x18, 0xa7, 0x21, 0xd1, 0x53, 0x82, 0xd9, 0x1a, 0x61, 0xfa, 0xb0, 0x17, 0x76,
+	0x22, 0x57, 0xe5, 0xb8, 0xe0, 0x0e, 0x2a, 0x2a, 0x31, 0x27, 0xfc, 0x02, 0x9c, 0x17, 0xd1, 0x0f,
+	0xfa, 0x3a, 0x23, 0x7c, 0x5d, 0x3b, 0x31, 0x8b, 0x01, 0x37, 0x73, 0x8d, 0x3e, 0x99, 0xf4, 0xd0,
+	0x00, 0x7a, 0x8f, 0xdc, 0xf5, 0x98, 0x43, 0xa3, 0x80, 0x23, 0xee, 0x91, 0x90, 0xe9, 0xaf, 0x09,
+	0x2f, 0xb7, 0x47, 0x6a, 0x41, 0x49, 0x41, 0xab, 0x1e, 0x09, 0x95, 0xb7, 0x34, 0x3f, 0x42, 0xc7,
+	0xe0, 0x97, 0x20, 0x8d, 0x1d, 0xe9, 0x46, 0x36, 0x05, 0x39, 0x34, 0xd8, 0xf2, 0x6a, 0xfa, 0xa4,
+	0xe8, 0xf8, 0xad, 0xa1, 0xee, 0x0a, 0x12, 0x29, 0x3b, 0xb2, 0x2c, 0x70, 0xca, 0xdb, 0x39, 0x7c,
+	0x58, 0x05, 0x3f, 0x03, 0x33, 0x49, 0x5f, 0x4c, 0x07, 0x22, 0xa9, 0xeb, 0xa3, 0x7b, 0x51, 0xfc,
+	0xd3, 0x09, 0x7e, 0x66, 0x7e, 0x97, 0x02, 0xd3, 0xc9, 0xed, 0x0a, 0x37, 0xc1, 0x9c, 0x4b, 0xb6,
+	0x70, 0x54, 0xe7, 0xbd, 0x86, 0x89, 0x33, 0x38, 0x59, 0xbc, 0x1b, 0x73, 0xfc, 0xd1, 0x32, 0x16,
+	0xe5, 0xc2, 0x67, 0xee, 0x8e, 0xe5, 0xd1, 0xbc, 0x8f, 0xf9, 0xb6, 0xb5, 0x46, 0x6a, 0xd8, 0x69,
+	0x96, 0x88, 0x73, 0xd0, 0x32, 0x66, 0x4a, 0x12, 0xdf, 0x21, 0xb6, 0x67, 0xdc, 0xa4, 0x00, 0x3e,
+	0xd3, 0x80, 0xb8, 0xab, 0x51, 0xa2, 0x6b, 0x3c, 0xf4, 0x36, 0x23, 0xb1, 0x78, 0xe4, 0xb1, 0x7e,
+	0x6f, 0xd4, 0x9e, 0x75, 0x81, 0x1b, 0x24, 0x74, 0x48, 0xc0, 0x71, 0x8d, 0x14, 0xb3, 0x71, 0xac,
+	0x07, 0x2d, 0x43, 0x5f, 0x67, 0x3e, 0x3d, 0xca, 0xd6, 0xd6, 0xe9, 0x31, 0x1a, 0xf8, 0x93, 0x06,
+	0x8c, 0x80, 0x06, 0x68, 0x58, 0x88, 0xe3, 0xff, 0x3f, 0xc4, 0x2b, 0x2a, 0xc4, 0xc5, 0x07, 0x34,
+	0x38, 0x36, 0xca, 0xc5, 0xe0, 0x78, 0x25, 0x5c, 0x06, 0x33, 0xd8, 0xf5, 0xbd, 0x00, 0x61, 0xd7,
+	0x0d, 0x09, 0x63, 0x84, 0xe9, 0x13, 0xe2, 0x42, 0x5b, 0x68, 0xb7, 0x8c, 0x0b, 0xea, 0x42, 0x4b,
+	0x1a, 0x98, 0xf6, 0xb4, 0x90, 0x14, 0x3a, 0x02, 0xf8, 0x8b, 0x06, 0xee, 0x3a, 0xd4, 0xf7, 0xa3,
+	0xc0, 0xe3, 0x4d, 0xb9, 0x75, 0xd5, 0x0e, 0xa7, 0x88, 0xc5, 0x6b, 0x3c, 0x2e, 0xc5, 0xde, 0xb6,
+	0xc7, 0x49, 0xdd, 0x63, 0x9c, 0xb8, 0x08, 0x33, 0x46, 0x38, 0x43, 0x9c, 0xea, 0xa7, 0xc5, 0x58,
+	0x14, 0xda, 0x2d, 0xe3, 0x9e, 0x74, 0xf6, 0xdf, 0x78, 0x4c, 0xdb, 0xea, 0x02, 0xe3, 0x03, 0x2f,
+	0x2f, 0x09, 0x5a, 0xd9, 0xc3, 0x8d, 0x07, 0x34, 0x78, 0xd4, 0x83, 0x14, 0x04, 0xa2, 0x4a, 0x61,
+	0x15, 0xa4, 0x43, 0xe2, 0x46, 0x0e, 0x71, 0x45, 0x67, 0xba, 0xac, 0x62, 0x7f, 0x4d, 0x16, 0xb3,
+	0xed, 0x96, 0x71, 0x49, 0x46, 0x74, 0xa4, 0x99, 0x69, 0x9f, 0x53, 0xf2, 0x15, 0x42, 0xba, 0xfc,
+	0xe6, 0x5f, 0x1a, 0xc8, 0x0c, 0xef, 0x19, 0xdc, 0x02, 0x33, 0x8c, 0xe3, 0x1d, 0x2f, 0xa8, 0xa1,
+	0x90, 0xec, 0xe1, 0xd0, 0x65, 0xea, 0x6c, 0xdc, 0x1b, 0xe1, 0x6c, 0xf4, 0x9a, 0x32, 0xc0, 0x61,
+	0xda, 0xd3, 0x4a, 0x62, 0x4b, 0x01, 0x74, 0xc0, 0x74, 0xb2, 0x96, 0xe2, 0x4c, 0x4c, 0x16, 0xdf,
+	0x1f, 0xcd, 0x4d, 0xfa, 0xa8, 0x76, 0x98, 0xf6, 0xeb, 0x89, 0x32, 0x9b, 0xbf, 0x9d, 0x02, 0xb3,
+	0x83, 0xb7, 0x0f, 0xb4, 0x41, 0xba, 0xff, 0x22, 0xa3, 0x88, 0x89, 0x4f, 0x76, 0xf2, 0xeb, 0x51,
+	0xae, 0x18, 0xd8, 0xbb, 0xbd, 0x68, 0x45, 0x42, 0x21, 0x02, 0x97, 0x92, 0x9c, 0x87, 0x72, 0x1b,
+	0x89, 0x5a, 0xef, 0xa3, 0x5e, 0xee, 0xcf, 0x04, 0xee, 0x80, 0xcb, 0xdb, 0xc4, 0xab, 0x6d, 0x73,
+	0xa4, 0x16, 0x5c, 0x5c, 0x5c, 0xc6, 0x71, 0xc8, 0x19, 0xda, 0x0a, 0xa9, 0x2f, 0x8e, 0xeb, 0x78,
+	0x31, 0xd7, 0x6e, 0x19, 0x57, 0x65, 0x69, 0x86, 0x9a, 0x9b, 0xf6, 0x82, 0xd4, 0x17, 0xba, 0xea,
+	0x8a, 0xd0, 0xae, 0xc4, 0xca, 0xa7, 0x1a, 0x00, 0xbd, 0xdb, 0x15, 0x5e, 0x04, 0x67, 0x92, 0x4f,
+	0x95, 0x54, 0x43, 0x3e, 0x53, 0xea, 0xea, 0x8d, 0x21, 0x97, 0xf6, 0xc9, 0x49, 0xde, 0x8a, 0x93,
+	0xfc, 0x57, 0x2f, 0x6c, 0xd0, 0xbb, 0xd8, 0xaf, 0x3f, 0xd3, 0x7a, 0xab, 0xbc, 0x42, 0xa3, 0xd0,
+	0x21, 0xf0, 0x12, 0xd0, 0xab, 0x85, 0x8f, 0xcb, 0x36, 0x5a, 0x29, 0x97, 0x51, 0x65, 0xfd, 0xa1,
+	0xbd, 0x5c, 0x46, 0xa5, 0xf2, 0x4a, 0xe1, 0xe1, 0x5a, 0x75, 0x76, 0x0c, 0x1a, 0x60, 0xf1, 0x08,
+	0xed, 0x83, 0xf5, 0xfb, 0x68, 0xa3, 0xb0, 0x6a, 0xcf, 0x6a, 0x70, 0x1e, 0xa4, 0x0f, 0x19, 0x6c,
+	0xac, 0xaf, 0xaf, 0xcd, 0x9e, 0x82, 0xd7, 0xc1, 0x9b, 0x87, 0x54, 0x76, 0xb9, 0xf4, 0x70, 0xb9,
+	0x5c, 0x12, 0xa2, 0x47, 0x1f, 0xae, 0x56, 0xcb, 0x6b, 0xab, 0x95, 0xea, 0xec, 0xf8, 0xc2, 0xc4,
+	0xb7, 0x3f, 0x66, 0xc6, 0x8a, 0xeb, 0xcf, 0x0f, 0x32, 0xda, 0x8b, 0x83, 0x8c, 0xf6, 0xe7, 0x41,
+	0x46, 0xfb, 0xfe, 0x55, 0x66, 0xec, 0xc5, 0xab, 0xcc, 0xd8, 0xef, 0xaf, 0x32, 0x63, 0x8f, 0xef,
+	0xf4, 0xa5, 0xeb, 0x63, 0x1c, 0x34, 0x6f, 0xee, 0x37, 0x9f, 0xa8, 0x5f, 0x2e, 0xd9, 0xcf, 0xef,
+	0xde, 0xc9, 0xef, 0x27, 0xfe, 0xf1, 0x11, 0x15, 0xd8, 0x4c, 0x89, 0xe7, 0xe8, 0xdb, 0xff, 0x0c,
+	0x00, 0x74, 0x65, 0x21, 0x8c, 0x20, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -558,6 +688,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BaseDenomTwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BaseDenomTwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BaseDenomPricePools) > 0 {
+		for iNdEx := len(m.BaseDenomPricePools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseDenomPricePools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.AuthorizedQuoteDenoms) > 0 {
 		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
@@ -614,6 +773,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountVolumes) > 0 {
+		for iNdEx := len(m.AccountVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.AccountVolumeConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.TakerFeeDiscountTiers) > 0 {
+		for iNdEx := len(m.TakerFeeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for iNdEx := len(m.PoolTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFeeStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -936,6 +1147,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BaseDenomPricePools) > 0 {
+		for _, e := range m.BaseDenomPricePools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BaseDenomTwapDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -972,6 +1195,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for _, e := range m.PoolTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeeDiscountTiers) > 0 {
+		for _, e := range m.TakerFeeDiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AccountVolumeConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountVolumes) > 0 {
+		for _, e := range m.AccountVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1195,6 +1438,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomPricePools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenomPricePools = append(m.BaseDenomPricePools, DenomPricePool{})
+			if err := m.BaseDenomPricePools[len(m.BaseDenomPricePools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomTwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BaseDenomTwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1435,6 +1777,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFeeStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTakerFeeStore = append(m.PoolTakerFeeStore, PoolTakerFee{})
+			if err := m.PoolTakerFeeStore[len(m.PoolTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeDiscountTiers = append(m.TakerFeeDiscountTiers, TakerFeeDiscountTier{})
+			if err := m.TakerFeeDiscountTiers[len(m.TakerFeeDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolumeConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountVolumeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountVolumes = append(m.AccountVolumes, AccountVolume{})
+			if err := m.AccountVolumes[len(m.AccountVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
 )
 
 const (
@@ -57,6 +61,24 @@ var (
 
 	// KeyRegisteredAlloyPool defines the key to store registered alloy pool data.
 	KeyRegisteredAlloyPool = []byte{0x0C}
+
+	// PoolTakerFeePrefix defines prefix to store the taker fee overrides of pools.
+	PoolTakerFeePrefix = []byte{0x0D}
+
+	// TakerFeeDiscountTierPrefix defines prefix to store the taker fee discount tiers by name.
+	TakerFeeDiscountTierPrefix = []byte{0x0E}
+
+	// TakerFeeDiscountMemberPrefix defines prefix to index the taker fee discount tiers by member.
+	TakerFeeDiscountMemberPrefix = []byte{0x0F}
+
+	// AccountVolumeConfigKey defines key to store the configuration of account volume tracking.
+	AccountVolumeConfigKey = []byte{0x10}
+
+	// AccountVolumePrefix defines prefix to store the volume of accounts by window.
+	AccountVolumePrefix = []byte{0x11}
+
+	// TakerFeeDiscountVolumePrefix defines prefix to index the taker fee volume tiers by min volume.
+	TakerFeeDiscountVolumePrefix = []byte{0x12}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -81,6 +103,80 @@ func FormatDenomTradePairKey(tokenInDenom, tokenOutDenom string) []byte {
 	return buffer.Bytes()
 }
 
+// FormatPoolTakerFeeKey serializes the key of the taker fee override of a pool.
+func FormatPoolTakerFeeKey(poolId uint64) []byte {
+	return append(append([]byte{}, PoolTakerFeePrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// ParsePoolTakerFeeKey parses the pool id out of the key of a pool taker fee override.
+func ParsePoolTakerFeeKey(key []byte) (uint64, error) {
+	if len(key) != len(PoolTakerFeePrefix)+8 || !bytes.HasPrefix(key, PoolTakerFeePrefix) {
+		return 0, ErrInvalidKeyFormat
+	}
+	return sdk.BigEndianToUint64(key[len(PoolTakerFeePrefix):]), nil
+}
+
+// FormatTakerFeeDiscountTierKey serializes the key of a taker fee discount tier.
+func FormatTakerFeeDiscountTierKey(name string) []byte {
+	return append(append([]byte{}, TakerFeeDiscountTierPrefix...), name...)
+}
+
+// FormatTakerFeeDiscountMemberPrefix serializes the prefix of the discount tiers a member is in.
+func FormatTakerFeeDiscountMemberPrefix(member sdk.AccAddress) []byte {
+	return append(append([]byte{}, TakerFeeDiscountMemberPrefix...), address.MustLengthPrefix(member)...)
+}
+
+// FormatTakerFeeDiscountMemberKey serializes the key indexing a member of a taker fee discount tier.
+func FormatTakerFeeDiscountMemberKey(member sdk.AccAddress, name string) []byte {
+	return append(FormatTakerFeeDiscountMemberPrefix(member), name...)
+}
+
+// FormatTakerFeeDiscountVolumePrefix serializes the prefix of the volume tiers of the given min
+// volume. The min volume is length prefixed so that the keys sort by min volume.
+func FormatTakerFeeDiscountVolumePrefix(minVolume osmomath.Int) []byte {
+	return append(append([]byte{}, TakerFeeDiscountVolumePrefix...), address.MustLengthPrefix(minVolume.BigInt().Bytes())...)
+}
+
+// FormatTakerFeeDiscountVolumeKey serializes the key indexing a volume tier by its min volume.
+func FormatTakerFeeDiscountVolumeKey(minVolume osmomath.Int, name string) []byte {
+	return append(FormatTakerFeeDiscountVolumePrefix(minVolume), name...)
+}
+
+// ParseTakerFeeDiscountVolumeKey parses the tier name out of the key indexing a volume tier.
+func ParseTakerFeeDiscountVolumeKey(key []byte) (string, error) {
+	prefixLen := len(TakerFeeDiscountVolumePrefix)
+	if len(key) <= prefixLen || !bytes.HasPrefix(key, TakerFeeDiscountVolumePrefix) || len(key) < prefixLen+1+int(key[prefixLen]) {
+		return "", ErrInvalidKeyFormat
+	}
+	return string(key[prefixLen+1+int(key[prefixLen]):]), nil
+}
+
+// FormatAccountVolumePrefix serializes the prefix of the volume windows of an account.
+func FormatAccountVolumePrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, AccountVolumePrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// FormatAccountVolumeKey serializes the key of the volume of an account during the window starting
+// at windowStart.
+func FormatAccountVolumeKey(addr sdk.AccAddress, windowStart time.Time) []byte {
+	return append(FormatAccountVolumePrefix(addr), sdk.Uint64ToBigEndian(uint64(windowStart.Unix()))...)
+}
+
+// ParseAccountVolumeKey parses the account and window start out of the key of an account volume.
+func ParseAccountVolumeKey(key []byte) (sdk.AccAddress, time.Time, error) {
+	if !bytes.HasPrefix(key, AccountVolumePrefix) || len(key) < len(AccountVolumePrefix)+1 {
+		return nil, time.Time{}, ErrInvalidKeyFormat
+	}
+	key = key[len(AccountVolumePrefix):]
+	addrLen := int(key[0])
+	if len(key) != 1+addrLen+8 {
+		return nil, time.Time{}, ErrInvalidKeyFormat
+	}
+	addr := sdk.AccAddress(key[1 : 1+addrLen])
+	windowStart := time.Unix(int64(sdk.BigEndianToUint64(key[1+addrLen:])), 0).UTC()
+	return addr, windowStart, nil
+}
+
 // ParseModuleRouteFromBz parses the raw bytes into ModuleRoute.
 // Returns error if fails to parse or if the bytes are empty.
 func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -17,6 +18,9 @@ const (
 	TypeMsgSetDenomPairTakerFee                  = "set_denom_pair_taker_fee"
 	TypeMsgSetTakerFeeShareAgreementForDenomPair = "set_taker_fee_share_agreement_for_denom_pair"
 	TypeMsgSetRegisteredAlloyedPool              = "set_registered_alloyed_pool"
+	TypeMsgSetPoolTakerFee                       = "set_pool_taker_fee"
+	TypeMsgSetTakerFeeDiscountTiers              = "set_taker_fee_discount_tiers"
+	TypeMsgSetAccountVolumeConfig                = "set_account_volume_config"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -43,7 +47,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return msg.SwapProtection().Validate()
 }
 
 func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -77,7 +81,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
-	return nil
+	return msg.SwapProtection().Validate()
 }
 
 func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
@@ -111,7 +115,7 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return msg.SwapProtection().Validate()
 }
 
 func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -145,7 +149,7 @@ func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
-	return nil
+	return msg.SwapProtection().Validate()
 }
 
 func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
@@ -242,3 +246,77 @@ func (msg MsgSetRegisteredAlloyedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetPoolTakerFee{}
+
+func (msg MsgSetPoolTakerFee) Route() string { return RouterKey }
+func (msg MsgSetPoolTakerFee) Type() string  { return TypeMsgSetPoolTakerFee }
+
+func (msg MsgSetPoolTakerFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.PoolTakerFees) == 0 {
+		return errors.New("empty pool taker fees")
+	}
+
+	return validatePoolTakerFees(msg.PoolTakerFees)
+}
+
+func (msg MsgSetPoolTakerFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetTakerFeeDiscountTiers{}
+
+func (msg MsgSetTakerFeeDiscountTiers) Route() string { return RouterKey }
+func (msg MsgSetTakerFeeDiscountTiers) Type() string  { return TypeMsgSetTakerFeeDiscountTiers }
+
+func (msg MsgSetTakerFeeDiscountTiers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.Tiers) == 0 {
+		return errors.New("empty taker fee discount tiers")
+	}
+
+	return validateTakerFeeDiscountTiers(msg.Tiers)
+}
+
+func (msg MsgSetTakerFeeDiscountTiers) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetAccountVolumeConfig{}
+
+func (msg MsgSetAccountVolumeConfig) Route() string { return RouterKey }
+func (msg MsgSetAccountVolumeConfig) Type() string  { return TypeMsgSetAccountVolumeConfig }
+
+func (msg MsgSetAccountVolumeConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	return msg.Config.Validate()
+}
+
+func (msg MsgSetAccountVolumeConfig) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
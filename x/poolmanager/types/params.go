@@ -1,7 +1,9 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	appparams "github.com/maany-xyz/maany-dex/v5/app/config"
 	"github.com/maany-xyz/maany-dex/v5/osmomath"
@@ -21,11 +23,17 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyBaseDenom                                      = []byte("BaseDenom")
+	KeyBaseDenomPricePools                            = []byte("BaseDenomPricePools")
+	KeyBaseDenomTwapDuration                          = []byte("BaseDenomTwapDuration")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
 )
 
+// DefaultBaseDenomTwapDuration is the default duration of the TWAP pool volume is priced at.
+const DefaultBaseDenomTwapDuration = time.Hour
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -73,9 +81,17 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		BaseDenom:             appparams.BaseCoinUnit,
+		BaseDenomPricePools:   []DenomPricePool{},
+		BaseDenomTwapDuration: DefaultBaseDenomTwapDuration,
 	}
 }
 
+// BaseDenomPricePool returns the pool pricing denom in the base denom, if any.
+func (p Params) BaseDenomPricePool(denom string) (uint64, bool) {
+	return findPricePool(p.BaseDenomPricePools, denom)
+}
+
 // validate params.
 func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
@@ -102,6 +118,15 @@ func (p Params) Validate() error {
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
+	if err := validateBaseDenom(p.BaseDenom); err != nil {
+		return err
+	}
+	if err := validateDenomPricePools(p.BaseDenomPricePools, p.BaseDenom); err != nil {
+		return err
+	}
+	if err := validateBaseDenomTwapDuration(p.BaseDenomTwapDuration); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -117,6 +142,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyBaseDenom, &p.BaseDenom, validateBaseDenom),
+		paramtypes.NewParamSetPair(KeyBaseDenomPricePools, &p.BaseDenomPricePools, validateBaseDenomPricePools),
+		paramtypes.NewParamSetPair(KeyBaseDenomTwapDuration, &p.BaseDenomTwapDuration, validateBaseDenomTwapDuration),
 	}
 }
 
@@ -227,6 +255,38 @@ func validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo(i interface{}) error
 	return nil
 }
 
+func validateBaseDenom(i interface{}) error {
+	baseDenom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(baseDenom)
+}
+
+func validateBaseDenomPricePools(i interface{}) error {
+	pricePools, ok := i.([]DenomPricePool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a price pool for the base denom itself is rejected by Params.Validate, which knows it
+	return validateDenomPricePools(pricePools, "")
+}
+
+func validateBaseDenomTwapDuration(i interface{}) error {
+	twapDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if twapDuration <= 0 {
+		return fmt.Errorf("base denom twap duration must be positive: %s", twapDuration)
+	}
+
+	return nil
+}
+
 func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	if len(pairs) == 0 {
 		return fmt.Errorf("Empty denom pair taker fee")
@@ -245,10 +305,74 @@ func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 			return fmt.Errorf("TokenOutDenom is invalid: %s", sdk.ValidateDenom(record.TokenOutDenom))
 		}
 
+		if record.Unset {
+			continue
+		}
+
 		takerFee := record.TakerFee
-		if takerFee.IsNegative() || takerFee.GTE(OneDec) {
+		if takerFee.IsNil() || takerFee.IsNegative() || takerFee.GTE(OneDec) {
 			return fmt.Errorf("taker fee must be between 0 and 1: %s", takerFee.String())
 		}
 	}
 	return nil
 }
+
+func validatePoolTakerFees(poolTakerFees []PoolTakerFee) error {
+	seen := make(map[uint64]bool, len(poolTakerFees))
+	for _, record := range poolTakerFees {
+		if record.PoolId == 0 {
+			return errors.New("pool id cannot be 0")
+		}
+		if seen[record.PoolId] {
+			return fmt.Errorf("duplicate taker fee for pool %d", record.PoolId)
+		}
+		seen[record.PoolId] = true
+
+		if record.Unset {
+			continue
+		}
+
+		if record.TakerFee.IsNil() || record.TakerFee.IsNegative() || record.TakerFee.GTE(OneDec) {
+			return fmt.Errorf("taker fee of pool %d must be between 0 and 1: %s", record.PoolId, record.TakerFee)
+		}
+	}
+	return nil
+}
+
+func validateTakerFeeDiscountTiers(tiers []TakerFeeDiscountTier) error {
+	seen := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return errors.New("taker fee discount tier name cannot be empty")
+		}
+		if seen[tier.Name] {
+			return fmt.Errorf("duplicate taker fee discount tier %s", tier.Name)
+		}
+		seen[tier.Name] = true
+
+		if tier.Unset {
+			continue
+		}
+
+		if tier.Discount.IsNil() || !tier.Discount.IsPositive() || tier.Discount.GT(OneDec) {
+			return fmt.Errorf("discount of taker fee discount tier %s must be in (0, 1]: %s", tier.Name, tier.Discount)
+		}
+		if tier.MinVolume != nil && tier.MinVolume.IsNegative() {
+			return fmt.Errorf("min volume of taker fee discount tier %s cannot be negative", tier.Name)
+		}
+		if len(tier.Members) == 0 && !tier.QualifiesByVolume() {
+			return fmt.Errorf("taker fee discount tier %s has no members nor min volume", tier.Name)
+		}
+		members := make(map[string]bool, len(tier.Members))
+		for _, member := range tier.Members {
+			if _, err := sdk.AccAddressFromBech32(member); err != nil {
+				return fmt.Errorf("invalid member %s of taker fee discount tier %s: %w", member, tier.Name, err)
+			}
+			if members[member] {
+				return fmt.Errorf("duplicate member %s of taker fee discount tier %s", member, tier.Name)
+			}
+			members[member] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+)
+
+// SwapProtection holds the optional bounds a swap is checked against on top of its token out min
+// or token in max amount. A nil field means the bound is not set, so the zero value protects nothing.
+type SwapProtection struct {
+	// Deadline is the block time after which the swap is rejected.
+	Deadline *time.Time
+	// MaxPriceImpact bounds, for every hop, the relative difference between the execution price and
+	// the spot price of the pool before the swap.
+	MaxPriceImpact *osmomath.Dec
+	// MaxSpotPriceDrift bounds, for every hop, the relative change of the spot price of the pool
+	// caused by the swap.
+	MaxSpotPriceDrift *osmomath.Dec
+}
+
+// Validate returns an error if a set bound is negative.
+func (p SwapProtection) Validate() error {
+	if p.MaxPriceImpact != nil && (p.MaxPriceImpact.IsNil() || p.MaxPriceImpact.IsNegative()) {
+		return InvalidSwapProtectionError{Field: "max price impact", Value: *p.MaxPriceImpact}
+	}
+	if p.MaxSpotPriceDrift != nil && (p.MaxSpotPriceDrift.IsNil() || p.MaxSpotPriceDrift.IsNegative()) {
+		return InvalidSwapProtectionError{Field: "max spot price drift", Value: *p.MaxSpotPriceDrift}
+	}
+	return nil
+}
+
+// CheckDeadline returns an error if the deadline is set and blockTime is past it.
+func (p SwapProtection) CheckDeadline(blockTime time.Time) error {
+	if p.Deadline != nil && blockTime.After(*p.Deadline) {
+		return SwapDeadlineExceededError{Deadline: *p.Deadline, BlockTime: blockTime}
+	}
+	return nil
+}
+
+// ChecksSpotPrice reports whether the spot price of every hop is needed to enforce the protection.
+func (p SwapProtection) ChecksSpotPrice() bool {
+	return p.MaxPriceImpact != nil || p.MaxSpotPriceDrift != nil
+}
+
+// SwapProtection returns the protection requested by the message.
+func (msg MsgSwapExactAmountIn) SwapProtection() SwapProtection {
+	return SwapProtection{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, MaxSpotPriceDrift: msg.MaxSpotPriceDrift}
+}
+
+// SwapProtection returns the protection requested by the message.
+func (msg MsgSwapExactAmountOut) SwapProtection() SwapProtection {
+	return SwapProtection{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, MaxSpotPriceDrift: msg.MaxSpotPriceDrift}
+}
+
+// SwapProtection returns the protection requested by the message.
+func (msg MsgSplitRouteSwapExactAmountIn) SwapProtection() SwapProtection {
+	return SwapProtection{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, MaxSpotPriceDrift: msg.MaxSpotPriceDrift}
+}
+
+// SwapProtection returns the protection requested by the message.
+func (msg MsgSplitRouteSwapExactAmountOut) SwapProtection() SwapProtection {
+	return SwapProtection{Deadline: msg.Deadline, MaxPriceImpact: msg.MaxPriceImpact, MaxSpotPriceDrift: msg.MaxSpotPriceDrift}
+}
@@ -13,18 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,16 @@ type MsgSwapExactAmountIn struct {
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the block time after which the swap is rejected. Unset
+	// means no deadline.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the
+	// execution price of a hop and the spot price of its pool before the
+	// swap. Unset means no limit.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// max_spot_price_drift is the maximum relative change of the spot price
+	// of a hop's pool caused by the swap. Unset means no limit.
+	MaxSpotPriceDrift *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_spot_price_drift,json=maxSpotPriceDrift,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price_drift,omitempty" yaml:"max_spot_price_drift"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -94,6 +109,13 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -137,6 +159,16 @@ type MsgSplitRouteSwapExactAmountIn struct {
 	Routes            []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInDenom      string                   `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 	TokenOutMinAmount cosmossdk_io_math.Int    `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the block time after which the swap is rejected. Unset
+	// means no deadline.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the
+	// execution price of a hop and the spot price of its pool before the
+	// swap. Unset means no limit.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// max_spot_price_drift is the maximum relative change of the spot price
+	// of a hop's pool caused by the swap. Unset means no limit.
+	MaxSpotPriceDrift *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_spot_price_drift,json=maxSpotPriceDrift,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price_drift,omitempty" yaml:"max_spot_price_drift"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
@@ -193,6 +225,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -238,6 +277,16 @@ type MsgSwapExactAmountOut struct {
 	Routes           []SwapAmountOutRoute  `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin            `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// deadline is the block time after which the swap is rejected. Unset
+	// means no deadline.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the
+	// execution price of a hop and the spot price of its pool before the
+	// swap. Unset means no limit.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// max_spot_price_drift is the maximum relative change of the spot price
+	// of a hop's pool caused by the swap. Unset means no limit.
+	MaxSpotPriceDrift *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_spot_price_drift,json=maxSpotPriceDrift,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price_drift,omitempty" yaml:"max_spot_price_drift"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -294,6 +343,13 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -337,6 +393,16 @@ type MsgSplitRouteSwapExactAmountOut struct {
 	Routes           []SwapAmountOutSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenOutDenom    string                    `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 	TokenInMaxAmount cosmossdk_io_math.Int     `protobuf:"bytes,4,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+	// deadline is the block time after which the swap is rejected. Unset
+	// means no deadline.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the
+	// execution price of a hop and the spot price of its pool before the
+	// swap. Unset means no limit.
+	MaxPriceImpact *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact,omitempty" yaml:"max_price_impact"`
+	// max_spot_price_drift is the maximum relative change of the spot price
+	// of a hop's pool caused by the swap. Unset means no limit.
+	MaxSpotPriceDrift *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_spot_price_drift,json=maxSpotPriceDrift,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price_drift,omitempty" yaml:"max_spot_price_drift"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSwapExactAmountOut{} }
@@ -393,6 +459,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -732,6 +805,281 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetRegisteredAlloyedPoolResponse proto.InternalMessageInfo
 
+// ===================== MsgSetPoolTakerFee
+// MsgSetPoolTakerFee sets or removes taker fee overrides for pools. A pool
+// override takes precedence over the denom pair and default taker fees. Only
+// the governance module account can send it.
+type MsgSetPoolTakerFee struct {
+	Sender        string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolTakerFees []PoolTakerFee `protobuf:"bytes,2,rep,name=pool_taker_fees,json=poolTakerFees,proto3" json:"pool_taker_fees" yaml:"pool_taker_fees"`
+}
+
+func (m *MsgSetPoolTakerFee) Reset()         { *m = MsgSetPoolTakerFee{} }
+func (m *MsgSetPoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolTakerFee) ProtoMessage()    {}
+func (*MsgSetPoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{14}
+}
+func (m *MsgSetPoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolTakerFee.Merge(m, src)
+}
+func (m *MsgSetPoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolTakerFee proto.InternalMessageInfo
+
+func (m *MsgSetPoolTakerFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolTakerFee) GetPoolTakerFees() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFees
+	}
+	return nil
+}
+
+type MsgSetPoolTakerFeeResponse struct {
+}
+
+func (m *MsgSetPoolTakerFeeResponse) Reset()         { *m = MsgSetPoolTakerFeeResponse{} }
+func (m *MsgSetPoolTakerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolTakerFeeResponse) ProtoMessage()    {}
+func (*MsgSetPoolTakerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{15}
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolTakerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolTakerFeeResponse.Merge(m, src)
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolTakerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolTakerFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolTakerFeeResponse proto.InternalMessageInfo
+
+// ===================== MsgSetTakerFeeDiscountTiers
+// MsgSetTakerFeeDiscountTiers sets or removes taker fee discount tiers, by
+// name. A tier that is set replaces the tier of the same name. Only governance
+// can send it.
+type MsgSetTakerFeeDiscountTiers struct {
+	Sender string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Tiers  []TakerFeeDiscountTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers" yaml:"tiers"`
+}
+
+func (m *MsgSetTakerFeeDiscountTiers) Reset()         { *m = MsgSetTakerFeeDiscountTiers{} }
+func (m *MsgSetTakerFeeDiscountTiers) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTakerFeeDiscountTiers) ProtoMessage()    {}
+func (*MsgSetTakerFeeDiscountTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{16}
+}
+func (m *MsgSetTakerFeeDiscountTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTakerFeeDiscountTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTakerFeeDiscountTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTakerFeeDiscountTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTakerFeeDiscountTiers.Merge(m, src)
+}
+func (m *MsgSetTakerFeeDiscountTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTakerFeeDiscountTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTakerFeeDiscountTiers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTakerFeeDiscountTiers proto.InternalMessageInfo
+
+func (m *MsgSetTakerFeeDiscountTiers) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetTakerFeeDiscountTiers) GetTiers() []TakerFeeDiscountTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+type MsgSetTakerFeeDiscountTiersResponse struct {
+}
+
+func (m *MsgSetTakerFeeDiscountTiersResponse) Reset()         { *m = MsgSetTakerFeeDiscountTiersResponse{} }
+func (m *MsgSetTakerFeeDiscountTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTakerFeeDiscountTiersResponse) ProtoMessage()    {}
+func (*MsgSetTakerFeeDiscountTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{17}
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTakerFeeDiscountTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTakerFeeDiscountTiersResponse.Merge(m, src)
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTakerFeeDiscountTiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTakerFeeDiscountTiersResponse proto.InternalMessageInfo
+
+// ===================== MsgSetAccountVolumeConfig
+// MsgSetAccountVolumeConfig sets the configuration of account volume tracking.
+// Only governance can send it.
+type MsgSetAccountVolumeConfig struct {
+	Sender string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Config AccountVolumeConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config" yaml:"config"`
+}
+
+func (m *MsgSetAccountVolumeConfig) Reset()         { *m = MsgSetAccountVolumeConfig{} }
+func (m *MsgSetAccountVolumeConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountVolumeConfig) ProtoMessage()    {}
+func (*MsgSetAccountVolumeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{18}
+}
+func (m *MsgSetAccountVolumeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountVolumeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountVolumeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountVolumeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountVolumeConfig.Merge(m, src)
+}
+func (m *MsgSetAccountVolumeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountVolumeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountVolumeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountVolumeConfig proto.InternalMessageInfo
+
+func (m *MsgSetAccountVolumeConfig) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetAccountVolumeConfig) GetConfig() AccountVolumeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return AccountVolumeConfig{}
+}
+
+type MsgSetAccountVolumeConfigResponse struct {
+}
+
+func (m *MsgSetAccountVolumeConfigResponse) Reset()         { *m = MsgSetAccountVolumeConfigResponse{} }
+func (m *MsgSetAccountVolumeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountVolumeConfigResponse) ProtoMessage()    {}
+func (*MsgSetAccountVolumeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{19}
+}
+func (m *MsgSetAccountVolumeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountVolumeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountVolumeConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountVolumeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountVolumeConfigResponse.Merge(m, src)
+}
+func (m *MsgSetAccountVolumeConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountVolumeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountVolumeConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountVolumeConfigResponse proto.InternalMessageInfo
+
 type DenomPairTakerFee struct {
 	// DEPRECATED: Now that we are using uni-directional trading pairs, we are
 	// using tokenInDenom and tokenOutDenom instead of denom0 and denom1 to
@@ -741,13 +1089,16 @@ type DenomPairTakerFee struct {
 	TakerFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
 	TokenInDenom  string                      `protobuf:"bytes,4,opt,name=tokenInDenom,proto3" json:"tokenInDenom,omitempty" yaml:"token_in_denom"`
 	TokenOutDenom string                      `protobuf:"bytes,5,opt,name=tokenOutDenom,proto3" json:"tokenOutDenom,omitempty" yaml:"token_out_denom"`
+	// unset removes the override of the pair, which then falls back to the
+	// default taker fee. taker_fee is ignored.
+	Unset bool `protobuf:"varint,6,opt,name=unset,proto3" json:"unset,omitempty" yaml:"unset"`
 }
 
 func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
 func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*DenomPairTakerFee) ProtoMessage()    {}
 func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{14}
+	return fileDescriptor_acd130b4825d67dc, []int{20}
 }
 func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,154 +1157,550 @@ func (m *DenomPairTakerFee) GetTokenOutDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
-	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
-	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountIn")
-	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
-	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOut")
-	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
-	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
-	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
-	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
-	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
-	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenom)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenom")
-	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenomResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenomResponse")
-	proto.RegisterType((*MsgSetRegisteredAlloyedPool)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPool")
-	proto.RegisterType((*MsgSetRegisteredAlloyedPoolResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPoolResponse")
-	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+func (m *DenomPairTakerFee) GetUnset() bool {
+	if m != nil {
+		return m.Unset
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("osmosis/poolmanager/v1beta1/tx.proto", fileDescriptor_acd130b4825d67dc)
+// PoolTakerFee is a taker fee override for every swap in a pool.
+type PoolTakerFee struct {
+	PoolId   uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+	// unset removes the override of the pool, which then falls back to the
+	// denom pair or default taker fee. taker_fee is ignored.
+	Unset bool `protobuf:"varint,3,opt,name=unset,proto3" json:"unset,omitempty" yaml:"unset"`
 }
 
-var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0x46, 0x98, 0xf0, 0xb1, 0x10, 0x82, 0x15, 0x28, 0x8e, 0x49, 0x2d, 0x2a, 0x48, 0x4a, 0x52,
-	0x24, 0xc7, 0xc0, 0x4c, 0xc0, 0xd0, 0x49, 0x70, 0x68, 0x66, 0x98, 0xc6, 0x03, 0x51, 0x72, 0xea,
-	0x4c, 0xc7, 0xb3, 0xb6, 0x36, 0x46, 0xc5, 0xd2, 0x7a, 0xa4, 0x35, 0x81, 0x5b, 0x9b, 0x66, 0xda,
-	0x29, 0xd3, 0x43, 0x4f, 0xbd, 0x76, 0xa6, 0xbf, 0x20, 0xb9, 0xf4, 0x37, 0xe4, 0x98, 0x63, 0xa7,
-	0x07, 0x4f, 0x0b, 0x87, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x85, 0xfc, 0x05, 0x53,
-	0x2e, 0x89, 0xa4, 0x7d, 0x9f, 0xf7, 0xe3, 0x79, 0x9f, 0x7d, 0x77, 0x0d, 0x98, 0xc7, 0x8e, 0x89,
-	0x1d, 0xc3, 0x49, 0x96, 0x31, 0x2e, 0x99, 0xd0, 0x82, 0x45, 0x64, 0x27, 0x0f, 0x52, 0x79, 0x44,
-	0x60, 0x2a, 0x49, 0x0e, 0xd5, 0xb2, 0x8d, 0x09, 0x16, 0x67, 0xb8, 0x95, 0xda, 0x60, 0xa5, 0x72,
-	0xab, 0xf8, 0x64, 0x11, 0x17, 0xb1, 0x6b, 0x97, 0xa4, 0x4f, 0x0c, 0x12, 0x8f, 0x42, 0xd3, 0xb0,
-	0x70, 0xd2, 0xfd, 0x97, 0x7f, 0x4a, 0x14, 0x5c, 0x37, 0xc9, 0x3c, 0x74, 0x90, 0x1f, 0xa3, 0x80,
-	0x0d, 0x8b, 0xaf, 0x2f, 0xb6, 0xcb, 0xc5, 0x79, 0x09, 0xcb, 0x39, 0x1b, 0x57, 0x08, 0xe2, 0xd6,
-	0xd3, 0xdc, 0x9b, 0xe9, 0x14, 0x93, 0x07, 0x29, 0xfa, 0x1f, 0x5b, 0x90, 0x7f, 0x8c, 0x80, 0xc9,
-	0xac, 0x53, 0x7c, 0xf6, 0x12, 0x96, 0xbf, 0x38, 0x84, 0x05, 0xb2, 0x69, 0xe2, 0x8a, 0x45, 0xb6,
-	0x2d, 0xf1, 0x0e, 0x18, 0x74, 0x90, 0xa5, 0x23, 0x3b, 0x26, 0xcc, 0x0a, 0x0b, 0x23, 0x99, 0x68,
-	0xad, 0x2a, 0x5d, 0x3d, 0x82, 0x66, 0x29, 0x2d, 0xb3, 0xef, 0xb2, 0xc6, 0x0d, 0xc4, 0x27, 0x60,
-	0xd0, 0x8d, 0xe5, 0xc4, 0xfa, 0x67, 0x23, 0x0b, 0xa3, 0x4b, 0xaa, 0xda, 0x86, 0x01, 0x95, 0x86,
-	0xf2, 0xa2, 0x68, 0x14, 0x96, 0x19, 0x78, 0x57, 0x95, 0xfa, 0x34, 0xee, 0x43, 0xcc, 0x82, 0x61,
-	0x82, 0xf7, 0x91, 0x95, 0x33, 0xac, 0x58, 0x64, 0x56, 0x58, 0x18, 0x5d, 0xba, 0xa1, 0xb2, 0xec,
-	0x55, 0xca, 0x85, 0xef, 0xe7, 0x11, 0x36, 0xac, 0xcc, 0x34, 0x85, 0xd6, 0xaa, 0xd2, 0x35, 0x96,
-	0x99, 0x07, 0x94, 0xb5, 0x21, 0xf7, 0x71, 0xdb, 0x12, 0x4d, 0x30, 0xc9, 0xbe, 0xe2, 0x0a, 0xc9,
-	0x99, 0x86, 0x95, 0x83, 0x6e, 0xec, 0xd8, 0x80, 0x5b, 0xd5, 0x06, 0xc5, 0xff, 0x55, 0x95, 0xa6,
-	0x58, 0x04, 0x47, 0xdf, 0x57, 0x0d, 0x9c, 0x34, 0x21, 0xd9, 0x53, 0xb7, 0x2d, 0x52, 0xab, 0x4a,
-	0x33, 0x8d, 0x8e, 0x9b, 0x5d, 0xc8, 0x5a, 0xd4, 0xfd, 0xbc, 0x53, 0x21, 0x59, 0xc3, 0x62, 0x25,
-	0xa5, 0x57, 0x5f, 0x7d, 0x78, 0x73, 0x97, 0x13, 0x73, 0xfc, 0xe1, 0xcd, 0xdd, 0x85, 0xb0, 0x36,
-	0xd1, 0xf6, 0x28, 0x88, 0xd2, 0xad, 0x30, 0x57, 0x8a, 0x61, 0xc9, 0xaf, 0x04, 0x70, 0x33, 0xac,
-	0x13, 0x1a, 0x72, 0xca, 0xd8, 0x72, 0x90, 0x98, 0x07, 0x13, 0xf5, 0x34, 0x78, 0x15, 0xac, 0x37,
-	0xab, 0x9d, 0xaa, 0x98, 0x0e, 0x56, 0xe1, 0x55, 0x30, 0xee, 0x55, 0xc0, 0xa2, 0xc9, 0xdf, 0x47,
-	0x40, 0x82, 0x26, 0x51, 0x2e, 0x19, 0xc4, 0x6d, 0xce, 0x85, 0x84, 0xf1, 0x34, 0x20, 0x8c, 0xe5,
-	0xae, 0x85, 0x51, 0x4f, 0x20, 0xa0, 0x8e, 0x07, 0x60, 0xdc, 0x6b, 0x72, 0x4e, 0x47, 0x16, 0x36,
-	0x5d, 0x8d, 0x8c, 0x64, 0x6e, 0xd4, 0xaa, 0xd2, 0x54, 0xb3, 0x08, 0xd8, 0xba, 0xac, 0x8d, 0x71,
-	0x29, 0x6c, 0xd1, 0xd7, 0xcb, 0xd6, 0xc3, 0x72, 0x40, 0x0f, 0x73, 0xa1, 0x7a, 0xa0, 0xd5, 0x36,
-	0x48, 0xe1, 0x67, 0x01, 0xdc, 0x6e, 0xdf, 0x85, 0x4b, 0x15, 0xc5, 0x71, 0x04, 0x4c, 0x9d, 0x55,
-	0xe6, 0x4e, 0x85, 0xf4, 0xa2, 0x85, 0x6c, 0x40, 0x0b, 0xc9, 0x2e, 0xb5, 0xb0, 0x53, 0x09, 0xd5,
-	0xc1, 0x37, 0xe0, 0xba, 0xdf, 0x67, 0x13, 0x1e, 0x7a, 0xa5, 0x33, 0x31, 0xac, 0x77, 0x2a, 0x3d,
-	0x1e, 0x50, 0x4a, 0xdd, 0x83, 0xac, 0x4d, 0x70, 0xb9, 0x64, 0xe1, 0x21, 0xcb, 0x40, 0xdc, 0x05,
-	0x23, 0x3e, 0x49, 0xae, 0x4e, 0xda, 0x8e, 0xa4, 0x18, 0x1f, 0x49, 0x13, 0x01, 0x7a, 0x65, 0x6d,
-	0xd8, 0xe3, 0x35, 0xbd, 0x16, 0x50, 0xc5, 0x9d, 0xee, 0xa6, 0x04, 0xf5, 0xf2, 0xad, 0x00, 0x3e,
-	0x0e, 0x6d, 0x86, 0x2f, 0x89, 0x1c, 0xb8, 0xe6, 0x17, 0xd6, 0xa4, 0x88, 0xfb, 0x9d, 0x68, 0xf9,
-	0x28, 0x40, 0x8b, 0x47, 0xc9, 0x55, 0x4e, 0x09, 0xd7, 0xc3, 0x0f, 0x11, 0x20, 0xb5, 0x93, 0x67,
-	0x8f, 0xca, 0xd0, 0x02, 0xca, 0x58, 0xe9, 0x5e, 0x19, 0x2d, 0xc7, 0x44, 0xc6, 0xe3, 0x80, 0xea,
-	0xba, 0x71, 0x4e, 0xc4, 0x83, 0x65, 0xfa, 0x06, 0x5e, 0x99, 0x3b, 0x15, 0xc2, 0x26, 0x45, 0x0b,
-	0x89, 0x0d, 0xfc, 0x0f, 0x12, 0x4b, 0xaf, 0x04, 0x04, 0x31, 0xdf, 0x71, 0x4c, 0x50, 0x2d, 0x1c,
-	0x0b, 0xe0, 0xd3, 0x0e, 0x8d, 0xb8, 0x3c, 0x55, 0xfc, 0xd4, 0x0f, 0xa6, 0x69, 0x32, 0x88, 0xd1,
-	0xb7, 0x0b, 0x0d, 0xfb, 0x39, 0xdc, 0x47, 0xf6, 0x63, 0x84, 0x7a, 0x51, 0xc3, 0x6b, 0x01, 0x4c,
-	0xba, 0xfd, 0xc8, 0x95, 0xa1, 0x61, 0xe7, 0x08, 0x75, 0x91, 0x7b, 0x81, 0x50, 0x57, 0x77, 0x8b,
-	0x33, 0x91, 0x33, 0x73, 0x7c, 0x37, 0xf2, 0xb9, 0x1d, 0xe6, 0x59, 0xd6, 0xa2, 0x7a, 0x10, 0x97,
-	0xde, 0x08, 0x34, 0x24, 0xf4, 0xba, 0xe5, 0x20, 0xa2, 0xb8, 0x50, 0x85, 0x7a, 0x54, 0x5c, 0x8f,
-	0x0a, 0xf5, 0xb8, 0xce, 0x36, 0x48, 0x08, 0x15, 0x7e, 0x3f, 0x62, 0x60, 0xc8, 0xa9, 0x14, 0x0a,
-	0xc8, 0x71, 0x5c, 0x4e, 0x86, 0x35, 0xef, 0x55, 0xfe, 0xa7, 0x1f, 0xcc, 0x33, 0xb4, 0x07, 0x7a,
-	0xb6, 0x07, 0x6d, 0xb4, 0x59, 0xb4, 0x11, 0x32, 0x91, 0x45, 0x1e, 0x63, 0x9b, 0x09, 0xb4, 0x07,
-	0x56, 0x6f, 0x83, 0x2b, 0x6c, 0x17, 0xf4, 0xbb, 0x96, 0x13, 0xb5, 0xaa, 0x34, 0xd6, 0xc0, 0x88,
-	0xac, 0xb1, 0x65, 0xf1, 0x6b, 0x30, 0xe6, 0xec, 0x1b, 0x66, 0xae, 0x8c, 0xec, 0x02, 0xf2, 0xe7,
-	0x69, 0x9a, 0x4b, 0x64, 0xe6, 0xac, 0x44, 0x9e, 0xa0, 0x22, 0x2c, 0x1c, 0x6d, 0xa1, 0x42, 0xad,
-	0x2a, 0x5d, 0xe7, 0xb1, 0x1b, 0x1c, 0xc8, 0xda, 0x28, 0x7d, 0xdd, 0x65, 0x6f, 0x62, 0x9a, 0xbb,
-	0x87, 0xba, 0x6e, 0xd3, 0xca, 0xd9, 0x5e, 0x9a, 0x0e, 0x60, 0xf9, 0x2a, 0xc7, 0x6e, 0xb2, 0xb7,
-	0xf4, 0x97, 0x81, 0x8e, 0xac, 0xb7, 0xea, 0x88, 0xdf, 0x06, 0xc5, 0xa1, 0xbc, 0x29, 0xd0, 0x23,
-	0x4e, 0x79, 0x81, 0x6d, 0xd6, 0x2f, 0x59, 0x05, 0x8b, 0xdd, 0x50, 0xec, 0x75, 0x4b, 0xfe, 0x43,
-	0x00, 0x33, 0x0c, 0xa0, 0xa1, 0xa2, 0xe1, 0x10, 0x64, 0x23, 0x7d, 0xb3, 0x54, 0xc2, 0x47, 0x48,
-	0xdf, 0xc5, 0xb8, 0xd4, 0x4b, 0x2b, 0x3e, 0x03, 0x43, 0x34, 0xe3, 0x9c, 0xa1, 0xbb, 0xcd, 0x18,
-	0xc8, 0x88, 0xb5, 0xaa, 0x34, 0xce, 0x6c, 0xf9, 0x82, 0xac, 0x0d, 0xd2, 0xa7, 0x6d, 0x3d, 0xfd,
-	0x20, 0x50, 0x74, 0xb2, 0x55, 0xd1, 0xb6, 0x9f, 0x96, 0x02, 0x59, 0x5e, 0x0a, 0x35, 0x91, 0x6f,
-	0x81, 0xb9, 0x36, 0x79, 0xfb, 0xf5, 0xfd, 0xdb, 0x0f, 0xa2, 0x67, 0xb7, 0xed, 0xe7, 0x60, 0xd0,
-	0xa5, 0xeb, 0x1e, 0xaf, 0xea, 0x56, 0xad, 0x2a, 0x49, 0x0d, 0xb2, 0xb9, 0x27, 0x2f, 0xea, 0xa8,
-	0x6c, 0xa3, 0x02, 0x24, 0x48, 0x4f, 0xcb, 0xc4, 0xae, 0x20, 0x39, 0x26, 0x68, 0x1c, 0xe4, 0xc3,
-	0x53, 0x5c, 0x75, 0x41, 0x78, 0xaa, 0x1d, 0x3c, 0x25, 0x3e, 0x07, 0x23, 0xf5, 0xdd, 0x1f, 0x69,
-	0x9a, 0x55, 0x1d, 0x84, 0xe8, 0x1d, 0xbd, 0xf5, 0x1d, 0x3e, 0x4c, 0xea, 0x35, 0x35, 0xdd, 0x07,
-	0xb9, 0x04, 0xbb, 0xbe, 0x3e, 0x3e, 0x04, 0xcd, 0xa7, 0x44, 0xec, 0x4a, 0x8f, 0xc7, 0xca, 0xd2,
-	0xdb, 0x61, 0x10, 0xc9, 0x3a, 0x45, 0xf1, 0x3b, 0x01, 0x44, 0xcf, 0xde, 0xae, 0x53, 0x6d, 0xe7,
-	0x5b, 0xd8, 0xef, 0x83, 0xf8, 0x5a, 0xcf, 0x10, 0x7f, 0x08, 0xbd, 0x16, 0x80, 0x18, 0x72, 0x78,
-	0x2f, 0xf5, 0xe8, 0x71, 0xa7, 0x42, 0xe2, 0xe9, 0xde, 0x31, 0x7e, 0x1a, 0xbf, 0x09, 0x60, 0xa6,
-	0xdd, 0x4f, 0x8e, 0xf5, 0x8e, 0xbe, 0x5b, 0x83, 0xe3, 0x8f, 0x2e, 0x00, 0xf6, 0x33, 0xfc, 0x5d,
-	0x00, 0x37, 0xdb, 0xde, 0x77, 0x36, 0xce, 0x1d, 0x85, 0x92, 0xb7, 0x75, 0x11, 0xb4, 0x9f, 0xe4,
-	0xb1, 0x00, 0x26, 0x43, 0x8f, 0xdf, 0x95, 0x8e, 0xee, 0x43, 0x50, 0xf1, 0x8d, 0xf3, 0xa0, 0xfc,
-	0x64, 0xde, 0x0a, 0xe0, 0x93, 0xce, 0x47, 0xd8, 0x66, 0x17, 0x31, 0xda, 0xbb, 0x88, 0x6f, 0x5f,
-	0xd8, 0x85, 0x9f, 0xf3, 0xaf, 0x02, 0x88, 0xb5, 0x1c, 0xf1, 0xab, 0x5d, 0xc4, 0x09, 0x45, 0xc6,
-	0x1f, 0x9e, 0x17, 0xe9, 0x25, 0x96, 0x79, 0xfa, 0xee, 0x24, 0x21, 0xbc, 0x3f, 0x49, 0x08, 0x7f,
-	0x9f, 0x24, 0x84, 0x5f, 0x4e, 0x13, 0x7d, 0xef, 0x4f, 0x13, 0x7d, 0x7f, 0x9e, 0x26, 0xfa, 0xbe,
-	0xba, 0x5f, 0x34, 0xc8, 0x5e, 0x25, 0xaf, 0x16, 0xb0, 0xe9, 0x9d, 0x0d, 0x4a, 0x09, 0xe6, 0x1d,
-	0xff, 0xa0, 0x38, 0x58, 0x5a, 0x4b, 0x1e, 0x36, 0x1d, 0x17, 0xe4, 0xa8, 0x8c, 0x9c, 0xfc, 0xa0,
-	0xfb, 0xf7, 0x9f, 0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x7f, 0x8a, 0x8d, 0xd4, 0x12,
-	0x00, 0x00,
+func (m *PoolTakerFee) Reset()         { *m = PoolTakerFee{} }
+func (m *PoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*PoolTakerFee) ProtoMessage()    {}
+func (*PoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
-	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
-	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
-	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
-	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
-	SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
-	SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegisteredAlloyedPool, opts ...grpc.CallOption) (*MsgSetRegisteredAlloyedPoolResponse, error)
+func (m *PoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *PoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTakerFee.Merge(m, src)
+}
+func (m *PoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTakerFee.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_PoolTakerFee proto.InternalMessageInfo
+
+func (m *PoolTakerFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (c *msgClient) SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error) {
-	out := new(MsgSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PoolTakerFee) GetUnset() bool {
+	if m != nil {
+		return m.Unset
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error) {
-	out := new(MsgSwapExactAmountOutResponse)
+// TakerFeeDiscountTier reduces the taker fee its members are charged by a
+// percentage, e.g. for market makers or protocol contracts, or for the senders
+// whose volume reaches min_volume. A sender in several tiers gets the largest
+// discount.
+type TakerFeeDiscountTier struct {
+	// name identifies the tier.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// discount is the share of the taker fee waived for the members, in (0, 1].
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+	// members are the addresses the discount applies to.
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty" yaml:"members"`
+	// unset removes the tier of the name. discount and members are ignored.
+	Unset bool `protobuf:"varint,4,opt,name=unset,proto3" json:"unset,omitempty" yaml:"unset"`
+	// min_volume, if positive, also applies the discount to the senders whose
+	// tracked volume during the previous window is at least min_volume, in the
+	// quote denom of the account volume config.
+	MinVolume *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_volume,json=minVolume,proto3,customtype=cosmossdk.io/math.Int" json:"min_volume,omitempty" yaml:"min_volume"`
+}
+
+func (m *TakerFeeDiscountTier) Reset()         { *m = TakerFeeDiscountTier{} }
+func (m *TakerFeeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountTier) ProtoMessage()    {}
+func (*TakerFeeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{22}
+}
+func (m *TakerFeeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountTier.Merge(m, src)
+}
+func (m *TakerFeeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountTier proto.InternalMessageInfo
+
+func (m *TakerFeeDiscountTier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TakerFeeDiscountTier) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *TakerFeeDiscountTier) GetUnset() bool {
+	if m != nil {
+		return m.Unset
+	}
+	return false
+}
+
+// AccountVolumeConfig configures the tracking of the trading volume of the
+// accounts swapping through the poolmanager.
+type AccountVolumeConfig struct {
+	// quote_denom is the denom volume is denominated in. Volume is not tracked
+	// while it is empty.
+	QuoteDenom string `protobuf:"bytes,1,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// window_duration is the length of the windows volume is accumulated over.
+	WindowDuration time.Duration `protobuf:"bytes,2,opt,name=window_duration,json=windowDuration,proto3,stdduration" json:"window_duration" yaml:"window_duration"`
+	// retained_windows is the number of most recent windows kept per account.
+	RetainedWindows uint64 `protobuf:"varint,3,opt,name=retained_windows,json=retainedWindows,proto3" json:"retained_windows,omitempty" yaml:"retained_windows"`
+	// twap_duration is how far back the TWAP volume is priced at goes. Volume is
+	// never priced at the spot price: a denom without a TWAP is not valued.
+	TwapDuration time.Duration `protobuf:"bytes,4,opt,name=twap_duration,json=twapDuration,proto3,stdduration" json:"twap_duration" yaml:"twap_duration"`
+	// price_pools are the pools denoms are priced in quote_denom with. Volume in
+	// a denom without a price pool is not tracked, unless the other side of the
+	// swap can be priced.
+	PricePools []DenomPricePool `protobuf:"bytes,5,rep,name=price_pools,json=pricePools,proto3" json:"price_pools" yaml:"price_pools"`
+}
+
+func (m *AccountVolumeConfig) Reset()         { *m = AccountVolumeConfig{} }
+func (m *AccountVolumeConfig) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeConfig) ProtoMessage()    {}
+func (*AccountVolumeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{23}
+}
+func (m *AccountVolumeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVolumeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVolumeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVolumeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeConfig.Merge(m, src)
+}
+func (m *AccountVolumeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVolumeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountVolumeConfig proto.InternalMessageInfo
+
+func (m *AccountVolumeConfig) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *AccountVolumeConfig) GetWindowDuration() time.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return 0
+}
+
+func (m *AccountVolumeConfig) GetRetainedWindows() uint64 {
+	if m != nil {
+		return m.RetainedWindows
+	}
+	return 0
+}
+
+func (m *AccountVolumeConfig) GetTwapDuration() time.Duration {
+	if m != nil {
+		return m.TwapDuration
+	}
+	return 0
+}
+
+func (m *AccountVolumeConfig) GetPricePools() []DenomPricePool {
+	if m != nil {
+		return m.PricePools
+	}
+	return nil
+}
+
+// DenomPricePool is the pool a denom is priced with in another denom, such as
+// the volume quote denom or the base denom.
+type DenomPricePool struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *DenomPricePool) Reset()         { *m = DenomPricePool{} }
+func (m *DenomPricePool) String() string { return proto.CompactTextString(m) }
+func (*DenomPricePool) ProtoMessage()    {}
+func (*DenomPricePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{24}
+}
+func (m *DenomPricePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPricePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPricePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPricePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPricePool.Merge(m, src)
+}
+func (m *DenomPricePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPricePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPricePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPricePool proto.InternalMessageInfo
+
+func (m *DenomPricePool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomPricePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// AccountVolume is the volume an account swapped during a window, in the quote
+// denom of the account volume config.
+type AccountVolume struct {
+	Address     string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	WindowStart time.Time             `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+	Volume      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume" yaml:"volume"`
+}
+
+func (m *AccountVolume) Reset()         { *m = AccountVolume{} }
+func (m *AccountVolume) String() string { return proto.CompactTextString(m) }
+func (*AccountVolume) ProtoMessage()    {}
+func (*AccountVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{25}
+}
+func (m *AccountVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolume.Merge(m, src)
+}
+func (m *AccountVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountVolume proto.InternalMessageInfo
+
+func (m *AccountVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountVolume) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
+	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOut")
+	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
+	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
+	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenom)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenom")
+	proto.RegisterType((*MsgSetTakerFeeShareAgreementForDenomResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeShareAgreementForDenomResponse")
+	proto.RegisterType((*MsgSetRegisteredAlloyedPool)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPool")
+	proto.RegisterType((*MsgSetRegisteredAlloyedPoolResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetRegisteredAlloyedPoolResponse")
+	proto.RegisterType((*MsgSetPoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetPoolTakerFee")
+	proto.RegisterType((*MsgSetPoolTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetPoolTakerFeeResponse")
+	proto.RegisterType((*MsgSetTakerFeeDiscountTiers)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeDiscountTiers")
+	proto.RegisterType((*MsgSetTakerFeeDiscountTiersResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetTakerFeeDiscountTiersResponse")
+	proto.RegisterType((*MsgSetAccountVolumeConfig)(nil), "osmosis.poolmanager.v1beta1.MsgSetAccountVolumeConfig")
+	proto.RegisterType((*MsgSetAccountVolumeConfigResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetAccountVolumeConfigResponse")
+	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*PoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.PoolTakerFee")
+	proto.RegisterType((*TakerFeeDiscountTier)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountTier")
+	proto.RegisterType((*AccountVolumeConfig)(nil), "osmosis.poolmanager.v1beta1.AccountVolumeConfig")
+	proto.RegisterType((*DenomPricePool)(nil), "osmosis.poolmanager.v1beta1.DenomPricePool")
+	proto.RegisterType((*AccountVolume)(nil), "osmosis.poolmanager.v1beta1.AccountVolume")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/tx.proto", fileDescriptor_acd130b4825d67dc)
+}
+
+var fileDescriptor_acd130b4825d67dc = []byte{
+	// 2102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0xa4, 0x2c, 0x3d, 0xea, 0x73, 0x4d, 0x5b, 0x34, 0xe9, 0x72, 0x95, 0x91, 0xe3,
+	0xca, 0x89, 0x49, 0x5a, 0xb2, 0x63, 0x3b, 0xb4, 0x93, 0x58, 0xb4, 0x6a, 0x40, 0x68, 0x04, 0x29,
+	0x2b, 0xa3, 0x05, 0x0a, 0xa4, 0xec, 0x8a, 0x3b, 0xa2, 0xb6, 0xe6, 0xee, 0x32, 0xbb, 0x43, 0x9b,
+	0x6a, 0x2f, 0x6d, 0x90, 0x4b, 0x8d, 0x1e, 0x7c, 0x2a, 0x7a, 0x2b, 0xd0, 0xbf, 0x20, 0xbd, 0xf4,
+	0x13, 0xe8, 0xd9, 0xe8, 0x29, 0xc7, 0xc2, 0x07, 0xb6, 0xb5, 0xd1, 0x06, 0xed, 0x91, 0x7f, 0x41,
+	0x31, 0x1f, 0xbb, 0x5c, 0xae, 0x96, 0x5f, 0x72, 0x1b, 0xe4, 0xe0, 0x8b, 0xb4, 0x3b, 0xf3, 0x7e,
+	0xef, 0xbd, 0x79, 0xef, 0xfd, 0x66, 0xe6, 0x2d, 0xe1, 0xa2, 0xed, 0x9a, 0xb6, 0x6b, 0xb8, 0xc5,
+	0x86, 0x6d, 0xd7, 0x4d, 0xcd, 0xd2, 0x6a, 0xd8, 0x29, 0x3e, 0x5a, 0xdb, 0xc7, 0x44, 0x5b, 0x2b,
+	0x92, 0x56, 0xa1, 0xe1, 0xd8, 0xc4, 0x96, 0xb3, 0x42, 0xaa, 0x10, 0x90, 0x2a, 0x08, 0xa9, 0x4c,
+	0xaa, 0x66, 0xd7, 0x6c, 0x26, 0x57, 0xa4, 0x4f, 0x1c, 0x92, 0x59, 0xd4, 0x4c, 0xc3, 0xb2, 0x8b,
+	0xec, 0xaf, 0x18, 0xca, 0x55, 0x99, 0x9a, 0xe2, 0xbe, 0xe6, 0x62, 0xdf, 0x46, 0xd5, 0x36, 0x2c,
+	0x31, 0x7f, 0x65, 0x90, 0x2f, 0xee, 0x63, 0xad, 0x51, 0x71, 0xec, 0x26, 0xc1, 0x42, 0x7a, 0x49,
+	0x68, 0x33, 0xdd, 0x5a, 0xf1, 0xd1, 0x1a, 0xfd, 0x27, 0x26, 0x94, 0x9a, 0x6d, 0xd7, 0xea, 0xb8,
+	0xc8, 0xde, 0xf6, 0x9b, 0x07, 0x45, 0x62, 0x98, 0xd8, 0x25, 0x9a, 0xd9, 0xf0, 0xfc, 0x08, 0x0b,
+	0xe8, 0x4d, 0x47, 0x23, 0x86, 0x2d, 0xfc, 0x40, 0x7f, 0x4a, 0x40, 0x6a, 0xdb, 0xad, 0xed, 0x3d,
+	0xd6, 0x1a, 0xdf, 0x6a, 0x69, 0x55, 0xb2, 0x61, 0xda, 0x4d, 0x8b, 0x6c, 0x59, 0xf2, 0x65, 0x98,
+	0x74, 0xb1, 0xa5, 0x63, 0x27, 0x2d, 0x2d, 0x4b, 0xab, 0xd3, 0xe5, 0xc5, 0x4e, 0x5b, 0x99, 0x3d,
+	0xd2, 0xcc, 0x7a, 0x09, 0xf1, 0x71, 0xa4, 0x0a, 0x01, 0xf9, 0x43, 0x98, 0x64, 0xce, 0xba, 0xe9,
+	0x89, 0xe5, 0xd8, 0x6a, 0x72, 0xbd, 0x50, 0x18, 0x10, 0xc2, 0x02, 0x35, 0xe5, 0x59, 0x51, 0x29,
+	0xac, 0x1c, 0x7f, 0xd6, 0x56, 0x4e, 0xa9, 0x42, 0x87, 0xbc, 0x0d, 0x53, 0xc4, 0x7e, 0x88, 0xad,
+	0x8a, 0x61, 0xa5, 0x63, 0xcb, 0xd2, 0x6a, 0x72, 0xfd, 0x7c, 0x81, 0x2f, 0xbf, 0x40, 0x83, 0xe9,
+	0xeb, 0xb9, 0x67, 0x1b, 0x56, 0x79, 0x89, 0x42, 0x3b, 0x6d, 0x65, 0x9e, 0x7b, 0xe6, 0x01, 0x91,
+	0x7a, 0x9a, 0x3d, 0x6e, 0x59, 0xb2, 0x09, 0x29, 0x3e, 0x6a, 0x37, 0x49, 0xc5, 0x34, 0xac, 0x8a,
+	0xc6, 0x6c, 0xa7, 0xe3, 0x6c, 0x55, 0x77, 0x28, 0xfe, 0x79, 0x5b, 0x39, 0xcb, 0x2d, 0xb8, 0xfa,
+	0xc3, 0x82, 0x61, 0x17, 0x4d, 0x8d, 0x1c, 0x16, 0xb6, 0x2c, 0xd2, 0x69, 0x2b, 0xd9, 0xa0, 0xe2,
+	0x5e, 0x15, 0x48, 0x5d, 0x64, 0xc3, 0x3b, 0x4d, 0xb2, 0x6d, 0x58, 0x7c, 0x49, 0xf2, 0x1e, 0x4c,
+	0xe9, 0x58, 0xd3, 0xeb, 0x86, 0x85, 0xd3, 0x09, 0xe6, 0x7d, 0xa6, 0xc0, 0x53, 0x50, 0xf0, 0x52,
+	0x50, 0x78, 0xe0, 0xe5, 0xa8, 0x9c, 0x7d, 0xd6, 0x56, 0xa4, 0xae, 0xfb, 0x1e, 0x12, 0x3d, 0xfd,
+	0x9b, 0x22, 0xa9, 0xbe, 0x22, 0xf9, 0x10, 0x16, 0x4c, 0xad, 0x55, 0x69, 0x38, 0x46, 0x15, 0x57,
+	0x0c, 0xb3, 0xa1, 0x55, 0x49, 0x7a, 0x92, 0xf9, 0xff, 0x3e, 0x55, 0xf0, 0xbc, 0xad, 0x64, 0x8f,
+	0xfb, 0xff, 0x21, 0xae, 0x69, 0xd5, 0xa3, 0x4d, 0x5c, 0xed, 0xb4, 0x95, 0x25, 0xae, 0x3f, 0xac,
+	0x04, 0xa9, 0x73, 0xa6, 0xd6, 0xda, 0xa5, 0x23, 0x5b, 0x6c, 0x40, 0x76, 0x21, 0x45, 0x85, 0xdc,
+	0x86, 0x4d, 0x84, 0xa4, 0xee, 0x18, 0x07, 0x24, 0x7d, 0x9a, 0x59, 0x2b, 0x8f, 0x66, 0x2d, 0xdb,
+	0xb5, 0x16, 0x56, 0x84, 0xd4, 0x45, 0x53, 0x6b, 0xed, 0x35, 0x6c, 0xc2, 0xac, 0x6e, 0xd2, 0xb1,
+	0xd2, 0xad, 0x4f, 0xbf, 0xfc, 0xfc, 0x2d, 0x51, 0x4c, 0x4f, 0xbe, 0xfc, 0xfc, 0xad, 0xd5, 0x28,
+	0x6e, 0x50, 0x4e, 0xe4, 0x31, 0x2d, 0xd1, 0x3c, 0x0f, 0x7f, 0xde, 0xb0, 0xd0, 0xa7, 0x12, 0x5c,
+	0x88, 0xaa, 0x5e, 0x15, 0xbb, 0x0d, 0xdb, 0x72, 0xb1, 0xbc, 0x0f, 0x0b, 0xdd, 0xd4, 0x89, 0xcc,
+	0xf3, 0x7a, 0xbe, 0x35, 0x2c, 0xf3, 0x4b, 0xe1, 0xcc, 0x7b, 0x59, 0x9f, 0xf3, 0xb2, 0xce, 0xad,
+	0xa1, 0xdf, 0x25, 0x20, 0x47, 0x9d, 0x68, 0xd4, 0x0d, 0xc2, 0x0a, 0xfa, 0x95, 0xc8, 0xf4, 0x51,
+	0x88, 0x4c, 0xd7, 0x46, 0x26, 0x53, 0xd7, 0x81, 0x10, 0xa3, 0x3e, 0x80, 0x39, 0x8f, 0x18, 0x15,
+	0x1d, 0x5b, 0xb6, 0xc9, 0x78, 0x35, 0x5d, 0x3e, 0xdf, 0x69, 0x2b, 0x67, 0x7b, 0x89, 0xc3, 0xe7,
+	0x91, 0x3a, 0x23, 0xe8, 0xb3, 0x49, 0x5f, 0x5f, 0x73, 0xe8, 0xeb, 0xcf, 0xa1, 0x6b, 0x21, 0x0e,
+	0xad, 0x44, 0x72, 0x88, 0x56, 0x48, 0x80, 0x3e, 0x3f, 0x97, 0xe0, 0xd2, 0xe0, 0xca, 0xfd, 0x4a,
+	0x89, 0xf4, 0xe7, 0x04, 0x9c, 0x3d, 0xce, 0xe6, 0x9d, 0x26, 0x19, 0x87, 0x3f, 0xdb, 0x21, 0xfe,
+	0x14, 0x47, 0xe4, 0xcf, 0x4e, 0x33, 0x92, 0x3b, 0x3f, 0x84, 0x33, 0x3e, 0x37, 0x68, 0x32, 0xc4,
+	0xd2, 0x39, 0x81, 0x6e, 0x0f, 0x5b, 0x7a, 0x26, 0xc4, 0xae, 0xae, 0x06, 0xa4, 0x2e, 0x08, 0x8a,
+	0x6d, 0x6b, 0x2d, 0x51, 0xf7, 0xbb, 0x30, 0xed, 0x07, 0x29, 0x1d, 0x1f, 0x76, 0xf4, 0xa5, 0xc5,
+	0xd1, 0xb7, 0x10, 0x0a, 0x2f, 0x52, 0xa7, 0xbc, 0xb8, 0xbe, 0x66, 0xd2, 0x09, 0x98, 0xf4, 0x6e,
+	0x88, 0x49, 0x97, 0x47, 0x3b, 0x8d, 0x68, 0xe4, 0x7f, 0x22, 0xc1, 0x37, 0x22, 0x0b, 0xd8, 0xa7,
+	0x51, 0x05, 0xe6, 0xfd, 0x62, 0xe8, 0x61, 0xd1, 0xcd, 0x61, 0xa5, 0x74, 0x2e, 0x54, 0x4a, 0x5e,
+	0x19, 0xcd, 0x8a, 0x32, 0x12, 0x1c, 0xfa, 0x63, 0x02, 0x94, 0x41, 0x94, 0x1e, 0x93, 0x4d, 0x6a,
+	0x88, 0x4d, 0xd7, 0x47, 0x67, 0x53, 0xdf, 0xe3, 0xa8, 0x0c, 0xf3, 0xdd, 0xbd, 0x20, 0x78, 0x1e,
+	0x65, 0xc2, 0xcb, 0xf4, 0x05, 0xbc, 0x65, 0xee, 0x34, 0x09, 0x3f, 0x91, 0xfa, 0xd0, 0x32, 0xfe,
+	0xff, 0xa0, 0xe5, 0x6b, 0x12, 0x8d, 0x4f, 0xa2, 0xeb, 0x21, 0x12, 0x5d, 0x1c, 0x7a, 0x1c, 0x51,
+	0xfe, 0x3c, 0x91, 0xe0, 0x9b, 0x43, 0x8a, 0xf7, 0xab, 0x63, 0xd2, 0xcf, 0x26, 0x60, 0x89, 0x3a,
+	0x83, 0x79, 0xc9, 0xed, 0x6a, 0x86, 0xf3, 0x40, 0x7b, 0x88, 0x9d, 0xfb, 0x18, 0x8f, 0xc3, 0xa0,
+	0xcf, 0x24, 0x48, 0xb1, 0x1a, 0xae, 0x34, 0x34, 0xc3, 0xa9, 0x10, 0xaa, 0xa2, 0x72, 0x80, 0xf1,
+	0x48, 0xbd, 0xd2, 0x31, 0xcb, 0xe5, 0x15, 0xb1, 0xeb, 0x67, 0xbd, 0xf2, 0x3a, 0xae, 0x19, 0xa9,
+	0x8b, 0x7a, 0x18, 0x57, 0xba, 0x13, 0x4a, 0x48, 0x64, 0xff, 0xe9, 0x62, 0x92, 0x67, 0xd0, 0x3c,
+	0xd5, 0x98, 0x67, 0x1a, 0xf3, 0x54, 0xe3, 0x6d, 0x50, 0xfa, 0x84, 0xc2, 0xcf, 0x47, 0x1a, 0x4e,
+	0xbb, 0xcd, 0x6a, 0x15, 0xbb, 0x2e, 0x8b, 0xc9, 0x94, 0xea, 0xbd, 0xa2, 0x7f, 0x4c, 0xc0, 0x45,
+	0x8e, 0xf6, 0x40, 0x7b, 0x87, 0x9a, 0x83, 0x37, 0x6a, 0x0e, 0xc6, 0x26, 0xb6, 0xc8, 0x7d, 0xdb,
+	0xe1, 0xa4, 0x1e, 0x23, 0xaa, 0x97, 0x20, 0xc1, 0x77, 0x8e, 0x09, 0x26, 0xb9, 0xd0, 0x69, 0x2b,
+	0x33, 0x81, 0x88, 0x20, 0x95, 0x4f, 0xcb, 0x1f, 0xc3, 0x8c, 0xfb, 0xd0, 0x30, 0x2b, 0x0d, 0xec,
+	0x54, 0xb1, 0x7f, 0x6e, 0x97, 0x44, 0x89, 0x0c, 0x29, 0xfa, 0x33, 0xc2, 0x76, 0x40, 0x01, 0x52,
+	0x93, 0xf4, 0x75, 0x97, 0xbf, 0xc9, 0x25, 0xa1, 0x5e, 0xd3, 0x75, 0x87, 0xae, 0x9c, 0xef, 0x3f,
+	0x4b, 0x21, 0xac, 0x98, 0x15, 0xd8, 0x0d, 0xfe, 0x56, 0xfa, 0x76, 0x28, 0x23, 0xb7, 0xfb, 0x65,
+	0xc4, 0x4f, 0x43, 0xde, 0xa5, 0x71, 0xcb, 0x6b, 0x5e, 0xe0, 0xf2, 0x07, 0xb6, 0xc3, 0xf3, 0x85,
+	0x0a, 0x70, 0x65, 0x94, 0x10, 0x7b, 0xd9, 0x42, 0xbf, 0x95, 0x20, 0xcb, 0x01, 0x2a, 0xae, 0x19,
+	0x2e, 0xc1, 0x0e, 0xd6, 0x37, 0xea, 0x75, 0xfb, 0x08, 0xeb, 0xbb, 0xb6, 0x5d, 0x1f, 0x27, 0x15,
+	0x6f, 0xc3, 0x69, 0xea, 0x71, 0xc5, 0xd0, 0x59, 0x32, 0xe2, 0x65, 0xb9, 0xd3, 0x56, 0xe6, 0xb8,
+	0xac, 0x98, 0x40, 0xea, 0x24, 0x7d, 0xda, 0xd2, 0x4b, 0x1f, 0x84, 0x16, 0x5d, 0xec, 0xb7, 0x68,
+	0xc7, 0x77, 0x2b, 0xaf, 0x71, 0xbf, 0xf2, 0x54, 0x04, 0xbd, 0x09, 0x2b, 0x03, 0xfc, 0xf6, 0xd7,
+	0xf7, 0x1f, 0x09, 0x64, 0x2e, 0x47, 0x87, 0x4f, 0xc2, 0xdb, 0x4f, 0x60, 0x9e, 0x79, 0xef, 0xd3,
+	0xca, 0x3b, 0x02, 0x2f, 0x0f, 0x64, 0x6c, 0xd0, 0x5c, 0x39, 0x27, 0xc8, 0x7a, 0x2e, 0x10, 0x8d,
+	0xae, 0x3e, 0xa4, 0xce, 0x36, 0x02, 0xd2, 0x6e, 0xe9, 0x46, 0x28, 0x38, 0x97, 0xfa, 0x05, 0x87,
+	0xbe, 0x07, 0xd8, 0x79, 0x01, 0x32, 0xc7, 0xd7, 0xea, 0x87, 0xe2, 0xdf, 0x7e, 0xaa, 0xbd, 0xa9,
+	0x4d, 0xc3, 0xad, 0xd2, 0x2d, 0xee, 0x81, 0x81, 0x1d, 0x77, 0x9c, 0x98, 0x7c, 0x0c, 0x09, 0x42,
+	0x31, 0x22, 0x12, 0x6b, 0x03, 0x23, 0x11, 0x65, 0xad, 0x9c, 0x12, 0x11, 0x11, 0x64, 0x65, 0xda,
+	0x90, 0xca, 0xb5, 0x96, 0xee, 0x86, 0xd6, 0x7f, 0x75, 0x38, 0x23, 0x74, 0xa1, 0x3c, 0xcf, 0x35,
+	0xf9, 0xd5, 0x11, 0xb9, 0x54, 0x3f, 0x24, 0xff, 0x92, 0xe0, 0x3c, 0x97, 0xdb, 0xa8, 0xb2, 0xe9,
+	0xef, 0xd8, 0xf5, 0xa6, 0x89, 0xef, 0xd9, 0xd6, 0x81, 0x51, 0x1b, 0x27, 0x20, 0x15, 0x98, 0xac,
+	0x32, 0x10, 0x2b, 0xfd, 0xe4, 0xfa, 0xd5, 0x81, 0x11, 0x89, 0x30, 0x56, 0x3e, 0x2b, 0x02, 0x22,
+	0x0c, 0x70, 0x6d, 0x48, 0x15, 0x6a, 0x4b, 0xef, 0x85, 0x42, 0x92, 0xef, 0x17, 0x12, 0x8d, 0xeb,
+	0xce, 0x3f, 0x62, 0xca, 0xf3, 0x42, 0xcf, 0x0a, 0xbc, 0xd1, 0x77, 0x9d, 0x7e, 0x34, 0x9e, 0xc6,
+	0x60, 0xf1, 0xf8, 0x11, 0xf7, 0x1e, 0x4c, 0xb2, 0xad, 0xe5, 0xaa, 0x88, 0xc2, 0x9b, 0x9d, 0xb6,
+	0xa2, 0x04, 0xb6, 0xd8, 0xab, 0xe8, 0x8a, 0x8e, 0x1b, 0x0e, 0xae, 0x6a, 0x04, 0xeb, 0x25, 0x44,
+	0x9c, 0x26, 0x46, 0x69, 0x49, 0x15, 0x20, 0x1f, 0xbe, 0x96, 0x9e, 0x88, 0x84, 0xaf, 0x0d, 0x82,
+	0xaf, 0xc9, 0x0f, 0x60, 0xba, 0x7b, 0x52, 0xc6, 0x7a, 0xce, 0xf5, 0x21, 0x9b, 0xb6, 0xd7, 0x0e,
+	0x75, 0x4f, 0xc3, 0x29, 0xd2, 0x5d, 0x53, 0xcf, 0x77, 0x8d, 0x74, 0x7c, 0xbc, 0xcf, 0x20, 0x77,
+	0xa1, 0xf7, 0x16, 0x9a, 0x4e, 0x8c, 0x7b, 0x6d, 0xbd, 0x04, 0x89, 0xa6, 0xe5, 0x62, 0x7e, 0xd5,
+	0x9b, 0x0a, 0x1e, 0x5b, 0x6c, 0x18, 0xa9, 0x7c, 0x1a, 0xfd, 0x41, 0x82, 0x99, 0x9e, 0x8d, 0x2b,
+	0xb0, 0xc9, 0x4a, 0xc3, 0x36, 0xd9, 0xde, 0xe0, 0x4d, 0xfc, 0xaf, 0x82, 0xe7, 0xfb, 0x1e, 0x1b,
+	0xec, 0xfb, 0xef, 0x27, 0x20, 0x15, 0x45, 0x3f, 0x79, 0x05, 0xe2, 0x96, 0x66, 0x62, 0x51, 0x4f,
+	0xf3, 0x9d, 0xb6, 0x92, 0xe4, 0x78, 0x3a, 0x8a, 0x54, 0x36, 0x29, 0xab, 0x30, 0xe5, 0x71, 0x5a,
+	0xb8, 0x7e, 0x63, 0x34, 0xd7, 0xbd, 0xfb, 0xb6, 0x00, 0x23, 0xd5, 0xd7, 0x23, 0x5f, 0x81, 0xd3,
+	0x26, 0x36, 0xf7, 0xe9, 0xc6, 0x15, 0x5b, 0x8e, 0xad, 0x4e, 0x07, 0x83, 0x27, 0x26, 0x90, 0xea,
+	0x89, 0x74, 0xd7, 0x19, 0x1f, 0xb8, 0x4e, 0xf9, 0x23, 0x00, 0xfa, 0x1d, 0x8b, 0x13, 0x4e, 0x94,
+	0xc2, 0xba, 0xb8, 0x4d, 0xf7, 0xbd, 0x7b, 0x2e, 0x0a, 0xab, 0x3e, 0x10, 0xa9, 0xd3, 0xa6, 0x61,
+	0x71, 0x5e, 0xa2, 0xbf, 0xc4, 0xe0, 0x4c, 0xd4, 0x8e, 0x74, 0x13, 0x92, 0x9f, 0x34, 0x6d, 0x82,
+	0x45, 0xb7, 0xc4, 0x03, 0x78, 0xae, 0xd3, 0x56, 0x64, 0xae, 0x2e, 0x30, 0x89, 0x54, 0x60, 0x6f,
+	0xbc, 0xde, 0x0e, 0x60, 0xfe, 0xb1, 0x61, 0xe9, 0xf6, 0xe3, 0x8a, 0xf7, 0xd9, 0x5f, 0x6c, 0x54,
+	0xe7, 0x8f, 0x75, 0x30, 0x9b, 0x42, 0xa0, 0x8c, 0x7a, 0x0f, 0xad, 0x10, 0x1e, 0xfd, 0x92, 0xf6,
+	0x31, 0x73, 0x7c, 0xd4, 0xc3, 0xc8, 0xf7, 0x61, 0xc1, 0xc1, 0x44, 0x33, 0x2c, 0xac, 0x57, 0xf8,
+	0x94, 0xcb, 0xca, 0x24, 0x5e, 0xce, 0x76, 0x5b, 0x95, 0xb0, 0x04, 0x52, 0xe7, 0xbd, 0xa1, 0xef,
+	0xf2, 0x11, 0xf9, 0x07, 0x30, 0x4b, 0xe8, 0x6f, 0x1f, 0xbe, 0xb7, 0xf1, 0x61, 0xde, 0x2e, 0x0b,
+	0x6f, 0x53, 0xa2, 0x72, 0x83, 0x68, 0xee, 0xeb, 0x0c, 0x1d, 0xf3, 0x3d, 0x3d, 0x84, 0x24, 0xef,
+	0x5d, 0x28, 0x57, 0xdc, 0x74, 0x82, 0x1d, 0x64, 0x6f, 0x8f, 0x70, 0x09, 0xa7, 0x20, 0x4a, 0xc9,
+	0x72, 0x46, 0x58, 0x14, 0xb1, 0x0f, 0x68, 0x43, 0x2a, 0x34, 0x3c, 0x31, 0x17, 0x61, 0x98, 0xeb,
+	0x45, 0x76, 0x2f, 0xad, 0xd2, 0xe0, 0x4b, 0xeb, 0x38, 0x37, 0x2a, 0xf4, 0x4f, 0x09, 0x66, 0x7b,
+	0x6a, 0x86, 0x96, 0xbb, 0x77, 0x1f, 0xe5, 0x86, 0x02, 0x70, 0xff, 0x2a, 0xea, 0x89, 0xc8, 0xdf,
+	0x87, 0x19, 0x91, 0x62, 0x97, 0x68, 0x0e, 0x49, 0x4f, 0x0c, 0xed, 0x70, 0x15, 0x11, 0x80, 0x33,
+	0x3d, 0x05, 0xc2, 0xd0, 0xbc, 0xcb, 0x4d, 0xf2, 0xa1, 0x3d, 0x3a, 0x22, 0xdf, 0x87, 0x49, 0x41,
+	0x11, 0xbe, 0x8d, 0x17, 0x86, 0xb5, 0x67, 0xe2, 0x24, 0xf4, 0xe8, 0x21, 0xd0, 0xeb, 0xcf, 0x93,
+	0x10, 0xdb, 0x76, 0x6b, 0xf2, 0x4f, 0x25, 0x58, 0x3c, 0xfe, 0x81, 0x7d, 0xf0, 0x55, 0x24, 0xea,
+	0x27, 0x82, 0xcc, 0xbb, 0x63, 0x43, 0xfc, 0x5e, 0xe7, 0x33, 0x09, 0xe4, 0x88, 0xef, 0x2a, 0xeb,
+	0x63, 0x6a, 0xdc, 0x69, 0x92, 0x4c, 0x69, 0x7c, 0x8c, 0xef, 0xc6, 0xaf, 0x24, 0xc8, 0x0e, 0xfa,
+	0xd5, 0xe1, 0xf6, 0x50, 0xdd, 0xfd, 0xc1, 0x99, 0x7b, 0xaf, 0x00, 0xf6, 0x3d, 0xfc, 0xb5, 0x04,
+	0x17, 0x06, 0x7e, 0x8a, 0xba, 0x73, 0x62, 0x2b, 0x34, 0x78, 0x9b, 0xaf, 0x82, 0xf6, 0x9d, 0x7c,
+	0x22, 0x41, 0x2a, 0xb2, 0xcb, 0xbf, 0x3e, 0x54, 0x7d, 0x04, 0x2a, 0x73, 0xe7, 0x24, 0x28, 0xdf,
+	0x99, 0xdf, 0x48, 0xf0, 0xc6, 0xf0, 0x4e, 0x79, 0x63, 0x04, 0x1b, 0x83, 0x55, 0x64, 0xb6, 0x5e,
+	0x59, 0x85, 0xef, 0xf3, 0x2f, 0x24, 0x48, 0xf7, 0xed, 0x24, 0x6f, 0x8d, 0x60, 0x27, 0x12, 0x99,
+	0xb9, 0x7b, 0x52, 0xa4, 0xef, 0xd8, 0x8f, 0x61, 0x3e, 0xdc, 0x01, 0x16, 0x47, 0x50, 0x1a, 0x04,
+	0x64, 0x6e, 0x8e, 0x09, 0x08, 0x47, 0x25, 0xba, 0xe9, 0xba, 0x35, 0x46, 0xf4, 0x7b, 0x90, 0x99,
+	0xbb, 0x27, 0x45, 0xfa, 0x8e, 0x3d, 0x95, 0xe0, 0x5c, 0x9f, 0xd6, 0xe7, 0xc6, 0x08, 0xca, 0x23,
+	0x70, 0x99, 0xf7, 0x4f, 0x86, 0xf3, 0x5c, 0x2a, 0xef, 0x3c, 0x7b, 0x91, 0x93, 0xbe, 0x78, 0x91,
+	0x93, 0xfe, 0xfe, 0x22, 0x27, 0x3d, 0x7d, 0x99, 0x3b, 0xf5, 0xc5, 0xcb, 0xdc, 0xa9, 0xbf, 0xbe,
+	0xcc, 0x9d, 0xfa, 0xde, 0x3b, 0x35, 0x83, 0x1c, 0x36, 0xf7, 0x0b, 0x55, 0xdb, 0x2c, 0x9a, 0x9a,
+	0x66, 0x1d, 0xe5, 0x5b, 0x47, 0x3f, 0x12, 0x4f, 0x3a, 0x6e, 0x15, 0x1f, 0xbd, 0x53, 0x6c, 0xf5,
+	0x34, 0x43, 0xe4, 0xa8, 0x81, 0xdd, 0xfd, 0x49, 0x76, 0x6e, 0x5d, 0xfb, 0xef, 0x00, 0xb0, 0x9e,
+	0x9d, 0x13, 0xf3, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
+	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
+	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
+	SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
+	SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegisteredAlloyedPool, opts ...grpc.CallOption) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	SetPoolTakerFee(ctx context.Context, in *MsgSetPoolTakerFee, opts ...grpc.CallOption) (*MsgSetPoolTakerFeeResponse, error)
+	SetTakerFeeDiscountTiers(ctx context.Context, in *MsgSetTakerFeeDiscountTiers, opts ...grpc.CallOption) (*MsgSetTakerFeeDiscountTiersResponse, error)
+	SetAccountVolumeConfig(ctx context.Context, in *MsgSetAccountVolumeConfig, opts ...grpc.CallOption) (*MsgSetAccountVolumeConfigResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error) {
+	out := new(MsgSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error) {
+	out := new(MsgSwapExactAmountOutResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountOut", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1006,6 +1753,33 @@ func (c *msgClient) SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegi
 	return out, nil
 }
 
+func (c *msgClient) SetPoolTakerFee(ctx context.Context, in *MsgSetPoolTakerFee, opts ...grpc.CallOption) (*MsgSetPoolTakerFeeResponse, error) {
+	out := new(MsgSetPoolTakerFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetPoolTakerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTakerFeeDiscountTiers(ctx context.Context, in *MsgSetTakerFeeDiscountTiers, opts ...grpc.CallOption) (*MsgSetTakerFeeDiscountTiersResponse, error) {
+	out := new(MsgSetTakerFeeDiscountTiersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetTakerFeeDiscountTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAccountVolumeConfig(ctx context.Context, in *MsgSetAccountVolumeConfig, opts ...grpc.CallOption) (*MsgSetAccountVolumeConfigResponse, error) {
+	out := new(MsgSetAccountVolumeConfigResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetAccountVolumeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
@@ -1015,6 +1789,9 @@ type MsgServer interface {
 	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(context.Context, *MsgSetTakerFeeShareAgreementForDenom) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(context.Context, *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error)
+	SetPoolTakerFee(context.Context, *MsgSetPoolTakerFee) (*MsgSetPoolTakerFeeResponse, error)
+	SetTakerFeeDiscountTiers(context.Context, *MsgSetTakerFeeDiscountTiers) (*MsgSetTakerFeeDiscountTiersResponse, error)
+	SetAccountVolumeConfig(context.Context, *MsgSetAccountVolumeConfig) (*MsgSetAccountVolumeConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1042,6 +1819,15 @@ func (*UnimplementedMsgServer) SetTakerFeeShareAgreementForDenom(ctx context.Con
 func (*UnimplementedMsgServer) SetRegisteredAlloyedPool(ctx context.Context, req *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRegisteredAlloyedPool not implemented")
 }
+func (*UnimplementedMsgServer) SetPoolTakerFee(ctx context.Context, req *MsgSetPoolTakerFee) (*MsgSetPoolTakerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolTakerFee not implemented")
+}
+func (*UnimplementedMsgServer) SetTakerFeeDiscountTiers(ctx context.Context, req *MsgSetTakerFeeDiscountTiers) (*MsgSetTakerFeeDiscountTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTakerFeeDiscountTiers not implemented")
+}
+func (*UnimplementedMsgServer) SetAccountVolumeConfig(ctx context.Context, req *MsgSetAccountVolumeConfig) (*MsgSetAccountVolumeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountVolumeConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1173,38 +1959,105 @@ func _Msg_SetRegisteredAlloyedPool_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SwapExactAmountIn",
-			Handler:    _Msg_SwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "SwapExactAmountOut",
-			Handler:    _Msg_SwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "SplitRouteSwapExactAmountIn",
-			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "SplitRouteSwapExactAmountOut",
-			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "SetDenomPairTakerFee",
-			Handler:    _Msg_SetDenomPairTakerFee_Handler,
-		},
-		{
-			MethodName: "SetTakerFeeShareAgreementForDenom",
-			Handler:    _Msg_SetTakerFeeShareAgreementForDenom_Handler,
-		},
-		{
-			MethodName: "SetRegisteredAlloyedPool",
+func _Msg_SetPoolTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolTakerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolTakerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SetPoolTakerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolTakerFee(ctx, req.(*MsgSetPoolTakerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTakerFeeDiscountTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTakerFeeDiscountTiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTakerFeeDiscountTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SetTakerFeeDiscountTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTakerFeeDiscountTiers(ctx, req.(*MsgSetTakerFeeDiscountTiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAccountVolumeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountVolumeConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountVolumeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SetAccountVolumeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountVolumeConfig(ctx, req.(*MsgSetAccountVolumeConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SwapExactAmountIn",
+			Handler:    _Msg_SwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountOut",
+			Handler:    _Msg_SwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "SplitRouteSwapExactAmountIn",
+			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SplitRouteSwapExactAmountOut",
+			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "SetDenomPairTakerFee",
+			Handler:    _Msg_SetDenomPairTakerFee_Handler,
+		},
+		{
+			MethodName: "SetTakerFeeShareAgreementForDenom",
+			Handler:    _Msg_SetTakerFeeShareAgreementForDenom_Handler,
+		},
+		{
+			MethodName: "SetRegisteredAlloyedPool",
 			Handler:    _Msg_SetRegisteredAlloyedPool_Handler,
 		},
+		{
+			MethodName: "SetPoolTakerFee",
+			Handler:    _Msg_SetPoolTakerFee_Handler,
+		},
+		{
+			MethodName: "SetTakerFeeDiscountTiers",
+			Handler:    _Msg_SetTakerFeeDiscountTiers_Handler,
+		},
+		{
+			MethodName: "SetAccountVolumeConfig",
+			Handler:    _Msg_SetAccountVolumeConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1230,6 +2083,40 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpotPriceDrift != nil {
+		{
+			size := m.MaxSpotPriceDrift.Size()
+			i -= size
+			if _, err := m.MaxSpotPriceDrift.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1327,6 +2214,40 @@ func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpotPriceDrift != nil {
+		{
+			size := m.MaxSpotPriceDrift.Size()
+			i -= size
+			if _, err := m.MaxSpotPriceDrift.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1421,6 +2342,40 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpotPriceDrift != nil {
+		{
+			size := m.MaxSpotPriceDrift.Size()
+			i -= size
+			if _, err := m.MaxSpotPriceDrift.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1518,6 +2473,40 @@ func (m *MsgSplitRouteSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSpotPriceDrift != nil {
+		{
+			size := m.MaxSpotPriceDrift.Size()
+			i -= size
+			if _, err := m.MaxSpotPriceDrift.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxPriceImpact != nil {
+		{
+			size := m.MaxPriceImpact.Size()
+			i -= size
+			if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deadline != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenInMaxAmount.Size()
 		i -= size
@@ -1804,7 +2793,7 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetPoolTakerFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1814,330 +2803,2723 @@ func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetPoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetPoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PoolTakerFees) > 0 {
+		for iNdEx := len(m.PoolTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetPoolTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgSetPoolTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetPoolTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTakerFeeDiscountTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTakerFeeDiscountTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTakerFeeDiscountTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTakerFeeDiscountTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetTakerFeeDiscountTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTakerFeeDiscountTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountVolumeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountVolumeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountVolumeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountVolumeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomPairTakerFee) > 0 {
-		for _, e := range m.DenomPairTakerFee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgSetAccountVolumeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAccountVolumeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Unset {
+		i--
+		if m.Unset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.SkimPercent.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SkimAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetRegisteredAlloyedPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unset {
+		i--
+		if m.Unset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinVolume != nil {
+		{
+			size := m.MinVolume.Size()
+			i -= size
+			if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unset {
+		i--
+		if m.Unset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountVolumeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolumeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PricePools) > 0 {
+		for iNdEx := len(m.PricePools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.RetainedWindows != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RetainedWindows))
+		i--
+		dAtA[i] = 0x18
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPricePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPricePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPricePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpotPriceDrift != nil {
+		l = m.MaxSpotPriceDrift.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgSetRegisteredAlloyedPoolResponse) Size() (n int) {
+func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *DenomPairTakerFee) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom0)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom1)
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TakerFee.Size()
+	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TokenInDenom)
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpotPriceDrift != nil {
+		l = m.MaxSpotPriceDrift.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpotPriceDrift != nil {
+		l = m.MaxSpotPriceDrift.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPriceImpact != nil {
+		l = m.MaxPriceImpact.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSpotPriceDrift != nil {
+		l = m.MaxSpotPriceDrift.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SkimPercent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SkimAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRegisteredAlloyedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgSetRegisteredAlloyedPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolTakerFees) > 0 {
+		for _, e := range m.PoolTakerFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPoolTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTakerFeeDiscountTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTakerFeeDiscountTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAccountVolumeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAccountVolumeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Unset {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Unset {
+		n += 2
+	}
+	return n
+}
+
+func (m *TakerFeeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Unset {
+		n += 2
+	}
+	if m.MinVolume != nil {
+		l = m.MinVolume.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountVolumeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.RetainedWindows != 0 {
+		n += 1 + sovTx(uint64(m.RetainedWindows))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PricePools) > 0 {
+		for _, e := range m.PricePools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPricePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *AccountVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPriceDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxSpotPriceDrift = &v
+			if err := m.MaxSpotPriceDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPriceDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxSpotPriceDrift = &v
+			if err := m.MaxSpotPriceDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPriceDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxSpotPriceDrift = &v
+			if err := m.MaxSpotPriceDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPriceImpact = &v
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPriceDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxSpotPriceDrift = &v
+			if err := m.MaxSpotPriceDrift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +5542,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2200,9 +5582,9 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2212,31 +5594,29 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2246,28 +5626,29 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,9 +5676,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SkimAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2320,7 +5699,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,46 +5722,12 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2404,7 +5749,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,10 +5772,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2466,10 +5811,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2479,29 +5824,114 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2529,13 +5959,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2545,23 +5975,23 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTakerFees = append(m.PoolTakerFees, PoolTakerFee{})
+			if err := m.PoolTakerFees[len(m.PoolTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2586,7 +6016,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,46 +6039,12 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2670,7 +6066,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTakerFeeDiscountTiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,10 +6089,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTakerFeeDiscountTiers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTakerFeeDiscountTiers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2733,7 +6129,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2760,14 +6156,114 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, TakerFeeDiscountTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTakerFeeDiscountTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTakerFeeDiscountTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTakerFeeDiscountTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountVolumeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountVolumeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountVolumeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,13 +6291,11 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2828,7 +6322,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2853,7 +6347,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountVolumeConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,55 +6361,21 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountVolumeConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountVolumeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2937,7 +6397,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,15 +6420,15 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2996,13 +6456,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3012,29 +6472,27 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3062,11 +6520,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3094,10 +6554,60 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3119,7 +6629,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolTakerFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,15 +6652,34 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTakerFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3178,10 +6707,30 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3203,7 +6752,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3226,15 +6775,15 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDiscountTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3262,13 +6811,13 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3278,79 +6827,61 @@ func (m *MsgSetDenomPairTakerFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomPairTakerFee = append(m.DenomPairTakerFee, DenomPairTakerFee{})
-			if err := m.DenomPairTakerFee[len(m.DenomPairTakerFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unset", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3367,7 +6898,43 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			m.Unset = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinVolume = &v
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3389,7 +6956,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,15 +6979,15 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3448,13 +7015,13 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3464,29 +7031,49 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedWindows", wireType)
+			}
+			m.RetainedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimPercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3496,31 +7083,30 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SkimPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkimAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3530,74 +7116,26 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SkimAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.PricePools = append(m.PricePools, DenomPricePool{})
+			if err := m.PricePools[len(m.PricePools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTakerFeeShareAgreementForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3619,7 +7157,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
+func (m *DenomPricePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3642,15 +7180,15 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPricePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPricePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3678,7 +7216,7 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3720,57 +7258,7 @@ func (m *MsgSetRegisteredAlloyedPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRegisteredAlloyedPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRegisteredAlloyedPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
+func (m *AccountVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3793,15 +7281,15 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3829,45 +7317,13 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3877,29 +7333,28 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3927,39 +7382,9 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	"github.com/maany-xyz/maany-dex/v5/osmoutils"
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+// EndBlocker distributes the accrued taker fees every `DistributionIntervalBlocks` blocks.
+// A failed distribution is logged and leaves the fees in the module account for the next interval.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.DistributionIntervalBlocks == 0 || ctx.BlockHeight()%int64(params.DistributionIntervalBlocks) != 0 {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.DistributeFees(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to distribute taker fees", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+	writeFn()
+}
+
+// DistributeFees is the taker fee counterpart of the feeburner's BurnAndDistribute. It does few things:
+// 1. If `SwapToNative` is set, swaps every non-native fee coin into the native denom through the deepest
+// PoolManager pool holding both denoms, as long as the swap executes within `MaxSlippage` of the
+// reference price of the pool. Coins that cannot be swapped are distributed as they are;
+// 2. Sends `StakingShare` of the fees to the fee collector, from where the CCV consumer reward
+// distribution forwards them to the stakers;
+// 3. Burns `BurnShare` of the native fees. The burn share of non-native fees, together with
+// `CommunityPoolShare` and rounding leftovers, is sent to the community pool (treasury);
+// 4. Updates the distributed totals.
+func (k Keeper) DistributeFees(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	if params.SwapToNative {
+		for _, coin := range k.bankKeeper.GetAllBalances(ctx, moduleAddr) {
+			if coin.Denom == params.NativeDenom {
+				continue
+			}
+			if err := k.swapToNative(ctx, params, moduleAddr, coin); err != nil {
+				k.Logger(ctx).Debug("taker fee is distributed without swapping", "coin", coin.String(), "err", err)
+			}
+		}
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if balances.IsZero() {
+		return nil
+	}
+
+	toStakers, toCommunityPool, toBurn := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range balances {
+		stakersAmt := params.StakingShare.MulInt(coin.Amount).TruncateInt()
+		burnAmt := params.BurnShare.MulInt(coin.Amount).TruncateInt()
+		if coin.Denom != params.NativeDenom {
+			burnAmt = math.ZeroInt()
+		}
+		communityPoolAmt := coin.Amount.Sub(stakersAmt).Sub(burnAmt)
+
+		toStakers = toStakers.Add(sdk.NewCoin(coin.Denom, stakersAmt))
+		toCommunityPool = toCommunityPool.Add(sdk.NewCoin(coin.Denom, communityPoolAmt))
+		toBurn = toBurn.Add(sdk.NewCoin(coin.Denom, burnAmt))
+	}
+
+	if !toStakers.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, toStakers); err != nil {
+			return errors.Wrap(err, "failed sending taker fees to the fee collector")
+		}
+	}
+
+	if !toCommunityPool.IsZero() {
+		addr, err := sdk.AccAddressFromBech32(k.getCommunityPoolAddr(ctx))
+		if err != nil {
+			// there's no way we face this kind of situation in production, since it means the chain is misconfigured
+			// still, in test environments it might be the case when the chain is started without Treasury
+			// in such case we just burn the tokens, same as the feeburner does
+			toBurn = toBurn.Add(toCommunityPool...)
+			toCommunityPool = sdk.NewCoins()
+		} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, toCommunityPool); err != nil {
+			return errors.Wrap(err, "failed sending taker fees to the community pool")
+		}
+	}
+
+	if !toBurn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, toBurn); err != nil {
+			return errors.Wrap(err, "failed to burn taker fees")
+		}
+	}
+
+	totals := k.GetDistributedTotals(ctx)
+	totals.ToStakers = totals.ToStakers.Add(toStakers...)
+	totals.ToCommunityPool = totals.ToCommunityPool.Add(toCommunityPool...)
+	totals.Burned = totals.Burned.Add(toBurn...)
+	totals.LastDistributionHeight = ctx.BlockHeight()
+	k.SetDistributedTotals(ctx, totals)
+
+	return nil
+}
+
+// swapToNative swaps the coin held by the module account into the native denom. The swap goes
+// through the pool with the most native liquidity among the pools holding both denoms, and is
+// executed in a cached context so that a failed swap leaves no partial state behind. The swap is
+// skipped if the pool cannot be priced or would pay out less than the reference value minus the
+// max slippage.
+func (k Keeper) swapToNative(ctx sdk.Context, params types.Params, moduleAddr sdk.AccAddress, coin sdk.Coin) error {
+	nativeDenom := params.NativeDenom
+	pools, err := k.poolManagerKeeper.ListPoolsByDenom(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bestPoolId    uint64
+		bestLiquidity = math.ZeroInt()
+	)
+	for _, pool := range pools {
+		denoms, err := k.poolManagerKeeper.RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil || !osmoutils.Contains(denoms, nativeDenom) {
+			continue
+		}
+		liquidity, err := k.poolManagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
+		if err != nil {
+			continue
+		}
+		if nativeLiquidity := liquidity.AmountOf(nativeDenom); nativeLiquidity.GT(bestLiquidity) {
+			bestPoolId, bestLiquidity = pool.GetId(), nativeLiquidity
+		}
+	}
+	if bestLiquidity.IsZero() {
+		return errors.Wrapf(types.ErrNoSwapPool, "%s -> %s", coin.Denom, nativeDenom)
+	}
+
+	price, err := k.referencePrice(ctx, params, bestPoolId, coin.Denom)
+	if err != nil {
+		return errors.Wrapf(err, "failed to price %s in pool %d", coin.Denom, bestPoolId)
+	}
+	minOut := osmomath.BigDecFromSDKInt(coin.Amount).Mul(price).Dec().
+		Mul(math.LegacyOneDec().Sub(params.MaxSlippage)).TruncateInt()
+	if !minOut.IsPositive() {
+		return errors.Wrapf(types.ErrNoSwapPool, "%s is worth no %s in pool %d", coin.String(), nativeDenom, bestPoolId)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if _, err := k.poolManagerKeeper.SwapExactAmountInNoTakerFee(cacheCtx, moduleAddr, bestPoolId, coin, nativeDenom, minOut); err != nil {
+		return errors.Wrapf(err, "failed to swap %s in pool %d", coin.String(), bestPoolId)
+	}
+	writeFn()
+
+	return nil
+}
+
+// referencePrice returns the price of the denom in the native denom in the pool, its TWAP over `TwapDuration`.
+// There is no spot price fallback, the spot price can be moved within the block the swap is made in.
+func (k Keeper) referencePrice(ctx sdk.Context, params types.Params, poolId uint64, denom string) (osmomath.BigDec, error) {
+	if k.twapKeeper == nil {
+		return osmomath.BigDec{}, types.ErrNoTwap
+	}
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, denom, params.NativeDenom, ctx.BlockTime().Add(-params.TwapDuration))
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return osmomath.BigDecFromDec(twap), nil
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/app/config"
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	mock_types "github.com/maany-xyz/maany-dex/v5/testutil/mocks/takerfee/types"
+	testkeeper "github.com/maany-xyz/maany-dex/v5/testutil/takerfee/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/gamm/pool-models/balancer"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+const foreignDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestKeeper_DistributeFees(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	treasury := sdk.AccAddress("treasury")
+
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	mockPoolManagerKeeper := mock_types.NewMockPoolManagerKeeper(ctrl)
+	k, ctx := testkeeper.TakerFeeKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper, mockPoolManagerKeeper, func(_ sdk.Context) string { return treasury.String() })
+
+	params := types.DefaultParams()
+	params.SwapToNative = false
+	require.NoError(t, k.SetParams(ctx, params))
+
+	balances := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(1001)), sdk.NewCoin(foreignDenom, math.NewInt(100)))
+	mockAccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr)
+	mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(balances)
+
+	// 50% to stakers, 30% to the community pool, 20% burned (native only)
+	toStakers := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(500)), sdk.NewCoin(foreignDenom, math.NewInt(50)))
+	toCommunityPool := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(301)), sdk.NewCoin(foreignDenom, math.NewInt(50)))
+	toBurn := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(200)))
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, toStakers).Return(nil)
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, treasury, toCommunityPool).Return(nil)
+	mockBankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, toBurn).Return(nil)
+
+	require.NoError(t, k.DistributeFees(ctx.WithBlockHeight(10)))
+
+	totals := k.GetDistributedTotals(ctx)
+	require.Equal(t, toStakers, totals.ToStakers)
+	require.Equal(t, toCommunityPool, totals.ToCommunityPool)
+	require.Equal(t, toBurn, totals.Burned)
+	require.Equal(t, int64(10), totals.LastDistributionHeight)
+}
+
+func TestKeeper_DistributeFeesSwapToNative(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	mockPoolManagerKeeper := mock_types.NewMockPoolManagerKeeper(ctrl)
+	mockTwapKeeper := mock_types.NewMockTwapKeeper(ctrl)
+	// no treasury configured: the community pool share is burned
+	k, ctx := testkeeper.TakerFeeKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper, mockPoolManagerKeeper, func(_ sdk.Context) string { return "" })
+	k.SetTwapKeeper(mockTwapKeeper)
+
+	params := types.DefaultParams()
+	foreignFee := sdk.NewCoin(foreignDenom, math.NewInt(100))
+	mockAccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr)
+	gomock.InOrder(
+		mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(sdk.NewCoins(foreignFee)),
+		mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(200)))),
+	)
+
+	// pool 2 holds more native liquidity than pool 1, pool 3 does not hold the native denom at all
+	mockPoolManagerKeeper.EXPECT().ListPoolsByDenom(gomock.Any(), foreignDenom).Return([]poolmanagertypes.PoolI{
+		&balancer.Pool{Id: 1}, &balancer.Pool{Id: 2}, &balancer.Pool{Id: 3},
+	}, nil)
+	mockPoolManagerKeeper.EXPECT().RouteGetPoolDenoms(gomock.Any(), uint64(1)).Return([]string{foreignDenom, params.NativeDenom}, nil)
+	mockPoolManagerKeeper.EXPECT().RouteGetPoolDenoms(gomock.Any(), uint64(2)).Return([]string{foreignDenom, params.NativeDenom}, nil)
+	mockPoolManagerKeeper.EXPECT().RouteGetPoolDenoms(gomock.Any(), uint64(3)).Return([]string{foreignDenom, "uatom"}, nil)
+	mockPoolManagerKeeper.EXPECT().GetTotalPoolLiquidity(gomock.Any(), uint64(1)).Return(sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(10))), nil)
+	mockPoolManagerKeeper.EXPECT().GetTotalPoolLiquidity(gomock.Any(), uint64(2)).Return(sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(1000))), nil)
+	// the foreign denom is worth 2 native at the twap, the swap must pay at least 200 minus 5% slippage
+	mockTwapKeeper.EXPECT().GetArithmeticTwapToNow(gomock.Any(), uint64(2), foreignDenom, params.NativeDenom, ctx.BlockTime().Add(-params.TwapDuration)).Return(osmomath.NewDec(2), nil)
+	mockPoolManagerKeeper.EXPECT().SwapExactAmountInNoTakerFee(gomock.Any(), moduleAddr, uint64(2), foreignFee, params.NativeDenom, math.NewInt(190)).Return(math.NewInt(200), nil)
+
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(100)))).Return(nil)
+	mockBankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(100)))).Return(nil)
+
+	require.NoError(t, k.DistributeFees(ctx))
+
+	totals := k.GetDistributedTotals(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(100))), totals.ToStakers)
+	require.True(t, totals.ToCommunityPool.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(100))), totals.Burned)
+}
+
+func TestKeeper_DistributeFeesSkipsSwapBeyondSlippage(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	mockPoolManagerKeeper := mock_types.NewMockPoolManagerKeeper(ctrl)
+	mockTwapKeeper := mock_types.NewMockTwapKeeper(ctrl)
+	k, ctx := testkeeper.TakerFeeKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper, mockPoolManagerKeeper, func(_ sdk.Context) string { return "" })
+	k.SetTwapKeeper(mockTwapKeeper)
+
+	params := types.DefaultParams()
+	foreignFee := sdk.NewCoin(foreignDenom, math.NewInt(100))
+	mockAccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+	mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(sdk.NewCoins(foreignFee)).AnyTimes()
+	mockPoolManagerKeeper.EXPECT().ListPoolsByDenom(gomock.Any(), foreignDenom).Return([]poolmanagertypes.PoolI{&balancer.Pool{Id: 1}}, nil).AnyTimes()
+	mockPoolManagerKeeper.EXPECT().RouteGetPoolDenoms(gomock.Any(), uint64(1)).Return([]string{foreignDenom, params.NativeDenom}, nil).AnyTimes()
+	mockPoolManagerKeeper.EXPECT().GetTotalPoolLiquidity(gomock.Any(), uint64(1)).Return(sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(1000))), nil).AnyTimes()
+
+	// the foreign fee is distributed as it is
+	toStakers := sdk.NewCoins(sdk.NewCoin(foreignDenom, math.NewInt(50)))
+	toBurn := sdk.NewCoins(sdk.NewCoin(foreignDenom, math.NewInt(50)))
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, toStakers).Return(nil).Times(3)
+	mockBankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, toBurn).Return(nil).Times(3)
+
+	// the pool price dropped below the twap minus the max slippage: the swap fails on its min out
+	twapStart := ctx.BlockTime().Add(-params.TwapDuration)
+	mockTwapKeeper.EXPECT().GetArithmeticTwapToNow(gomock.Any(), uint64(1), foreignDenom, params.NativeDenom, twapStart).Return(osmomath.NewDec(2), nil)
+	mockPoolManagerKeeper.EXPECT().SwapExactAmountInNoTakerFee(gomock.Any(), moduleAddr, uint64(1), foreignFee, params.NativeDenom, math.NewInt(190)).
+		Return(math.Int{}, fmt.Errorf("token amount calculated is lesser than min amount"))
+	require.NoError(t, k.DistributeFees(ctx))
+
+	// without a twap the swap is not attempted at all
+	mockTwapKeeper.EXPECT().GetArithmeticTwapToNow(gomock.Any(), uint64(1), foreignDenom, params.NativeDenom, twapStart).Return(osmomath.Dec{}, fmt.Errorf("no twap"))
+	require.NoError(t, k.DistributeFees(ctx))
+
+	// nor without a twap keeper
+	k.SetTwapKeeper(nil)
+	require.NoError(t, k.DistributeFees(ctx))
+}
+
+func TestKeeper_EndBlockerKeepsFeesOnFailure(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := testkeeper.TakerFeeKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper, nil, func(_ sdk.Context) string { return "" })
+
+	params := types.DefaultParams()
+	params.SwapToNative = false
+	params.DistributionIntervalBlocks = 5
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// not a distribution height, nothing is called
+	k.EndBlocker(ctx.WithBlockHeight(3))
+
+	mockAccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr)
+	mockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(sdk.NewCoins(sdk.NewCoin(params.NativeDenom, math.NewInt(10))))
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(fmt.Errorf("send failed"))
+
+	k.EndBlocker(ctx.WithBlockHeight(5))
+
+	require.Equal(t, types.DistributedTotals{}, k.GetDistributedTotals(ctx))
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) AccruedFees(goCtx context.Context, _ *types.QueryAccruedFeesRequest) (*types.QueryAccruedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAccruedFeesResponse{AccruedFees: k.GetAccruedFees(ctx)}, nil
+}
+
+func (k Keeper) DistributedTotals(goCtx context.Context, _ *types.QueryDistributedTotalsRequest) (*types.QueryDistributedTotalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryDistributedTotalsResponse{DistributedTotals: k.GetDistributedTotals(ctx)}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+
+		accountKeeper        types.AccountKeeper
+		bankKeeper           types.BankKeeper
+		poolManagerKeeper    types.PoolManagerKeeper
+		twapKeeper           types.TwapKeeper
+		getCommunityPoolAddr types.GetCommunityPoolAddr
+		authority            string
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	poolManagerKeeper types.PoolManagerKeeper,
+	getCommunityPoolAddr types.GetCommunityPoolAddr,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:                  cdc,
+		storeKey:             storeKey,
+		accountKeeper:        accountKeeper,
+		bankKeeper:           bankKeeper,
+		poolManagerKeeper:    poolManagerKeeper,
+		getCommunityPoolAddr: getCommunityPoolAddr,
+		authority:            authority,
+	}
+}
+
+// SetTwapKeeper sets the twap keeper used to price the fees swapped into the native denom.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetDistributedTotals gets the cumulative amounts of distributed taker fees
+func (k Keeper) GetDistributedTotals(ctx sdk.Context) types.DistributedTotals {
+	store := ctx.KVStore(k.storeKey)
+
+	var totals types.DistributedTotals
+	bz := store.Get(types.DistributedTotalsKey)
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &totals)
+	}
+
+	return totals
+}
+
+// SetDistributedTotals sets the cumulative amounts of distributed taker fees
+func (k Keeper) SetDistributedTotals(ctx sdk.Context, totals types.DistributedTotals) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.DistributedTotalsKey, k.cdc.MustMarshal(&totals))
+}
+
+// GetAccruedFees returns the taker fees collected since the last distribution
+func (k Keeper) GetAccruedFees(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
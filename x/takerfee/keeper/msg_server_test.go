@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/app/config"
+	testkeeper "github.com/maany-xyz/maany-dex/v5/testutil/takerfee/keeper"
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	k, ctx := testkeeper.TakerFeeKeeper(t)
+	notAuthority := sdk.AccAddress("not_authority").String()
+
+	newParams := types.DefaultParams()
+	newParams.SwapToNative = false
+	newParams.DistributionIntervalBlocks = 10
+
+	invalidShares := types.DefaultParams()
+	invalidShares.BurnShare = invalidShares.BurnShare.MulInt64(2)
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgUpdateParams
+		errMsg string
+	}{
+		{
+			desc:   "invalid authority",
+			msg:    &types.MsgUpdateParams{Authority: "invalid", Params: newParams},
+			errMsg: "authority is invalid",
+		},
+		{
+			desc:   "wrong authority",
+			msg:    &types.MsgUpdateParams{Authority: notAuthority, Params: newParams},
+			errMsg: "invalid authority",
+		},
+		{
+			desc:   "shares not adding up to one",
+			msg:    &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalidShares},
+			errMsg: "must add up to 1",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := k.UpdateParams(ctx, tc.msg)
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Nil(t, resp)
+		})
+	}
+
+	resp, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: newParams})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUpdateParamsResponse{}, resp)
+	require.Equal(t, newParams, k.GetParams(ctx))
+}
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/takerfee module sentinel errors
+var (
+	ErrNoSwapPool = errors.Register(ModuleName, 1100, "no pool to swap the fee denom into the native denom")
+	ErrNoTwap     = errors.Register(ModuleName, 1101, "no twap to price the fee denom in the native denom")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-dex/v5/osmomath"
+	poolmanagertypes "github.com/maany-xyz/maany-dex/v5/x/poolmanager/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to move and burn the collected fees.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// PoolManagerKeeper defines the expected interface needed to swap collected fees into the native denom.
+type PoolManagerKeeper interface {
+	ListPoolsByDenom(ctx sdk.Context, denom string) ([]poolmanagertypes.PoolI, error)
+	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+	SwapExactAmountInNoTakerFee(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolId uint64,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount osmomath.Int,
+	) (osmomath.Int, error)
+}
+
+// TwapKeeper defines the expected interface needed to price the fees swapped into the native denom.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
+
+// GetCommunityPoolAddr is a function to return the current community pool (treasury) address
+type GetCommunityPoolAddr func(ctx sdk.Context) string
@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	totals := gs.DistributedTotals
+	if err := totals.ToStakers.Validate(); err != nil {
+		return fmt.Errorf("invalid distributed_totals.to_stakers: %w", err)
+	}
+	if err := totals.ToCommunityPool.Validate(); err != nil {
+		return fmt.Errorf("invalid distributed_totals.to_community_pool: %w", err)
+	}
+	if err := totals.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid distributed_totals.burned: %w", err)
+	}
+
+	return nil
+}
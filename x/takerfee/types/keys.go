@@ -1,9 +1,25 @@
-// x/treasury/types/keys.go
 package types
 
-const ModuleName = "takerfee"
-const StoreKey   = ModuleName
-const RouterKey  = ModuleName
+const (
+	// ModuleName defines the module name
+	ModuleName = "takerfee"
 
-// Module account that will hold taker fees:
-const TakerFeeModuleAccount = "takerfee"  // keep it simple
\ No newline at end of file
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// TakerFeeModuleAccount is the module account that collects taker fees charged by the PoolManager
+	TakerFeeModuleAccount = ModuleName
+)
+
+const (
+	prefixParamsKey = iota + 1
+	prefixDistributedTotalsKey
+)
+
+var (
+	ParamsKey            = []byte{prefixParamsKey}
+	DistributedTotalsKey = []byte{prefixDistributedTotalsKey}
+)
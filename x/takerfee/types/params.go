@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v2"
+
+	"github.com/maany-xyz/maany-dex/v5/app/params"
+)
+
+var (
+	DefaultNativeDenom                = params.DefaultDenom
+	DefaultStakingShare               = math.LegacyNewDecWithPrec(5, 1)
+	DefaultCommunityPoolShare         = math.LegacyNewDecWithPrec(3, 1)
+	DefaultBurnShare                  = math.LegacyNewDecWithPrec(2, 1)
+	DefaultSwapToNative               = true
+	DefaultDistributionIntervalBlocks = uint64(100)
+	DefaultMaxSlippage                = math.LegacyNewDecWithPrec(5, 2)
+	DefaultTwapDuration               = 10 * time.Minute
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	nativeDenom string,
+	stakingShare, communityPoolShare, burnShare math.LegacyDec,
+	swapToNative bool,
+	distributionIntervalBlocks uint64,
+	maxSlippage math.LegacyDec,
+	twapDuration time.Duration,
+) Params {
+	return Params{
+		NativeDenom:                nativeDenom,
+		StakingShare:               stakingShare,
+		CommunityPoolShare:         communityPoolShare,
+		BurnShare:                  burnShare,
+		SwapToNative:               swapToNative,
+		DistributionIntervalBlocks: distributionIntervalBlocks,
+		MaxSlippage:                maxSlippage,
+		TwapDuration:               twapDuration,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultNativeDenom,
+		DefaultStakingShare,
+		DefaultCommunityPoolShare,
+		DefaultBurnShare,
+		DefaultSwapToNative,
+		DefaultDistributionIntervalBlocks,
+		DefaultMaxSlippage,
+		DefaultTwapDuration,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.NativeDenom == "" {
+		return fmt.Errorf("native_denom must not be empty")
+	}
+
+	shares := map[string]math.LegacyDec{
+		"staking_share":        p.StakingShare,
+		"community_pool_share": p.CommunityPoolShare,
+		"burn_share":           p.BurnShare,
+	}
+	for name, share := range shares {
+		if share.IsNil() || share.IsNegative() {
+			return fmt.Errorf("%s must be non-negative", name)
+		}
+	}
+
+	if !p.StakingShare.Add(p.CommunityPoolShare).Add(p.BurnShare).Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("staking_share, community_pool_share and burn_share must add up to 1")
+	}
+
+	if p.DistributionIntervalBlocks == 0 {
+		return fmt.Errorf("distribution_interval_blocks must be positive")
+	}
+
+	if p.MaxSlippage.IsNil() || p.MaxSlippage.IsNegative() || p.MaxSlippage.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max_slippage must be in [0, 1)")
+	}
+
+	if p.TwapDuration <= 0 {
+		return fmt.Errorf("twap_duration must be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/takerfee/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the takerfee module.
+type Params struct {
+	// Native denom of the chain. Burning only applies to this denom and, when
+	// swap_to_native is enabled, other fee denoms are swapped into it first.
+	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// Share of the accrued fees sent to the fee collector, where the CCV consumer
+	// reward distribution picks them up for stakers.
+	StakingShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=staking_share,json=stakingShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_share"`
+	// Share of the accrued fees sent to the community pool (treasury).
+	CommunityPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_share"`
+	// Share of the accrued fees that is burned. For denoms other than
+	// native_denom this share goes to the community pool instead.
+	BurnShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=burn_share,json=burnShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_share"`
+	// Whether non-native fees are swapped into native_denom through the
+	// PoolManager before being distributed.
+	SwapToNative bool `protobuf:"varint,5,opt,name=swap_to_native,json=swapToNative,proto3" json:"swap_to_native,omitempty"`
+	// Fees are distributed every distribution_interval_blocks blocks.
+	DistributionIntervalBlocks uint64 `protobuf:"varint,6,opt,name=distribution_interval_blocks,json=distributionIntervalBlocks,proto3" json:"distribution_interval_blocks,omitempty"`
+	// Maximum slippage accepted when swapping a fee coin into native_denom,
+	// relative to its reference price. A swap that would execute below it is
+	// skipped and the coin is distributed as it is.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+	// Duration of the TWAP used as the reference price of the swaps into
+	// native_denom, must be positive. Fees are not swapped when no TWAP is
+	// available in the app.
+	TwapDuration time.Duration `protobuf:"bytes,8,opt,name=twap_duration,json=twapDuration,proto3,stdduration" json:"twap_duration"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7478c841fc3be5f6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetSwapToNative() bool {
+	if m != nil {
+		return m.SwapToNative
+	}
+	return false
+}
+
+func (m *Params) GetDistributionIntervalBlocks() uint64 {
+	if m != nil {
+		return m.DistributionIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTwapDuration() time.Duration {
+	if m != nil {
+		return m.TwapDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "maany.takerfee.v1.Params")
+}
+
+func init() { proto.RegisterFile("maany/takerfee/v1/params.proto", fileDescriptor_7478c841fc3be5f6) }
+
+var fileDescriptor_7478c841fc3be5f6 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x6b, 0x28, 0xa5, 0xe7, 0x06, 0x24, 0xa2, 0x1b, 0x42, 0x41, 0x69, 0xf9, 0x33, 0x74,
+	0xc1, 0xd6, 0x1d, 0x62, 0x61, 0x42, 0x51, 0x85, 0x40, 0x3a, 0xa1, 0x53, 0x0e, 0x16, 0x96, 0xc8,
+	0x49, 0x7c, 0xa9, 0xd5, 0x38, 0x6f, 0x14, 0x3b, 0x21, 0xe1, 0x53, 0xdc, 0x78, 0x23, 0x1f, 0xe7,
+	0xc6, 0x1b, 0x11, 0xc3, 0x81, 0xda, 0x2f, 0x82, 0x9c, 0xa4, 0x70, 0x63, 0x37, 0xe7, 0x79, 0x9f,
+	0xe7, 0x67, 0xc7, 0x8f, 0xb1, 0x2b, 0x19, 0xcb, 0x1a, 0xaa, 0xd9, 0x9a, 0x17, 0xe7, 0x9c, 0xd3,
+	0xea, 0x88, 0xe6, 0xac, 0x60, 0x52, 0x91, 0xbc, 0x00, 0x0d, 0xf6, 0xa3, 0x76, 0x4e, 0x76, 0x73,
+	0x52, 0x1d, 0x4d, 0x0f, 0x13, 0x48, 0xa0, 0x9d, 0x52, 0xb3, 0xea, 0x8c, 0x53, 0x37, 0x01, 0x48,
+	0x52, 0x4e, 0xdb, 0xaf, 0xb0, 0x3c, 0xa7, 0x71, 0x59, 0x30, 0x2d, 0x20, 0xeb, 0xe6, 0xcf, 0x2f,
+	0x86, 0x78, 0x74, 0xda, 0x92, 0xed, 0x67, 0xd8, 0xca, 0x98, 0x16, 0x15, 0x0f, 0x62, 0x9e, 0x81,
+	0x74, 0xd0, 0x1c, 0x2d, 0x0e, 0xfc, 0x49, 0xa7, 0x2d, 0x8d, 0x64, 0x7f, 0xc0, 0x0f, 0x94, 0x66,
+	0x6b, 0x91, 0x25, 0x81, 0x5a, 0xb1, 0x82, 0x3b, 0x77, 0x8c, 0xc7, 0x7b, 0x71, 0x75, 0x33, 0x1b,
+	0xfc, 0xba, 0x99, 0x3d, 0x89, 0x40, 0x49, 0x50, 0x2a, 0x5e, 0x13, 0x01, 0x54, 0x32, 0xbd, 0x22,
+	0x27, 0x3c, 0x61, 0x51, 0xb3, 0xe4, 0x91, 0x6f, 0xf5, 0xc9, 0x33, 0x13, 0xb4, 0xbf, 0xe0, 0xc3,
+	0x08, 0xa4, 0x2c, 0x33, 0xa1, 0x9b, 0x20, 0x07, 0x48, 0x7b, 0xe0, 0xdd, 0xfd, 0x81, 0xf6, 0x3f,
+	0xc0, 0x29, 0x40, 0xda, 0x61, 0x3d, 0x8c, 0xc3, 0xb2, 0xc8, 0x7a, 0xd8, 0x70, 0x7f, 0xd8, 0x81,
+	0x89, 0x75, 0x8c, 0x97, 0xf8, 0xa1, 0xfa, 0xc6, 0xf2, 0x40, 0x43, 0xd0, 0xfd, 0xbb, 0x73, 0x6f,
+	0x8e, 0x16, 0x63, 0xdf, 0x32, 0xea, 0x67, 0xf8, 0xd4, 0x6a, 0xf6, 0x3b, 0xfc, 0x34, 0x16, 0x4a,
+	0x17, 0x22, 0x2c, 0xcd, 0x75, 0x06, 0x22, 0xd3, 0xbc, 0xa8, 0x58, 0x1a, 0x84, 0x29, 0x44, 0x6b,
+	0xe5, 0x8c, 0xe6, 0x68, 0x31, 0xf4, 0xa7, 0xb7, 0x3d, 0x1f, 0x7b, 0x8b, 0xd7, 0x3a, 0xec, 0xf7,
+	0xd8, 0x92, 0xac, 0x0e, 0x54, 0x2a, 0xf2, 0x9c, 0x25, 0xdc, 0xb9, 0xbf, 0xff, 0x69, 0x27, 0x92,
+	0xd5, 0x67, 0x7d, 0xce, 0x94, 0xa2, 0xcd, 0x79, 0x77, 0xcd, 0x3a, 0xe3, 0x39, 0x5a, 0x4c, 0x8e,
+	0x1f, 0x93, 0xae, 0x7a, 0xb2, 0xab, 0x9e, 0x2c, 0x7b, 0x83, 0x37, 0x36, 0x7b, 0x5c, 0xfe, 0x9e,
+	0x21, 0xdf, 0x32, 0xc9, 0x9d, 0xfe, 0x76, 0x78, 0xf9, 0x63, 0x36, 0xf0, 0x4e, 0xae, 0x36, 0x2e,
+	0xba, 0xde, 0xb8, 0xe8, 0xcf, 0xc6, 0x45, 0x17, 0x5b, 0x77, 0x70, 0xbd, 0x75, 0x07, 0x3f, 0xb7,
+	0xee, 0xe0, 0xeb, 0x71, 0x22, 0xf4, 0xaa, 0x0c, 0x49, 0x04, 0x92, 0xb6, 0x0f, 0xf0, 0x55, 0xdd,
+	0x7c, 0xef, 0x57, 0x31, 0xaf, 0x69, 0xf5, 0x86, 0xd6, 0xff, 0xdf, 0xac, 0x6e, 0x72, 0xae, 0xc2,
+	0x51, 0xbb, 0xfd, 0xeb, 0xbf, 0x03, 0x00, 0xa0, 0xbb, 0xeb, 0xd7, 0xd2, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.DistributionIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DistributionIntervalBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SwapToNative {
+		i--
+		if m.SwapToNative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.BurnShare.Size()
+		i -= size
+		if _, err := m.BurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakingShare.Size()
+		i -= size
+		if _, err := m.StakingShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.StakingShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BurnShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SwapToNative {
+		n += 2
+	}
+	if m.DistributionIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DistributionIntervalBlocks))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapToNative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapToNative = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionIntervalBlocks", wireType)
+			}
+			m.DistributionIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
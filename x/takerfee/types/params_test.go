@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maany-xyz/maany-dex/v5/x/takerfee/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(p *types.Params)
+		errMsg string
+	}{
+		{
+			desc:   "default params",
+			modify: func(_ *types.Params) {},
+		},
+		{
+			desc:   "empty native denom",
+			modify: func(p *types.Params) { p.NativeDenom = "" },
+			errMsg: "native_denom must not be empty",
+		},
+		{
+			desc: "negative share",
+			modify: func(p *types.Params) {
+				p.StakingShare = math.LegacyNewDecWithPrec(-1, 1)
+				p.BurnShare = math.LegacyNewDecWithPrec(8, 1)
+			},
+			errMsg: "staking_share must be non-negative",
+		},
+		{
+			desc:   "shares do not add up to one",
+			modify: func(p *types.Params) { p.BurnShare = math.LegacyZeroDec() },
+			errMsg: "must add up to 1",
+		},
+		{
+			desc: "everything is burned",
+			modify: func(p *types.Params) {
+				p.StakingShare = math.LegacyZeroDec()
+				p.CommunityPoolShare = math.LegacyZeroDec()
+				p.BurnShare = math.LegacyOneDec()
+			},
+		},
+		{
+			desc:   "zero interval",
+			modify: func(p *types.Params) { p.DistributionIntervalBlocks = 0 },
+			errMsg: "distribution_interval_blocks must be positive",
+		},
+		{
+			desc:   "max slippage of one",
+			modify: func(p *types.Params) { p.MaxSlippage = math.LegacyOneDec() },
+			errMsg: "max_slippage must be in [0, 1)",
+		},
+		{
+			desc:   "negative twap duration",
+			modify: func(p *types.Params) { p.TwapDuration = -1 },
+			errMsg: "twap_duration must be positive",
+		},
+		{
+			desc:   "zero twap duration",
+			modify: func(p *types.Params) { p.TwapDuration = 0 },
+			errMsg: "twap_duration must be positive",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
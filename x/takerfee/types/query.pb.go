@@ -0,0 +1,1225 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/takerfee/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryAccruedFeesRequest is request type for the Query/AccruedFees RPC method.
+type QueryAccruedFeesRequest struct {
+}
+
+func (m *QueryAccruedFeesRequest) Reset()         { *m = QueryAccruedFeesRequest{} }
+func (m *QueryAccruedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeesRequest) ProtoMessage()    {}
+func (*QueryAccruedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{2}
+}
+func (m *QueryAccruedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeesRequest.Merge(m, src)
+}
+func (m *QueryAccruedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeesRequest proto.InternalMessageInfo
+
+// QueryAccruedFeesResponse is response type for the Query/AccruedFees RPC method.
+type QueryAccruedFeesResponse struct {
+	AccruedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=accrued_fees,json=accruedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued_fees"`
+}
+
+func (m *QueryAccruedFeesResponse) Reset()         { *m = QueryAccruedFeesResponse{} }
+func (m *QueryAccruedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedFeesResponse) ProtoMessage()    {}
+func (*QueryAccruedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{3}
+}
+func (m *QueryAccruedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedFeesResponse.Merge(m, src)
+}
+func (m *QueryAccruedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedFeesResponse) GetAccruedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AccruedFees
+	}
+	return nil
+}
+
+// QueryDistributedTotalsRequest is request type for the Query/DistributedTotals
+// RPC method.
+type QueryDistributedTotalsRequest struct {
+}
+
+func (m *QueryDistributedTotalsRequest) Reset()         { *m = QueryDistributedTotalsRequest{} }
+func (m *QueryDistributedTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributedTotalsRequest) ProtoMessage()    {}
+func (*QueryDistributedTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{4}
+}
+func (m *QueryDistributedTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributedTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributedTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributedTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributedTotalsRequest.Merge(m, src)
+}
+func (m *QueryDistributedTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributedTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributedTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributedTotalsRequest proto.InternalMessageInfo
+
+// QueryDistributedTotalsResponse is response type for the
+// Query/DistributedTotals RPC method.
+type QueryDistributedTotalsResponse struct {
+	DistributedTotals DistributedTotals `protobuf:"bytes,1,opt,name=distributed_totals,json=distributedTotals,proto3" json:"distributed_totals"`
+}
+
+func (m *QueryDistributedTotalsResponse) Reset()         { *m = QueryDistributedTotalsResponse{} }
+func (m *QueryDistributedTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributedTotalsResponse) ProtoMessage()    {}
+func (*QueryDistributedTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc5dcad36ef00ee, []int{5}
+}
+func (m *QueryDistributedTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributedTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributedTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributedTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributedTotalsResponse.Merge(m, src)
+}
+func (m *QueryDistributedTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributedTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributedTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributedTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributedTotalsResponse) GetDistributedTotals() DistributedTotals {
+	if m != nil {
+		return m.DistributedTotals
+	}
+	return DistributedTotals{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "maany.takerfee.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "maany.takerfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAccruedFeesRequest)(nil), "maany.takerfee.v1.QueryAccruedFeesRequest")
+	proto.RegisterType((*QueryAccruedFeesResponse)(nil), "maany.takerfee.v1.QueryAccruedFeesResponse")
+	proto.RegisterType((*QueryDistributedTotalsRequest)(nil), "maany.takerfee.v1.QueryDistributedTotalsRequest")
+	proto.RegisterType((*QueryDistributedTotalsResponse)(nil), "maany.takerfee.v1.QueryDistributedTotalsResponse")
+}
+
+func init() { proto.RegisterFile("maany/takerfee/v1/query.proto", fileDescriptor_2fc5dcad36ef00ee) }
+
+var fileDescriptor_2fc5dcad36ef00ee = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x29, 0x74, 0xb8, 0xb0, 0xe4, 0xa8, 0x44, 0x63, 0xe8, 0xa5, 0xb5, 0x28, 0xad, 0x40,
+	0xb9, 0x6b, 0x82, 0x10, 0x33, 0x01, 0x31, 0x21, 0x04, 0x11, 0x0b, 0x2c, 0xd5, 0xd9, 0x7e, 0x35,
+	0x56, 0x1b, 0x9f, 0xeb, 0x3b, 0x47, 0x49, 0xd9, 0x18, 0x59, 0x40, 0xe2, 0x47, 0x20, 0xf1, 0x27,
+	0x58, 0x3b, 0x56, 0x62, 0x61, 0x02, 0x94, 0xf0, 0x43, 0x90, 0xcf, 0xd7, 0x24, 0x70, 0xb1, 0x80,
+	0x29, 0xa7, 0xf7, 0xbd, 0xf7, 0x7d, 0x5f, 0xbe, 0xf7, 0x8c, 0x36, 0x06, 0x9c, 0x27, 0x63, 0xa6,
+	0xf8, 0x21, 0x64, 0x07, 0x00, 0x6c, 0xd8, 0x61, 0xc7, 0x39, 0x64, 0x63, 0x9a, 0x66, 0x42, 0x09,
+	0xdc, 0xd0, 0x30, 0x3d, 0x87, 0xe9, 0xb0, 0xe3, 0x92, 0x40, 0xc8, 0x81, 0x90, 0xcc, 0xe7, 0xb2,
+	0x68, 0xf7, 0x41, 0xf1, 0x0e, 0x0b, 0x44, 0x9c, 0x94, 0x23, 0xee, 0x5a, 0x24, 0x22, 0xa1, 0x9f,
+	0xac, 0x78, 0x99, 0xea, 0xf5, 0x48, 0x88, 0xe8, 0x08, 0x18, 0x4f, 0x63, 0xc6, 0x93, 0x44, 0x28,
+	0xae, 0x62, 0x91, 0x48, 0x83, 0x12, 0xdb, 0x45, 0xca, 0x33, 0x3e, 0x38, 0xc7, 0x37, 0x6d, 0x7c,
+	0x66, 0x49, 0x77, 0x78, 0x6b, 0x08, 0x3f, 0x2b, 0x7c, 0x3f, 0xd5, 0x63, 0x7d, 0x38, 0xce, 0x41,
+	0x2a, 0xef, 0x09, 0xba, 0xf2, 0x5b, 0x55, 0xa6, 0x22, 0x91, 0x80, 0xef, 0xa1, 0xd5, 0x92, 0x7e,
+	0xdd, 0xd9, 0x74, 0x76, 0xeb, 0xdd, 0x26, 0xb5, 0xfe, 0x26, 0x2d, 0x47, 0x7a, 0x17, 0x4f, 0xbf,
+	0xb5, 0x6a, 0x7d, 0xd3, 0xee, 0x35, 0xd1, 0x55, 0xcd, 0x77, 0x3f, 0x08, 0xb2, 0x1c, 0xc2, 0x47,
+	0x00, 0x33, 0xa9, 0xb7, 0x0e, 0x5a, 0xb7, 0x31, 0x23, 0x98, 0xa0, 0xcb, 0xbc, 0x2c, 0xef, 0x1f,
+	0x00, 0x14, 0xb2, 0x2b, 0x5a, 0xb6, 0x8c, 0x92, 0x16, 0x51, 0x52, 0x13, 0x25, 0x7d, 0x20, 0xe2,
+	0xa4, 0xb7, 0x57, 0xc8, 0x7e, 0xfa, 0xde, 0xda, 0x8d, 0x62, 0xf5, 0x2a, 0xf7, 0x69, 0x20, 0x06,
+	0xcc, 0xe4, 0x5e, 0xfe, 0xb4, 0x65, 0x78, 0xc8, 0xd4, 0x38, 0x05, 0xa9, 0x07, 0x64, 0xbf, 0xce,
+	0xe7, 0xba, 0x5e, 0x0b, 0x6d, 0x68, 0x2f, 0x0f, 0x63, 0xa9, 0xb2, 0xd8, 0xcf, 0x15, 0x84, 0xcf,
+	0x85, 0xe2, 0x47, 0x33, 0xb7, 0xaf, 0x11, 0xa9, 0x6a, 0x30, 0x96, 0x5f, 0x20, 0x1c, 0xce, 0xc1,
+	0x7d, 0xa5, 0x51, 0x93, 0xd7, 0x8d, 0x25, 0x79, 0x59, 0x4c, 0x26, 0xba, 0x46, 0xf8, 0x27, 0xd0,
+	0xfd, 0xbc, 0x82, 0x2e, 0x69, 0x75, 0x7c, 0x82, 0x56, 0xcb, 0x9c, 0xf1, 0xf6, 0x12, 0x4a, 0x7b,
+	0xa1, 0xee, 0xcd, 0xbf, 0xb5, 0x95, 0xee, 0xbd, 0xad, 0x37, 0x5f, 0x7e, 0x7e, 0xb8, 0x70, 0x0d,
+	0x37, 0x59, 0xd5, 0x65, 0xe1, 0x77, 0x0e, 0xaa, 0x2f, 0xec, 0x0a, 0xdf, 0xaa, 0xa2, 0xb6, 0x97,
+	0xed, 0xde, 0xfe, 0xa7, 0x5e, 0xe3, 0x65, 0x47, 0x7b, 0xd9, 0xc2, 0xad, 0x25, 0x5e, 0x16, 0xaf,
+	0x02, 0x7f, 0x74, 0x50, 0xc3, 0x8a, 0x11, 0xef, 0x55, 0x69, 0x55, 0x2d, 0xd7, 0xed, 0xfc, 0xc7,
+	0x84, 0xf1, 0xd8, 0xd6, 0x1e, 0x77, 0xf0, 0xf6, 0x12, 0x8f, 0xf6, 0x19, 0xf4, 0x1e, 0x9f, 0x4e,
+	0x88, 0x73, 0x36, 0x21, 0xce, 0x8f, 0x09, 0x71, 0xde, 0x4f, 0x49, 0xed, 0x6c, 0x4a, 0x6a, 0x5f,
+	0xa7, 0xa4, 0xf6, 0xb2, 0xbb, 0x70, 0xb0, 0x9a, 0xaa, 0x3d, 0x1a, 0x9f, 0x98, 0x57, 0x08, 0x23,
+	0x36, 0xbc, 0xcb, 0x46, 0x73, 0x76, 0x7d, 0xc0, 0xfe, 0xaa, 0xfe, 0x84, 0xef, 0xfc, 0x1a, 0x00,
+	0xa6, 0xa5, 0xe3, 0x87, 0x8c, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AccruedFees queries the taker fees waiting for the next distribution.
+	AccruedFees(ctx context.Context, in *QueryAccruedFeesRequest, opts ...grpc.CallOption) (*QueryAccruedFeesResponse, error)
+	// DistributedTotals queries the cumulative amounts of distributed taker fees.
+	DistributedTotals(ctx context.Context, in *QueryDistributedTotalsRequest, opts ...grpc.CallOption) (*QueryDistributedTotalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.takerfee.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedFees(ctx context.Context, in *QueryAccruedFeesRequest, opts ...grpc.CallOption) (*QueryAccruedFeesResponse, error) {
+	out := new(QueryAccruedFeesResponse)
+	err := c.cc.Invoke(ctx, "/maany.takerfee.v1.Query/AccruedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributedTotals(ctx context.Context, in *QueryDistributedTotalsRequest, opts ...grpc.CallOption) (*QueryDistributedTotalsResponse, error) {
+	out := new(QueryDistributedTotalsResponse)
+	err := c.cc.Invoke(ctx, "/maany.takerfee.v1.Query/DistributedTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AccruedFees queries the taker fees waiting for the next distribution.
+	AccruedFees(context.Context, *QueryAccruedFeesRequest) (*QueryAccruedFeesResponse, error)
+	// DistributedTotals queries the cumulative amounts of distributed taker fees.
+	DistributedTotals(context.Context, *QueryDistributedTotalsRequest) (*QueryDistributedTotalsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AccruedFees(ctx context.Context, req *QueryAccruedFeesRequest) (*QueryAccruedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedFees not implemented")
+}
+func (*UnimplementedQueryServer) DistributedTotals(ctx context.Context, req *QueryDistributedTotalsRequest) (*QueryDistributedTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributedTotals not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.takerfee.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.takerfee.v1.Query/AccruedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedFees(ctx, req.(*QueryAccruedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributedTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributedTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributedTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.takerfee.v1.Query/DistributedTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributedTotals(ctx, req.(*QueryDistributedTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.takerfee.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AccruedFees",
+			Handler:    _Query_AccruedFees_Handler,
+		},
+		{
+			MethodName: "DistributedTotals",
+			Handler:    _Query_DistributedTotals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/takerfee/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccruedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedFees) > 0 {
+		for iNdEx := len(m.AccruedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributedTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributedTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributedTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributedTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributedTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributedTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistributedTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccruedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccruedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedFees) > 0 {
+		for _, e := range m.AccruedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributedTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributedTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributedTotals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedFees = append(m.AccruedFees, types.Coin{})
+			if err := m.AccruedFees[len(m.AccruedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributedTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributedTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributedTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributedTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributedTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributedTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributedTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/takerfee/v1/takerfee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributedTotals defines the cumulative amounts of taker fees distributed
+// by the module.
+type DistributedTotals struct {
+	ToStakers       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=to_stakers,json=toStakers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_stakers"`
+	ToCommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=to_community_pool,json=toCommunityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_community_pool"`
+	Burned          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// Height of the last block in which fees were distributed.
+	LastDistributionHeight int64 `protobuf:"varint,4,opt,name=last_distribution_height,json=lastDistributionHeight,proto3" json:"last_distribution_height,omitempty"`
+}
+
+func (m *DistributedTotals) Reset()         { *m = DistributedTotals{} }
+func (m *DistributedTotals) String() string { return proto.CompactTextString(m) }
+func (*DistributedTotals) ProtoMessage()    {}
+func (*DistributedTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a3ce475de4af2fc, []int{0}
+}
+func (m *DistributedTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributedTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributedTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributedTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributedTotals.Merge(m, src)
+}
+func (m *DistributedTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributedTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributedTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributedTotals proto.InternalMessageInfo
+
+func (m *DistributedTotals) GetToStakers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToStakers
+	}
+	return nil
+}
+
+func (m *DistributedTotals) GetToCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToCommunityPool
+	}
+	return nil
+}
+
+func (m *DistributedTotals) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *DistributedTotals) GetLastDistributionHeight() int64 {
+	if m != nil {
+		return m.LastDistributionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DistributedTotals)(nil), "maany.takerfee.v1.DistributedTotals")
+}
+
+func init() { proto.RegisterFile("maany/takerfee/v1/takerfee.proto", fileDescriptor_7a3ce475de4af2fc) }
+
+var fileDescriptor_7a3ce475de4af2fc = []byte{
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x6b, 0x48, 0xac, 0x83, 0xa1, 0x31, 0xa6, 0x32, 0x14, 0xe2, 0xc4, 0xc2, 0x9d,
+	0x60, 0x4c, 0x9c, 0x81, 0xc1, 0xc1, 0xc1, 0xa0, 0x93, 0x4b, 0x73, 0x6d, 0xcf, 0x72, 0xd2, 0xf6,
+	0x91, 0xde, 0x2b, 0x52, 0x3f, 0x85, 0x9b, 0xdf, 0xc1, 0x4f, 0xc2, 0xc8, 0xe8, 0xa4, 0x06, 0xbe,
+	0x88, 0xe9, 0xb5, 0x20, 0x1f, 0x80, 0xe9, 0xfe, 0x79, 0xef, 0xde, 0xff, 0xf7, 0xcf, 0xdd, 0x33,
+	0x5b, 0x31, 0x63, 0x49, 0x4e, 0x91, 0x4d, 0x78, 0xfa, 0xcc, 0x39, 0x9d, 0x75, 0xb7, 0x9a, 0x4c,
+	0x53, 0x40, 0xb0, 0xea, 0xea, 0x06, 0xd9, 0x56, 0x67, 0xdd, 0x86, 0xe3, 0x83, 0x8c, 0x41, 0x52,
+	0x8f, 0xc9, 0x62, 0xc2, 0xe3, 0xc8, 0xba, 0xd4, 0x07, 0x91, 0x94, 0x23, 0x8d, 0xd3, 0x10, 0x42,
+	0x50, 0x92, 0x16, 0xaa, 0xac, 0x5e, 0x7c, 0x18, 0x66, 0x7d, 0x28, 0x24, 0xa6, 0xc2, 0xcb, 0x90,
+	0x07, 0x8f, 0x80, 0x2c, 0x92, 0xd6, 0x8b, 0x69, 0x22, 0xb8, 0x52, 0xd9, 0x4b, 0x5b, 0x6f, 0x19,
+	0xed, 0xe3, 0xde, 0x39, 0x29, 0x01, 0xa4, 0x00, 0x90, 0x0a, 0x40, 0x06, 0x20, 0x92, 0xfe, 0xe5,
+	0xe2, 0xbb, 0xa9, 0x7d, 0xfe, 0x34, 0xdb, 0xa1, 0xc0, 0x71, 0xe6, 0x11, 0x1f, 0x62, 0x5a, 0xa5,
+	0x29, 0x8f, 0x8e, 0x0c, 0x26, 0x14, 0xf3, 0x29, 0x97, 0x6a, 0x40, 0x8e, 0x8e, 0x10, 0x1e, 0x4a,
+	0x77, 0xeb, 0xd5, 0xac, 0x23, 0xb8, 0x3e, 0xc4, 0x71, 0x96, 0x08, 0xcc, 0xdd, 0x29, 0x40, 0x64,
+	0x1f, 0xec, 0x1f, 0x79, 0x82, 0x30, 0xd8, 0x40, 0xee, 0x01, 0x22, 0xcb, 0x37, 0x6b, 0x5e, 0x96,
+	0x26, 0x3c, 0xb0, 0x8d, 0xfd, 0xd3, 0x2a, 0x6b, 0xeb, 0xc6, 0xb4, 0x23, 0x26, 0xd1, 0x0d, 0x36,
+	0x6f, 0x2c, 0x20, 0x71, 0xc7, 0x5c, 0x84, 0x63, 0xb4, 0x0f, 0x5b, 0x7a, 0xdb, 0x18, 0x9d, 0x15,
+	0xfd, 0xe1, 0x4e, 0xfb, 0x56, 0x75, 0xfb, 0x77, 0x8b, 0x95, 0xa3, 0x2f, 0x57, 0x8e, 0xfe, 0xbb,
+	0x72, 0xf4, 0xf7, 0xb5, 0xa3, 0x2d, 0xd7, 0x8e, 0xf6, 0xb5, 0x76, 0xb4, 0xa7, 0xde, 0x4e, 0x0a,
+	0xb5, 0x07, 0x9d, 0x79, 0xfe, 0x56, 0xa9, 0x80, 0xcf, 0xe9, 0xec, 0x9a, 0xce, 0xff, 0x97, 0x47,
+	0xa5, 0xf2, 0x6a, 0xea, 0xbb, 0xaf, 0xfe, 0x06, 0x00, 0xe1, 0x71, 0x9a, 0x66, 0x5b, 0x02, 0x00,
+	0x00,
+}
+
+func (m *DistributedTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributedTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributedTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastDistributionHeight != 0 {
+		i = encodeVarintTakerfee(dAtA, i, uint64(m.LastDistributionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToCommunityPool) > 0 {
+		for iNdEx := len(m.ToCommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToCommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToStakers) > 0 {
+		for iNdEx := len(m.ToStakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToStakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTakerfee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTakerfee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributedTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ToStakers) > 0 {
+		for _, e := range m.ToStakers {
+			l = e.Size()
+			n += 1 + l + sovTakerfee(uint64(l))
+		}
+	}
+	if len(m.ToCommunityPool) > 0 {
+		for _, e := range m.ToCommunityPool {
+			l = e.Size()
+			n += 1 + l + sovTakerfee(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTakerfee(uint64(l))
+		}
+	}
+	if m.LastDistributionHeight != 0 {
+		n += 1 + sovTakerfee(uint64(m.LastDistributionHeight))
+	}
+	return n
+}
+
+func sovTakerfee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTakerfee(x uint64) (n int) {
+	return sovTakerfee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributedTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributedTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributedTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStakers = append(m.ToStakers, types.Coin{})
+			if err := m.ToStakers[len(m.ToStakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCommunityPool = append(m.ToCommunityPool, types.Coin{})
+			if err := m.ToCommunityPool[len(m.ToCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
+			}
+			m.LastDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTakerfee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTakerfee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTakerfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTakerfee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTakerfee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTakerfee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTakerfee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTakerfee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTakerfee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -53,7 +53,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	k.clearTakerFeeShareAccumulators(ctx)
 
 	// Distribute and track the taker fees.
-	k.calculateDistributeAndTrackTakerFees(ctx, defaultFeesDenom)
+	k.calculateDistributeAndTrackTakerFees(ctx)
 
 	return nil
 }
@@ -86,28 +86,30 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 // calculateDistributeAndTrackTakerFees calculates the taker fees and distributes them to the community pool and stakers.
 // The following is the logic for the taker fee distribution:
 //
-// - OSMO taker fees
+// - Taker fees in the poolmanager base denom
 //   - For Community Pool: Sent directly to community pool
 //   - For Stakers: Sent directly to auth module account, which distributes it to stakers
 //
 // - Non native taker fees
 //   - For Community Pool: Sent to `non_native_fee_collector_community_pool` module account, swapped to `CommunityPoolDenomToSwapNonWhitelistedAssetsTo`, then sent to community pool
-//   - For Stakers: Sent to `non_native_fee_collector_stakers` module account, swapped to OSMO, then sent to auth module account, which distributes it to stakers
+//   - For Stakers: Sent to `non_native_fee_collector_stakers` module account, swapped to the base denom, then sent to auth module account, which distributes it to stakers
 //   - The sub-module accounts here are used so that, if a swap fails, the tokens that fail to swap are not grouped back into the wrong taker fee category in the next epoch
-func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFeesDenom string) {
+func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context) {
+	poolManagerParams := k.poolManager.GetParams(ctx)
+	baseDenom := poolManagerParams.BaseDenom
+
 	// First deal with the native tokens in the taker fee collector.
 	takerFeeModuleAccount := k.accountKeeper.GetModuleAddress(txfeestypes.TakerFeeCollectorName)
-	osmoFromTakerFeeModuleAccount := k.bankKeeper.GetBalance(ctx, takerFeeModuleAccount, defaultFeesDenom)
+	osmoFromTakerFeeModuleAccount := k.bankKeeper.GetBalance(ctx, takerFeeModuleAccount, baseDenom)
 
-	poolManagerParams := k.poolManager.GetParams(ctx)
 	takerFeeParams := poolManagerParams.TakerFeeParams
 	osmoTakerFeeDistribution := takerFeeParams.OsmoTakerFeeDistribution
 
 	// Community Pool:
 	if osmoTakerFeeDistribution.CommunityPool.GT(zeroDec) && osmoFromTakerFeeModuleAccount.Amount.GT(osmomath.ZeroInt()) {
-		// Osmo community pool funds are a direct send to the community pool.
+		// Base denom community pool funds are a direct send to the community pool.
 		osmoTakerFeeToCommunityPoolDec := osmoFromTakerFeeModuleAccount.Amount.ToLegacyDec().Mul(osmoTakerFeeDistribution.CommunityPool)
-		osmoTakerFeeToCommunityPoolCoin := sdk.NewCoin(defaultFeesDenom, osmoTakerFeeToCommunityPoolDec.TruncateInt())
+		osmoTakerFeeToCommunityPoolCoin := sdk.NewCoin(baseDenom, osmoTakerFeeToCommunityPoolDec.TruncateInt())
 		applyFuncIfNoErrorAndLog(ctx, func(cacheCtx sdk.Context) error {
 			err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(osmoTakerFeeToCommunityPoolCoin), takerFeeModuleAccount)
 			trackerErr := k.poolManager.UpdateTakerFeeTrackerForCommunityPoolByDenom(ctx, osmoTakerFeeToCommunityPoolCoin.Denom, osmoTakerFeeToCommunityPoolCoin.Amount)
@@ -121,8 +123,8 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 
 	// Staking Rewards:
 	if osmoTakerFeeDistribution.StakingRewards.GT(zeroDec) && osmoFromTakerFeeModuleAccount.Amount.GT(osmomath.ZeroInt()) {
-		// Osmo staking rewards funds are a direct send to the auth fee token collector (indirectly distributing to stakers)
-		osmoTakerFeeToStakingRewardsCoin := sdk.NewCoin(defaultFeesDenom, osmoFromTakerFeeModuleAccount.Amount)
+		// Base denom staking rewards funds are a direct send to the auth fee token collector (indirectly distributing to stakers)
+		osmoTakerFeeToStakingRewardsCoin := sdk.NewCoin(baseDenom, osmoFromTakerFeeModuleAccount.Amount)
 		applyFuncIfNoErrorAndLog(ctx, func(cacheCtx sdk.Context) error {
 			err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, takerFeeModuleAccount, authtypes.FeeCollectorName, sdk.NewCoins(osmoTakerFeeToStakingRewardsCoin))
 			trackerErr := k.poolManager.UpdateTakerFeeTrackerForStakersByDenom(ctx, osmoTakerFeeToStakingRewardsCoin.Denom, osmoTakerFeeToStakingRewardsCoin.Amount)
@@ -145,7 +147,7 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 		// Community Pool:
 		if nonOsmoTakerFeeDistribution.CommunityPool.GT(zeroDec) && takerFeeCoin.Amount.GT(osmomath.ZeroInt()) {
 			denomIsWhitelisted := isDenomWhitelisted(takerFeeCoin.Denom, authorizedQuoteDenoms)
-			// If the non osmo denom is a whitelisted quote asset, we directly send to the community pool
+			// If the non native denom is a whitelisted quote asset, we directly send to the community pool
 			if denomIsWhitelisted {
 				nonOsmoTakerFeeToCommunityPoolDec := takerFeeCoin.Amount.ToLegacyDec().Mul(nonOsmoTakerFeeDistribution.CommunityPool)
 				nonOsmoTakerFeeToCommunityPoolCoin := sdk.NewCoin(takerFeeCoin.Denom, nonOsmoTakerFeeToCommunityPoolDec.TruncateInt())
@@ -161,7 +163,7 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 					return err
 				}, txfeestypes.TakerFeeFailedCommunityPoolUpdateMetricName, nonOsmoTakerFeeToCommunityPoolCoin)
 			} else {
-				// If the non osmo denom is not a whitelisted asset, we track the assets here and later swap everything to the community pool denom.
+				// If the non native denom is not a whitelisted asset, we track the assets here and later swap everything to the community pool denom.
 				nonOsmoTakerFeeToCommunityPoolDec := takerFeeCoin.Amount.ToLegacyDec().Mul(nonOsmoTakerFeeDistribution.CommunityPool)
 				nonOsmoTakerFeeToCommunityPoolCoin := sdk.NewCoin(takerFeeCoin.Denom, nonOsmoTakerFeeToCommunityPoolDec.TruncateInt())
 				nonOsmoForCommunityPool = nonOsmoForCommunityPool.Add(nonOsmoTakerFeeToCommunityPoolCoin)
@@ -206,7 +208,7 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 
 	// Swap the taker fees slated for staking rewards into the base denom.
 	takerFeeStakersModuleAccount := k.accountKeeper.GetModuleAddress(txfeestypes.TakerFeeStakersName)
-	totalCoinOut = k.swapNonNativeFeeToDenom(ctx, defaultFeesDenom, takerFeeStakersModuleAccount)
+	totalCoinOut = k.swapNonNativeFeeToDenom(ctx, baseDenom, takerFeeStakersModuleAccount)
 	if totalCoinOut.Amount.GT(osmomath.ZeroInt()) {
 		// Now that the assets have been swapped, transfer any base denom existing in the taker fee module account to the auth fee collector module account (indirectly distributing to stakers)
 		applyFuncIfNoErrorAndLog(ctx, func(cacheCtx sdk.Context) error {
@@ -235,7 +237,7 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 		}
 
 		// Search for the denom pair route via the protorev store.
-		// Since OSMO is one of the protorev denoms, many of the routes will exist in this store.
+		// Since the base denom is one of the protorev denoms, many of the routes will exist in this store.
 		// There will be times when this store does not know about a route, but this is acceptable
 		// since this will likely be a very small value of a relatively unknown token. If this begins
 		// to accrue more value, we can always manually register the route and it will get swapped in